@@ -6,6 +6,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
 	"github.com/Azure/go-autorest/autorest"
@@ -13,7 +14,7 @@ import (
 	"github.com/giantswarm/microerror"
 
 	"github.com/giantswarm/azure-operator/v5/client/senddecorator"
-	"github.com/giantswarm/azure-operator/v5/pkg/backpressure"
+	"github.com/giantswarm/azure-operator/v5/pkg/ratelimit"
 	"github.com/giantswarm/azure-operator/v5/service/collector"
 )
 
@@ -37,10 +38,17 @@ type AzureClientSet struct {
 	DNSZonesClient *dns.ZonesClient
 	// InterfacesClient manages virtual network interfaces.
 	InterfacesClient *network.InterfacesClient
+	// ManagementLocksClient manages locks preventing the deletion of
+	// resources.
+	ManagementLocksClient *locks.ManagementLocksClient
 	// NatGatewaysClient manages Nat Gateways.
 	NatGatewaysClient *network.NatGatewaysClient
+	// ProvidersClient is used to look up the API versions of resource types.
+	ProvidersClient *resources.ProvidersClient
 	// PublicIpAddressesClient manages public IP addresses.
 	PublicIpAddressesClient *network.PublicIPAddressesClient
+	// ResourcesClient manages resources of any type by their ID.
+	ResourcesClient *resources.Client
 	// ResourceSkusClient manages VM type SKUs.
 	ResourceSkusClient *compute.ResourceSkusClient
 	// SecurityRulesClient manages networking rules in a security group.
@@ -67,7 +75,7 @@ type AzureClientSet struct {
 }
 
 // NewAzureClientSet returns the Azure API clients using the given Authorizer.
-func NewAzureClientSet(clientCredentialsConfig auth.ClientCredentialsConfig, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (*AzureClientSet, error) {
+func NewAzureClientSet(clientCredentialsConfig auth.ClientCredentialsConfig, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string) (*AzureClientSet, error) {
 	authorizer, err := clientCredentialsConfig.Authorizer()
 	if err != nil {
 		return nil, microerror.Mask(err)
@@ -78,83 +86,95 @@ func NewAzureClientSet(clientCredentialsConfig auth.ClientCredentialsConfig, met
 	}
 	partnerID = fmt.Sprintf("pid-%s", partnerID)
 
-	deploymentsClient, err := newDeploymentsClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	deploymentsClient, err := newDeploymentsClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	disksClient, err := newDisksClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	disksClient, err := newDisksClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	dnsRecordSetsClient, err := newDNSRecordSetsClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	dnsRecordSetsClient, err := newDNSRecordSetsClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	dnsZonesClient, err := newDNSZonesClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	dnsZonesClient, err := newDNSZonesClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	groupsClient, err := newGroupsClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	groupsClient, err := newGroupsClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	interfacesClient, err := newInterfacesClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	interfacesClient, err := newInterfacesClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	natGatewaysClient, err := newNatGatewaysClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	managementLocksClient, err := newManagementLocksClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	publicIpAddressesClient, err := newPublicIPAddressesClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	natGatewaysClient, err := newNatGatewaysClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	resourcesSkusClient, err := newResourceSkusClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	providersClient, err := newProvidersClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	securityRulesClient, err := newSecurityRulesClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	publicIpAddressesClient, err := newPublicIPAddressesClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	snapshotsClient, err := newSnapshotsClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	resourcesClient, err := newResourcesClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	storageAccountsClient, err := newStorageAccountsClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	resourcesSkusClient, err := newResourceSkusClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	subnetsClient, err := newSubnetsClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	securityRulesClient, err := newSecurityRulesClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	usageClient, err := newUsageClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	snapshotsClient, err := newSnapshotsClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	virtualNetworkClient, err := newVirtualNetworksClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	storageAccountsClient, err := newStorageAccountsClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	virtualNetworkGatewayConnectionsClient, err := newVirtualNetworkGatewayConnectionsClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	subnetsClient, err := newSubnetsClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	virtualNetworkGatewaysClient, err := newVirtualNetworkGatewaysClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	usageClient, err := newUsageClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	virtualMachineScaleSetVMsClient, err := newVirtualMachineScaleSetVMsClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	virtualNetworkClient, err := newVirtualNetworksClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	virtualMachineScaleSetsClient, err := newVirtualMachineScaleSetsClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	virtualNetworkGatewayConnectionsClient, err := newVirtualNetworkGatewayConnectionsClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	vnetPeeringClient, err := newVnetPeeringClient(authorizer, metricsCollector, subscriptionID, partnerID)
+	virtualNetworkGatewaysClient, err := newVirtualNetworkGatewaysClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	virtualMachineScaleSetVMsClient, err := newVirtualMachineScaleSetVMsClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	virtualMachineScaleSetsClient, err := newVirtualMachineScaleSetsClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	vnetPeeringClient, err := newVnetPeeringClient(authorizer, metricsCollector, rateLimiter, subscriptionID, partnerID)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
@@ -166,15 +186,18 @@ func NewAzureClientSet(clientCredentialsConfig auth.ClientCredentialsConfig, met
 		DNSZonesClient:                         dnsZonesClient,
 		GroupsClient:                           toGroupsClient(groupsClient),
 		InterfacesClient:                       toInterfacesClient(interfacesClient),
+		ManagementLocksClient:                  toManagementLocksClient(managementLocksClient),
 		NatGatewaysClient:                      toNatGatewaysClient(natGatewaysClient),
+		ProvidersClient:                        toProvidersClient(providersClient),
 		PublicIpAddressesClient:                toPublicIPAddressesClient(publicIpAddressesClient),
+		ResourcesClient:                        toResourcesClient(resourcesClient),
 		ResourceSkusClient:                     toResourceSkusClient(resourcesSkusClient),
 		SecurityRulesClient:                    securityRulesClient,
 		SnapshotsClient:                        toSnapshotsClient(snapshotsClient),
 		StorageAccountsClient:                  toStorageAccountsClient(storageAccountsClient),
 		SubnetsClient:                          toSubnetsClient(subnetsClient),
 		SubscriptionID:                         subscriptionID,
-		UsageClient:                            usageClient,
+		UsageClient:                            toUsageClient(usageClient),
 		VirtualNetworkClient:                   toVirtualNetworksClient(virtualNetworkClient),
 		VirtualNetworkGatewayConnectionsClient: virtualNetworkGatewayConnectionsClient,
 		VirtualNetworkGatewaysClient:           virtualNetworkGatewaysClient,
@@ -186,165 +209,197 @@ func NewAzureClientSet(clientCredentialsConfig auth.ClientCredentialsConfig, met
 	return clientSet, nil
 }
 
-func prepareClient(client *autorest.Client, authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, name, subscriptionID, partnerID string) *autorest.Client {
+func prepareClient(client *autorest.Client, authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, name, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) *autorest.Client {
 	client.Authorizer = authorizer
 	_ = client.AddToUserAgent(partnerID)
 	senddecorator.WrapClient(client,
-		// Rate limit circuit breaker should be first so that it shortcuts the
+		// Rate limiter should be first so that it shortcuts and delays the
 		// request before metrics measurements. Otherwise the request metrics
-		// would be skewed by sub-millisecond roundtrips.
-		senddecorator.RateLimitCircuitBreaker(&backpressure.Backpressure{}),
+		// would be skewed by sub-millisecond roundtrips and delays.
+		senddecorator.RateLimiter(rateLimiter, subscriptionID, metricsCollector),
 
 		// Gather metrics from API calls.
 		senddecorator.MetricsDecorator(name, subscriptionID, metricsCollector),
 	)
 
+	// Additional decorators are wrapped first so that they sit right in front
+	// of the HTTP sender and see the requests as they would be sent.
+	senddecorator.WrapClient(client, decorators...)
+
 	return client
 }
 
-func newDeploymentsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (interface{}, error) {
+func newDeploymentsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
 	client := resources.NewDeploymentsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "deployments", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "deployments", subscriptionID, partnerID, decorators...)
+
+	return &client, nil
+}
+
+func newDeploymentOperationsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
+	client := resources.NewDeploymentOperationsClient(subscriptionID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "deployment_operations", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newDisksClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (interface{}, error) {
+func newDisksClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
 	client := compute.NewDisksClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "disks", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "disks", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newDNSRecordSetsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (interface{}, error) {
+func newDNSRecordSetsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
 	client := dns.NewRecordSetsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "dns_record_sets", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "dns_record_sets", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newDNSZonesClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (*dns.ZonesClient, error) {
+func newDNSZonesClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (*dns.ZonesClient, error) {
 	client := dns.NewZonesClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "dns_zones", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "dns_zones", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newGroupsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (interface{}, error) {
+func newGroupsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
 	client := resources.NewGroupsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "groups", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "groups", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newInterfacesClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (interface{}, error) {
+func newInterfacesClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
 	client := network.NewInterfacesClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "interfaces", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "interfaces", subscriptionID, partnerID, decorators...)
+
+	return &client, nil
+}
+
+func newManagementLocksClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
+	client := locks.NewManagementLocksClient(subscriptionID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "management_locks", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newNatGatewaysClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (interface{}, error) {
+func newNatGatewaysClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
 	client := network.NewNatGatewaysClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "nat_gateways", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "nat_gateways", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newNetworkSecurityGroupsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (interface{}, error) {
+func newNetworkSecurityGroupsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
 	client := network.NewSecurityGroupsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "network_security_groups", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "network_security_groups", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newPublicIPAddressesClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (interface{}, error) {
+func newProvidersClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
+	client := resources.NewProvidersClient(subscriptionID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "providers", subscriptionID, partnerID, decorators...)
+
+	return &client, nil
+}
+
+func newPublicIPAddressesClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
 	client := network.NewPublicIPAddressesClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "public_ip_addresses", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "public_ip_addresses", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newSecurityRulesClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (*network.SecurityRulesClient, error) {
+func newSecurityRulesClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (*network.SecurityRulesClient, error) {
 	client := network.NewSecurityRulesClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "security_rules", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "security_rules", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newSnapshotsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (interface{}, error) {
+func newSnapshotsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
 	client := compute.NewSnapshotsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "snapshots", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "snapshots", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newStorageAccountsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (interface{}, error) {
+func newStorageAccountsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
 	client := storage.NewAccountsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "storage_accounts", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "storage_accounts", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newSubnetsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (interface{}, error) {
+func newSubnetsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
 	client := network.NewSubnetsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "subnets", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "subnets", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newUsageClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (*compute.UsageClient, error) {
+func newUsageClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
 	client := compute.NewUsageClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "usage", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "usage", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newVirtualNetworksClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (interface{}, error) {
+func newVirtualNetworksClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
 	client := network.NewVirtualNetworksClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "virtual_networks", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "virtual_networks", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newVirtualNetworkGatewayConnectionsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (*network.VirtualNetworkGatewayConnectionsClient, error) {
+func newVirtualNetworkGatewayConnectionsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (*network.VirtualNetworkGatewayConnectionsClient, error) {
 	client := network.NewVirtualNetworkGatewayConnectionsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "virtual_network_gateway_connections", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "virtual_network_gateway_connections", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newVirtualNetworkGatewaysClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (*network.VirtualNetworkGatewaysClient, error) {
+func newVirtualNetworkGatewaysClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (*network.VirtualNetworkGatewaysClient, error) {
 	client := network.NewVirtualNetworkGatewaysClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "virtual_network_gateways", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "virtual_network_gateways", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newVirtualMachineScaleSetsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (interface{}, error) {
+func newVirtualMachineScaleSetsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
 	client := compute.NewVirtualMachineScaleSetsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "virtual_machine_scale_sets", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "virtual_machine_scale_sets", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newVirtualMachineScaleSetVMsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (interface{}, error) {
+func newVirtualMachineScaleSetVMsClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
 	client := compute.NewVirtualMachineScaleSetVMsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "virtual_machine_scale_set_vms", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "virtual_machine_scale_set_vms", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newVnetPeeringClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (*network.VirtualNetworkPeeringsClient, error) {
+func newVnetPeeringClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (*network.VirtualNetworkPeeringsClient, error) {
 	client := network.NewVirtualNetworkPeeringsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "vnet_peering", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "vnet_peering", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
 
-func newResourceSkusClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, subscriptionID, partnerID string) (interface{}, error) {
+func newResourcesClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
+	client := resources.NewClient(subscriptionID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "resources", subscriptionID, partnerID, decorators...)
+
+	return &client, nil
+}
+
+func newResourceSkusClient(authorizer autorest.Authorizer, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter, subscriptionID, partnerID string, decorators ...autorest.SendDecorator) (interface{}, error) {
 	client := compute.NewResourceSkusClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, metricsCollector, "resource_skus", subscriptionID, partnerID)
+	prepareClient(&client.Client, authorizer, metricsCollector, rateLimiter, "resource_skus", subscriptionID, partnerID, decorators...)
 
 	return &client, nil
 }
@@ -353,6 +408,10 @@ func toDeploymentsClient(client interface{}) *resources.DeploymentsClient {
 	return client.(*resources.DeploymentsClient)
 }
 
+func toDeploymentOperationsClient(client interface{}) *resources.DeploymentOperationsClient {
+	return client.(*resources.DeploymentOperationsClient)
+}
+
 func toDisksClient(client interface{}) *compute.DisksClient {
 	return client.(*compute.DisksClient)
 }
@@ -405,6 +464,22 @@ func toPublicIPAddressesClient(client interface{}) *network.PublicIPAddressesCli
 	return client.(*network.PublicIPAddressesClient)
 }
 
+func toManagementLocksClient(client interface{}) *locks.ManagementLocksClient {
+	return client.(*locks.ManagementLocksClient)
+}
+
+func toProvidersClient(client interface{}) *resources.ProvidersClient {
+	return client.(*resources.ProvidersClient)
+}
+
+func toResourcesClient(client interface{}) *resources.Client {
+	return client.(*resources.Client)
+}
+
 func toResourceSkusClient(client interface{}) *compute.ResourceSkusClient {
 	return client.(*compute.ResourceSkusClient)
 }
+
+func toUsageClient(client interface{}) *compute.UsageClient {
+	return client.(*compute.UsageClient)
+}
@@ -18,6 +18,7 @@ import (
 	gocache "github.com/patrickmn/go-cache"
 
 	"github.com/giantswarm/azure-operator/v5/pkg/credential"
+	"github.com/giantswarm/azure-operator/v5/pkg/ratelimit"
 	"github.com/giantswarm/azure-operator/v5/service/collector"
 )
 
@@ -35,21 +36,33 @@ type FactoryConfig struct {
 	CacheDuration      time.Duration
 	CredentialProvider credential.Provider
 	Logger             micrologger.Logger
+	RateLimiter        *ratelimit.Limiter
+
+	// Authorizer, when set, is used instead of the authorizer created from the
+	// organization credentials. It is meant for tests against a fake Azure API.
+	Authorizer autorest.Authorizer
+	// SendDecorators are added to every created client, closest to the HTTP
+	// sender. They allow recording requests or answering them without a live
+	// subscription.
+	SendDecorators []autorest.SendDecorator
 }
 
 // Factory is creating Azure clients for specified AzureConfig CRs, so basically for specified
 // tenant clusters. All created clients are cached.
 type Factory struct {
+	authorizer         autorest.Authorizer
 	credentialProvider credential.Provider
 	logger             micrologger.Logger
 	metricsCollector   collector.AzureAPIMetrics
 	mutex              sync.Mutex
+	rateLimiter        *ratelimit.Limiter
+	sendDecorators     []autorest.SendDecorator
 
 	// map [credentialName + client type] -> client
 	cachedClients *gocache.Cache
 }
 
-type clientCreatorFunc func(autorest.Authorizer, collector.AzureAPIMetrics, string, string) (interface{}, error)
+type clientCreatorFunc func(autorest.Authorizer, collector.AzureAPIMetrics, *ratelimit.Limiter, string, string, ...autorest.SendDecorator) (interface{}, error)
 
 // NewFactory returns a new Azure client factory that is used throughout entire azure-operator
 // lifetime.
@@ -66,12 +79,18 @@ func NewFactory(config FactoryConfig) (*Factory, error) {
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
+	if config.RateLimiter == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.RateLimiter must not be empty", config)
+	}
 
 	factory := &Factory{
+		authorizer:         config.Authorizer,
 		logger:             config.Logger,
 		credentialProvider: config.CredentialProvider,
 		cachedClients:      gocache.New(config.CacheDuration, 2*config.CacheDuration),
 		metricsCollector:   config.AzureAPIMetrics,
+		rateLimiter:        config.RateLimiter,
+		sendDecorators:     config.SendDecorators,
 	}
 
 	factory.cachedClients.OnEvicted(func(clientKey string, i interface{}) {
@@ -93,6 +112,18 @@ func (f *Factory) GetDeploymentsClient(credentialNamespace, credentialName strin
 	return toDeploymentsClient(client), nil
 }
 
+// GetDeploymentOperationsClient returns DeploymentOperationsClient that is used to inspect the
+// operations of deployments, e.g. to find out why a deployment failed. The client (for specified
+// cluster) is cached after creation, so the same client is returned every time.
+func (f *Factory) GetDeploymentOperationsClient(credentialNamespace, credentialName string) (*resources.DeploymentOperationsClient, error) {
+	client, err := f.getClient(credentialNamespace, credentialName, "DeploymentOperationsClient", newDeploymentOperationsClient)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return toDeploymentOperationsClient(client), nil
+}
+
 // GetDisksClient returns DisksClient that is used for management of virtual disks.
 // The client (for specified cluster) is cached after creation, so the same client
 // is returned every time.
@@ -253,6 +284,17 @@ func (f *Factory) GetResourceSkusClient(credentialNamespace, credentialName stri
 	return toResourceSkusClient(client), nil
 }
 
+// GetUsageClient returns *compute.UsageClient that is used for reading compute quotas and their usage.
+// The created client is cached for the time period specified in the factory config.
+func (f *Factory) GetUsageClient(credentialNamespace, credentialName string) (*compute.UsageClient, error) {
+	client, err := f.getClient(credentialNamespace, credentialName, "UsageClient", newUsageClient)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return toUsageClient(client), nil
+}
+
 func (f *Factory) getClient(credentialNamespace, credentialName string, clientType string, createClient clientCreatorFunc) (interface{}, error) {
 	l := f.logger.With(
 		logLevelLogKey, logLevelDebug,
@@ -289,11 +331,14 @@ func (f *Factory) createClient(credentialNamespace, credentialName string, creat
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	authorizer, err := organizationCredentialsConfig.Authorizer()
-	if err != nil {
-		return nil, microerror.Mask(err)
+	authorizer := f.authorizer
+	if authorizer == nil {
+		authorizer, err = organizationCredentialsConfig.Authorizer()
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
 	}
-	client, err := createClient(authorizer, f.metricsCollector, subscriptionID, partnerID)
+	client, err := createClient(authorizer, f.metricsCollector, f.rateLimiter, subscriptionID, partnerID, f.sendDecorators...)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
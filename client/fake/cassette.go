@@ -0,0 +1,176 @@
+package fake
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/giantswarm/microerror"
+)
+
+// Exchange is a recorded HTTP request to the Azure API together with its
+// response. Request headers are not recorded, so that credentials don't end up
+// in recordings.
+type Exchange struct {
+	Method       string      `json:"method"`
+	URL          string      `json:"url"`
+	RequestBody  string      `json:"requestBody,omitempty"`
+	StatusCode   int         `json:"statusCode"`
+	Header       http.Header `json:"header,omitempty"`
+	ResponseBody string      `json:"responseBody,omitempty"`
+}
+
+// Cassette holds recorded exchanges. Its Recorder records the exchanges of
+// real clients and its Replayer answers requests with the recorded responses,
+// so that whole reconciliation runs can be repeated offline.
+type Cassette struct {
+	Exchanges []Exchange `json:"exchanges"`
+
+	mutex sync.Mutex
+	// replayed marks the exchanges which have been replayed already.
+	replayed []bool
+}
+
+// LoadCassette reads the cassette saved at the given path.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	c := &Cassette{}
+	err = json.Unmarshal(data, c)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return c, nil
+}
+
+// Save writes the cassette to the given path.
+func (c *Cassette) Save(path string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = ioutil.WriteFile(path, data, 0644) // nolint:gosec
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// Recorder returns a send decorator which records every exchange of the
+// decorated sender in the cassette.
+func (c *Cassette) Recorder() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			requestBody, err := readBody(&r.Body)
+			if err != nil {
+				return nil, microerror.Mask(err)
+			}
+
+			resp, err := s.Do(r)
+			if err != nil {
+				return resp, err
+			}
+
+			responseBody, err := readBody(&resp.Body)
+			if err != nil {
+				return nil, microerror.Mask(err)
+			}
+
+			c.mutex.Lock()
+			defer c.mutex.Unlock()
+
+			c.Exchanges = append(c.Exchanges, Exchange{
+				Method:       r.Method,
+				URL:          r.URL.String(),
+				RequestBody:  requestBody,
+				StatusCode:   resp.StatusCode,
+				Header:       resp.Header,
+				ResponseBody: responseBody,
+			})
+
+			return resp, nil
+		})
+	}
+}
+
+// Replayer returns a send decorator which answers every request with the
+// first recorded exchange of the same method and URL that has not been
+// replayed yet. Requests which have not been recorded fail with an
+// unexpectedRequestError.
+func (c *Cassette) Replayer() autorest.SendDecorator {
+	return func(autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(c.replay)
+	}
+}
+
+func (c *Cassette) replay(r *http.Request) (*http.Response, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if len(c.replayed) != len(c.Exchanges) {
+		replayed := make([]bool, len(c.Exchanges))
+		copy(replayed, c.replayed)
+		c.replayed = replayed
+	}
+
+	for i, e := range c.Exchanges {
+		if c.replayed[i] || e.Method != r.Method || e.URL != r.URL.String() {
+			continue
+		}
+
+		c.replayed[i] = true
+
+		header := http.Header{}
+		for k, v := range e.Header {
+			header[k] = v
+		}
+
+		resp := &http.Response{
+			Status:        fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)),
+			StatusCode:    e.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewBufferString(e.ResponseBody)),
+			ContentLength: int64(len(e.ResponseBody)),
+			Request:       r,
+		}
+
+		return resp, nil
+	}
+
+	return nil, microerror.Maskf(unexpectedRequestError, "%s %s has not been recorded", r.Method, r.URL.String())
+}
+
+// readBody reads the given body and replaces it with a copy, so that it can be
+// read again.
+func readBody(body *io.ReadCloser) (string, error) {
+	if *body == nil || *body == http.NoBody {
+		return "", nil
+	}
+
+	data, err := ioutil.ReadAll(*body)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+	_ = (*body).Close()
+
+	*body = ioutil.NopCloser(bytes.NewReader(data))
+
+	return string(data), nil
+}
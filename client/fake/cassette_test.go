@@ -0,0 +1,96 @@
+package fake
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_Cassette_RecordAndReplay(t *testing.T) {
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "cassette")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassette.json")
+
+	var recordedNames []string
+	{
+		server := NewServer()
+		cassette := &Cassette{}
+		factory := newTestFactory(t, server.Decorator(), cassette.Recorder())
+
+		vmssClient, err := factory.GetVirtualMachineScaleSetsClient(testCredentialNamespace, testCredentialName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		vmsClient, err := factory.GetVirtualMachineScaleSetVMsClient(testCredentialNamespace, testCredentialName)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ensureVMSS(ctx, t, vmssClient, newTestVMSS(2, "v1"))
+
+		result, err := vmsClient.List(ctx, testResourceGroup, "nodepool-a1b2c", "", "", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, instance := range result.Values() {
+			recordedNames = append(recordedNames, *instance.Name)
+		}
+
+		if len(cassette.Exchanges) != 2 {
+			t.Fatalf("expected 2 recorded exchanges, got %d", len(cassette.Exchanges))
+		}
+		if cassette.Exchanges[0].RequestBody == "" {
+			t.Fatalf("expected request body of the PUT request to be recorded")
+		}
+
+		err = cassette.Save(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	{
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		factory := newTestFactory(t, cassette.Replayer())
+
+		vmssClient, err := factory.GetVirtualMachineScaleSetsClient(testCredentialNamespace, testCredentialName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		vmsClient, err := factory.GetVirtualMachineScaleSetVMsClient(testCredentialNamespace, testCredentialName)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ensureVMSS(ctx, t, vmssClient, newTestVMSS(2, "v1"))
+
+		result, err := vmsClient.List(ctx, testResourceGroup, "nodepool-a1b2c", "", "", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.Values()) != len(recordedNames) {
+			t.Fatalf("expected %d replayed instances, got %d", len(recordedNames), len(result.Values()))
+		}
+		for i, instance := range result.Values() {
+			if *instance.Name != recordedNames[i] {
+				t.Fatalf("expected replayed instance %#q, got %#q", recordedNames[i], *instance.Name)
+			}
+		}
+
+		// Every exchange is replayed once only.
+		_, err = vmsClient.List(ctx, testResourceGroup, "nodepool-a1b2c", "", "", "")
+		if !IsUnexpectedRequest(err) {
+			t.Fatalf("expected unexpectedRequestError, got %#v", err)
+		}
+	}
+}
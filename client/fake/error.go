@@ -0,0 +1,32 @@
+package fake
+
+import (
+	"github.com/giantswarm/microerror"
+)
+
+var notFoundError = &microerror.Error{
+	Kind: "notFoundError",
+}
+
+// IsNotFound asserts notFoundError.
+func IsNotFound(err error) bool {
+	return microerror.Cause(err) == notFoundError
+}
+
+var unexpectedRequestError = &microerror.Error{
+	Kind: "unexpectedRequestError",
+}
+
+// IsUnexpectedRequest asserts unexpectedRequestError.
+func IsUnexpectedRequest(err error) bool {
+	return microerror.Cause(err) == unexpectedRequestError
+}
+
+var unsupportedRequestError = &microerror.Error{
+	Kind: "unsupportedRequestError",
+}
+
+// IsUnsupportedRequest asserts unsupportedRequestError.
+func IsUnsupportedRequest(err error) bool {
+	return microerror.Cause(err) == unsupportedRequestError
+}
@@ -0,0 +1,672 @@
+// Package fake provides an in-process fake of the Azure Resource Manager API
+// and a recorder to replay HTTP exchanges with the real one. Both plug into
+// SDK clients as autorest send decorators, so handlers can be tested with the
+// clients they get from the client factory without a live subscription.
+package fake
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/giantswarm/microerror"
+)
+
+const (
+	provisioningStateSucceeded = "Succeeded"
+
+	typeDeployments               = "microsoft.resources/deployments"
+	typeStorageAccounts           = "microsoft.storage/storageaccounts"
+	typeVirtualMachineScaleSets   = "microsoft.compute/virtualmachinescalesets"
+	typeVirtualMachineScaleSetVMs = "microsoft.compute/virtualmachinescalesets/virtualmachines"
+)
+
+// Server is a fake Azure Resource Manager API. It keeps resources in memory
+// and answers requests the way ARM does for the resource types the operator
+// manages: deployments, virtual machine scale sets and their instances,
+// subnets, NAT gateways and storage accounts. Any other resource type is
+// stored and returned as it was sent.
+//
+// Deployments are stored but their templates are not evaluated. Tests create
+// the resources a template would create with SetResource.
+type Server struct {
+	mutex     sync.Mutex
+	now       func() time.Time
+	resources map[string]*resource
+	// nextInstanceID holds the ID of the next instance of each scale set.
+	// Instance IDs are not reused, like in ARM.
+	nextInstanceID map[string]int
+}
+
+type resource struct {
+	collection string
+	body       map[string]interface{}
+}
+
+// NewServer returns an empty fake Azure Resource Manager API.
+func NewServer() *Server {
+	return &Server{
+		now:            time.Now,
+		resources:      map[string]*resource{},
+		nextInstanceID: map[string]int{},
+	}
+}
+
+// Decorator returns a send decorator which answers every request with the
+// fake server instead of passing it on. It is meant to be used with
+// senddecorator.WrapClient or client.FactoryConfig.SendDecorators.
+func (s *Server) Decorator() autorest.SendDecorator {
+	return func(autorest.Sender) autorest.Sender {
+		return s
+	}
+}
+
+// Do implements autorest.Sender.
+func (s *Server) Do(r *http.Request) (*http.Response, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var body []byte
+	if r.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(r.Body)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		_ = r.Body.Close()
+	}
+
+	p := parsePath(r.URL.Path)
+
+	if p.isResource() {
+		switch r.Method {
+		case http.MethodGet:
+			return s.get(r, p)
+		case http.MethodPut:
+			return s.put(r, p, body)
+		case http.MethodPatch:
+			return s.patch(r, p, body)
+		case http.MethodDelete:
+			return s.delete(r, p)
+		}
+	} else {
+		switch r.Method {
+		case http.MethodGet:
+			return s.list(r, p)
+		case http.MethodPost:
+			return s.action(r, p, body)
+		}
+	}
+
+	return nil, microerror.Maskf(unsupportedRequestError, "%s %s", r.Method, r.URL.Path)
+}
+
+// SetResource stores the given resource under the given ARM ID, as if it had
+// been created with a PUT request.
+func (s *Server) SetResource(id string, v interface{}) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	body, err := toMap(v)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	p := parsePath(id)
+	if !p.isResource() {
+		return microerror.Maskf(unsupportedRequestError, "%#q is not a resource ID", id)
+	}
+
+	s.store(p, body)
+
+	return nil
+}
+
+// GetResource decodes the resource with the given ARM ID into v.
+func (s *Server) GetResource(id string, v interface{}) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	res, ok := s.resources[parsePath(id).key]
+	if !ok {
+		return microerror.Maskf(notFoundError, "resource %#q", id)
+	}
+
+	data, err := json.Marshal(res.body)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = json.Unmarshal(data, v)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// SetProvisioningState changes the provisioning state of the resource with
+// the given ARM ID, e.g. to simulate a running or failed deployment.
+func (s *Server) SetProvisioningState(id string, state string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	res, ok := s.resources[parsePath(id).key]
+	if !ok {
+		return microerror.Maskf(notFoundError, "resource %#q", id)
+	}
+
+	properties(res.body)["provisioningState"] = state
+
+	return nil
+}
+
+func (s *Server) get(r *http.Request, p armPath) (*http.Response, error) {
+	res, ok := s.resources[p.key]
+	if !ok {
+		return notFound(r, p)
+	}
+
+	return newResponse(r, http.StatusOK, res.body)
+}
+
+func (s *Server) put(r *http.Request, p armPath, body []byte) (*http.Response, error) {
+	desired := map[string]interface{}{}
+	if len(body) > 0 {
+		err := json.Unmarshal(body, &desired)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var current map[string]interface{}
+	if res, ok := s.resources[p.key]; ok {
+		current = res.body
+	}
+
+	switch p.resourceType {
+	case typeDeployments:
+		desired = s.newDeployment(desired)
+	case typeVirtualMachineScaleSets:
+		delete(properties(desired), "provisioningState")
+		s.reconcileInstances(p, current, desired)
+	}
+
+	return newResponse(r, http.StatusOK, s.store(p, desired))
+}
+
+func (s *Server) patch(r *http.Request, p armPath, body []byte) (*http.Response, error) {
+	res, ok := s.resources[p.key]
+	if !ok {
+		return notFound(r, p)
+	}
+
+	update := map[string]interface{}{}
+	err := json.Unmarshal(body, &update)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	desired := map[string]interface{}{}
+	for k, v := range res.body {
+		desired[k] = v
+	}
+	for k, v := range update {
+		if k == "properties" {
+			merged := map[string]interface{}{}
+			for pk, pv := range properties(res.body) {
+				merged[pk] = pv
+			}
+			for pk, pv := range properties(update) {
+				merged[pk] = pv
+			}
+			v = merged
+		}
+		desired[k] = v
+	}
+
+	if p.resourceType == typeVirtualMachineScaleSets {
+		s.reconcileInstances(p, res.body, desired)
+	}
+
+	return newResponse(r, http.StatusOK, s.store(p, desired))
+}
+
+func (s *Server) delete(r *http.Request, p armPath) (*http.Response, error) {
+	if _, ok := s.resources[p.key]; !ok {
+		return newResponse(r, http.StatusNoContent, nil)
+	}
+
+	if p.resourceType == typeVirtualMachineScaleSetVMs {
+		parent := parsePath(p.parent())
+		if vmss, ok := s.resources[parent.key]; ok {
+			setCapacity(vmss.body, capacity(vmss.body)-1)
+		}
+	}
+
+	for k := range s.resources {
+		if strings.HasPrefix(k, p.key+"/") {
+			delete(s.resources, k)
+		}
+	}
+	delete(s.resources, p.key)
+
+	return newResponse(r, http.StatusOK, nil)
+}
+
+func (s *Server) list(r *http.Request, p armPath) (*http.Response, error) {
+	parent := parsePath(p.parent())
+	if parent.resourceType != "" {
+		if _, ok := s.resources[parent.key]; !ok {
+			return notFound(r, parent)
+		}
+	}
+
+	var keys []string
+	for k, res := range s.resources {
+		if res.collection == p.key {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	values := []interface{}{}
+	for _, k := range keys {
+		values = append(values, s.resources[k].body)
+	}
+
+	return newResponse(r, http.StatusOK, map[string]interface{}{"value": values})
+}
+
+func (s *Server) action(r *http.Request, p armPath, body []byte) (*http.Response, error) {
+	parent := parsePath(p.parent())
+	res, ok := s.resources[parent.key]
+	if !ok {
+		return notFound(r, parent)
+	}
+
+	action := strings.ToLower(p.name)
+
+	switch {
+	case parent.resourceType == typeVirtualMachineScaleSets && action == "delete":
+		ids, err := instanceIDs(body)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		for _, id := range ids {
+			k := parent.key + "/virtualmachines/" + strings.ToLower(id)
+			if _, ok := s.resources[k]; ok {
+				delete(s.resources, k)
+				setCapacity(res.body, capacity(res.body)-1)
+			}
+		}
+
+		return newResponse(r, http.StatusOK, nil)
+
+	case parent.resourceType == typeVirtualMachineScaleSets && action == "manualupgrade":
+		ids, err := instanceIDs(body)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		for _, id := range ids {
+			if vm, ok := s.resources[parent.key+"/virtualmachines/"+strings.ToLower(id)]; ok {
+				properties(vm.body)["latestModelApplied"] = true
+			}
+		}
+
+		return newResponse(r, http.StatusOK, nil)
+
+	case parent.resourceType == typeStorageAccounts && action == "listkeys":
+		keys := map[string]interface{}{
+			"keys": []interface{}{
+				map[string]interface{}{
+					"keyName":     "key1",
+					"value":       base64.StdEncoding.EncodeToString([]byte(parent.key)),
+					"permissions": "FULL",
+				},
+			},
+		}
+
+		return newResponse(r, http.StatusOK, keys)
+	}
+
+	return nil, microerror.Maskf(unsupportedRequestError, "%s %s", r.Method, r.URL.Path)
+}
+
+// store saves the given resource body and sets the fields ARM sets on every
+// resource.
+func (s *Server) store(p armPath, body map[string]interface{}) map[string]interface{} {
+	body["id"] = p.id
+	body["name"] = p.name
+	body["type"] = p.typeName
+	if _, ok := properties(body)["provisioningState"]; !ok {
+		properties(body)["provisioningState"] = provisioningStateSucceeded
+	}
+
+	s.resources[p.key] = &resource{
+		collection: strings.ToLower(p.parentCollection()),
+		body:       body,
+	}
+
+	return body
+}
+
+// newDeployment turns the given deployment request into the deployment ARM
+// returns. The parameter types are taken from the template and values of
+// secure parameters are not returned.
+func (s *Server) newDeployment(desired map[string]interface{}) map[string]interface{} {
+	props := properties(desired)
+
+	var templateParameters map[string]interface{}
+	if t, ok := props["template"].(map[string]interface{}); ok {
+		templateParameters, _ = t["parameters"].(map[string]interface{})
+	}
+
+	parameters := map[string]interface{}{}
+	if requested, ok := props["parameters"].(map[string]interface{}); ok {
+		for name, p := range requested {
+			var value interface{}
+			if m, ok := p.(map[string]interface{}); ok {
+				for k, v := range m {
+					if strings.EqualFold(k, "value") {
+						value = v
+					}
+				}
+			}
+
+			parameterType := "String"
+			if tp, ok := templateParameters[name].(map[string]interface{}); ok {
+				if t, ok := tp["type"].(string); ok {
+					parameterType = t
+				}
+			}
+
+			parameter := map[string]interface{}{"type": parameterType}
+			if !strings.HasPrefix(strings.ToLower(parameterType), "secure") {
+				parameter["value"] = value
+			}
+			parameters[name] = parameter
+		}
+	}
+
+	return map[string]interface{}{
+		"properties": map[string]interface{}{
+			"mode":              props["mode"],
+			"parameters":        parameters,
+			"provisioningState": provisioningStateSucceeded,
+			"timestamp":         s.now().UTC().Format(time.RFC3339Nano),
+			"outputs":           map[string]interface{}{},
+		},
+	}
+}
+
+// reconcileInstances creates and removes instances of the given scale set to
+// match its capacity. Existing instances no longer run the latest model when
+// the model of the scale set changed.
+func (s *Server) reconcileInstances(p armPath, current, desired map[string]interface{}) {
+	if current != nil && !reflect.DeepEqual(properties(current)["virtualMachineProfile"], properties(desired)["virtualMachineProfile"]) {
+		for _, vm := range s.instances(p) {
+			properties(vm.body)["latestModelApplied"] = false
+		}
+	}
+
+	instances := s.instances(p)
+	for len(instances) > capacity(desired) {
+		// Scale in removes the newest instances first.
+		last := instances[len(instances)-1]
+		delete(s.resources, parsePath(last.body["id"].(string)).key)
+		instances = instances[:len(instances)-1]
+	}
+
+	var computerNamePrefix string
+	if profile, ok := properties(desired)["virtualMachineProfile"].(map[string]interface{}); ok {
+		if osProfile, ok := profile["osProfile"].(map[string]interface{}); ok {
+			computerNamePrefix, _ = osProfile["computerNamePrefix"].(string)
+		}
+	}
+
+	for i := len(instances); i < capacity(desired); i++ {
+		instanceID := strconv.Itoa(s.nextInstanceID[p.key])
+		s.nextInstanceID[p.key]++
+
+		vm := map[string]interface{}{
+			"instanceId": instanceID,
+			"location":   desired["location"],
+			"sku":        desired["sku"],
+			"properties": map[string]interface{}{
+				"latestModelApplied": true,
+				"osProfile": map[string]interface{}{
+					"computerName": fmt.Sprintf("%s%06s", computerNamePrefix, strconv.FormatInt(int64(s.nextInstanceID[p.key]-1), 36)),
+				},
+			},
+		}
+		if zones, ok := desired["zones"].([]interface{}); ok && len(zones) > 0 {
+			vm["zones"] = []interface{}{zones[(s.nextInstanceID[p.key]-1)%len(zones)]}
+		}
+
+		s.store(parsePath(p.id+"/virtualMachines/"+instanceID), vm)
+		vm["name"] = p.name + "_" + instanceID
+	}
+}
+
+// instances returns the instances of the given scale set ordered by their
+// instance ID.
+func (s *Server) instances(p armPath) []*resource {
+	collection := p.key + "/virtualmachines"
+
+	var instances []*resource
+	for _, res := range s.resources {
+		if res.collection == collection {
+			instances = append(instances, res)
+		}
+	}
+
+	sort.Slice(instances, func(i, j int) bool {
+		a, _ := strconv.Atoi(instances[i].body["instanceId"].(string))
+		b, _ := strconv.Atoi(instances[j].body["instanceId"].(string))
+		return a < b
+	})
+
+	return instances
+}
+
+// armPath is the parsed path of an ARM request. ARM IDs alternate between
+// resource type and resource name segments after the provider namespace, so
+// a path with an odd number of those segments points to a collection or an
+// action of the parent resource.
+type armPath struct {
+	id   string
+	key  string
+	name string
+	// segments are the path segments without the providers keyword and the
+	// provider namespace.
+	segments []string
+	// resourceType is the lower case type of the resource, e.g.
+	// microsoft.compute/virtualmachinescalesets. It is empty for
+	// subscriptions and resource groups.
+	resourceType string
+	typeName     string
+}
+
+func parsePath(path string) armPath {
+	path = "/" + strings.Trim(path, "/")
+
+	var namespace string
+	var segments, types []string
+	{
+		raw := strings.Split(strings.Trim(path, "/"), "/")
+		for i := 0; i < len(raw); i++ {
+			if strings.EqualFold(raw[i], "providers") && i+1 < len(raw) {
+				namespace = raw[i+1]
+				types = nil
+				i++
+				continue
+			}
+			if namespace != "" && (len(segments)%2 == 0) {
+				types = append(types, raw[i])
+			}
+			segments = append(segments, raw[i])
+		}
+	}
+
+	p := armPath{
+		id:       path,
+		key:      strings.ToLower(path),
+		name:     segments[len(segments)-1],
+		segments: segments,
+	}
+
+	if namespace != "" {
+		p.typeName = namespace + "/" + strings.Join(types, "/")
+		p.resourceType = strings.ToLower(p.typeName)
+	}
+
+	return p
+}
+
+func (p armPath) isResource() bool {
+	return len(p.segments)%2 == 0
+}
+
+// parent returns the path without the last segment, e.g. the resource an
+// action is called on.
+func (p armPath) parent() string {
+	parts := strings.Split(p.id, "/")
+	parts = parts[:len(parts)-1]
+
+	// The provider namespace is not part of the resource hierarchy.
+	if len(parts) > 2 && strings.EqualFold(parts[len(parts)-2], "providers") {
+		parts = parts[:len(parts)-2]
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// parentCollection returns the path of the collection the resource is listed
+// in.
+func (p armPath) parentCollection() string {
+	return p.id[:strings.LastIndex(p.id, "/")]
+}
+
+func capacity(body map[string]interface{}) int {
+	if sku, ok := body["sku"].(map[string]interface{}); ok {
+		if c, ok := sku["capacity"].(float64); ok {
+			return int(c)
+		}
+	}
+
+	return 0
+}
+
+func setCapacity(body map[string]interface{}, c int) {
+	sku, ok := body["sku"].(map[string]interface{})
+	if !ok {
+		sku = map[string]interface{}{}
+		body["sku"] = sku
+	}
+
+	if c < 0 {
+		c = 0
+	}
+	sku["capacity"] = float64(c)
+}
+
+func instanceIDs(body []byte) ([]string, error) {
+	var request struct {
+		InstanceIds []string `json:"instanceIds"`
+	}
+
+	if len(body) > 0 {
+		err := json.Unmarshal(body, &request)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	return request.InstanceIds, nil
+}
+
+// properties returns the properties of the given resource body, adding them
+// if missing.
+func properties(body map[string]interface{}) map[string]interface{} {
+	props, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		props = map[string]interface{}{}
+		body["properties"] = props
+	}
+
+	return props
+}
+
+func toMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	m := map[string]interface{}{}
+	err = json.Unmarshal(data, &m)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return m, nil
+}
+
+func notFound(r *http.Request, p armPath) (*http.Response, error) {
+	code := "ResourceNotFound"
+	if p.resourceType == typeDeployments {
+		code = "DeploymentNotFound"
+	} else if p.resourceType == "" {
+		code = "ResourceGroupNotFound"
+	}
+
+	body := map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": fmt.Sprintf("The Resource '%s' was not found.", p.id),
+		},
+	}
+
+	return newResponse(r, http.StatusNotFound, body)
+}
+
+func newResponse(r *http.Request, statusCode int, body interface{}) (*http.Response, error) {
+	var data []byte
+	if body != nil {
+		var err error
+		data, err = json.Marshal(body)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	res := &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{},
+		Body:          ioutil.NopCloser(bytes.NewReader(data)),
+		ContentLength: int64(len(data)),
+		Request:       r,
+	}
+	if body != nil {
+		res.Header.Set("Content-Type", "application/json; charset=utf-8")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,384 @@
+package fake
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/giantswarm/micrologger/microloggertest"
+
+	"github.com/giantswarm/azure-operator/v5/client"
+	"github.com/giantswarm/azure-operator/v5/pkg/ratelimit"
+	"github.com/giantswarm/azure-operator/v5/service/collector"
+)
+
+const (
+	testCredentialNamespace = "giantswarm"
+	testCredentialName      = "credential-default"
+	testResourceGroup       = "c1a2b"
+	testSubscriptionID      = "00000000-0000-0000-0000-000000000000"
+)
+
+func Test_Server_VirtualMachineScaleSets(t *testing.T) {
+	ctx := context.Background()
+	server := NewServer()
+	factory := newTestFactory(t, server.Decorator())
+
+	vmssClient, err := factory.GetVirtualMachineScaleSetsClient(testCredentialNamespace, testCredentialName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vmsClient, err := factory.GetVirtualMachineScaleSetVMsClient(testCredentialNamespace, testCredentialName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = vmsClient.List(ctx, testResourceGroup, "nodepool-a1b2c", "", "", "")
+	if !isNotFound(err) {
+		t.Fatalf("expected 404 listing instances of a missing scale set, got %#v", err)
+	}
+
+	vmss := newTestVMSS(3, "v1")
+	ensureVMSS(ctx, t, vmssClient, vmss)
+	assertInstances(ctx, t, vmsClient, []string{"0", "1", "2"}, []string{"0", "1", "2"})
+
+	// A new model marks the existing instances as outdated, new instances run
+	// the latest model.
+	vmss = newTestVMSS(4, "v2")
+	ensureVMSS(ctx, t, vmssClient, vmss)
+	assertInstances(ctx, t, vmsClient, []string{"0", "1", "2", "3"}, []string{"3"})
+
+	instanceIDs := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+		InstanceIds: to.StringSlicePtr([]string{"0", "2"}),
+	}
+	res, err := vmssClient.DeleteInstances(ctx, testResourceGroup, "nodepool-a1b2c", instanceIDs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = vmssClient.DeleteInstancesResponder(res.Response())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertInstances(ctx, t, vmsClient, []string{"1", "3"}, []string{"3"})
+
+	// Instance IDs are not reused when scaling out again.
+	vmss = newTestVMSS(3, "v2")
+	ensureVMSS(ctx, t, vmssClient, vmss)
+	assertInstances(ctx, t, vmsClient, []string{"1", "3", "4"}, []string{"3", "4"})
+
+	current, err := vmssClient.Get(ctx, testResourceGroup, "nodepool-a1b2c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *current.Sku.Capacity != 3 {
+		t.Fatalf("expected capacity 3, got %d", *current.Sku.Capacity)
+	}
+	if *current.ProvisioningState != "Succeeded" {
+		t.Fatalf("expected provisioning state Succeeded, got %#q", *current.ProvisioningState)
+	}
+
+	vm, err := vmsClient.Get(ctx, testResourceGroup, "nodepool-a1b2c", "4", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *vm.Name != "nodepool-a1b2c_4" {
+		t.Fatalf("expected instance name %#q, got %#q", "nodepool-a1b2c_4", *vm.Name)
+	}
+	if *vm.OsProfile.ComputerName != "nodepool-a1b2c-000004" {
+		t.Fatalf("expected computer name %#q, got %#q", "nodepool-a1b2c-000004", *vm.OsProfile.ComputerName)
+	}
+}
+
+func Test_Server_Deployments(t *testing.T) {
+	ctx := context.Background()
+	server := NewServer()
+	server.now = func() time.Time { return time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC) }
+	factory := newTestFactory(t, server.Decorator())
+
+	deploymentsClient, err := factory.GetDeploymentsClient(testCredentialNamespace, testCredentialName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = deploymentsClient.Get(ctx, testResourceGroup, "nodepool-a1b2c-deploy")
+	if !isNotFound(err) {
+		t.Fatalf("expected 404 for a missing deployment, got %#v", err)
+	}
+
+	deployment := resources.Deployment{
+		Properties: &resources.DeploymentProperties{
+			Mode: resources.Incremental,
+			Template: map[string]interface{}{
+				"parameters": map[string]interface{}{
+					"vmssName":     map[string]interface{}{"type": "string"},
+					"vmCustomData": map[string]interface{}{"type": "securestring"},
+				},
+			},
+			Parameters: map[string]interface{}{
+				"vmssName":     struct{ Value interface{} }{Value: "nodepool-a1b2c"},
+				"vmCustomData": struct{ Value interface{} }{Value: "secret"},
+			},
+		},
+	}
+
+	res, err := deploymentsClient.CreateOrUpdate(ctx, testResourceGroup, "nodepool-a1b2c-deploy", deployment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = deploymentsClient.CreateOrUpdateResponder(res.Response())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	current, err := deploymentsClient.Get(ctx, testResourceGroup, "nodepool-a1b2c-deploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *current.Properties.ProvisioningState != "Succeeded" {
+		t.Fatalf("expected provisioning state Succeeded, got %#q", *current.Properties.ProvisioningState)
+	}
+	if current.Properties.Timestamp.Year() != 2020 {
+		t.Fatalf("expected timestamp of the fake clock, got %s", current.Properties.Timestamp)
+	}
+
+	parameters := current.Properties.Parameters.(map[string]interface{})
+	if parameters["vmssName"].(map[string]interface{})["value"] != "nodepool-a1b2c" {
+		t.Fatalf("expected parameter vmssName to be returned, got %#v", parameters["vmssName"])
+	}
+	if _, ok := parameters["vmCustomData"].(map[string]interface{})["value"]; ok {
+		t.Fatalf("expected secure parameter vmCustomData not to be returned, got %#v", parameters["vmCustomData"])
+	}
+
+	err = server.SetProvisioningState(*current.ID, "Failed")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	current, err = deploymentsClient.Get(ctx, testResourceGroup, "nodepool-a1b2c-deploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *current.Properties.ProvisioningState != "Failed" {
+		t.Fatalf("expected provisioning state Failed, got %#q", *current.Properties.ProvisioningState)
+	}
+}
+
+func Test_Server_Network(t *testing.T) {
+	ctx := context.Background()
+	server := NewServer()
+	factory := newTestFactory(t, server.Decorator())
+
+	vnetID := "/subscriptions/" + testSubscriptionID + "/resourceGroups/" + testResourceGroup + "/providers/Microsoft.Network/virtualNetworks/" + testResourceGroup + "-VirtualNetwork"
+	err := server.SetResource(vnetID, network.VirtualNetwork{Location: to.StringPtr("westeurope")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subnetsClient, err := factory.GetSubnetsClient(testCredentialNamespace, testCredentialName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	natGatewaysClient, err := factory.GetNatGatewaysClient(testCredentialNamespace, testCredentialName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, name := range []string{"a1b2c", "d3e4f"} {
+		subnet := network.Subnet{
+			SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+				AddressPrefix: to.StringPtr("10.1." + strconv.Itoa(i) + ".0/24"),
+			},
+		}
+		res, err := subnetsClient.CreateOrUpdate(ctx, testResourceGroup, testResourceGroup+"-VirtualNetwork", name, subnet)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = subnetsClient.CreateOrUpdateResponder(res.Response())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	subnets, err := subnetsClient.List(ctx, testResourceGroup, testResourceGroup+"-VirtualNetwork")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subnets.Values()) != 2 {
+		t.Fatalf("expected 2 subnets, got %d", len(subnets.Values()))
+	}
+
+	res, err := natGatewaysClient.CreateOrUpdate(ctx, testResourceGroup, "workers-nat-gw", network.NatGateway{Location: to.StringPtr("westeurope")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = natGatewaysClient.CreateOrUpdateResponder(res.Response())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	natGateways, err := natGatewaysClient.List(ctx, testResourceGroup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(natGateways.Values()) != 1 || *natGateways.Values()[0].Name != "workers-nat-gw" {
+		t.Fatalf("expected NAT gateway workers-nat-gw, got %#v", natGateways.Values())
+	}
+
+	deleted, err := subnetsClient.Delete(ctx, testResourceGroup, testResourceGroup+"-VirtualNetwork", "a1b2c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = subnetsClient.DeleteResponder(deleted.Response())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = subnetsClient.Get(ctx, testResourceGroup, testResourceGroup+"-VirtualNetwork", "a1b2c", "")
+	if !isNotFound(err) {
+		t.Fatalf("expected 404 for a deleted subnet, got %#v", err)
+	}
+}
+
+func Test_Server_StorageAccountKeys(t *testing.T) {
+	ctx := context.Background()
+	server := NewServer()
+	factory := newTestFactory(t, server.Decorator())
+
+	storageAccountsClient, err := factory.GetStorageAccountsClient(testCredentialNamespace, testCredentialName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = storageAccountsClient.ListKeys(ctx, testResourceGroup, "gssatjb62", "")
+	if !isNotFound(err) {
+		t.Fatalf("expected 404 for a missing storage account, got %#v", err)
+	}
+
+	id := "/subscriptions/" + testSubscriptionID + "/resourceGroups/" + testResourceGroup + "/providers/Microsoft.Storage/storageAccounts/gssatjb62"
+	err = server.SetResource(id, map[string]interface{}{"location": "westeurope"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := storageAccountsClient.ListKeys(ctx, testResourceGroup, "gssatjb62", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keys.Keys == nil || len(*keys.Keys) != 1 || *(*keys.Keys)[0].Value == "" {
+		t.Fatalf("expected one storage account key, got %#v", keys.Keys)
+	}
+}
+
+func assertInstances(ctx context.Context, t *testing.T, vmsClient *compute.VirtualMachineScaleSetVMsClient, expectedIDs []string, expectedLatestModel []string) {
+	t.Helper()
+
+	result, err := vmsClient.List(ctx, testResourceGroup, "nodepool-a1b2c", "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	latestModel := map[string]bool{}
+	for _, id := range expectedLatestModel {
+		latestModel[id] = true
+	}
+
+	instances := result.Values()
+	if len(instances) != len(expectedIDs) {
+		t.Fatalf("expected %d instances, got %d", len(expectedIDs), len(instances))
+	}
+
+	for i, instance := range instances {
+		if *instance.InstanceID != expectedIDs[i] {
+			t.Fatalf("expected instance %d to have ID %#q, got %#q", i, expectedIDs[i], *instance.InstanceID)
+		}
+		if *instance.LatestModelApplied != latestModel[*instance.InstanceID] {
+			t.Fatalf("expected instance %#q to have latest model applied %t, got %t", *instance.InstanceID, latestModel[*instance.InstanceID], *instance.LatestModelApplied)
+		}
+	}
+}
+
+func ensureVMSS(ctx context.Context, t *testing.T, vmssClient *compute.VirtualMachineScaleSetsClient, vmss compute.VirtualMachineScaleSet) {
+	t.Helper()
+
+	res, err := vmssClient.CreateOrUpdate(ctx, testResourceGroup, "nodepool-a1b2c", vmss)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = vmssClient.CreateOrUpdateResponder(res.Response())
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func isNotFound(err error) bool {
+	detailed, ok := err.(autorest.DetailedError)
+	return ok && detailed.StatusCode == http.StatusNotFound
+}
+
+func newTestVMSS(capacity int64, customData string) compute.VirtualMachineScaleSet {
+	return compute.VirtualMachineScaleSet{
+		Location: to.StringPtr("westeurope"),
+		Sku: &compute.Sku{
+			Name:     to.StringPtr("Standard_D4s_v3"),
+			Capacity: to.Int64Ptr(capacity),
+		},
+		VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
+			VirtualMachineProfile: &compute.VirtualMachineScaleSetVMProfile{
+				OsProfile: &compute.VirtualMachineScaleSetOSProfile{
+					ComputerNamePrefix: to.StringPtr("nodepool-a1b2c-"),
+					CustomData:         to.StringPtr(customData),
+				},
+			},
+		},
+	}
+}
+
+func newTestFactory(t *testing.T, decorators ...autorest.SendDecorator) *client.Factory {
+	t.Helper()
+
+	logger := microloggertest.New()
+
+	metricsCollector, err := collector.NewAzureAPIMetricsCollector(collector.Config{Logger: logger})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rateLimiter, err := ratelimit.New(ratelimit.Config{
+		MaxDelay:   ratelimit.DefaultMaxDelay,
+		Thresholds: ratelimit.DefaultThresholds,
+		TTL:        ratelimit.DefaultTTL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	factory, err := client.NewFactory(client.FactoryConfig{
+		AzureAPIMetrics:    metricsCollector,
+		CacheDuration:      5 * time.Minute,
+		CredentialProvider: testCredentialProvider{},
+		Logger:             logger,
+		RateLimiter:        rateLimiter,
+
+		Authorizer:     autorest.NullAuthorizer{},
+		SendDecorators: decorators,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return factory
+}
+
+type testCredentialProvider struct{}
+
+func (p testCredentialProvider) GetOrganizationAzureCredentials(ctx context.Context, credentialNamespace, credentialName string) (auth.ClientCredentialsConfig, string, string, error) {
+	return auth.ClientCredentialsConfig{}, testSubscriptionID, "", nil
+}
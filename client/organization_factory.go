@@ -27,6 +27,7 @@ const (
 
 type Interface interface {
 	GetDeploymentsClient(ctx context.Context, objectMeta v1.ObjectMeta) (*resources.DeploymentsClient, error)
+	GetDeploymentOperationsClient(ctx context.Context, objectMeta v1.ObjectMeta) (*resources.DeploymentOperationsClient, error)
 	GetDisksClient(ctx context.Context, objectMeta v1.ObjectMeta) (*compute.DisksClient, error)
 	GetGroupsClient(ctx context.Context, objectMeta v1.ObjectMeta) (*resources.GroupsClient, error)
 	GetInterfacesClient(ctx context.Context, objectMeta v1.ObjectMeta) (*network.InterfacesClient, error)
@@ -39,6 +40,7 @@ type Interface interface {
 	GetSubnetsClient(ctx context.Context, objectMeta v1.ObjectMeta) (*network.SubnetsClient, error)
 	GetNatGatewaysClient(ctx context.Context, objectMeta v1.ObjectMeta) (*network.NatGatewaysClient, error)
 	GetResourceSkusClient(ctx context.Context, objectMeta v1.ObjectMeta) (*compute.ResourceSkusClient, error)
+	GetUsageClient(ctx context.Context, objectMeta v1.ObjectMeta) (*compute.UsageClient, error)
 }
 
 type OrganizationFactoryConfig struct {
@@ -70,6 +72,15 @@ func (f *OrganizationFactory) GetDeploymentsClient(ctx context.Context, objectMe
 	return f.factory.GetDeploymentsClient(credentialSecret.Namespace, credentialSecret.Name)
 }
 
+func (f *OrganizationFactory) GetDeploymentOperationsClient(ctx context.Context, objectMeta v1.ObjectMeta) (*resources.DeploymentOperationsClient, error) {
+	credentialSecret, err := f.getCredentialSecret(ctx, objectMeta)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return f.factory.GetDeploymentOperationsClient(credentialSecret.Namespace, credentialSecret.Name)
+}
+
 func (f *OrganizationFactory) GetDisksClient(ctx context.Context, objectMeta v1.ObjectMeta) (*compute.DisksClient, error) {
 	credentialSecret, err := f.getCredentialSecret(ctx, objectMeta)
 	if err != nil {
@@ -178,6 +189,15 @@ func (f *OrganizationFactory) GetResourceSkusClient(ctx context.Context, objectM
 	return f.factory.GetResourceSkusClient(credentialSecret.Namespace, credentialSecret.Name)
 }
 
+func (f *OrganizationFactory) GetUsageClient(ctx context.Context, objectMeta v1.ObjectMeta) (*compute.UsageClient, error) {
+	credentialSecret, err := f.getCredentialSecret(ctx, objectMeta)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return f.factory.GetUsageClient(credentialSecret.Namespace, credentialSecret.Name)
+}
+
 func (f *OrganizationFactory) getCredentialSecret(ctx context.Context, objectMeta v1.ObjectMeta) (*v1alpha1.CredentialSecret, error) {
 	f.logger.Debugf(ctx, "finding credential secret")
 
@@ -0,0 +1,102 @@
+package senddecorator
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/giantswarm/microerror"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/ratelimit"
+	"github.com/giantswarm/azure-operator/v5/service/collector"
+)
+
+const (
+	// tooManyRequestsRetries is the number of times a request is sent again
+	// after ARM responded HTTP 429 Too Many Requests.
+	tooManyRequestsRetries = 2
+	// maxRetryAfter is the longest time a request waits for ARM to lift the
+	// rate limit before it is sent again. Requests which would have to wait
+	// longer fail, so that they are retried with the next reconciliation.
+	maxRetryAfter = 30 * time.Second
+)
+
+// RateLimiter delays requests using the given limiter, which is shared by all
+// clients, when the remaining ARM quota of the subscription runs low. While
+// ARM responds HTTP 429 Too Many Requests for the subscription, requests wait
+// until the time given in the Retry-After header and are sent again when it is
+// close enough, otherwise they are short-circuited. The remaining quota is
+// exposed as a metric.
+func RateLimiter(limiter *ratelimit.Limiter, subscriptionID string, metricsCollector collector.AzureAPIMetrics) autorest.SendDecorator {
+	remainingOpts := prometheus.Opts{Namespace: metricsNamespace, Name: "ratelimit_remaining", Help: "Remaining ARM requests of the subscription before being rate limited"}
+	delayOpts := prometheus.Opts{Namespace: metricsNamespace, Name: "ratelimit_delay", Help: "Delay of API calls to stay within the ARM rate limits"}
+
+	labelNames := []string{"operation", "subscription_id"}
+
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			class := ratelimit.ClassOf(r.Method)
+			labels := prometheus.Labels{
+				"operation":       string(class),
+				"subscription_id": subscriptionID,
+			}
+
+			send := func(r *http.Request) (*http.Response, error) {
+				metricsCollector.GetHistogramVec(delayOpts, labelNames).With(labels).Observe(limiter.Delay(subscriptionID, class).Seconds())
+
+				// Check if we can proceed with request. If not, short-circuit
+				// here.
+				err := limiter.Wait(r.Context(), subscriptionID, class)
+				if err != nil {
+					return nil, microerror.Mask(err)
+				}
+
+				// Pass the request to next SendDecorator.
+				resp, err := s.Do(r)
+
+				// Track the remaining quota and check if rate-limiting has
+				// kicked in.
+				limitErr := limiter.Update(subscriptionID, class, resp)
+
+				remaining, ok := limiter.Remaining(subscriptionID, class)
+				if ok {
+					metricsCollector.GetGaugeVec(remainingOpts, labelNames).With(labels).Set(float64(remaining))
+				}
+
+				if limitErr != nil {
+					return nil, microerror.Mask(limitErr)
+				}
+
+				return resp, err
+			}
+
+			// The request body is rewound for every attempt.
+			rr := autorest.NewRetriableRequest(r)
+			for attempt := 0; ; attempt++ {
+				err := rr.Prepare()
+				if err != nil {
+					return nil, microerror.Mask(err)
+				}
+
+				resp, err := send(rr.Request())
+				if !ratelimit.IsTooManyRequests(err) || attempt >= tooManyRequestsRetries {
+					return resp, err
+				}
+
+				wait := time.Until(limiter.RetryAfter(subscriptionID, class))
+				if wait > maxRetryAfter {
+					return resp, err
+				}
+
+				t := time.NewTimer(wait)
+				select {
+				case <-r.Context().Done():
+					t.Stop()
+					return nil, microerror.Mask(r.Context().Err())
+				case <-t.C:
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package senddecorator
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/giantswarm/micrologger/microloggertest"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/ratelimit"
+	"github.com/giantswarm/azure-operator/v5/service/collector"
+)
+
+func Test_RateLimiter(t *testing.T) {
+	elapsed := time.Now().Add(-time.Second).UTC().Format(http.TimeFormat)
+
+	testCases := []struct {
+		name          string
+		retryAfters   []string
+		expectedCalls int
+		errorMatcher  func(error) bool
+	}{
+		{
+			name:          "case 0: successful request is sent once",
+			retryAfters:   []string{},
+			expectedCalls: 1,
+		},
+		{
+			name:          "case 1: rate limited request is sent again after Retry-After",
+			retryAfters:   []string{"1"},
+			expectedCalls: 2,
+		},
+		{
+			name:          "case 2: rate limited request fails when Retry-After is too far away",
+			retryAfters:   []string{"100"},
+			expectedCalls: 1,
+			errorMatcher:  ratelimit.IsTooManyRequests,
+		},
+		{
+			name:          "case 3: rate limited request fails after the last retry",
+			retryAfters:   []string{elapsed, elapsed, elapsed},
+			expectedCalls: 3,
+			errorMatcher:  ratelimit.IsTooManyRequests,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			metricsCollector, err := collector.NewAzureAPIMetricsCollector(collector.Config{Logger: microloggertest.New()})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			limiter, err := ratelimit.New(ratelimit.Config{
+				MaxDelay:   ratelimit.DefaultMaxDelay,
+				Thresholds: ratelimit.DefaultThresholds,
+				TTL:        ratelimit.DefaultTTL,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var calls int
+			var bodies []string
+			sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+				body, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				bodies = append(bodies, string(body))
+
+				resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Request: r}
+				if calls < len(tc.retryAfters) {
+					resp.StatusCode = http.StatusTooManyRequests
+					resp.Header.Set("Retry-After", tc.retryAfters[calls])
+				}
+				calls++
+
+				return resp, nil
+			})
+
+			r, err := http.NewRequest(http.MethodPut, "https://management.azure.com/", strings.NewReader("body"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = RateLimiter(limiter, "subscription", metricsCollector)(sender).Do(r)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if calls != tc.expectedCalls {
+				t.Fatalf("calls == %d, want %d", calls, tc.expectedCalls)
+			}
+
+			// Every attempt sends the whole body.
+			for _, body := range bodies {
+				if body != "body" {
+					t.Fatalf("body == %#q, want %#q", body, "body")
+				}
+			}
+		})
+	}
+}
@@ -7,14 +7,17 @@ import (
 )
 
 type Azure struct {
-	ClientID        string
-	ClientSecret    string
-	EnvironmentName string
-	HostCluster     hostcluster.HostCluster
-	MSI             msi.MSI
-	Location        string
-	PartnerID       string
-	SubscriptionID  string
-	TenantID        string
-	Template        template.Template
+	ClientID              string
+	ClientSecret          string
+	Connectivity          string
+	EncryptionKeyDelivery string
+	EnvironmentName       string
+	HostCluster           hostcluster.HostCluster
+	InstanceConcurrency   string
+	MSI                   msi.MSI
+	Location              string
+	PartnerID             string
+	SubscriptionID        string
+	TenantID              string
+	Template              template.Template
 }
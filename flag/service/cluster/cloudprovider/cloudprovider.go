@@ -0,0 +1,13 @@
+package cloudprovider
+
+type CloudProvider struct {
+	BackoffDuration      string
+	BackoffExponent      string
+	BackoffJitter        string
+	BackoffRetries       string
+	LoadBalancerSku      string
+	RateLimitBucket      string
+	RateLimitBucketWrite string
+	RateLimitQPS         string
+	RateLimitQPSWrite    string
+}
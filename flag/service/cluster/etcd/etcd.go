@@ -1,7 +1,12 @@
 package etcd
 
+import (
+	"github.com/giantswarm/azure-operator/v5/flag/service/cluster/etcd/snapshots"
+)
+
 type Etcd struct {
-	AltNames string
-	Port     string
-	Prefix   string
+	AltNames  string
+	Port      string
+	Prefix    string
+	Snapshots snapshots.Snapshots
 }
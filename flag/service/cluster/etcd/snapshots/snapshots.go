@@ -0,0 +1,6 @@
+package snapshots
+
+type Snapshots struct {
+	Interval  string
+	Retention string
+}
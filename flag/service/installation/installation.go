@@ -2,11 +2,13 @@ package installation
 
 import (
 	"github.com/giantswarm/azure-operator/v5/flag/service/installation/guest"
+	"github.com/giantswarm/azure-operator/v5/flag/service/installation/sweeper"
 	"github.com/giantswarm/azure-operator/v5/flag/service/installation/tenant"
 )
 
 type Installation struct {
-	Name   string
-	Guest  guest.Guest
-	Tenant tenant.Tenant
+	Name    string
+	Guest   guest.Guest
+	Sweeper sweeper.Sweeper
+	Tenant  tenant.Tenant
 }
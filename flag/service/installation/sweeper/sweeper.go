@@ -0,0 +1,6 @@
+package sweeper
+
+type Sweeper struct {
+	Delete   string
+	Interval string
+}
@@ -1,8 +1,9 @@
 package ignition
 
 type Ignition struct {
-	Debug Debug
-	Path  string
+	Debug       Debug
+	Path        string
+	SpecVersion string
 }
 
 type Debug struct {
@@ -120,6 +120,9 @@ func mainError() error {
 	daemonCommand.PersistentFlags().String(f.Service.Azure.SubscriptionID, "", "ID of the Azure Subscription.")
 	daemonCommand.PersistentFlags().String(f.Service.Azure.TenantID, "", "ID of the Active Directory Tenant.")
 	daemonCommand.PersistentFlags().Bool(f.Service.Azure.MSI.Enabled, true, "Whether to enabled Managed Service Identity (MSI).")
+	daemonCommand.PersistentFlags().String(f.Service.Azure.Connectivity, "vpn", "How the control plane connects to guest clusters, either \"vpn\" for a VPN gateway per guest cluster or \"vnetpeering\" for peerings between the host cluster and guest cluster virtual networks.")
+	daemonCommand.PersistentFlags().String(f.Service.Azure.EncryptionKeyDelivery, "keyvault", "How the certificate encryption key is delivered to nodes, either \"keyvault\" or \"customdata\". Key Vault delivery requires Managed Service Identity (MSI), without it the key is written into the VMSS custom data.")
+	daemonCommand.PersistentFlags().Int(f.Service.Azure.InstanceConcurrency, 3, "Number of VMSS instances operated on in parallel, e.g. when updating, reimaging or terminating instances.")
 	daemonCommand.PersistentFlags().String(f.Service.Azure.HostCluster.CIDR, "10.0.0.0/16", "CIDR of the host cluster virtual network used to create a peering.")
 	daemonCommand.PersistentFlags().String(f.Service.Azure.HostCluster.ResourceGroup, "", "Host cluster resource group name.")
 	daemonCommand.PersistentFlags().String(f.Service.Azure.HostCluster.Tenant.TenantID, "", "Tenant ID used for the Control Plane cluster.")
@@ -134,12 +137,24 @@ func mainError() error {
 	daemonCommand.PersistentFlags().Int(f.Service.Cluster.Calico.MTU, 1500, "Calico MTU of guest clusters.")
 	daemonCommand.PersistentFlags().String(f.Service.Cluster.Calico.Subnet, "", "Calico subnet of guest clusters.")
 
+	daemonCommand.PersistentFlags().Int(f.Service.Cluster.CloudProvider.BackoffDuration, 6, "Seconds the Azure cloud provider of guest clusters waits before retrying a request.")
+	daemonCommand.PersistentFlags().Float64(f.Service.Cluster.CloudProvider.BackoffExponent, 1.5, "Exponent of the backoff of the Azure cloud provider of guest clusters.")
+	daemonCommand.PersistentFlags().Float64(f.Service.Cluster.CloudProvider.BackoffJitter, 1, "Jitter of the backoff of the Azure cloud provider of guest clusters.")
+	daemonCommand.PersistentFlags().Int(f.Service.Cluster.CloudProvider.BackoffRetries, 6, "Number of retries of the Azure cloud provider of guest clusters.")
+	daemonCommand.PersistentFlags().String(f.Service.Cluster.CloudProvider.LoadBalancerSku, "standard", "SKU of the load balancers created by the Azure cloud provider of guest clusters.")
+	daemonCommand.PersistentFlags().Int(f.Service.Cluster.CloudProvider.RateLimitBucket, 10, "Bucket size of the read rate limit of the Azure cloud provider of guest clusters.")
+	daemonCommand.PersistentFlags().Int(f.Service.Cluster.CloudProvider.RateLimitBucketWrite, 10, "Bucket size of the write rate limit of the Azure cloud provider of guest clusters.")
+	daemonCommand.PersistentFlags().Float64(f.Service.Cluster.CloudProvider.RateLimitQPS, 3, "Read requests per second the Azure cloud provider of guest clusters sends.")
+	daemonCommand.PersistentFlags().Float64(f.Service.Cluster.CloudProvider.RateLimitQPSWrite, 3, "Write requests per second the Azure cloud provider of guest clusters sends.")
+
 	daemonCommand.PersistentFlags().String(f.Service.Cluster.Docker.Daemon.CIDR, "", "CIDR of the Docker daemon bridge configured in guest clusters.")
 	daemonCommand.PersistentFlags().String(f.Service.Cluster.Docker.Daemon.ExtraArgs, "", "Extra args of the Docker daemon configured in guest clusters.")
 
 	daemonCommand.PersistentFlags().String(f.Service.Cluster.Etcd.AltNames, "", "Alternative names for guest cluster Calico certificates.")
 	daemonCommand.PersistentFlags().Int(f.Service.Cluster.Etcd.Port, 0, "Port of guest cluster etcd.")
 	daemonCommand.PersistentFlags().String(f.Service.Cluster.Etcd.Prefix, "", "Prefix of guest cluster etcd.")
+	daemonCommand.PersistentFlags().Duration(f.Service.Cluster.Etcd.Snapshots.Interval, 24*time.Hour, "Interval of the snapshots of the etcd data disks of guest cluster masters, 0 disables them.")
+	daemonCommand.PersistentFlags().Int(f.Service.Cluster.Etcd.Snapshots.Retention, 7, "Number of snapshots of the etcd data disks of guest cluster masters kept.")
 
 	daemonCommand.PersistentFlags().String(f.Service.Cluster.Kubernetes.API.AltNames, "", "Alternative names for guest cluster API certificates.")
 	daemonCommand.PersistentFlags().String(f.Service.Cluster.Kubernetes.API.ClusterIPRange, "", "Service IP range within guest clusters.")
@@ -155,6 +170,8 @@ func mainError() error {
 	daemonCommand.PersistentFlags().String(f.Service.Installation.Guest.IPAM.Network.CIDR, "10.1.0.0/8", "Guest cluster network segment from which IPAM allocates subnets.")
 	daemonCommand.PersistentFlags().Int(f.Service.Installation.Guest.IPAM.Network.SubnetMaskBits, 16, "Number of bits in guest cluster subnet network mask.")
 	daemonCommand.PersistentFlags().String(f.Service.Installation.Name, "", "Installation name for tagging Azure resources.")
+	daemonCommand.PersistentFlags().Bool(f.Service.Installation.Sweeper.Delete, false, "Whether orphaned Azure resources of guest clusters found by the sweeper are deleted instead of only reported.")
+	daemonCommand.PersistentFlags().Duration(f.Service.Installation.Sweeper.Interval, time.Hour, "Interval of the sweeps for orphaned Azure resources of guest clusters, 0 disables them.")
 	daemonCommand.PersistentFlags().String(f.Service.Installation.Tenant.Kubernetes.API.Auth.Provider.OIDC.ClientID, "", "OIDC authorization provider ClientID.")
 	daemonCommand.PersistentFlags().String(f.Service.Installation.Tenant.Kubernetes.API.Auth.Provider.OIDC.IssuerURL, "", "OIDC authorization provider IssuerURL.")
 	daemonCommand.PersistentFlags().String(f.Service.Installation.Tenant.Kubernetes.API.Auth.Provider.OIDC.UsernameClaim, "", "OIDC authorization provider UsernameClaim.")
@@ -170,6 +187,7 @@ func mainError() error {
 	daemonCommand.PersistentFlags().String(f.Service.Tenant.Ignition.Debug.LogsPrefix, "", "Enable services which help debugging ignition.")
 	daemonCommand.PersistentFlags().String(f.Service.Tenant.Ignition.Debug.LogsToken, "", "Enable services which help debugging ignition.")
 	daemonCommand.PersistentFlags().String(f.Service.Tenant.Ignition.Path, "/opt/ignition", "Default path for the ignition base directory.")
+	daemonCommand.PersistentFlags().String(f.Service.Tenant.Ignition.SpecVersion, "2.2.0", "Ignition spec version of the configs rendered for tenant cluster nodes, one of 2.2.0, 3.0.0, 3.1.0 or 3.2.0.")
 	daemonCommand.PersistentFlags().String(f.Service.Tenant.SSH.SSOPublicKey, "", "Public key for trusted SSO CA.")
 	daemonCommand.PersistentFlags().String(f.Service.Sentry.DSN, "", "DSN of the Sentry instance to forward errors to.")
 	daemonCommand.PersistentFlags().String(f.Service.Registry.DockerhubToken, "", "Token used to authenticate/authorize to DockerHub.")
@@ -4,6 +4,191 @@ const (
 	IsMasterUpgrading        = "azure-machine-pool.giantswarm.io/is-master-upgrading"
 	StateMachineCurrentState = "azure-machine-pool.giantswarm.io/state-machine-current-state"
 
+	// StateMachineHistory holds the bounded transition history of the node pool
+	// state machine on the AzureMachinePool CR, serialized as JSON.
+	StateMachineHistory = "azure-machine-pool.giantswarm.io/state-machine-history"
+
+	// StateMachineDeadlines sets the deadlines of the node pool state machine
+	// states on the AzureMachinePool CR, e.g.
+	// "DrainOldWorkerNodes=2h,WaitForWorkersToBecomeReady=45m". States which
+	// are not listed or have a duration of 0 have no deadline.
+	StateMachineDeadlines = "azure-machine-pool.giantswarm.io/state-machine-deadlines"
+
+	// StateMachineResume resumes the node pool state machine after a state
+	// timed out when set to an RFC 3339 timestamp later than the timeout.
+	StateMachineResume = "azure-machine-pool.giantswarm.io/state-machine-resume"
+
+	// RolledBackDeployment holds the fingerprint of the node pool deployment
+	// parameters which were rolled back on the AzureMachinePool CR. The
+	// deployment is not applied again as long as the annotation is set.
+	// Removing it retries the upgrade.
+	RolledBackDeployment = "azure-machine-pool.giantswarm.io/rolled-back-deployment"
+
+	// RollbackInstances holds the IDs of the VMSS instances created from the
+	// rolled back deployment, which are terminated during the rollback.
+	RollbackInstances = "azure-machine-pool.giantswarm.io/rollback-instances"
+
+	// MastersStateMachineHistory holds the bounded transition history of the
+	// masters state machine on the AzureConfig CR, serialized as JSON.
+	MastersStateMachineHistory = "azure-operator.giantswarm.io/masters-state-machine-history"
+
+	// MastersStateMachineDeadlines sets the deadlines of the masters state
+	// machine states on the AzureConfig CR, e.g. "MasterInstancesUpgrading=2h".
+	// States which are not listed or have a duration of 0 have no deadline.
+	MastersStateMachineDeadlines = "azure-operator.giantswarm.io/masters-state-machine-deadlines"
+
+	// MastersStateMachineResume resumes the masters state machine after a state
+	// timed out when set to an RFC 3339 timestamp later than the timeout.
+	MastersStateMachineResume = "azure-operator.giantswarm.io/masters-state-machine-resume"
+
+	// ScaleStrategy selects how the nodes of a node pool are scaled and
+	// replaced when they are rolled, e.g. "batch:5" or "percentage:25". See
+	// scalestrategy.Parse for all supported values.
+	ScaleStrategy = "azure-machine-pool.giantswarm.io/scale-strategy"
+
+	// ScaleBatch marks the tenant cluster nodes of the batch of old nodes
+	// currently being replaced while a node pool is rolled. It is set when the
+	// nodes are cordoned, so that nodes cordoned for other reasons are not
+	// drained and terminated with the batch.
+	ScaleBatch = "azure-machine-pool.giantswarm.io/scale-batch"
+
+	// EncryptionKeyRotation requests a rotation of the certificate encryption
+	// key when set on the AzureConfig CR. The key is rotated whenever the value
+	// of the annotation changes, e.g. by setting it to the current timestamp.
+	EncryptionKeyRotation = "azure-operator.giantswarm.io/encryption-key-rotation"
+
+	// MaxUnhealthy limits the remediation of unhealthy nodes on the Cluster CR.
+	// No node is remediated while more nodes than the given number, e.g. "3",
+	// or percentage of all nodes, e.g. "40%", are not ready.
+	MaxUnhealthy = "azure-operator.giantswarm.io/max-unhealthy"
+
+	// NodeRemediationStep holds the last remediation step taken for an
+	// unhealthy tenant cluster node, e.g. "Reboot", on the Node itself.
+	NodeRemediationStep = "azure-operator.giantswarm.io/remediation-step"
+
+	// NodeRemediationAttempts counts the reimages of an unhealthy master node
+	// on the Node itself. The master is not reimaged anymore once the limit is
+	// reached, removing the annotation allows further reimages.
+	NodeRemediationAttempts = "azure-operator.giantswarm.io/remediation-attempts"
+
+	// CloudProviderConfig overrides the rate limits and backoff of the Azure
+	// cloud provider of a tenant cluster on the AzureCluster CR, e.g.
+	// "rateLimitQPS=10,rateLimitBucket=100". See setting.CloudProvider for
+	// all supported names. Changing it rolls the masters and workers.
+	CloudProviderConfig = "azure-operator.giantswarm.io/cloud-provider-config"
+
+	// DataDiskMountPoints configures where the data disks of a node pool are
+	// mounted on the AzureMachinePool CR, e.g.
+	// "cache=/var/lib/cache,logs=/var/log/archive" for the data disks with the
+	// name suffixes "cache" and "logs". Changing it rolls the nodes.
+	DataDiskMountPoints = "azure-operator.giantswarm.io/data-disk-mount-points"
+
+	// SpotFallbackNodePool names the on-demand node pool of the same cluster
+	// which gets the replicas a spot node pool is missing on its
+	// AzureMachinePool CR, once spot capacity is unavailable for longer than
+	// SpotFallbackAfter.
+	SpotFallbackNodePool = "azure-machine-pool.giantswarm.io/spot-fallback-node-pool"
+
+	// SpotFallbackAfter is the duration spot capacity must be unavailable
+	// before the fallback node pool is scaled up, e.g. "15m". Defaults to 10
+	// minutes.
+	SpotFallbackAfter = "azure-machine-pool.giantswarm.io/spot-fallback-after"
+
+	// SpotCapacityUnavailableSince records when a spot node pool started to
+	// run fewer instances than its minimum number of replicas, as RFC 3339
+	// timestamp on the AzureMachinePool CR.
+	SpotCapacityUnavailableSince = "azure-machine-pool.giantswarm.io/spot-capacity-unavailable-since"
+
+	// SpotFallbackReplicas records the number of replicas added to the
+	// fallback node pool on behalf of a spot node pool, so that they are
+	// removed again once spot capacity is available. It is set on the
+	// MachinePool CR of the fallback node pool, suffixed with "-" and the name
+	// of the spot node pool, and written in the same patch as its replicas.
+	SpotFallbackReplicas = "azure-machine-pool.giantswarm.io/spot-fallback-replicas"
+
+	// UpgradePaused pauses the upgrade of a cluster when set to "true" on the
+	// Cluster CR. No upgrade step is started and the masters and node pools
+	// state machines hold until the annotation is removed.
+	UpgradePaused = "azure-operator.giantswarm.io/upgrade-paused"
+
+	// UpgradeMaintenanceWindows restricts when upgrade steps of a cluster are
+	// started on the Cluster CR, e.g. "0 22 * * 1-5 4h;0 6 * * 0,6 8h" for
+	// four hours from 22:00 UTC on weekdays and eight hours from 06:00 UTC
+	// on weekends. Steps which started already are completed outside of the
+	// windows. See upgradepolicy.ParseWindows for the format.
+	UpgradeMaintenanceWindows = "azure-operator.giantswarm.io/upgrade-maintenance-windows"
+
+	// UpgradeNodePoolOrder lists the names of the MachinePool CRs upgraded
+	// first on the Cluster CR, separated by commas. Node pools which are not
+	// listed follow ordered by name.
+	UpgradeNodePoolOrder = "azure-operator.giantswarm.io/upgrade-node-pool-order"
+
+	// UpgradeMaxParallelNodePools is the number of node pools upgraded at the
+	// same time on the Cluster CR, e.g. "2". Defaults to 1.
+	UpgradeMaxParallelNodePools = "azure-operator.giantswarm.io/upgrade-max-parallel-node-pools"
+
+	// Connectivity selects how the control plane connects to the tenant
+	// cluster on the AzureCluster CR, either "vpn" for a VPN gateway connected
+	// to the host cluster VPN gateway or "vnetpeering" for peerings between
+	// the host and tenant cluster virtual networks. Defaults to the
+	// connectivity of the installation.
+	Connectivity = "azure-operator.giantswarm.io/connectivity"
+
+	// ExistingResourceGroup places the cluster into a resource group created
+	// beforehand on the AzureCluster CR, given by its name in the subscription
+	// of the cluster, e.g. "customer-rg". The resource group must be in the
+	// location of the installation and can't be shared with other clusters.
+	// It is neither created nor deleted by the operator, only the resources it
+	// created are deleted with the cluster.
+	ExistingResourceGroup = "azure-operator.giantswarm.io/existing-resource-group"
+
+	// ExistingVirtualNetwork places the cluster into an existing virtual
+	// network on the AzureCluster CR, given as "<resource group>/<name>" in
+	// the subscription of the cluster, e.g. "network-rg/spoke-vnet". The
+	// network range of the cluster is allocated inside the first address
+	// prefix of the virtual network, next to its existing subnets. The
+	// virtual network can't be shared with other clusters, is neither created
+	// nor deleted by the operator and requires vnet peering connectivity.
+	ExistingVirtualNetwork = "azure-operator.giantswarm.io/existing-virtual-network"
+
+	// EtcdSnapshots overrides how often the etcd data disks of the masters are
+	// snapshotted and how many snapshots are kept on the AzureCluster CR, e.g.
+	// "interval=6h,retention=28". An interval of "0" disables the snapshots.
+	EtcdSnapshots = "azure-operator.giantswarm.io/etcd-snapshots"
+
+	// EtcdSnapshotRestore requests to restore the etcd data disks of the
+	// masters from the snapshots taken at the given time on the AzureCluster
+	// CR, e.g. "20261018120000". The masters are recreated with disks created
+	// from the snapshots of their instances.
+	EtcdSnapshotRestore = "azure-operator.giantswarm.io/etcd-snapshot-restore"
+
+	// EtcdSnapshotRestored records the time of the snapshots the etcd data
+	// disks of the masters were last restored from on the AzureCluster CR, so
+	// that a restore is only done once.
+	EtcdSnapshotRestored = "azure-operator.giantswarm.io/etcd-snapshot-restored"
+
+	// DeletionProtection protects a cluster against deletion when set to
+	// "true" on the AzureCluster CR. The resource group of the cluster gets a
+	// CanNotDelete management lock and deleting the cluster waits until the
+	// annotation is removed. While locked, Azure refuses to delete any
+	// resource of the cluster, so node pools are not deleted and old workers
+	// are not terminated, which is reported with ScopeLocked warning events.
+	DeletionProtection = "azure-operator.giantswarm.io/deletion-protection"
+
+	// Tags holds user-defined tags put on every Azure resource of a tenant
+	// cluster, e.g. "cost-centre=1234,environment=production". It is read
+	// from the Organization, Cluster and AzureCluster CRs, the latter
+	// overriding the former. The tags of the operator can't be overridden.
+	// Changing them doesn't roll the nodes.
+	Tags = "azure-operator.giantswarm.io/tags"
+
+	// IPAMFencingToken holds the fencing token of the IPAM lease the network
+	// ranges were last written with on the AzureConfig and AzureCluster CRs.
+	// Writes with a lower token come from a holder which lost the lease and
+	// are rejected. The annotation has to be removed when the Lease object is
+	// deleted, as its tokens start from 0 again.
+	IPAMFencingToken = "azure-operator.giantswarm.io/ipam-fencing-token"
+
 	// UpgradingToNodePools is set to True during the first cluster upgrade to node pools release.
 	UpgradingToNodePools = "release.giantswarm.io/upgrading-to-node-pools"
 )
@@ -6,11 +6,11 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	capi "sigs.k8s.io/cluster-api/api/v1alpha3"
 	capiconditions "sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-
-	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
 
 const (
@@ -22,34 +22,43 @@ const (
 )
 
 type DeploymentCheckerConfig struct {
-	CtrlClient client.Client
-	Logger     micrologger.Logger
+	CtrlClient    client.Client
+	EventRecorder record.EventRecorder
+	Logger        micrologger.Logger
 }
 
 // Resource ensures that AzureMachinePool Status Conditions are set.
 type DeploymentChecker struct {
-	ctrlClient client.Client
-	logger     micrologger.Logger
+	ctrlClient    client.Client
+	eventRecorder record.EventRecorder
+	logger        micrologger.Logger
 }
 
 func NewDeploymentChecker(config DeploymentCheckerConfig) (*DeploymentChecker, error) {
 	if config.CtrlClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.CtrlClient must not be empty", config)
 	}
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
 
 	r := &DeploymentChecker{
-		ctrlClient: config.CtrlClient,
-		logger:     config.Logger,
+		ctrlClient:    config.CtrlClient,
+		eventRecorder: config.EventRecorder,
+		logger:        config.Logger,
 	}
 
 	return r, nil
 }
 
-func (r *DeploymentChecker) CheckIfDeploymentIsSuccessful(ctx context.Context, deploymentsClient *resources.DeploymentsClient, cr capiconditions.Setter, deploymentName string, conditionType capi.ConditionType) (bool, error) {
-	deployment, err := deploymentsClient.Get(ctx, key.ClusterName(cr), deploymentName)
+// CheckIfDeploymentIsSuccessful sets the given condition according to the
+// provisioning state of the given deployment. When the deployment failed, the
+// errors of its failed operations are put into the condition and an Event.
+func (r *DeploymentChecker) CheckIfDeploymentIsSuccessful(ctx context.Context, deploymentsClient *resources.DeploymentsClient, deploymentOperationsClient *resources.DeploymentOperationsClient, cr capiconditions.Setter, resourceGroupName, deploymentName string, conditionType capi.ConditionType) (bool, error) {
+	deployment, err := deploymentsClient.Get(ctx, resourceGroupName, deploymentName)
 	if IsNotFound(err) {
 		// Deployment has not been found, which means that we still
 		// didn't start deploying it.
@@ -82,7 +91,14 @@ func (r *DeploymentChecker) CheckIfDeploymentIsSuccessful(ctx context.Context, d
 		return true, nil
 	case DeploymentProvisioningStateFailed:
 		// Deployment has failed.
-		r.setProvisioningStateWarningFailed(ctx, cr, deploymentName, conditionType)
+		failures, err := GetDeploymentFailures(ctx, deploymentOperationsClient, resourceGroupName, deploymentName)
+		if err != nil {
+			// The condition is set without the details of the failed
+			// operations then.
+			r.logger.Debugf(ctx, "failed to get the operations of deployment %s: %s", deploymentName, err)
+		}
+
+		r.setProvisioningStateWarningFailed(ctx, cr, deployment, failures, conditionType)
 	default:
 		// Deployment is probably still running.
 		r.setProvisioningStateWarning(ctx, cr, deploymentName, currentProvisioningState, conditionType)
@@ -101,20 +117,28 @@ func isProvisioningStateSet(deployment *resources.DeploymentExtended) bool {
 	return false
 }
 
-func (r *DeploymentChecker) setProvisioningStateWarningFailed(ctx context.Context, cr capiconditions.Setter, deploymentName string, condition capi.ConditionType) {
-	message := "Deployment %s failed, it might succeed after retrying, see Azure portal for more details"
-	messageArgs := deploymentName
+func (r *DeploymentChecker) setProvisioningStateWarningFailed(ctx context.Context, cr capiconditions.Setter, deployment resources.DeploymentExtended, failures []DeploymentFailure, condition capi.ConditionType) {
+	message := FailedDeploymentMessage(deployment, failures)
+	if len(failures) == 0 {
+		message += ", it might succeed after retrying, see Azure portal for more details"
+	}
 	reason := DeploymentProvisioningStatePrefix + DeploymentProvisioningStateFailed
 
+	// The Event is only emitted when the failure changed, not on every
+	// reconciliation.
+	if capiconditions.GetMessage(cr, condition) != message {
+		r.eventRecorder.Event(cr, corev1.EventTypeWarning, DeploymentFailedReason, message)
+	}
+
 	capiconditions.MarkFalse(
 		cr,
 		condition,
 		reason,
 		capi.ConditionSeverityError,
-		message,
-		messageArgs)
+		"%s",
+		message)
 
-	r.logger.Debugf(ctx, message, messageArgs)
+	r.logger.Debugf(ctx, "%s", message)
 }
 
 func (r *DeploymentChecker) setProvisioningStateWarning(ctx context.Context, cr capiconditions.Setter, deploymentName string, currentProvisioningState string, condition capi.ConditionType) {
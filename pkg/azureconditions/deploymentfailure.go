@@ -0,0 +1,228 @@
+package azureconditions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/giantswarm/microerror"
+)
+
+const (
+	// DeploymentFailedReason is the reason of the Events emitted for failed
+	// deployments.
+	DeploymentFailedReason = "DeploymentFailed"
+
+	deploymentResourceType = "Microsoft.Resources/deployments"
+
+	// maxDeploymentFailures is the number of failures put into condition
+	// messages and Events. Further failures are only counted.
+	maxDeploymentFailures = 5
+	// maxNestedDeploymentDepth is how deep the operations of nested
+	// deployments are walked.
+	maxNestedDeploymentDepth = 3
+)
+
+// DeploymentFailure is the error of a failed deployment operation.
+type DeploymentFailure struct {
+	// Code is the innermost error code, e.g. SkuNotAvailable.
+	Code    string
+	Message string
+	// TargetResource is the type and name of the resource the operation
+	// deployed, e.g. Microsoft.Compute/virtualMachineScaleSets/nodepool-a1b2c.
+	TargetResource string
+	// ServiceRequestID is the ID of the request ARM made to the resource
+	// provider for the operation.
+	ServiceRequestID string
+}
+
+func (f DeploymentFailure) String() string {
+	s := f.Code
+	if f.TargetResource != "" {
+		s += " on " + f.TargetResource
+	}
+	if f.Message != "" {
+		s += ": " + f.Message
+	}
+	if f.ServiceRequestID != "" {
+		s += " (request ID " + f.ServiceRequestID + ")"
+	}
+
+	return s
+}
+
+// GetDeploymentFailures walks the operations of the given deployment,
+// including the operations of nested deployments, and returns the innermost
+// errors of the failed ones.
+func GetDeploymentFailures(ctx context.Context, deploymentOperationsClient *resources.DeploymentOperationsClient, resourceGroupName, deploymentName string) ([]DeploymentFailure, error) {
+	failures, err := getDeploymentFailures(ctx, deploymentOperationsClient, resourceGroupName, deploymentName, 0)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return failures, nil
+}
+
+// FailedDeploymentMessage describes why the given deployment failed, for use
+// in condition messages and Events.
+func FailedDeploymentMessage(deployment resources.DeploymentExtended, failures []DeploymentFailure) string {
+	var name string
+	if deployment.Name != nil {
+		name = *deployment.Name
+	}
+
+	message := fmt.Sprintf("Deployment %s failed", name)
+
+	if len(failures) > 0 {
+		var errors []string
+		for i, f := range failures {
+			if i == maxDeploymentFailures {
+				errors = append(errors, fmt.Sprintf("and %d more", len(failures)-maxDeploymentFailures))
+				break
+			}
+			errors = append(errors, f.String())
+		}
+
+		message += ": " + strings.Join(errors, "; ")
+	}
+
+	if deployment.Properties != nil && deployment.Properties.CorrelationID != nil {
+		message += fmt.Sprintf(", correlation ID %s", *deployment.Properties.CorrelationID)
+	}
+
+	return message
+}
+
+func getDeploymentFailures(ctx context.Context, deploymentOperationsClient *resources.DeploymentOperationsClient, resourceGroupName, deploymentName string, depth int) ([]DeploymentFailure, error) {
+	iterator, err := deploymentOperationsClient.ListComplete(ctx, resourceGroupName, deploymentName, nil)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var failures []DeploymentFailure
+	for iterator.NotDone() {
+		operation := iterator.Value()
+
+		err = iterator.NextWithContext(ctx)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		properties := operation.Properties
+		if properties == nil || properties.ProvisioningState == nil || *properties.ProvisioningState != DeploymentProvisioningStateFailed {
+			continue
+		}
+
+		var targetResource string
+		if t := properties.TargetResource; t != nil && t.ResourceType != nil && t.ResourceName != nil {
+			targetResource = *t.ResourceType + "/" + *t.ResourceName
+
+			// The errors of nested deployments are found in their own
+			// operations.
+			if strings.EqualFold(*t.ResourceType, deploymentResourceType) && depth < maxNestedDeploymentDepth {
+				nestedResourceGroupName := resourceGroupName
+				if t.ID != nil {
+					if rg := resourceGroupFromID(*t.ID); rg != "" {
+						nestedResourceGroupName = rg
+					}
+				}
+
+				nested, err := getDeploymentFailures(ctx, deploymentOperationsClient, nestedResourceGroupName, *t.ResourceName, depth+1)
+				if err != nil {
+					return nil, microerror.Mask(err)
+				}
+
+				if len(nested) > 0 {
+					failures = append(failures, nested...)
+					continue
+				}
+			}
+		}
+
+		var serviceRequestID string
+		if properties.ServiceRequestID != nil {
+			serviceRequestID = *properties.ServiceRequestID
+		}
+
+		for _, e := range innermostErrors(properties.StatusMessage) {
+			failures = append(failures, DeploymentFailure{
+				Code:             e.Code,
+				Message:          e.Message,
+				TargetResource:   targetResource,
+				ServiceRequestID: serviceRequestID,
+			})
+		}
+	}
+
+	return failures, nil
+}
+
+// armError is the error format of the status messages of deployment
+// operations. Status messages either are an error or wrap one.
+type armError struct {
+	Code    string     `json:"code"`
+	Message string     `json:"message"`
+	Details []armError `json:"details"`
+	Error   *armError  `json:"error"`
+}
+
+// innermostErrors returns the errors without details found in the given status
+// message of a deployment operation. These carry the actual cause, e.g.
+// SkuNotAvailable, while the outer ones are generic, e.g. DeploymentFailed.
+func innermostErrors(statusMessage interface{}) []armError {
+	if statusMessage == nil {
+		return nil
+	}
+
+	if s, ok := statusMessage.(string); ok {
+		var e armError
+		if json.Unmarshal([]byte(s), &e) != nil {
+			return []armError{{Code: DeploymentProvisioningStateFailed, Message: s}}
+		}
+		return leafErrors(e)
+	}
+
+	data, err := json.Marshal(statusMessage)
+	if err != nil {
+		return nil
+	}
+
+	var e armError
+	err = json.Unmarshal(data, &e)
+	if err != nil {
+		return nil
+	}
+
+	return leafErrors(e)
+}
+
+func leafErrors(e armError) []armError {
+	if e.Error != nil {
+		return leafErrors(*e.Error)
+	}
+
+	var leaves []armError
+	for _, d := range e.Details {
+		leaves = append(leaves, leafErrors(d)...)
+	}
+
+	if len(leaves) == 0 && (e.Code != "" || e.Message != "") {
+		leaves = append(leaves, armError{Code: e.Code, Message: e.Message})
+	}
+
+	return leaves
+}
+
+// resourceGroupFromID returns the resource group of the given resource ID.
+func resourceGroupFromID(id string) string {
+	parts := strings.Split(id, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if strings.EqualFold(parts[i], "resourceGroups") {
+			return parts[i+1]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,158 @@
+package azureconditions
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func Test_innermostErrors(t *testing.T) {
+	testCases := []struct {
+		name           string
+		statusMessage  interface{}
+		expectedErrors []armError
+	}{
+		{
+			name:           "case 0: no status message",
+			statusMessage:  nil,
+			expectedErrors: nil,
+		},
+		{
+			name: "case 1: error wrapping nested details",
+			statusMessage: map[string]interface{}{
+				"status": "Failed",
+				"error": map[string]interface{}{
+					"code":    "DeploymentFailed",
+					"message": "At least one resource deployment operation failed.",
+					"details": []interface{}{
+						map[string]interface{}{
+							"code":    "Conflict",
+							"message": "Operation failed.",
+							"details": []interface{}{
+								map[string]interface{}{
+									"code":    "SkuNotAvailable",
+									"message": "The requested size for resource is currently not available in location westeurope.",
+								},
+							},
+						},
+						map[string]interface{}{
+							"code":    "QuotaExceeded",
+							"message": "Operation could not be completed as it results in exceeding approved standardDSv3Family Cores quota.",
+						},
+					},
+				},
+			},
+			expectedErrors: []armError{
+				{
+					Code:    "SkuNotAvailable",
+					Message: "The requested size for resource is currently not available in location westeurope.",
+				},
+				{
+					Code:    "QuotaExceeded",
+					Message: "Operation could not be completed as it results in exceeding approved standardDSv3Family Cores quota.",
+				},
+			},
+		},
+		{
+			name:          "case 2: error without details",
+			statusMessage: map[string]interface{}{"code": "InvalidTemplate", "message": "Template is not valid."},
+			expectedErrors: []armError{
+				{
+					Code:    "InvalidTemplate",
+					Message: "Template is not valid.",
+				},
+			},
+		},
+		{
+			name:          "case 3: JSON string",
+			statusMessage: `{"error":{"code":"AllocationFailed","message":"Allocation failed."}}`,
+			expectedErrors: []armError{
+				{
+					Code:    "AllocationFailed",
+					Message: "Allocation failed.",
+				},
+			},
+		},
+		{
+			name:          "case 4: plain string",
+			statusMessage: "Something went wrong.",
+			expectedErrors: []armError{
+				{
+					Code:    DeploymentProvisioningStateFailed,
+					Message: "Something went wrong.",
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			errors := innermostErrors(tc.statusMessage)
+			if !reflect.DeepEqual(errors, tc.expectedErrors) {
+				t.Fatalf("expected %#v, got %#v", tc.expectedErrors, errors)
+			}
+		})
+	}
+}
+
+func Test_FailedDeploymentMessage(t *testing.T) {
+	deployment := resources.DeploymentExtended{
+		Name: to.StringPtr("nodepool-a1b2c"),
+		Properties: &resources.DeploymentPropertiesExtended{
+			CorrelationID: to.StringPtr("0b9e3f1c"),
+		},
+	}
+
+	var manyFailures []DeploymentFailure
+	for i := 0; i < maxDeploymentFailures+2; i++ {
+		manyFailures = append(manyFailures, DeploymentFailure{Code: fmt.Sprintf("Error%d", i)})
+	}
+
+	testCases := []struct {
+		name            string
+		failures        []DeploymentFailure
+		expectedMessage string
+	}{
+		{
+			name:            "case 0: no failures",
+			failures:        nil,
+			expectedMessage: "Deployment nodepool-a1b2c failed, correlation ID 0b9e3f1c",
+		},
+		{
+			name: "case 1: one failure",
+			failures: []DeploymentFailure{
+				{
+					Code:             "SkuNotAvailable",
+					Message:          "The requested size is not available.",
+					TargetResource:   "Microsoft.Compute/virtualMachineScaleSets/nodepool-a1b2c",
+					ServiceRequestID: "7f3c",
+				},
+			},
+			expectedMessage: "Deployment nodepool-a1b2c failed: SkuNotAvailable on Microsoft.Compute/virtualMachineScaleSets/nodepool-a1b2c: " +
+				"The requested size is not available. (request ID 7f3c), correlation ID 0b9e3f1c",
+		},
+		{
+			name:     "case 2: more failures than put into the message",
+			failures: manyFailures,
+			expectedMessage: "Deployment nodepool-a1b2c failed: Error0; Error1; Error2; Error3; Error4; and 2 more, " +
+				"correlation ID 0b9e3f1c",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			message := FailedDeploymentMessage(deployment, tc.failures)
+			if message != tc.expectedMessage {
+				t.Fatalf("expected %#q, got %#q", tc.expectedMessage, message)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Package etcd implements the few etcd requests the operator needs to make
+// against the etcd cluster of a tenant cluster. It talks to the HTTP gateway
+// of the etcd v3 API, so no etcd client library is needed.
+package etcd
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/giantswarm/certs/v3/pkg/certs"
+	"github.com/giantswarm/microerror"
+)
+
+const (
+	requestTimeout = 10 * time.Second
+)
+
+type Config struct {
+	// Endpoint is the client URL of the etcd cluster, e.g.
+	// https://etcd.example.com:2379.
+	Endpoint string
+	// TLS holds the client certificate used to authenticate against etcd.
+	TLS certs.TLS
+}
+
+type Client struct {
+	endpoint   string
+	httpClient *http.Client
+}
+
+// Member is a member of the etcd cluster. Members which were added but did
+// not start yet have no name.
+type Member struct {
+	ID         string   `json:"ID"`
+	Name       string   `json:"name"`
+	PeerURLs   []string `json:"peerURLs"`
+	ClientURLs []string `json:"clientURLs"`
+}
+
+// Started returns true when the member joined the cluster.
+func (m Member) Started() bool {
+	return m.Name != ""
+}
+
+func New(config Config) (*Client, error) {
+	if config.Endpoint == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Endpoint must not be empty", config)
+	}
+	if len(config.TLS.CA) == 0 || len(config.TLS.Crt) == 0 || len(config.TLS.Key) == 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.TLS must not be empty", config)
+	}
+
+	certificate, err := tls.X509KeyPair(config.TLS.Crt, config.TLS.Key)
+	if err != nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.TLS: %s", config, err)
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(config.TLS.CA) {
+		return nil, microerror.Maskf(invalidConfigError, "%T.TLS.CA must contain a PEM encoded certificate", config)
+	}
+
+	c := &Client{
+		endpoint: strings.TrimSuffix(config.Endpoint, "/"),
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					Certificates: []tls.Certificate{certificate},
+					RootCAs:      rootCAs,
+				},
+			},
+		},
+	}
+
+	return c, nil
+}
+
+// Healthy returns true when the etcd member serving the request has a leader
+// and is able to commit writes.
+func (c *Client) Healthy(ctx context.Context) (bool, error) {
+	var response struct {
+		Health string `json:"health"`
+	}
+
+	err := c.do(ctx, http.MethodGet, "/health", nil, &response)
+	if IsExecutionFailed(err) {
+		// etcd answers with a non-2xx status code when it is unhealthy.
+		return false, nil
+	} else if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	return response.Health == "true", nil
+}
+
+// Members returns the members of the etcd cluster.
+func (c *Client) Members(ctx context.Context) ([]Member, error) {
+	var response struct {
+		Members []Member `json:"members"`
+	}
+
+	err := c.do(ctx, http.MethodPost, "/v3/cluster/member/list", struct{}{}, &response)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return response.Members, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, v interface{}) error {
+	var reader *bytes.Reader
+	{
+		b := []byte{}
+		if body != nil {
+			var err error
+			b, err = json.Marshal(body)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+		reader = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, reader)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	defer res.Body.Close()
+
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return microerror.Maskf(executionFailedError, "%s %s returned status %d: %s", method, path, res.StatusCode, strings.TrimSpace(string(b)))
+	}
+
+	err = json.Unmarshal(b, v)
+	if err != nil {
+		return microerror.Maskf(executionFailedError, "%s %s returned invalid response: %s", method, path, err)
+	}
+
+	return nil
+}
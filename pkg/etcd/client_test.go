@@ -0,0 +1,173 @@
+package etcd
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/giantswarm/certs/v3/pkg/certs"
+)
+
+func Test_Client(t *testing.T) {
+	testCases := []struct {
+		name            string
+		healthStatus    int
+		healthBody      string
+		expectedHealthy bool
+		expectedMembers []Member
+	}{
+		{
+			name:            "case 0: healthy cluster with a joining member",
+			healthStatus:    http.StatusOK,
+			healthBody:      `{"health":"true"}`,
+			expectedHealthy: true,
+			expectedMembers: []Member{
+				{
+					ID:         "10276657743932975437",
+					Name:       "etcd1",
+					PeerURLs:   []string{"https://etcd1.example.com:2380"},
+					ClientURLs: []string{"https://etcd.example.com:2379"},
+				},
+				{
+					ID:       "10501334649042878790",
+					PeerURLs: []string{"https://etcd2.example.com:2380"},
+				},
+			},
+		},
+		{
+			name:            "case 1: cluster without leader",
+			healthStatus:    http.StatusServiceUnavailable,
+			healthBody:      `{"health":"false"}`,
+			expectedHealthy: false,
+			expectedMembers: []Member{
+				{
+					ID:         "10276657743932975437",
+					Name:       "etcd1",
+					PeerURLs:   []string{"https://etcd1.example.com:2380"},
+					ClientURLs: []string{"https://etcd.example.com:2379"},
+				},
+				{
+					ID:       "10501334649042878790",
+					PeerURLs: []string{"https://etcd2.example.com:2380"},
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.healthStatus)
+				_, _ = w.Write([]byte(tc.healthBody))
+			})
+			mux.HandleFunc("/v3/cluster/member/list", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					w.WriteHeader(http.StatusMethodNotAllowed)
+					return
+				}
+				_, _ = w.Write([]byte(`{"header":{"cluster_id":"1"},"members":[{"ID":"10276657743932975437","name":"etcd1","peerURLs":["https://etcd1.example.com:2380"],"clientURLs":["https://etcd.example.com:2379"]},{"ID":"10501334649042878790","peerURLs":["https://etcd2.example.com:2380"]}]}`))
+			})
+
+			clientTLS := newTestTLS(t)
+
+			server := httptest.NewUnstartedServer(mux)
+			server.TLS = newTestServerTLSConfig(t, clientTLS)
+			server.StartTLS()
+			defer server.Close()
+
+			c, err := New(Config{
+				Endpoint: server.URL,
+				TLS:      clientTLS,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			healthy, err := c.Healthy(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if healthy != tc.expectedHealthy {
+				t.Fatalf("expected %t, got %t", tc.expectedHealthy, healthy)
+			}
+
+			members, err := c.Members(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(members, tc.expectedMembers) {
+				t.Fatalf("expected %#v, got %#v", tc.expectedMembers, members)
+			}
+		})
+	}
+}
+
+// newTestTLS returns a self-signed certificate for 127.0.0.1 which is used as
+// CA, server and client certificate alike.
+func newTestTLS(t *testing.T) certs.TLS {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "etcd"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	crt := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	return certs.TLS{
+		CA:  crt,
+		Crt: crt,
+		Key: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+// newTestServerTLSConfig returns a TLS config requiring clients to
+// authenticate with a certificate like etcd does.
+func newTestServerTLSConfig(t *testing.T, c certs.TLS) *tls.Config {
+	certificate, err := tls.X509KeyPair(c.Crt, c.Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM(c.CA)
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	}
+}
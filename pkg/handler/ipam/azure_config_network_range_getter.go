@@ -6,6 +6,8 @@ import (
 	"reflect"
 
 	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
 
 const (
@@ -45,8 +47,26 @@ func NewAzureConfigNetworkRangeGetter(config AzureConfigNetworkRangeGetterConfig
 
 // GetParentNetworkRange gets the predefined installation network range, since the tenant cluster
 // virtual network is getting its IP range from all available address ranges in the installation.
-func (g *AzureConfigNetworkRangeGetter) GetParentNetworkRange(_ context.Context, _ interface{}) (net.IPNet, error) {
-	return g.installationNetworkRange, nil
+// Tenant clusters placed into an existing virtual network get their IP range from the first
+// address prefix of that virtual network instead.
+func (g *AzureConfigNetworkRangeGetter) GetParentNetworkRange(ctx context.Context, obj interface{}) (net.IPNet, error) {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return net.IPNet{}, microerror.Mask(err)
+	}
+
+	existing, ok, err := getExistingVirtualNetwork(ctx, cr)
+	if err != nil {
+		return net.IPNet{}, microerror.Mask(err)
+	} else if !ok {
+		return g.installationNetworkRange, nil
+	}
+
+	if len(existing.prefixes) == 0 {
+		return net.IPNet{}, microerror.Maskf(invalidObjectError, "existing virtual network of AzureConfig %#q has no address prefix", cr.Name)
+	}
+
+	return existing.prefixes[0], nil
 }
 
 // GetRequiredIPMask returns an IP mask for tenant cluster virtual network.
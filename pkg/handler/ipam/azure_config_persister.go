@@ -38,13 +38,18 @@ func NewAzureConfigPersister(config AzureConfigPersisterConfig) (*AzureConfigPer
 	return p, nil
 }
 
-func (p *AzureConfigPersister) Persist(ctx context.Context, vnet net.IPNet, namespace string, name string) error {
+func (p *AzureConfigPersister) Persist(ctx context.Context, vnet net.IPNet, namespace string, name string, token int64) error {
 	azureConfig := &v1alpha1.AzureConfig{}
 	err := p.ctrlClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, azureConfig)
 	if err != nil {
 		return microerror.Mask(err)
 	}
 
+	err = fence(azureConfig, token)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	azureNetwork, err := network.Compute(vnet)
 	if err != nil {
 		return microerror.Mask(err)
@@ -174,8 +174,7 @@ func (c *AzureMachinePoolSubnetCollector) collectSubnetsFromAzureVNet(ctx contex
 		return nil, microerror.Mask(err)
 	}
 
-	// cluster ID is tenant cluster resource group name
-	resourceGroupName := azureCluster.Name
+	resourceGroupName := key.VnetResourceGroupNameFromAzureCluster(*azureCluster)
 	vnetName := azureCluster.Spec.NetworkSpec.Vnet.Name
 	resultPage, err := subnetsClient.List(ctx, resourceGroupName, vnetName)
 	if err != nil {
@@ -43,8 +43,10 @@ func NewAzureMachinePoolSubnetPersister(config AzureMachinePoolSubnetPersisterCo
 
 // Persist functions takes a subnet CIDR allocated for the specified
 // AzureMachinePool (namespace/ name) and adds it to Subnets array in the
-// corresponding AzureCluster CR that owns the specified AzureMachinePool.
-func (p *AzureMachinePoolSubnetPersister) Persist(ctx context.Context, subnet net.IPNet, namespace string, name string) error {
+// corresponding AzureCluster CR that owns the specified AzureMachinePool. The
+// AzureCluster CR is only updated when it was not written with a newer
+// fencing token and did not change since it was read.
+func (p *AzureMachinePoolSubnetPersister) Persist(ctx context.Context, subnet net.IPNet, namespace string, name string, token int64) error {
 	p.logger.Debugf(ctx, "persisting allocated subnet in AzureCluster CR")
 
 	azureMachinePool := &v1alpha3.AzureMachinePool{}
@@ -53,7 +55,7 @@ func (p *AzureMachinePoolSubnetPersister) Persist(ctx context.Context, subnet ne
 		return microerror.Mask(err)
 	}
 
-	err = p.addSubnetToAzureCluster(ctx, subnet, azureMachinePool)
+	err = p.addSubnetToAzureCluster(ctx, subnet, azureMachinePool, token)
 	if err != nil {
 		return microerror.Mask(err)
 	}
@@ -62,7 +64,7 @@ func (p *AzureMachinePoolSubnetPersister) Persist(ctx context.Context, subnet ne
 	return nil
 }
 
-func (p *AzureMachinePoolSubnetPersister) addSubnetToAzureCluster(ctx context.Context, subnet net.IPNet, azureMachinePool *v1alpha3.AzureMachinePool) error {
+func (p *AzureMachinePoolSubnetPersister) addSubnetToAzureCluster(ctx context.Context, subnet net.IPNet, azureMachinePool *v1alpha3.AzureMachinePool, token int64) error {
 	azureCluster, err := helpers.GetAzureClusterFromMetadata(ctx, p.ctrlClient, azureMachinePool.ObjectMeta)
 	if err != nil {
 		errorMessage := "error while getting AzureCluster CR from AzureMachinePool CR metadata"
@@ -70,6 +72,11 @@ func (p *AzureMachinePoolSubnetPersister) addSubnetToAzureCluster(ctx context.Co
 		return microerror.Mask(err)
 	}
 
+	err = fence(azureCluster, token)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	azureMachinePoolSubnet := &capzv1alpha3.SubnetSpec{
 		Role:       capzv1alpha3.SubnetNode,
 		Name:       azureMachinePool.Name,
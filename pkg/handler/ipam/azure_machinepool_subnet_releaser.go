@@ -6,7 +6,6 @@ import (
 
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -41,7 +40,11 @@ func NewAzureMachinePoolSubnetReleaser(config AzureMachinePoolSubnetReleaserConf
 	return p, nil
 }
 
-func (r *AzureMachinePoolSubnetReleaser) Release(ctx context.Context, subnet net.IPNet, namespace, name string) error {
+// Release removes the subnet allocated for the specified AzureMachinePool
+// (namespace/ name) from the corresponding AzureCluster CR. The AzureCluster
+// CR is only updated when it was not written with a newer fencing token and did
+// not change since it was read.
+func (r *AzureMachinePoolSubnetReleaser) Release(ctx context.Context, subnet net.IPNet, namespace, name string, token int64) error {
 	r.logger.Debugf(ctx, "releasing allocated subnet from AzureCluster CR")
 
 	azureMachinePool := &v1alpha3.AzureMachinePool{}
@@ -50,7 +53,7 @@ func (r *AzureMachinePoolSubnetReleaser) Release(ctx context.Context, subnet net
 		return microerror.Mask(err)
 	}
 
-	err = r.removeSubnetFromAzureCluster(ctx, subnet, azureMachinePool)
+	err = r.removeSubnetFromAzureCluster(ctx, subnet, azureMachinePool, token)
 	if err != nil {
 		return microerror.Mask(err)
 	}
@@ -59,7 +62,7 @@ func (r *AzureMachinePoolSubnetReleaser) Release(ctx context.Context, subnet net
 	return nil
 }
 
-func (r *AzureMachinePoolSubnetReleaser) removeSubnetFromAzureCluster(ctx context.Context, subnet net.IPNet, azureMachinePool *v1alpha3.AzureMachinePool) error {
+func (r *AzureMachinePoolSubnetReleaser) removeSubnetFromAzureCluster(ctx context.Context, subnet net.IPNet, azureMachinePool *v1alpha3.AzureMachinePool, token int64) error {
 	azureCluster, err := helpers.GetAzureClusterFromMetadata(ctx, r.ctrlClient, azureMachinePool.ObjectMeta)
 	if err != nil {
 		errorMessage := "error while getting AzureCluster CR from AzureMachinePool CR metadata"
@@ -67,6 +70,11 @@ func (r *AzureMachinePoolSubnetReleaser) removeSubnetFromAzureCluster(ctx contex
 		return microerror.Mask(err)
 	}
 
+	err = fence(azureCluster, token)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	for i, subnet := range azureCluster.Spec.NetworkSpec.Subnets {
 		if subnet.Name == azureMachinePool.Name {
 			azureCluster.Spec.NetworkSpec.Subnets = append(azureCluster.Spec.NetworkSpec.Subnets[:i], azureCluster.Spec.NetworkSpec.Subnets[i+1:]...)
@@ -74,11 +82,11 @@ func (r *AzureMachinePoolSubnetReleaser) removeSubnetFromAzureCluster(ctx contex
 		}
 	}
 
+	// The update fails with a conflict when the AzureCluster CR changed since
+	// it was read, e.g. because a newer holder of the IPAM lease wrote it. The
+	// subnet is released again in one of the next reconciliation loops.
 	err = r.ctrlClient.Update(ctx, azureCluster)
-	if apierrors.IsConflict(err) {
-		r.logger.Debugf(ctx, "conflict trying to save object in k8s API concurrently")
-		r.logger.Debugf(ctx, "cancelling resource")
-	} else if err != nil {
+	if err != nil {
 		return microerror.Mask(err)
 	}
 
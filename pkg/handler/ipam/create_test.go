@@ -2,11 +2,18 @@ package ipam
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"strconv"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger/microloggertest"
+	"github.com/giantswarm/operatorkit/v4/pkg/controller/context/reconciliationcanceledcontext"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 	capzExpV1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
 
 	"github.com/giantswarm/azure-operator/v5/pkg/locker"
@@ -86,6 +93,251 @@ func Test_SubnetAllocator(t *testing.T) {
 	}
 }
 
+func Test_ConcurrentSubnetAllocation(t *testing.T) {
+	var err error
+
+	var mutexLocker locker.Interface
+	{
+		c := locker.MutexLockerConfig{
+			Logger: microloggertest.New(),
+		}
+
+		mutexLocker, err = locker.NewMutexLocker(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	store := NewTestStore(5 * time.Millisecond)
+
+	// Two resources share the same locker and store, like the AzureConfig
+	// VNet IPAM and the AzureMachinePool subnet IPAM do in the operator.
+	var resources []*Resource
+	for i := 0; i < 2; i++ {
+		c := Config{
+			Checker:            store,
+			Collector:          store,
+			Locker:             mutexLocker,
+			Logger:             microloggertest.New(),
+			NetworkRangeGetter: NewTestNetworkRangeGetter(mustParseCIDR("10.100.0.0/16"), 24),
+			NetworkRangeType:   "unit-test-network-range",
+			Persister:          store,
+			Releaser:           store,
+		}
+
+		r, err := New(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resources = append(resources, r)
+	}
+
+	const allocations = 20
+
+	var wg sync.WaitGroup
+	errs := make(chan error, allocations)
+	for i := 0; i < allocations; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			obj := &capzExpV1alpha3.AzureMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      fmt.Sprintf("np-%d", i),
+				},
+			}
+
+			errs <- resources[i%len(resources)].EnsureCreated(context.Background(), obj)
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	allocated := store.Allocated()
+	if len(allocated) != allocations {
+		t.Fatalf("expected %d allocations, got %d", allocations, len(allocated))
+	}
+
+	owners := map[string]string{}
+	for name, subnet := range allocated {
+		if owner, ok := owners[subnet.String()]; ok {
+			t.Fatalf("subnet %s allocated for both %s and %s", subnet.String(), owner, name)
+		}
+		owners[subnet.String()] = name
+	}
+}
+
+func Test_SubnetAllocatorLockContention(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		locker              locker.Interface
+		storeToken          int64
+		expectedCanceled    bool
+		expectedErrorMatch  func(error) bool
+		expectedAllocations int
+	}{
+		{
+			name: "case 0 cancel reconciliation when lock is held by another holder",
+
+			locker:              mustNewHeldLeaseLocker(),
+			expectedCanceled:    true,
+			expectedAllocations: 0,
+		},
+		{
+			name: "case 1 do not persist allocation when lease got lost",
+
+			locker: &testLocker{},
+			expectedErrorMatch: func(err error) bool {
+				return microerror.Cause(err) == testLeaseLostError
+			},
+			expectedAllocations: 0,
+		},
+		{
+			name: "case 2 do not persist allocation when a newer holder persisted already",
+
+			locker:              &testStaleLocker{},
+			storeToken:          1,
+			expectedErrorMatch:  IsStaleFencingToken,
+			expectedAllocations: 0,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			store := NewTestStore(0)
+			store.token = tc.storeToken
+
+			var newResource *Resource
+			{
+				c := Config{
+					Checker:            store,
+					Collector:          store,
+					Locker:             tc.locker,
+					Logger:             microloggertest.New(),
+					NetworkRangeGetter: NewTestNetworkRangeGetter(mustParseCIDR("10.100.0.0/16"), 24),
+					NetworkRangeType:   "unit-test-network-range",
+					Persister:          store,
+					Releaser:           store,
+				}
+
+				var err error
+				newResource, err = New(c)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			ctx := reconciliationcanceledcontext.NewContext(context.Background(), make(chan struct{}))
+
+			err := newResource.EnsureCreated(ctx, &capzExpV1alpha3.AzureMachinePool{})
+
+			switch {
+			case err == nil && tc.expectedErrorMatch == nil:
+				// correct; carry on
+			case err != nil && tc.expectedErrorMatch == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.expectedErrorMatch != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.expectedErrorMatch(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if reconciliationcanceledcontext.IsCanceled(ctx) != tc.expectedCanceled {
+				t.Fatalf("reconciliation canceled == %t, want %t", reconciliationcanceledcontext.IsCanceled(ctx), tc.expectedCanceled)
+			}
+
+			if len(store.Allocated()) != tc.expectedAllocations {
+				t.Fatalf("expected %d allocations, got %d", tc.expectedAllocations, len(store.Allocated()))
+			}
+		})
+	}
+}
+
+var testLeaseLostError = &microerror.Error{
+	Kind: "testLeaseLostError",
+}
+
+// mustNewHeldLeaseLocker returns a *locker.LeaseLocker whose lock is already
+// held by another holder.
+func mustNewHeldLeaseLocker() *locker.LeaseLocker {
+	c := locker.LeaseLockerConfig{
+		K8sClient: fake.NewSimpleClientset(),
+		Logger:    microloggertest.New(),
+
+		Identity: "other-replica",
+	}
+
+	l, err := locker.NewLeaseLocker(c)
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = l.Lock(context.Background())
+	if err != nil {
+		panic(err)
+	}
+
+	return l
+}
+
+// testLocker issues leases which are always lost when being validated.
+type testLocker struct{}
+
+func (l *testLocker) Lock(ctx context.Context) (locker.Lease, error) {
+	return &testLostLease{}, nil
+}
+
+type testLostLease struct{}
+
+func (l *testLostLease) Holder() string {
+	return "unit-test"
+}
+
+func (l *testLostLease) Token() int64 {
+	return 0
+}
+
+func (l *testLostLease) TTL() time.Duration {
+	return 0
+}
+
+func (l *testLostLease) Renew(ctx context.Context) error {
+	return l.Validate(ctx)
+}
+
+func (l *testLostLease) Validate(ctx context.Context) error {
+	return microerror.Mask(testLeaseLostError)
+}
+
+func (l *testLostLease) Release(ctx context.Context) error {
+	return nil
+}
+
+// testStaleLocker issues leases with fencing token 0 which are still valid
+// when being validated, like a lease which gets lost right after the
+// validation.
+type testStaleLocker struct{}
+
+func (l *testStaleLocker) Lock(ctx context.Context) (locker.Lease, error) {
+	return &testStaleLease{}, nil
+}
+
+type testStaleLease struct {
+	testLostLease
+}
+
+func (l *testStaleLease) Validate(ctx context.Context) error {
+	return nil
+}
+
 func mustParseCIDR(val string) net.IPNet {
 	_, n, err := net.ParseCIDR(val)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"net"
 
 	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/operatorkit/v4/pkg/controller/context/reconciliationcanceledcontext"
 	"k8s.io/apimachinery/pkg/api/meta"
 
 	"github.com/giantswarm/azure-operator/v5/pkg/locker"
@@ -18,28 +19,25 @@ func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
 		return microerror.Mask(err)
 	}
 
+	var lease locker.Lease
 	{
-		r.logger.Debugf(ctx, "acquiring lock for IPAM")
-		err := r.locker.Lock(ctx)
+		leaseCtx, l, unlock, err := r.lock(ctx)
 		if locker.IsAlreadyExists(err) {
-			r.logger.Debugf(ctx, "lock for IPAM is already acquired")
+			// Another holder is currently allocating or releasing network
+			// ranges. Proceeding would risk overlapping allocations, so we
+			// cancel the reconciliation and retry in one of the next loops.
+			r.logger.Debugf(ctx, "lock for IPAM is held by another holder")
+			r.logger.Debugf(ctx, "canceling reconciliation")
+			reconciliationcanceledcontext.SetCanceled(ctx)
+			return nil
 		} else if err != nil {
 			return microerror.Mask(err)
-		} else {
-			r.logger.Debugf(ctx, "acquired lock for IPAM")
 		}
 
-		defer func() {
-			r.logger.Debugf(ctx, "releasing lock for IPAM")
-			err := r.locker.Unlock(ctx)
-			if locker.IsNotFound(err) {
-				r.logger.Debugf(ctx, "lock for IPAM is already released")
-			} else if err != nil {
-				r.logger.Errorf(ctx, err, "failed to release lock for IPAM")
-			} else {
-				r.logger.Debugf(ctx, "released lock for IPAM")
-			}
-		}()
+		defer unlock()
+
+		ctx = leaseCtx
+		lease = l
 	}
 
 	// Check if subnet is still allocated.
@@ -60,8 +58,15 @@ func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
 		r.logger.Debugf(ctx, "found allocated subnet")
 		r.logger.Debugf(ctx, "releasing allocated subnet")
 
-		// Release allocated subnet.
-		err = r.releaser.Release(ctx, *subnet, m.GetNamespace(), m.GetName())
+		// Release allocated subnet only as long as we still hold the lease. The
+		// releaser rejects the write when it was already written with a newer
+		// fencing token.
+		err = lease.Validate(ctx)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = r.releaser.Release(ctx, *subnet, m.GetNamespace(), m.GetName(), lease.Token())
 		if err != nil {
 			return microerror.Mask(err)
 		}
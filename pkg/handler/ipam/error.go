@@ -35,6 +35,17 @@ func IsInvalidObject(err error) bool {
 	return microerror.Cause(err) == invalidObjectError
 }
 
+var staleFencingTokenError = &microerror.Error{
+	Kind: "staleFencingTokenError",
+}
+
+// IsStaleFencingToken asserts staleFencingTokenError. It is returned when
+// network ranges are written with the fencing token of a lease which has been
+// acquired again by another holder in the meantime.
+func IsStaleFencingToken(err error) bool {
+	return microerror.Cause(err) == staleFencingTokenError
+}
+
 func IsNotFound(err error) bool {
 	if err == nil {
 		return false
@@ -0,0 +1,84 @@
+package ipam
+
+import (
+	"context"
+	"net"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+// existingVirtualNetwork holds the address prefixes of the existing virtual
+// network a tenant cluster is placed into and of the subnets it already has.
+type existingVirtualNetwork struct {
+	prefixes []net.IPNet
+	subnets  []net.IPNet
+}
+
+// getExistingVirtualNetwork returns the address prefixes of the existing
+// virtual network of the given AzureConfig CR. It returns false when the
+// cluster has a virtual network of its own.
+func getExistingVirtualNetwork(ctx context.Context, cr v1alpha1.AzureConfig) (existingVirtualNetwork, bool, error) {
+	resourceGroup, name, ok := key.ExistingVirtualNetwork(cr)
+	if !ok {
+		return existingVirtualNetwork{}, false, nil
+	}
+
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return existingVirtualNetwork{}, false, microerror.Mask(err)
+	}
+
+	vnet, err := cc.AzureClientSet.VirtualNetworkClient.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return existingVirtualNetwork{}, false, microerror.Mask(err)
+	}
+
+	var existing existingVirtualNetwork
+	if vnet.VirtualNetworkPropertiesFormat == nil {
+		return existing, true, nil
+	}
+
+	if vnet.AddressSpace != nil && vnet.AddressSpace.AddressPrefixes != nil {
+		for _, cidr := range *vnet.AddressSpace.AddressPrefixes {
+			_, n, err := net.ParseCIDR(cidr)
+			if err != nil {
+				return existingVirtualNetwork{}, false, microerror.Mask(err)
+			}
+
+			existing.prefixes = append(existing.prefixes, *n)
+		}
+	}
+
+	if vnet.Subnets != nil {
+		for _, subnet := range *vnet.Subnets {
+			if subnet.SubnetPropertiesFormat == nil {
+				continue
+			}
+
+			cidrs := []string{to.String(subnet.AddressPrefix)}
+			if subnet.AddressPrefixes != nil {
+				cidrs = append(cidrs, *subnet.AddressPrefixes...)
+			}
+
+			for _, cidr := range cidrs {
+				if cidr == "" {
+					continue
+				}
+
+				_, n, err := net.ParseCIDR(cidr)
+				if err != nil {
+					return existingVirtualNetwork{}, false, microerror.Mask(err)
+				}
+
+				existing.subnets = append(existing.subnets, *n)
+			}
+		}
+	}
+
+	return existing, true, nil
+}
@@ -0,0 +1,40 @@
+package ipam
+
+import (
+	"strconv"
+
+	"github.com/giantswarm/microerror"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+)
+
+// fence guards the write of network ranges to the given object with the given
+// fencing token of the IPAM lease. It returns an error matched by
+// IsStaleFencingToken when the object was already written with a newer token
+// and records the given token in the object otherwise. The object must be
+// updated with the resource version it was read with, so that the update fails
+// with a conflict when a newer holder writes the object in the meantime.
+func fence(obj metav1.Object, token int64) error {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	v, ok := annotations[annotation.IPAMFencingToken]
+	if ok {
+		current, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return microerror.Maskf(invalidObjectError, "annotation %#q must be an integer, got %#q", annotation.IPAMFencingToken, v)
+		}
+
+		if current > token {
+			return microerror.Maskf(staleFencingTokenError, "%T %#q was written with fencing token %d, newer than %d", obj, obj.GetName(), current, token)
+		}
+	}
+
+	annotations[annotation.IPAMFencingToken] = strconv.FormatInt(token, 10)
+	obj.SetAnnotations(annotations)
+
+	return nil
+}
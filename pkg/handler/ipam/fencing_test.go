@@ -0,0 +1,83 @@
+package ipam
+
+import (
+	"strconv"
+	"testing"
+
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+)
+
+func Test_fence(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		annotations        map[string]string
+		token              int64
+		expectedToken      string
+		expectedErrorMatch func(error) bool
+	}{
+		{
+			name: "case 0 record token when object was not written yet",
+
+			annotations:   nil,
+			token:         3,
+			expectedToken: "3",
+		},
+		{
+			name: "case 1 accept write with the same token",
+
+			annotations:   map[string]string{annotation.IPAMFencingToken: "3"},
+			token:         3,
+			expectedToken: "3",
+		},
+		{
+			name: "case 2 accept write with a newer token",
+
+			annotations:   map[string]string{annotation.IPAMFencingToken: "3"},
+			token:         4,
+			expectedToken: "4",
+		},
+		{
+			name: "case 3 reject write with an older token",
+
+			annotations:        map[string]string{annotation.IPAMFencingToken: "4"},
+			token:              3,
+			expectedToken:      "4",
+			expectedErrorMatch: IsStaleFencingToken,
+		},
+		{
+			name: "case 4 reject write when recorded token is invalid",
+
+			annotations:        map[string]string{annotation.IPAMFencingToken: "four"},
+			token:              3,
+			expectedToken:      "four",
+			expectedErrorMatch: IsInvalidObject,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			azureCluster := &capzv1alpha3.AzureCluster{}
+			azureCluster.SetAnnotations(tc.annotations)
+
+			err := fence(azureCluster, tc.token)
+
+			switch {
+			case err == nil && tc.expectedErrorMatch == nil:
+				// correct; carry on
+			case err != nil && tc.expectedErrorMatch == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.expectedErrorMatch != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.expectedErrorMatch(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if azureCluster.GetAnnotations()[annotation.IPAMFencingToken] != tc.expectedToken {
+				t.Fatalf("token == %#q, want %#q", azureCluster.GetAnnotations()[annotation.IPAMFencingToken], tc.expectedToken)
+			}
+		})
+	}
+}
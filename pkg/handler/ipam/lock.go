@@ -0,0 +1,43 @@
+package ipam
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/locker"
+)
+
+// lock acquires the IPAM lock and keeps renewing its lease in the background.
+// The returned context is canceled as soon as the lease is lost. The returned
+// unlock function stops the renewal and releases the lease. When the lock is
+// held by another holder, an error matched by locker.IsAlreadyExists is
+// returned.
+func (r *Resource) lock(ctx context.Context) (context.Context, locker.Lease, func(), error) {
+	r.logger.Debugf(ctx, "acquiring lock for IPAM")
+
+	lease, err := r.locker.Lock(ctx)
+	if err != nil {
+		return nil, nil, nil, microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "acquired lock for IPAM as %#q with fencing token %d", lease.Holder(), lease.Token())
+
+	leaseCtx, cancel := locker.KeepAlive(ctx, lease)
+
+	unlock := func() {
+		cancel()
+
+		r.logger.Debugf(ctx, "releasing lock for IPAM")
+		err := lease.Release(ctx)
+		if locker.IsNotFound(err) {
+			r.logger.Debugf(ctx, "lock for IPAM is already released")
+		} else if err != nil {
+			r.logger.Errorf(ctx, err, "failed to release lock for IPAM")
+		} else {
+			r.logger.Debugf(ctx, "released lock for IPAM")
+		}
+	}
+
+	return leaseCtx, lease, unlock, nil
+}
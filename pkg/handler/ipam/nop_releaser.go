@@ -11,6 +11,6 @@ func NewNOPReleaser() Releaser {
 	return &nopReleaser{}
 }
 
-func (r *nopReleaser) Release(ctx context.Context, subnet net.IPNet, namespace, name string) error {
+func (r *nopReleaser) Release(ctx context.Context, subnet net.IPNet, namespace, name string, token int64) error {
 	return nil
 }
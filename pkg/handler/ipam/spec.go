@@ -32,13 +32,18 @@ type NetworkRangeGetter interface {
 }
 
 // Persister must mutate shared persistent state so that on successful execution
-// persisted networks are visible by Collector implementations.
+// persisted networks are visible by Collector implementations. The given token
+// is the fencing token of the IPAM lease. Implementations must reject writes
+// with a token lower than the one of the last write with an error matched by
+// IsStaleFencingToken and must only write when the state did not change since
+// it was read.
 type Persister interface {
-	Persist(ctx context.Context, subnet net.IPNet, namespace, name string) error
+	Persist(ctx context.Context, subnet net.IPNet, namespace, name string, token int64) error
 }
 
 // Releaser must mutate shared persistent state so that on successful execution
-// allocated subnet is released.
+// allocated subnet is released. The given token is the fencing token of the
+// IPAM lease and is handled like in Persister implementations.
 type Releaser interface {
-	Release(ctx context.Context, subnet net.IPNet, namespace, name string) error
+	Release(ctx context.Context, subnet net.IPNet, namespace, name string, token int64) error
 }
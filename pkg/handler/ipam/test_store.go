@@ -0,0 +1,99 @@
+package ipam
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/giantswarm/microerror"
+)
+
+// TestStore is an in memory implementation of Checker, Collector, Persister and
+// Releaser sharing the same state. It is used to test concurrent allocations.
+// Collect and Persist take some time in order to widen the window in which
+// unsynchronized allocations would overlap. Like the real implementations,
+// Persist and Release reject writes with a fencing token lower than the one
+// of the last write.
+type TestStore struct {
+	mutex     sync.Mutex
+	allocated map[string]net.IPNet
+	delay     time.Duration
+	token     int64
+}
+
+func NewTestStore(delay time.Duration) *TestStore {
+	s := &TestStore{
+		allocated: map[string]net.IPNet{},
+		delay:     delay,
+	}
+
+	return s
+}
+
+func (s *TestStore) Allocated() map[string]net.IPNet {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	allocated := map[string]net.IPNet{}
+	for k, v := range s.allocated {
+		allocated[k] = v
+	}
+
+	return allocated
+}
+
+func (s *TestStore) Check(ctx context.Context, namespace string, name string) (*net.IPNet, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	subnet, ok := s.allocated[namespace+"/"+name]
+	if !ok {
+		return nil, nil
+	}
+
+	return &subnet, nil
+}
+
+func (s *TestStore) Collect(ctx context.Context, obj interface{}) ([]net.IPNet, error) {
+	s.mutex.Lock()
+	var subnets []net.IPNet
+	for _, subnet := range s.allocated {
+		subnets = append(subnets, subnet)
+	}
+	s.mutex.Unlock()
+
+	time.Sleep(s.delay)
+
+	return subnets, nil
+}
+
+func (s *TestStore) Persist(ctx context.Context, subnet net.IPNet, namespace string, name string, token int64) error {
+	time.Sleep(s.delay)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if token < s.token {
+		return microerror.Maskf(staleFencingTokenError, "store was written with fencing token %d, newer than %d", s.token, token)
+	}
+	s.token = token
+
+	s.allocated[namespace+"/"+name] = subnet
+
+	return nil
+}
+
+func (s *TestStore) Release(ctx context.Context, subnet net.IPNet, namespace string, name string, token int64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if token < s.token {
+		return microerror.Maskf(staleFencingTokenError, "store was written with fencing token %d, newer than %d", s.token, token)
+	}
+	s.token = token
+
+	delete(s.allocated, namespace+"/"+name)
+
+	return nil
+}
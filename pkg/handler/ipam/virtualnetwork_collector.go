@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"sync"
 
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
 	"github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
 	"github.com/giantswarm/ipam"
 	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
@@ -18,39 +19,57 @@ import (
 
 	"github.com/giantswarm/azure-operator/v5/client"
 	"github.com/giantswarm/azure-operator/v5/pkg/credential"
+	"github.com/giantswarm/azure-operator/v5/pkg/ratelimit"
 	"github.com/giantswarm/azure-operator/v5/service/collector"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
 
 type VirtualNetworkCollectorConfig struct {
 	AzureMetricsCollector collector.AzureAPIMetrics
+	AzureRateLimiter      *ratelimit.Limiter
 	CredentialProvider    credential.Provider
-	InstallationName      string
-	K8sClient             k8sclient.Interface
-	Logger                micrologger.Logger
-
-	NetworkRange  net.IPNet
-	ReservedCIDRs []net.IPNet
+	// HostVirtualNetworksClient is used to reserve the address spaces of the
+	// host cluster virtual network and of the virtual networks peered with it,
+	// which tenant clusters using vnet peering connectivity must not overlap.
+	HostVirtualNetworksClient *network.VirtualNetworksClient
+	InstallationName          string
+	K8sClient                 k8sclient.Interface
+	Logger                    micrologger.Logger
+
+	HostResourceGroup  string
+	HostVirtualNetwork string
+	NetworkRange       net.IPNet
+	ReservedCIDRs      []net.IPNet
 }
 
 type VirtualNetworkCollector struct {
-	azureMetricsCollector collector.AzureAPIMetrics
-	credentialProvider    credential.Provider
-	installationName      string
-	k8sclient             k8sclient.Interface
-	logger                micrologger.Logger
-
-	networkRange  net.IPNet
-	reservedCIDRs []net.IPNet
+	azureMetricsCollector     collector.AzureAPIMetrics
+	azureRateLimiter          *ratelimit.Limiter
+	credentialProvider        credential.Provider
+	hostVirtualNetworksClient *network.VirtualNetworksClient
+	installationName          string
+	k8sclient                 k8sclient.Interface
+	logger                    micrologger.Logger
+
+	hostResourceGroup  string
+	hostVirtualNetwork string
+	networkRange       net.IPNet
+	reservedCIDRs      []net.IPNet
 }
 
 func NewVirtualNetworkCollector(config VirtualNetworkCollectorConfig) (*VirtualNetworkCollector, error) {
 	if config.AzureMetricsCollector == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.AzureMetricsCollector must not be empty", config)
 	}
+	if config.AzureRateLimiter == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.AzureRateLimiter must not be empty", config)
+	}
 	if config.CredentialProvider == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.CredentialProvider must not be empty", config)
 	}
+	if config.HostVirtualNetworksClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.HostVirtualNetworksClient must not be empty", config)
+	}
 	if config.K8sClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
 	}
@@ -61,26 +80,51 @@ func NewVirtualNetworkCollector(config VirtualNetworkCollectorConfig) (*VirtualN
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
 
+	if config.HostResourceGroup == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.HostResourceGroup must not be empty", config)
+	}
+	if config.HostVirtualNetwork == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.HostVirtualNetwork must not be empty", config)
+	}
 	if reflect.DeepEqual(config.NetworkRange, net.IPNet{}) {
 		return nil, microerror.Maskf(invalidConfigError, "%T.NetworkRange must not be empty", config)
 	}
 
 	c := &VirtualNetworkCollector{
-		azureMetricsCollector: config.AzureMetricsCollector,
-		credentialProvider:    config.CredentialProvider,
-		k8sclient:             config.K8sClient,
-		installationName:      config.InstallationName,
-		logger:                config.Logger,
-
-		networkRange:  config.NetworkRange,
-		reservedCIDRs: config.ReservedCIDRs,
+		azureMetricsCollector:     config.AzureMetricsCollector,
+		azureRateLimiter:          config.AzureRateLimiter,
+		credentialProvider:        config.CredentialProvider,
+		hostVirtualNetworksClient: config.HostVirtualNetworksClient,
+		k8sclient:                 config.K8sClient,
+		installationName:          config.InstallationName,
+		logger:                    config.Logger,
+
+		hostResourceGroup:  config.HostResourceGroup,
+		hostVirtualNetwork: config.HostVirtualNetwork,
+		networkRange:       config.NetworkRange,
+		reservedCIDRs:      config.ReservedCIDRs,
 	}
 
 	return c, nil
 }
 
-func (c *VirtualNetworkCollector) Collect(ctx context.Context, _ interface{}) ([]net.IPNet, error) {
-	var err error
+func (c *VirtualNetworkCollector) Collect(ctx context.Context, obj interface{}) ([]net.IPNet, error) {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	// Tenant clusters placed into an existing virtual network are allocated
+	// inside of it, next to its existing subnets. Clusters can't share an
+	// existing virtual network, the other tenant clusters are only reserved
+	// so that the peerings with the host cluster don't overlap.
+	existing, ok, err := getExistingVirtualNetwork(ctx, cr)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	} else if ok {
+		return c.collectFromExistingVirtualNetwork(ctx, existing)
+	}
+
 	var mutex sync.Mutex
 	var reservedVirtualNetworks []net.IPNet
 	reservedVirtualNetworks = append(reservedVirtualNetworks, c.reservedCIDRs...)
@@ -119,6 +163,22 @@ func (c *VirtualNetworkCollector) Collect(ctx context.Context, _ interface{}) ([
 		return nil
 	})
 
+	g.Go(func() error {
+		c.logger.Debugf(ctx, "finding allocated virtual networks from the host cluster virtual network and its peerings")
+
+		virtualNetworks, err := c.getVirtualNetworksFromHostVirtualNetwork(ctx)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		mutex.Lock()
+		reservedVirtualNetworks = append(reservedVirtualNetworks, virtualNetworks...)
+		mutex.Unlock()
+
+		c.logger.Debugf(ctx, "found allocated virtual networks from the host cluster virtual network and its peerings")
+
+		return nil
+	})
+
 	err = g.Wait()
 	if err != nil {
 		return nil, microerror.Mask(err)
@@ -129,6 +189,23 @@ func (c *VirtualNetworkCollector) Collect(ctx context.Context, _ interface{}) ([
 	return reservedVirtualNetworks, nil
 }
 
+func (c *VirtualNetworkCollector) collectFromExistingVirtualNetwork(ctx context.Context, existing existingVirtualNetwork) ([]net.IPNet, error) {
+	if len(existing.prefixes) == 0 {
+		return nil, nil
+	}
+
+	reservedVirtualNetworks := append([]net.IPNet{}, c.reservedCIDRs...)
+	reservedVirtualNetworks = append(reservedVirtualNetworks, existing.subnets...)
+
+	virtualNetworks, err := c.getVirtualNetworksFromAzureConfigs(ctx)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	reservedVirtualNetworks = append(reservedVirtualNetworks, virtualNetworks...)
+
+	return ipam.CanonicalizeSubnets(existing.prefixes[0], reservedVirtualNetworks), nil
+}
+
 func (c *VirtualNetworkCollector) getVirtualNetworksFromAzureConfigs(ctx context.Context) ([]net.IPNet, error) {
 	tenantClusterList, err := c.getAllTenantClusters(ctx)
 	if err != nil {
@@ -167,7 +244,7 @@ func (c *VirtualNetworkCollector) getVirtualNetworksFromAllSubscriptions(ctx con
 			return nil, microerror.Mask(err)
 		}
 
-		organizationAzureClientSet, err := client.NewAzureClientSet(organizationAzureClientCredentialsConfig, c.azureMetricsCollector, subscriptionID, partnerID)
+		organizationAzureClientSet, err := client.NewAzureClientSet(organizationAzureClientCredentialsConfig, c.azureMetricsCollector, c.azureRateLimiter, subscriptionID, partnerID)
 		if err != nil {
 			return nil, microerror.Mask(err)
 		}
@@ -243,6 +320,47 @@ func (c *VirtualNetworkCollector) getVirtualNetworksFromSubscription(ctx context
 	return ret, nil
 }
 
+// getVirtualNetworksFromHostVirtualNetwork returns the address spaces of the
+// host cluster virtual network and of all virtual networks peered with it.
+// Peered virtual networks must not overlap, so they are reserved even when
+// they do not belong to tenant clusters, e.g. for shared services.
+func (c *VirtualNetworkCollector) getVirtualNetworksFromHostVirtualNetwork(ctx context.Context) ([]net.IPNet, error) {
+	vnet, err := c.hostVirtualNetworksClient.Get(ctx, c.hostResourceGroup, c.hostVirtualNetwork, "")
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	if vnet.VirtualNetworkPropertiesFormat == nil {
+		return nil, nil
+	}
+
+	var cidrs []string
+	if vnet.AddressSpace != nil && vnet.AddressSpace.AddressPrefixes != nil {
+		cidrs = append(cidrs, *vnet.AddressSpace.AddressPrefixes...)
+	}
+	if vnet.VirtualNetworkPeerings != nil {
+		for _, peering := range *vnet.VirtualNetworkPeerings {
+			if peering.VirtualNetworkPeeringPropertiesFormat == nil || peering.RemoteAddressSpace == nil || peering.RemoteAddressSpace.AddressPrefixes == nil {
+				continue
+			}
+
+			cidrs = append(cidrs, *peering.RemoteAddressSpace.AddressPrefixes...)
+		}
+	}
+
+	var ret []net.IPNet
+	for _, cidr := range cidrs {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		ret = append(ret, *n)
+	}
+
+	return ret, nil
+}
+
 func inArray(a []string, s string) bool {
 	for _, x := range a {
 		if x == s {
@@ -5,14 +5,22 @@ import (
 
 	corev1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/core/v1alpha1"
 	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/giantswarm/azure-operator/v5/pkg/label"
 )
 
 func (r *Resource) CreateDrainerConfig(ctx context.Context, clusterID, clusterAPIEndpoint string, nodeName string) error {
-	r.Logger.Debugf(ctx, "creating drainer config for tenant cluster node")
+	return CreateDrainerConfig(ctx, r.CtrlClient, r.Logger, clusterID, clusterAPIEndpoint, nodeName)
+}
+
+// CreateDrainerConfig creates the DrainerConfig asking node-operator to drain
+// the given tenant cluster node. An already existing DrainerConfig is kept.
+func CreateDrainerConfig(ctx context.Context, ctrlClient client.Client, logger micrologger.Logger, clusterID, clusterAPIEndpoint string, nodeName string) error {
+	logger.Debugf(ctx, "creating drainer config for tenant cluster node")
 
 	c := &corev1alpha1.DrainerConfig{
 		ObjectMeta: metav1.ObjectMeta{
@@ -40,14 +48,14 @@ func (r *Resource) CreateDrainerConfig(ctx context.Context, clusterID, clusterAP
 		},
 	}
 
-	err := r.CtrlClient.Create(ctx, c)
+	err := ctrlClient.Create(ctx, c)
 	if errors.IsAlreadyExists(err) {
-		r.Logger.Debugf(ctx, "did not create drainer config for tenant cluster node")
-		r.Logger.Debugf(ctx, "drainer config for tenant cluster node does already exist")
+		logger.Debugf(ctx, "did not create drainer config for tenant cluster node")
+		logger.Debugf(ctx, "drainer config for tenant cluster node does already exist")
 	} else if err != nil {
 		return microerror.Mask(err)
 	} else {
-		r.Logger.Debugf(ctx, "created drainer config for tenant cluster node")
+		logger.Debugf(ctx, "created drainer config for tenant cluster node")
 	}
 
 	return nil
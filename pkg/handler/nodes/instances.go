@@ -2,6 +2,8 @@ package nodes
 
 import (
 	"context"
+	"sort"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
 	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
@@ -112,3 +114,21 @@ func (r *Resource) CreateARMDeployment(ctx context.Context, deploymentsClient *a
 
 	return nil
 }
+
+// FormatInstanceIDs formats VMSS instance IDs for log messages and events.
+func FormatInstanceIDs(ids []string) string {
+	if len(ids) == 0 {
+		return "none identified"
+	}
+
+	// Instance IDs are numeric, order them by value.
+	sorted := append([]string{}, ids...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if len(sorted[i]) != len(sorted[j]) {
+			return len(sorted[i]) < len(sorted[j])
+		}
+		return sorted[i] < sorted[j]
+	})
+
+	return strings.Join(sorted, ", ")
+}
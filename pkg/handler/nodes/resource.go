@@ -6,10 +6,12 @@ import (
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/giantswarm/azure-operator/v5/client"
 	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
+	"github.com/giantswarm/azure-operator/v5/pkg/ignition"
 	"github.com/giantswarm/azure-operator/v5/service/controller/debugger"
 	"github.com/giantswarm/azure-operator/v5/service/controller/encrypter"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
@@ -17,23 +19,29 @@ import (
 )
 
 type Config struct {
-	CtrlClient ctrlclient.Client
-	Debugger   *debugger.Debugger
-	Logger     micrologger.Logger
+	CtrlClient    ctrlclient.Client
+	Debugger      *debugger.Debugger
+	EventRecorder record.EventRecorder
+	Logger        micrologger.Logger
 
 	Azure         setting.Azure
 	ClientFactory client.OrganizationFactory
+	CloudProvider setting.CloudProvider
+	Ignition      setting.Ignition
 	Name          string
 }
 
 type Resource struct {
-	CtrlClient   ctrlclient.Client
-	Debugger     *debugger.Debugger
-	Logger       micrologger.Logger
-	StateMachine state.Machine
+	CtrlClient    ctrlclient.Client
+	Debugger      *debugger.Debugger
+	EventRecorder record.EventRecorder
+	Logger        micrologger.Logger
+	StateMachine  state.Machine
 
 	Azure         setting.Azure
 	ClientFactory client.OrganizationFactory
+	CloudProvider setting.CloudProvider
+	Ignition      setting.Ignition
 	name          string
 }
 
@@ -44,6 +52,9 @@ func New(config Config) (*Resource, error) {
 	if config.Debugger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Debugger must not be empty", config)
 	}
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
@@ -51,18 +62,27 @@ func New(config Config) (*Resource, error) {
 	if err := config.Azure.Validate(); err != nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Azure.%s", config, err)
 	}
+	if err := config.CloudProvider.Validate(); err != nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CloudProvider.%s", config, err)
+	}
+	if err := ignition.ValidateSpecVersion(config.Ignition.SpecVersion); err != nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Ignition.%s", config, err)
+	}
 
 	if len(config.Name) == 0 {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Name must not be empty", config)
 	}
 
 	r := &Resource{
-		CtrlClient: config.CtrlClient,
-		Debugger:   config.Debugger,
-		Logger:     config.Logger,
+		CtrlClient:    config.CtrlClient,
+		Debugger:      config.Debugger,
+		EventRecorder: config.EventRecorder,
+		Logger:        config.Logger,
 
 		Azure:         config.Azure,
 		ClientFactory: config.ClientFactory,
+		CloudProvider: config.CloudProvider,
+		Ignition:      config.Ignition,
 		name:          config.Name,
 	}
 
@@ -95,8 +115,9 @@ func (r *Resource) GetEncrypterObject(ctx context.Context, secretName string) (e
 			return nil, microerror.Maskf(invalidConfigError, "encryption iv not found in secret %q", secret.Name)
 		}
 		c := encrypter.Config{
-			Key: secret.Data[key.CertificateEncryptionKeyName],
-			IV:  secret.Data[key.CertificateEncryptionIVName],
+			Key:         secret.Data[key.CertificateEncryptionKeyName],
+			IV:          secret.Data[key.CertificateEncryptionIVName],
+			PreviousKey: secret.Data[key.CertificateEncryptionPreviousKeyName],
 		}
 
 		enc, err = encrypter.New(c)
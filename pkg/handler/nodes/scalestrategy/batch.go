@@ -0,0 +1,14 @@
+package scalestrategy
+
+// Batch scales and replaces nodes in batches of a fixed size.
+type Batch struct {
+	Size int64
+}
+
+func (b Batch) GetBatchSize(remainingCount int64) int64 {
+	return batchSize(b.Size, remainingCount)
+}
+
+func (b Batch) GetNodeCount(currentCount int64, desiredCount int64) int64 {
+	return step(currentCount, desiredCount, b.Size)
+}
@@ -3,14 +3,10 @@ package scalestrategy
 type Incremental struct {
 }
 
-func (i Incremental) GetNodeCount(currentCount int64, desiredCount int64) int64 {
-	if currentCount < desiredCount {
-		return currentCount + 1
-	}
-
-	if currentCount > desiredCount {
-		return currentCount - 1
-	}
+func (i Incremental) GetBatchSize(remainingCount int64) int64 {
+	return batchSize(1, remainingCount)
+}
 
-	return currentCount
+func (i Incremental) GetNodeCount(currentCount int64, desiredCount int64) int64 {
+	return step(currentCount, desiredCount, 1)
 }
@@ -1,5 +1,11 @@
 package scalestrategy
 
 type Interface interface {
+	// GetBatchSize returns how many of the given remaining nodes are replaced
+	// at once, i.e. cordoned, drained and terminated together when rolling
+	// nodes.
+	GetBatchSize(remainingCount int64) int64
+	// GetNodeCount returns the node count to scale to in the next step when
+	// scaling from currentCount towards desiredCount.
 	GetNodeCount(currentCount int64, desiredCount int64) int64
 }
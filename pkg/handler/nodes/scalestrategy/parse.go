@@ -0,0 +1,110 @@
+package scalestrategy
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/giantswarm/microerror"
+)
+
+const (
+	NameBatch       = "batch"
+	NameIncremental = "incremental"
+	NamePercentage  = "percentage"
+	NameQuick       = "quick"
+	NameTimeRamp    = "ramp"
+)
+
+// Parse returns the scale strategy described by spec. The spec has the form
+// <name>[:<parameters>] and supports the following strategies.
+//
+//     quick                               all nodes at once, the default
+//     incremental                         one node at a time
+//     batch:<size>                        fixed number of nodes at a time
+//     percentage:<percent>                percentage of poolSize at a time
+//     ramp:<initial>,<interval>[,<max>]   batches starting with initial nodes
+//                                         at rampStart, doubling every
+//                                         interval up to max nodes
+//
+func Parse(spec string, poolSize int64, rampStart time.Time) (Interface, error) {
+	name, params := spec, ""
+	if i := strings.Index(spec, ":"); i >= 0 {
+		name, params = spec[:i], spec[i+1:]
+	}
+	name = strings.TrimSpace(name)
+
+	switch name {
+	case "", NameQuick:
+		if params != "" {
+			return nil, microerror.Maskf(invalidConfigError, "scale strategy %#q does not take parameters", name)
+		}
+		return Quick{}, nil
+
+	case NameIncremental:
+		if params != "" {
+			return nil, microerror.Maskf(invalidConfigError, "scale strategy %#q does not take parameters", name)
+		}
+		return Incremental{}, nil
+
+	case NameBatch:
+		size, err := parsePositive(params, "batch size")
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		return Batch{Size: size}, nil
+
+	case NamePercentage:
+		percentage, err := parsePositive(params, "percentage")
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		if percentage > 100 {
+			return nil, microerror.Maskf(invalidConfigError, "percentage must not be greater than 100, got %d", percentage)
+		}
+		return Percentage{Percentage: percentage, PoolSize: poolSize}, nil
+
+	case NameTimeRamp:
+		parts := strings.Split(params, ",")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, microerror.Maskf(invalidConfigError, "scale strategy %#q expects parameters <initial>,<interval>[,<max>], got %#q", name, params)
+		}
+
+		initialSize, err := parsePositive(parts[0], "initial batch size")
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		interval, err := time.ParseDuration(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, microerror.Maskf(invalidConfigError, "invalid ramp interval %#q", parts[1])
+		}
+		if interval <= 0 {
+			return nil, microerror.Maskf(invalidConfigError, "ramp interval must be positive, got %#q", parts[1])
+		}
+
+		var maxSize int64
+		if len(parts) == 3 {
+			maxSize, err = parsePositive(parts[2], "maximum batch size")
+			if err != nil {
+				return nil, microerror.Mask(err)
+			}
+		}
+
+		return TimeRamp{Start: rampStart, Interval: interval, InitialSize: initialSize, MaxSize: maxSize}, nil
+	}
+
+	return nil, microerror.Maskf(invalidConfigError, "unknown scale strategy %#q", name)
+}
+
+func parsePositive(s string, what string) (int64, error) {
+	i, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return 0, microerror.Maskf(invalidConfigError, "invalid %s %#q", what, s)
+	}
+	if i < 1 {
+		return 0, microerror.Maskf(invalidConfigError, "%s must be positive, got %d", what, i)
+	}
+
+	return i, nil
+}
@@ -0,0 +1,227 @@
+package scalestrategy
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_Parse(t *testing.T) {
+	start := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name             string
+		spec             string
+		poolSize         int64
+		expectedStrategy Interface
+		errorMatcher     func(error) bool
+	}{
+		{
+			name:             "case 0: empty spec defaults to quick",
+			spec:             "",
+			expectedStrategy: Quick{},
+		},
+		{
+			name:             "case 1: incremental",
+			spec:             "incremental",
+			expectedStrategy: Incremental{},
+		},
+		{
+			name:             "case 2: batch",
+			spec:             "batch:5",
+			expectedStrategy: Batch{Size: 5},
+		},
+		{
+			name:             "case 3: percentage",
+			spec:             "percentage:25",
+			poolSize:         10,
+			expectedStrategy: Percentage{Percentage: 25, PoolSize: 10},
+		},
+		{
+			name:             "case 4: ramp without maximum",
+			spec:             "ramp:1,10m",
+			expectedStrategy: TimeRamp{Start: start, Interval: 10 * time.Minute, InitialSize: 1},
+		},
+		{
+			name:             "case 5: ramp with maximum",
+			spec:             "ramp:2,5m,20",
+			expectedStrategy: TimeRamp{Start: start, Interval: 5 * time.Minute, InitialSize: 2, MaxSize: 20},
+		},
+		{
+			name:         "case 6: unknown strategy",
+			spec:         "yolo",
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name:         "case 7: batch without size",
+			spec:         "batch",
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name:         "case 8: zero batch size",
+			spec:         "batch:0",
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name:         "case 9: percentage above 100",
+			spec:         "percentage:150",
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name:         "case 10: ramp with invalid interval",
+			spec:         "ramp:1,often",
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name:         "case 11: quick with parameters",
+			spec:         "quick:3",
+			errorMatcher: IsInvalidConfig,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			strategy, err := Parse(tc.spec, tc.poolSize, start)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if !cmp.Equal(strategy, tc.expectedStrategy) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedStrategy, strategy))
+			}
+		})
+	}
+}
+
+func Test_Strategies(t *testing.T) {
+	start := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	rampAt := func(elapsed time.Duration) TimeRamp {
+		return TimeRamp{
+			Start:       start,
+			Interval:    10 * time.Minute,
+			InitialSize: 2,
+			MaxSize:     10,
+			Now:         func() time.Time { return start.Add(elapsed) },
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		strategy          Interface
+		currentCount      int64
+		desiredCount      int64
+		remainingCount    int64
+		expectedNodeCount int64
+		expectedBatchSize int64
+	}{
+		{
+			name:              "case 0: quick jumps to desired count",
+			strategy:          Quick{},
+			currentCount:      3,
+			desiredCount:      10,
+			remainingCount:    7,
+			expectedNodeCount: 10,
+			expectedBatchSize: 7,
+		},
+		{
+			name:              "case 1: incremental scales down by one",
+			strategy:          Incremental{},
+			currentCount:      10,
+			desiredCount:      3,
+			remainingCount:    7,
+			expectedNodeCount: 9,
+			expectedBatchSize: 1,
+		},
+		{
+			name:              "case 2: batch scales up by batch size",
+			strategy:          Batch{Size: 4},
+			currentCount:      3,
+			desiredCount:      10,
+			remainingCount:    7,
+			expectedNodeCount: 7,
+			expectedBatchSize: 4,
+		},
+		{
+			name:              "case 3: batch does not overshoot",
+			strategy:          Batch{Size: 4},
+			currentCount:      8,
+			desiredCount:      10,
+			remainingCount:    2,
+			expectedNodeCount: 10,
+			expectedBatchSize: 2,
+		},
+		{
+			name:              "case 4: percentage rounds up",
+			strategy:          Percentage{Percentage: 25, PoolSize: 10},
+			currentCount:      10,
+			desiredCount:      20,
+			remainingCount:    10,
+			expectedNodeCount: 13,
+			expectedBatchSize: 3,
+		},
+		{
+			name:              "case 5: percentage processes at least one node",
+			strategy:          Percentage{Percentage: 1, PoolSize: 3},
+			currentCount:      3,
+			desiredCount:      6,
+			remainingCount:    3,
+			expectedNodeCount: 4,
+			expectedBatchSize: 1,
+		},
+		{
+			name:              "case 6: ramp starts with initial size",
+			strategy:          rampAt(5 * time.Minute),
+			currentCount:      10,
+			desiredCount:      20,
+			remainingCount:    10,
+			expectedNodeCount: 12,
+			expectedBatchSize: 2,
+		},
+		{
+			name:              "case 7: ramp doubles every interval",
+			strategy:          rampAt(25 * time.Minute),
+			currentCount:      10,
+			desiredCount:      20,
+			remainingCount:    10,
+			expectedNodeCount: 18,
+			expectedBatchSize: 8,
+		},
+		{
+			name:              "case 8: ramp is capped at maximum size",
+			strategy:          rampAt(24 * time.Hour),
+			currentCount:      10,
+			desiredCount:      30,
+			remainingCount:    20,
+			expectedNodeCount: 20,
+			expectedBatchSize: 10,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			nodeCount := tc.strategy.GetNodeCount(tc.currentCount, tc.desiredCount)
+			if nodeCount != tc.expectedNodeCount {
+				t.Fatalf("GetNodeCount() == %d, want %d", nodeCount, tc.expectedNodeCount)
+			}
+
+			batchSize := tc.strategy.GetBatchSize(tc.remainingCount)
+			if batchSize != tc.expectedBatchSize {
+				t.Fatalf("GetBatchSize() == %d, want %d", batchSize, tc.expectedBatchSize)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package scalestrategy
+
+// Percentage scales and replaces nodes in batches sized as a percentage of the
+// node pool size. Batches contain at least one node.
+type Percentage struct {
+	Percentage int64
+	PoolSize   int64
+}
+
+func (p Percentage) GetBatchSize(remainingCount int64) int64 {
+	return batchSize(p.size(), remainingCount)
+}
+
+func (p Percentage) GetNodeCount(currentCount int64, desiredCount int64) int64 {
+	return step(currentCount, desiredCount, p.size())
+}
+
+func (p Percentage) size() int64 {
+	// Round up so that small pools still make progress.
+	return (p.PoolSize*p.Percentage + 99) / 100
+}
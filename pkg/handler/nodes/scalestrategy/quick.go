@@ -3,6 +3,10 @@ package scalestrategy
 type Quick struct {
 }
 
+func (i Quick) GetBatchSize(remainingCount int64) int64 {
+	return remainingCount
+}
+
 func (i Quick) GetNodeCount(currentCount int64, desiredCount int64) int64 {
 	return desiredCount
 }
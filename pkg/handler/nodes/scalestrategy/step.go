@@ -0,0 +1,37 @@
+package scalestrategy
+
+// step moves currentCount towards desiredCount by at most size nodes. At least
+// one node is added or removed per step.
+func step(currentCount int64, desiredCount int64, size int64) int64 {
+	if size < 1 {
+		size = 1
+	}
+
+	if currentCount < desiredCount {
+		if desiredCount-currentCount < size {
+			return desiredCount
+		}
+		return currentCount + size
+	}
+
+	if currentCount > desiredCount {
+		if currentCount-desiredCount < size {
+			return desiredCount
+		}
+		return currentCount - size
+	}
+
+	return currentCount
+}
+
+// batchSize bounds size to the range from one to remainingCount.
+func batchSize(size int64, remainingCount int64) int64 {
+	if size < 1 {
+		size = 1
+	}
+	if size > remainingCount {
+		return remainingCount
+	}
+
+	return size
+}
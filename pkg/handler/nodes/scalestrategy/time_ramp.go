@@ -0,0 +1,56 @@
+package scalestrategy
+
+import (
+	"time"
+)
+
+// maxRampDoublings caps the exponent of the ramp so that the batch size can
+// not overflow.
+const maxRampDoublings = 32
+
+// TimeRamp scales and replaces nodes in batches growing over time. Batches
+// start with InitialSize nodes at Start and double every Interval until they
+// reach MaxSize. A MaxSize of zero means the batch size is not capped.
+type TimeRamp struct {
+	Start       time.Time
+	Interval    time.Duration
+	InitialSize int64
+	MaxSize     int64
+
+	// Now returns the current time. It defaults to time.Now and is only
+	// overwritten in tests.
+	Now func() time.Time
+}
+
+func (t TimeRamp) GetBatchSize(remainingCount int64) int64 {
+	return batchSize(t.size(), remainingCount)
+}
+
+func (t TimeRamp) GetNodeCount(currentCount int64, desiredCount int64) int64 {
+	return step(currentCount, desiredCount, t.size())
+}
+
+func (t TimeRamp) size() int64 {
+	now := time.Now
+	if t.Now != nil {
+		now = t.Now
+	}
+
+	var doublings int64
+	if t.Interval > 0 {
+		elapsed := now().Sub(t.Start)
+		if elapsed > 0 {
+			doublings = int64(elapsed / t.Interval)
+		}
+	}
+	if doublings > maxRampDoublings {
+		doublings = maxRampDoublings
+	}
+
+	size := t.InitialSize << uint(doublings)
+	if t.MaxSize > 0 && size > t.MaxSize {
+		size = t.MaxSize
+	}
+
+	return size
+}
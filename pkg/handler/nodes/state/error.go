@@ -13,3 +13,21 @@ import "github.com/giantswarm/microerror"
 var executionFailedError = &microerror.Error{
 	Kind: "executionFailedError",
 }
+
+var invalidHistoryError = &microerror.Error{
+	Kind: "invalidHistoryError",
+}
+
+// IsInvalidHistory asserts invalidHistoryError.
+func IsInvalidHistory(err error) bool {
+	return microerror.Cause(err) == invalidHistoryError
+}
+
+var invalidConfigError = &microerror.Error{
+	Kind: "invalidConfigError",
+}
+
+// IsInvalidConfig asserts invalidConfigError.
+func IsInvalidConfig(err error) bool {
+	return microerror.Cause(err) == invalidConfigError
+}
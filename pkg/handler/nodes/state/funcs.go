@@ -2,26 +2,103 @@ package state
 
 import (
 	"context"
+	"time"
 
 	"github.com/giantswarm/microerror"
 )
 
+// Execute executes the transition function of the current state and returns
+// the new state.
 func (m Machine) Execute(ctx context.Context, obj interface{}, currentState State) (State, error) {
+	newState, _, err := m.ExecuteWithHistory(ctx, obj, currentState, nil)
+	if err != nil {
+		return newState, microerror.Mask(err)
+	}
+
+	return newState, nil
+}
+
+// ExecuteWithHistory works like Execute and records the executed transition in
+// the given history. Failed transitions are recorded as well so that the
+// history shows why the machine does not make progress. The returned history
+// is bounded by HistoryLimit.
+func (m Machine) ExecuteWithHistory(ctx context.Context, obj interface{}, currentState State, history History) (State, History, error) {
 	transitionFunc, exists := m.Transitions[currentState]
 	if !exists {
-		return "", microerror.Maskf(executionFailedError, "State: %q is not configured in this state machine", currentState)
+		return "", history, microerror.Maskf(executionFailedError, "State: %q is not configured in this state machine", currentState)
 	}
 
-	newState, err := transitionFunc(ctx, obj, currentState)
-	if err != nil {
-		return newState, microerror.Mask(err)
+	ctx, reason := withReason(ctx)
+
+	var newState State
+	var skip bool
+	var err error
+	for _, h := range m.BeforeHooks {
+		newState, skip, err = h.BeforeTransition(ctx, obj, currentState, history)
+		if err != nil || skip {
+			break
+		}
 	}
 
-	_, exists = m.Transitions[newState]
-	if !exists {
-		return newState, microerror.Maskf(executionFailedError, "State transition returned new unknown state: %q. Input state: %q", newState, currentState)
+	if err == nil && !skip {
+		newState, err = transitionFunc(ctx, obj, currentState)
+	}
+
+	if err == nil {
+		_, exists = m.Transitions[newState]
+		if !exists {
+			err = microerror.Maskf(executionFailedError, "State transition returned new unknown state: %q. Input state: %q", newState, currentState)
+		}
+	}
+
+	if err != nil || newState != currentState {
+		t := Transition{
+			From:   currentState,
+			To:     newState,
+			Time:   m.currentTime(),
+			Reason: reason.reason,
+		}
+		if err != nil {
+			t.To = currentState
+			t.Error = err.Error()
+		}
+
+		history = history.record(t, m.historyLimit())
+		t, _ = history.Last()
+		m.executeAfterHooks(ctx, obj, t)
+	}
+
+	if err != nil {
+		return newState, history, microerror.Mask(err)
 	}
 
 	m.Logger.LogCtx(ctx, "resource", m.ResourceName, "message", "state changed", "oldState", currentState, "newState", newState)
-	return newState, nil
+	return newState, history, nil
+}
+
+func (m Machine) executeAfterHooks(ctx context.Context, obj interface{}, t Transition) {
+	for _, h := range m.AfterHooks {
+		err := h.AfterTransition(ctx, obj, t)
+		if err != nil && m.Logger != nil {
+			// The transition already happened, failing hooks must not stop
+			// the machine from persisting it.
+			m.Logger.Errorf(ctx, err, "failed to execute after transition hook of resource %#q", m.ResourceName)
+		}
+	}
+}
+
+func (m Machine) currentTime() time.Time {
+	if m.now != nil {
+		return m.now()
+	}
+
+	return time.Now()
+}
+
+func (m Machine) historyLimit() int {
+	if m.HistoryLimit > 0 {
+		return m.HistoryLimit
+	}
+
+	return DefaultHistoryLimit
 }
@@ -2,8 +2,10 @@ package state
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
@@ -103,6 +105,162 @@ func Test_StateMachine(t *testing.T) {
 	}
 }
 
+type testBeforeHook struct {
+	timeout time.Duration
+	now     time.Time
+}
+
+func (h testBeforeHook) BeforeTransition(ctx context.Context, obj interface{}, currentState State, history History) (State, bool, error) {
+	enteredAt, ok := history.EnteredAt(currentState)
+	if ok && h.now.Sub(enteredAt) > h.timeout {
+		SetReason(ctx, "Timeout")
+		return ClosedState, true, nil
+	}
+
+	return "", false, nil
+}
+
+type testAfterHook struct {
+	transitions *[]Transition
+}
+
+func (h testAfterHook) AfterTransition(ctx context.Context, obj interface{}, transition Transition) error {
+	*h.transitions = append(*h.transitions, transition)
+	return nil
+}
+
+func Test_StateMachineHistory(t *testing.T) {
+	logger, err := micrologger.New(micrologger.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	testError := errors.New("node not ready")
+
+	testCases := []struct {
+		name             string
+		transitions      TransitionMap
+		beforeHooks      []BeforeHook
+		historyLimit     int
+		currentState     State
+		history          History
+		expectedNewState State
+		expectedHistory  History
+	}{
+		{
+			name: "case 0: state change is recorded with reason",
+			transitions: TransitionMap{
+				OpenState: func(ctx context.Context, obj interface{}, currentState State) (State, error) {
+					SetReason(ctx, "Closing")
+					return ClosedState, nil
+				},
+				ClosedState: nil,
+			},
+			currentState:     OpenState,
+			expectedNewState: ClosedState,
+			expectedHistory: History{
+				{From: OpenState, To: ClosedState, Time: now, Reason: "Closing", Count: 1},
+			},
+		},
+		{
+			name: "case 1: unchanged state is not recorded",
+			transitions: TransitionMap{
+				OpenState: func(ctx context.Context, obj interface{}, currentState State) (State, error) { return currentState, nil },
+			},
+			currentState:     OpenState,
+			history:          History{{From: ClosedState, To: OpenState, Time: now.Add(-time.Minute), Count: 1}},
+			expectedNewState: OpenState,
+			expectedHistory:  History{{From: ClosedState, To: OpenState, Time: now.Add(-time.Minute), Count: 1}},
+		},
+		{
+			name: "case 2: repeated failures are recorded once",
+			transitions: TransitionMap{
+				OpenState: func(ctx context.Context, obj interface{}, currentState State) (State, error) { return currentState, testError },
+			},
+			currentState:     OpenState,
+			history:          History{{From: OpenState, To: OpenState, Time: now.Add(-time.Minute), Error: testError.Error(), Count: 2}},
+			expectedNewState: OpenState,
+			expectedHistory:  History{{From: OpenState, To: OpenState, Time: now, Error: testError.Error(), Count: 3}},
+		},
+		{
+			name: "case 3: history is bounded",
+			transitions: TransitionMap{
+				OpenState:   func(ctx context.Context, obj interface{}, currentState State) (State, error) { return ClosedState, nil },
+				ClosedState: nil,
+			},
+			historyLimit: 2,
+			currentState: OpenState,
+			history: History{
+				{From: OpenState, To: ClosedState, Time: now.Add(-2 * time.Minute), Count: 1},
+				{From: ClosedState, To: OpenState, Time: now.Add(-time.Minute), Count: 1},
+			},
+			expectedNewState: ClosedState,
+			expectedHistory: History{
+				{From: ClosedState, To: OpenState, Time: now.Add(-time.Minute), Count: 1},
+				{From: OpenState, To: ClosedState, Time: now, Count: 1},
+			},
+		},
+		{
+			name: "case 4: before hook overrides transition",
+			transitions: TransitionMap{
+				OpenState:   func(ctx context.Context, obj interface{}, currentState State) (State, error) { return currentState, nil },
+				ClosedState: nil,
+			},
+			beforeHooks:      []BeforeHook{testBeforeHook{timeout: time.Hour, now: now}},
+			currentState:     OpenState,
+			history:          History{{From: ClosedState, To: OpenState, Time: now.Add(-2 * time.Hour), Count: 1}},
+			expectedNewState: ClosedState,
+			expectedHistory: History{
+				{From: ClosedState, To: OpenState, Time: now.Add(-2 * time.Hour), Count: 1},
+				{From: OpenState, To: ClosedState, Time: now, Reason: "Timeout", Count: 1},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			var recorded []Transition
+			machine := Machine{
+				Logger:       logger,
+				Transitions:  tc.transitions,
+				BeforeHooks:  tc.beforeHooks,
+				AfterHooks:   []AfterHook{testAfterHook{transitions: &recorded}},
+				HistoryLimit: tc.historyLimit,
+				now:          func() time.Time { return now },
+			}
+
+			newState, history, _ := machine.ExecuteWithHistory(context.Background(), nil, tc.currentState, tc.history)
+
+			if !cmp.Equal(newState, tc.expectedNewState) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedNewState, newState))
+			}
+
+			if !cmp.Equal(history, tc.expectedHistory) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedHistory, history))
+			}
+
+			parsed, err := ParseHistory(history.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(parsed, history) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(history, parsed))
+			}
+
+			if last, ok := history.Last(); ok && last.Time.Equal(now) {
+				if len(recorded) != 1 || !cmp.Equal(recorded[0], last) {
+					t.Fatalf("expected after hook to observe %#v, got %#v", last, recorded)
+				}
+			} else if len(recorded) != 0 {
+				t.Fatalf("expected after hook not to be called, got %#v", recorded)
+			}
+		})
+	}
+}
+
 func IsExecutionFailedError(err error) bool {
 	return microerror.Cause(err) == executionFailedError
 }
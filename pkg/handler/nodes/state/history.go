@@ -0,0 +1,116 @@
+package state
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/giantswarm/microerror"
+)
+
+const (
+	// DefaultHistoryLimit is the default number of transitions kept in the
+	// history of a state machine.
+	DefaultHistoryLimit = 20
+)
+
+// Transition is a single recorded step of a state machine. Failed transitions
+// keep the state and carry the error message. Consecutive identical failures
+// are recorded once, counting the attempts.
+type Transition struct {
+	From   State     `json:"from"`
+	To     State     `json:"to"`
+	Time   time.Time `json:"time"`
+	Reason string    `json:"reason,omitempty"`
+	Error  string    `json:"error,omitempty"`
+	Count  int       `json:"count,omitempty"`
+}
+
+// History holds the recorded transitions of a state machine, oldest first.
+type History []Transition
+
+// ParseHistory parses a history serialized using History.String. An empty
+// string results in an empty history.
+func ParseHistory(s string) (History, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var h History
+	err := json.Unmarshal([]byte(s), &h)
+	if err != nil {
+		return nil, microerror.Maskf(invalidHistoryError, err.Error())
+	}
+
+	return h, nil
+}
+
+// String serializes the history so that it can be stored in an annotation.
+func (h History) String() string {
+	if len(h) == 0 {
+		return ""
+	}
+
+	b, err := json.Marshal(h)
+	if err != nil {
+		// Transitions only hold strings and times, this can not happen.
+		panic(err)
+	}
+
+	return string(b)
+}
+
+// EnteredAt returns when the machine last moved into the given state. It
+// returns false when the history does not contain such a transition.
+func (h History) EnteredAt(s State) (time.Time, bool) {
+	for i := len(h) - 1; i >= 0; i-- {
+		if h[i].To == s && h[i].From != h[i].To {
+			return h[i].Time, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// EnteredFrom returns the state the machine was in before it last moved into
+// the given state. It returns false when the history does not contain such a
+// transition.
+func (h History) EnteredFrom(s State) (State, bool) {
+	for i := len(h) - 1; i >= 0; i-- {
+		if h[i].To == s && h[i].From != h[i].To {
+			return h[i].From, true
+		}
+	}
+
+	return "", false
+}
+
+// Last returns the latest recorded transition.
+func (h History) Last() (Transition, bool) {
+	if len(h) == 0 {
+		return Transition{}, false
+	}
+
+	return h[len(h)-1], true
+}
+
+// record appends the transition to the history, merging repeated failures,
+// and drops the oldest transitions beyond limit.
+func (h History) record(t Transition, limit int) History {
+	if t.Count == 0 {
+		t.Count = 1
+	}
+
+	if last, ok := h.Last(); ok && t.Error != "" && last.From == t.From && last.To == t.To && last.Error == t.Error {
+		t.Count = last.Count + 1
+		h = h[:len(h)-1]
+	}
+
+	history := append(History{}, h...)
+	history = append(history, t)
+
+	if limit > 0 && len(history) > limit {
+		history = history[len(history)-limit:]
+	}
+
+	return history
+}
@@ -0,0 +1,55 @@
+package state
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+)
+
+const (
+	// ReasonPaused is recorded when a machine is moved to the paused state.
+	ReasonPaused = "Paused"
+	// ReasonUnpaused is recorded when a machine leaves the paused state.
+	ReasonUnpaused = "Unpaused"
+)
+
+// PauseHook is a BeforeHook which moves the machine to PausedState while
+// Paused returns true. Once Paused returns false again the machine moves back
+// to the state it was paused in. Leaving the current state instead of only
+// skipping its transition function restarts the deadline of the state when
+// the machine is unpaused, so it must be configured before a TimeoutHook.
+type PauseHook struct {
+	Paused      func(ctx context.Context, obj interface{}) (bool, error)
+	PausedState State
+}
+
+func (h PauseHook) BeforeTransition(ctx context.Context, obj interface{}, currentState State, history History) (State, bool, error) {
+	paused, err := h.Paused(ctx, obj)
+	if err != nil {
+		return "", false, microerror.Mask(err)
+	}
+
+	if currentState != h.PausedState {
+		if !paused {
+			return "", false, nil
+		}
+
+		SetReason(ctx, ReasonPaused)
+
+		return h.PausedState, true, nil
+	}
+
+	if paused {
+		return currentState, true, nil
+	}
+
+	// Go back to the state the machine was paused in.
+	pausedState, ok := history.EnteredFrom(currentState)
+	if !ok {
+		return "", false, nil
+	}
+
+	SetReason(ctx, ReasonUnpaused)
+
+	return pausedState, true, nil
+}
@@ -0,0 +1,87 @@
+package state
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	PausedState State = "paused"
+)
+
+func Test_PauseHook(t *testing.T) {
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		currentState  State
+		history       History
+		paused        bool
+		expectedState State
+		expectedSkip  bool
+	}{
+		{
+			name:         "case 0: machine is not paused",
+			currentState: WaitingState,
+			history:      History{{From: OpenState, To: WaitingState, Time: now.Add(-5 * time.Minute)}},
+		},
+		{
+			name:          "case 1: machine gets paused",
+			currentState:  WaitingState,
+			history:       History{{From: OpenState, To: WaitingState, Time: now.Add(-5 * time.Minute)}},
+			paused:        true,
+			expectedState: PausedState,
+			expectedSkip:  true,
+		},
+		{
+			name:         "case 2: machine stays paused",
+			currentState: PausedState,
+			history: History{
+				{From: OpenState, To: WaitingState, Time: now.Add(-30 * time.Minute)},
+				{From: WaitingState, To: PausedState, Time: now.Add(-15 * time.Minute)},
+			},
+			paused:        true,
+			expectedState: PausedState,
+			expectedSkip:  true,
+		},
+		{
+			name:         "case 3: machine gets unpaused",
+			currentState: PausedState,
+			history: History{
+				{From: OpenState, To: WaitingState, Time: now.Add(-30 * time.Minute)},
+				{From: WaitingState, To: PausedState, Time: now.Add(-15 * time.Minute)},
+			},
+			expectedState: WaitingState,
+			expectedSkip:  true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			hook := PauseHook{
+				Paused: func(ctx context.Context, obj interface{}) (bool, error) {
+					return tc.paused, nil
+				},
+				PausedState: PausedState,
+			}
+
+			newState, skip, err := hook.BeforeTransition(context.Background(), nil, tc.currentState, tc.history)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(newState, tc.expectedState) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedState, newState))
+			}
+			if skip != tc.expectedSkip {
+				t.Fatalf("skip == %t, want %t", skip, tc.expectedSkip)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package state
+
+import (
+	"context"
+)
+
+type reasonKey string
+
+const key reasonKey = "reason"
+
+type reasonHolder struct {
+	reason string
+}
+
+// SetReason sets the reason recorded for the current transition. It must be
+// called with the context given to a transition function or a BeforeHook.
+func SetReason(ctx context.Context, reason string) {
+	h, ok := ctx.Value(key).(*reasonHolder)
+	if !ok {
+		return
+	}
+
+	h.reason = reason
+}
+
+func withReason(ctx context.Context) (context.Context, *reasonHolder) {
+	h := &reasonHolder{}
+	return context.WithValue(ctx, key, h), h
+}
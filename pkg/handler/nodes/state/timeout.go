@@ -0,0 +1,167 @@
+package state
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+const (
+	// ReasonResumed is recorded when a machine leaves the failure state after
+	// a resume was requested.
+	ReasonResumed = "Resumed"
+	// ReasonTimeout is recorded when a machine is moved to the failure state
+	// because it stayed in a state longer than the deadline of the state.
+	ReasonTimeout = "Timeout"
+)
+
+// TimeoutHook is a BeforeHook which moves the machine to FailureState once it
+// stayed in a state for longer than the deadline configured for that state.
+// The time a state was entered is taken from the history. The machine stays
+// in FailureState until the ResumeAnnotation of the reconciled object is set
+// to an RFC 3339 timestamp after the time of the failure. It then moves back
+// to the state that timed out. Escalate allows moving the machine to another
+// state than FailureState, e.g. one which recovers from the timeout.
+type TimeoutHook struct {
+	// Deadlines returns the deadlines of the states for the given object.
+	// States without deadline never time out.
+	Deadlines        func(obj interface{}) (map[State]time.Duration, error)
+	FailureState     State
+	ResumeAnnotation string
+
+	// Escalate returns the state the machine is moved to when the given state
+	// timed out. It is optional and the machine is moved to FailureState when
+	// it is not set.
+	Escalate func(ctx context.Context, obj interface{}, timedOutState State) (State, error)
+	// OnTimeout is called before the machine is moved to FailureState.
+	OnTimeout func(ctx context.Context, obj interface{}, timedOutState State, deadline time.Duration) error
+	// OnResume is called before the machine is moved back to the state that
+	// timed out.
+	OnResume func(ctx context.Context, obj interface{}, resumedState State) error
+
+	// now returns the current time. It defaults to time.Now and is only
+	// overwritten in tests.
+	now func() time.Time
+}
+
+func (h TimeoutHook) BeforeTransition(ctx context.Context, obj interface{}, currentState State, history History) (State, bool, error) {
+	if currentState == h.FailureState {
+		return h.resume(ctx, obj, currentState, history)
+	}
+
+	deadlines, err := h.Deadlines(obj)
+	if err != nil {
+		return "", false, microerror.Mask(err)
+	}
+
+	deadline, ok := deadlines[currentState]
+	if !ok || deadline <= 0 {
+		return "", false, nil
+	}
+
+	enteredAt, ok := history.EnteredAt(currentState)
+	if !ok || h.currentTime().Sub(enteredAt) <= deadline {
+		return "", false, nil
+	}
+
+	SetReason(ctx, ReasonTimeout)
+
+	if h.Escalate != nil {
+		newState, err := h.Escalate(ctx, obj, currentState)
+		if err != nil {
+			return "", false, microerror.Mask(err)
+		}
+
+		if newState != h.FailureState {
+			return newState, true, nil
+		}
+	}
+
+	if h.OnTimeout != nil {
+		err = h.OnTimeout(ctx, obj, currentState, deadline)
+		if err != nil {
+			return "", false, microerror.Mask(err)
+		}
+	}
+
+	return h.FailureState, true, nil
+}
+
+func (h TimeoutHook) resume(ctx context.Context, obj interface{}, currentState State, history History) (State, bool, error) {
+	failedAt, ok := history.EnteredAt(currentState)
+	if !ok {
+		return "", false, nil
+	}
+
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return "", false, microerror.Mask(err)
+	}
+
+	value, ok := accessor.GetAnnotations()[h.ResumeAnnotation]
+	if !ok {
+		return "", false, nil
+	}
+
+	requestedAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return "", false, microerror.Maskf(invalidConfigError, "annotation %#q must be an RFC 3339 timestamp, got %#q", h.ResumeAnnotation, value)
+	}
+
+	if !requestedAt.After(failedAt) {
+		return "", false, nil
+	}
+
+	// Go back to the state that timed out.
+	timedOutState, _ := history.EnteredFrom(currentState)
+
+	if h.OnResume != nil {
+		err = h.OnResume(ctx, obj, timedOutState)
+		if err != nil {
+			return "", false, microerror.Mask(err)
+		}
+	}
+
+	SetReason(ctx, ReasonResumed)
+
+	return timedOutState, true, nil
+}
+
+func (h TimeoutHook) currentTime() time.Time {
+	if h.now != nil {
+		return h.now()
+	}
+
+	return time.Now()
+}
+
+// ParseDeadlines parses deadlines of the form <state>=<duration>[,...], e.g.
+// "DrainOldWorkerNodes=1h,WaitForWorkersToBecomeReady=30m". States which are
+// not listed and states with a duration of 0 have no deadline, so an empty
+// string disables all deadlines.
+func ParseDeadlines(s string) (map[State]time.Duration, error) {
+	deadlines := map[State]time.Duration{}
+
+	if strings.TrimSpace(s) == "" {
+		return deadlines, nil
+	}
+
+	for _, pair := range strings.Split(s, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, microerror.Maskf(invalidConfigError, "deadline %#q must have the form <state>=<duration>", pair)
+		}
+
+		d, err := time.ParseDuration(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, microerror.Maskf(invalidConfigError, "invalid duration in deadline %#q", pair)
+		}
+
+		deadlines[State(strings.TrimSpace(parts[0]))] = d
+	}
+
+	return deadlines, nil
+}
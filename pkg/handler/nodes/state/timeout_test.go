@@ -0,0 +1,234 @@
+package state
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	WaitingState State = "waiting"
+	FailedState  State = "failed"
+)
+
+func Test_TimeoutHook(t *testing.T) {
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	resumeAnnotation := "test.giantswarm.io/resume"
+
+	testCases := []struct {
+		name          string
+		currentState  State
+		history       History
+		annotations   map[string]string
+		expectedState State
+		expectedSkip  bool
+		expectTimeout bool
+		expectResume  bool
+		errorMatcher  func(error) bool
+	}{
+		{
+			name:         "case 0: state within deadline",
+			currentState: WaitingState,
+			history:      History{{From: OpenState, To: WaitingState, Time: now.Add(-5 * time.Minute)}},
+		},
+		{
+			name:          "case 1: state exceeded deadline",
+			currentState:  WaitingState,
+			history:       History{{From: OpenState, To: WaitingState, Time: now.Add(-15 * time.Minute)}},
+			expectedState: FailedState,
+			expectedSkip:  true,
+			expectTimeout: true,
+		},
+		{
+			name:         "case 2: state without deadline",
+			currentState: OpenState,
+			history:      History{{From: ClosedState, To: OpenState, Time: now.Add(-24 * time.Hour)}},
+		},
+		{
+			name:         "case 3: failure state without resume annotation",
+			currentState: FailedState,
+			history: History{
+				{From: OpenState, To: WaitingState, Time: now.Add(-30 * time.Minute)},
+				{From: WaitingState, To: FailedState, Time: now.Add(-15 * time.Minute)},
+			},
+		},
+		{
+			name:         "case 4: failure state with outdated resume annotation",
+			currentState: FailedState,
+			history: History{
+				{From: OpenState, To: WaitingState, Time: now.Add(-30 * time.Minute)},
+				{From: WaitingState, To: FailedState, Time: now.Add(-15 * time.Minute)},
+			},
+			annotations: map[string]string{resumeAnnotation: now.Add(-20 * time.Minute).Format(time.RFC3339)},
+		},
+		{
+			name:         "case 5: failure state is resumed",
+			currentState: FailedState,
+			history: History{
+				{From: OpenState, To: WaitingState, Time: now.Add(-30 * time.Minute)},
+				{From: WaitingState, To: FailedState, Time: now.Add(-15 * time.Minute)},
+			},
+			annotations:   map[string]string{resumeAnnotation: now.Add(-time.Minute).Format(time.RFC3339)},
+			expectedState: WaitingState,
+			expectedSkip:  true,
+			expectResume:  true,
+		},
+		{
+			name:         "case 6: invalid resume annotation",
+			currentState: FailedState,
+			history: History{
+				{From: WaitingState, To: FailedState, Time: now.Add(-15 * time.Minute)},
+			},
+			annotations:  map[string]string{resumeAnnotation: "now"},
+			errorMatcher: IsInvalidConfig,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			var timedOut, resumed bool
+			hook := TimeoutHook{
+				Deadlines: func(obj interface{}) (map[State]time.Duration, error) {
+					return ParseDeadlines("waiting=10m")
+				},
+				FailureState:     FailedState,
+				ResumeAnnotation: resumeAnnotation,
+				OnTimeout: func(ctx context.Context, obj interface{}, timedOutState State, deadline time.Duration) error {
+					timedOut = true
+					return nil
+				},
+				OnResume: func(ctx context.Context, obj interface{}, resumedState State) error {
+					resumed = true
+					return nil
+				},
+				now: func() time.Time { return now },
+			}
+
+			obj := &metav1.ObjectMeta{Annotations: tc.annotations}
+
+			newState, skip, err := hook.BeforeTransition(context.Background(), obj, tc.currentState, tc.history)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if !cmp.Equal(newState, tc.expectedState) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedState, newState))
+			}
+			if skip != tc.expectedSkip {
+				t.Fatalf("skip == %t, want %t", skip, tc.expectedSkip)
+			}
+			if timedOut != tc.expectTimeout {
+				t.Fatalf("timed out == %t, want %t", timedOut, tc.expectTimeout)
+			}
+			if resumed != tc.expectResume {
+				t.Fatalf("resumed == %t, want %t", resumed, tc.expectResume)
+			}
+		})
+	}
+}
+
+func Test_ParseDeadlines(t *testing.T) {
+	deadlines, err := ParseDeadlines("waiting=30m, closed=2h, open=0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[State]time.Duration{
+		OpenState:    0,
+		WaitingState: 30 * time.Minute,
+		ClosedState:  2 * time.Hour,
+	}
+	if !cmp.Equal(deadlines, expected) {
+		t.Fatalf("\n\n%s\n", cmp.Diff(expected, deadlines))
+	}
+
+	// Without annotation no state has a deadline.
+	deadlines, err = ParseDeadlines("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deadlines) != 0 {
+		t.Fatalf("expected no deadlines, got %v", deadlines)
+	}
+
+	_, err = ParseDeadlines("waiting")
+	if !IsInvalidConfig(err) {
+		t.Fatalf("error == %#v, want invalid config", err)
+	}
+}
+
+func Test_TimeoutHookEscalation(t *testing.T) {
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		escalation    State
+		expectedState State
+		expectTimeout bool
+	}{
+		{
+			name:          "case 0: timeout is escalated to another state",
+			escalation:    ClosedState,
+			expectedState: ClosedState,
+		},
+		{
+			name:          "case 1: timeout is escalated to the failure state",
+			escalation:    FailedState,
+			expectedState: FailedState,
+			expectTimeout: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			var timedOut bool
+			hook := TimeoutHook{
+				Deadlines: func(obj interface{}) (map[State]time.Duration, error) {
+					return map[State]time.Duration{WaitingState: 10 * time.Minute}, nil
+				},
+				FailureState: FailedState,
+				Escalate: func(ctx context.Context, obj interface{}, timedOutState State) (State, error) {
+					return tc.escalation, nil
+				},
+				OnTimeout: func(ctx context.Context, obj interface{}, timedOutState State, deadline time.Duration) error {
+					timedOut = true
+					return nil
+				},
+				now: func() time.Time { return now },
+			}
+
+			history := History{{From: OpenState, To: WaitingState, Time: now.Add(-15 * time.Minute)}}
+
+			newState, skip, err := hook.BeforeTransition(context.Background(), &metav1.ObjectMeta{}, WaitingState, history)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			if !skip {
+				t.Fatalf("skip == false, want true")
+			}
+			if newState != tc.expectedState {
+				t.Fatalf("state == %#q, want %#q", newState, tc.expectedState)
+			}
+			if timedOut != tc.expectTimeout {
+				t.Fatalf("timed out == %t, want %t", timedOut, tc.expectTimeout)
+			}
+		})
+	}
+}
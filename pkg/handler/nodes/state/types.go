@@ -2,6 +2,7 @@ package state
 
 import (
 	"context"
+	"time"
 
 	"github.com/giantswarm/micrologger"
 )
@@ -11,6 +12,19 @@ type Machine struct {
 	Logger       micrologger.Logger
 	ResourceName string
 	Transitions  TransitionMap
+
+	// BeforeHooks are executed in order before the transition function of the
+	// current state.
+	BeforeHooks []BeforeHook
+	// AfterHooks are executed in order after every recorded transition.
+	AfterHooks []AfterHook
+	// HistoryLimit is the maximum number of transitions kept in the history.
+	// It defaults to DefaultHistoryLimit.
+	HistoryLimit int
+
+	// now returns the current time. It defaults to time.Now and is only
+	// overwritten in tests.
+	now func() time.Time
 }
 
 type State string
@@ -18,3 +32,18 @@ type TransitionMap map[State]TransitionFunc
 
 // TransitionFunc defines state transition function signature.
 type TransitionFunc func(ctx context.Context, obj interface{}, currentState State) (State, error)
+
+// BeforeHook is executed before the transition function of the current state.
+// When it returns true the transition function and all further hooks are
+// skipped and the machine moves to the returned state instead. This allows to
+// attach e.g. timeouts to the machine. The reason of the transition can be set
+// using SetReason.
+type BeforeHook interface {
+	BeforeTransition(ctx context.Context, obj interface{}, currentState State, history History) (State, bool, error)
+}
+
+// AfterHook is executed after every recorded transition, e.g. to emit events
+// or metrics.
+type AfterHook interface {
+	AfterTransition(ctx context.Context, obj interface{}, transition Transition) error
+}
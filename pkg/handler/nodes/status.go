@@ -6,6 +6,8 @@ import (
 	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
 	"github.com/giantswarm/microerror"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
 )
 
 func (r *Resource) GetResourceStatus(ctx context.Context, customObject providerv1alpha1.AzureConfig, t string) (string, error) {
@@ -90,3 +92,47 @@ func (r *Resource) SetResourceStatus(ctx context.Context, customObject providerv
 
 	return nil
 }
+
+// GetStateHistory returns the state machine history stored in the given
+// annotation of the AzureConfig CR. An unparsable history is discarded.
+func (r *Resource) GetStateHistory(ctx context.Context, customObject providerv1alpha1.AzureConfig, annotationKey string) (state.History, error) {
+	c := &providerv1alpha1.AzureConfig{}
+	err := r.CtrlClient.Get(ctx, client.ObjectKey{Namespace: customObject.Namespace, Name: customObject.Name}, c)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	history, err := state.ParseHistory(c.GetAnnotations()[annotationKey])
+	if state.IsInvalidHistory(err) {
+		r.Logger.Debugf(ctx, "discarding invalid state machine history in annotation %#q", annotationKey)
+		return nil, nil
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return history, nil
+}
+
+// SetStateHistory stores the state machine history in the given annotation of
+// the AzureConfig CR.
+func (r *Resource) SetStateHistory(ctx context.Context, customObject providerv1alpha1.AzureConfig, annotationKey string, history state.History) error {
+	// Get the newest CR version. Otherwise the update may fail because of
+	// concurrent status updates.
+	c := &providerv1alpha1.AzureConfig{}
+	err := r.CtrlClient.Get(ctx, client.ObjectKey{Namespace: customObject.Namespace, Name: customObject.Name}, c)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if c.Annotations == nil {
+		c.Annotations = map[string]string{}
+	}
+	c.Annotations[annotationKey] = history.String()
+
+	err = r.CtrlClient.Update(ctx, c)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
@@ -10,6 +10,8 @@ import (
 	capi "sigs.k8s.io/cluster-api/api/v1alpha3"
 	capiexp "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
 
 func GetMachinePoolsByMetadata(ctx context.Context, c client.Client, obj metav1.ObjectMeta) (*capiexp.MachinePoolList, error) {
@@ -54,6 +56,17 @@ func GetAzureClusterFromMetadata(ctx context.Context, c client.Client, obj metav
 	return GetAzureClusterByName(ctx, c, obj.Namespace, obj.Labels[capi.ClusterLabelName])
 }
 
+// GetResourceGroupNameFromMetadata returns the name of the resource group of
+// the cluster the object with the given metadata belongs to.
+func GetResourceGroupNameFromMetadata(ctx context.Context, c client.Client, obj metav1.ObjectMeta) (string, error) {
+	azureCluster, err := GetAzureClusterFromMetadata(ctx, c, obj)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	return key.ResourceGroupNameFromAzureCluster(*azureCluster), nil
+}
+
 // GetAzureClusterByName finds and return a AzureCluster object using the specified params.
 func GetAzureClusterByName(ctx context.Context, c client.Client, namespace, name string) (*capz.AzureCluster, error) {
 	azureCluster := &capz.AzureCluster{}
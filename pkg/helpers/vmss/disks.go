@@ -0,0 +1,237 @@
+package vmss
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	k8signition "github.com/giantswarm/k8scloudconfig/v10/pkg/ignition"
+	"github.com/giantswarm/microerror"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+)
+
+const (
+	instanceRoleMaster = "master"
+
+	defaultFormat = "xfs"
+	// maxLabelLength is the maximum length of XFS filesystem labels.
+	maxLabelLength = 12
+)
+
+var (
+	labelRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+	// wellKnownDataDisks are the data disks the nodes of every cluster have.
+	// They are mounted without a mount point being configured.
+	wellKnownDataDisks = map[string]DataDisk{
+		"docker": {
+			Format:     "xfs",
+			Wipe:       true,
+			MountPoint: "/var/lib/docker",
+			Before:     "docker.service",
+		},
+		"etcd": {
+			Format:     "ext4",
+			Wipe:       false,
+			MountPoint: "/var/lib/etcd",
+			Before:     "etcd3.service",
+		},
+		"kubelet": {
+			Format:     "xfs",
+			Wipe:       true,
+			MountPoint: "/var/lib/kubelet",
+			Before:     "k8s-kubelet.service",
+		},
+	}
+)
+
+// DataDisk describes the filesystem of a data disk attached to VMSS instances
+// and where it is mounted.
+type DataDisk struct {
+	// Name is the name suffix of the disk, also used as name and label of the
+	// filesystem.
+	Name string
+	// LUN is the logical unit number the disk is attached with.
+	LUN int
+	// Format is the filesystem type.
+	Format string
+	// Wipe tells whether the filesystem is recreated when the instance is
+	// provisioned or reimaged.
+	Wipe bool
+	// MountPoint is the absolute path the filesystem is mounted at.
+	MountPoint string
+	// Before is the unit which must only start once the filesystem is
+	// mounted.
+	Before string
+}
+
+// MasterDataDisks returns the data disks attached to master instances by the
+// masters ARM template. Docker and kubelet data is recreated when an instance
+// is reimaged, etcd data is kept.
+func MasterDataDisks() []DataDisk {
+	return []DataDisk{
+		wellKnownDataDisk("docker", 1),
+		wellKnownDataDisk("kubelet", 2),
+		wellKnownDataDisk("etcd", 0),
+	}
+}
+
+// WorkerDataDisks returns the data disks to format and mount for the given
+// data disks of a node pool. The docker and kubelet disks are mounted where
+// docker and the kubelet expect them, other disks are mounted at the mount
+// point given for their name suffix in mountPoints, e.g.
+// "cache=/var/lib/cache,logs=/var/log/containers-archive". Disks without
+// mount point are left alone. Without data disks, the docker and kubelet disks
+// legacy node pools got attached are returned.
+func WorkerDataDisks(dataDisks []capzv1alpha3.DataDisk, mountPoints string) ([]DataDisk, error) {
+	if len(dataDisks) == 0 {
+		return []DataDisk{
+			wellKnownDataDisk("docker", 21),
+			wellKnownDataDisk("kubelet", 22),
+		}, nil
+	}
+
+	parsed, err := ParseMountPoints(mountPoints)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var disks []DataDisk
+	usedMountPoints := map[string]string{}
+	for _, d := range dataDisks {
+		if d.Lun == nil {
+			return nil, microerror.Maskf(invalidConfigError, "data disk %#q has no LUN", d.NameSuffix)
+		}
+
+		disk, ok := wellKnownDataDisks[d.NameSuffix]
+		if !ok {
+			disk = DataDisk{
+				Format: defaultFormat,
+			}
+		}
+		disk.Name = d.NameSuffix
+		disk.LUN = int(*d.Lun)
+
+		if mountPoint, ok := parsed[d.NameSuffix]; ok {
+			disk.MountPoint = mountPoint
+			delete(parsed, d.NameSuffix)
+		}
+		if disk.MountPoint == "" {
+			continue
+		}
+
+		if len(disk.Name) > maxLabelLength || !labelRegexp.MatchString(disk.Name) {
+			return nil, microerror.Maskf(invalidConfigError, "name suffix %#q of mounted data disk must consist of at most %d lower case alphanumeric characters or '-'", disk.Name, maxLabelLength)
+		}
+		if other, ok := usedMountPoints[disk.MountPoint]; ok {
+			return nil, microerror.Maskf(invalidConfigError, "data disks %#q and %#q are both mounted at %#q", other, disk.Name, disk.MountPoint)
+		}
+		usedMountPoints[disk.MountPoint] = disk.Name
+
+		disks = append(disks, disk)
+	}
+
+	for name := range parsed {
+		return nil, microerror.Maskf(invalidConfigError, "mount point given for unknown data disk %#q", name)
+	}
+
+	return disks, nil
+}
+
+// ParseMountPoints parses mount points of data disks given as comma separated
+// list of "<name suffix>=<absolute path>" pairs.
+func ParseMountPoints(mountPoints string) (map[string]string, error) {
+	parsed := map[string]string{}
+	if strings.TrimSpace(mountPoints) == "" {
+		return parsed, nil
+	}
+
+	for _, pair := range strings.Split(mountPoints, ",") {
+		parts := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, microerror.Maskf(invalidConfigError, "mount point %#q must be given as <name suffix>=<path>", pair)
+		}
+
+		name, mountPoint := parts[0], parts[1]
+		if !path.IsAbs(mountPoint) || path.Clean(mountPoint) == "/" {
+			return nil, microerror.Maskf(invalidConfigError, "mount point %#q of data disk %#q must be an absolute path", mountPoint, name)
+		}
+		if _, ok := parsed[name]; ok {
+			return nil, microerror.Maskf(invalidConfigError, "data disk %#q has several mount points", name)
+		}
+
+		parsed[name] = path.Clean(mountPoint)
+	}
+
+	return parsed, nil
+}
+
+// NormalizeMountPoints returns the given mount points of data disks in a
+// canonical form, so that they can be compared.
+func NormalizeMountPoints(mountPoints string) (string, error) {
+	parsed, err := ParseMountPoints(mountPoints)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	var pairs []string
+	for name, mountPoint := range parsed {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", name, mountPoint))
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ","), nil
+}
+
+// MountUnitName returns the name of the systemd mount unit mounting the
+// filesystem of the data disk, following systemd-escape --path.
+func (d DataDisk) MountUnitName() string {
+	var b strings.Builder
+	for i, c := range []byte(strings.Trim(path.Clean(d.MountPoint), "/")) {
+		switch {
+		case c == '/':
+			b.WriteByte('-')
+		case c == '.' && i == 0:
+			fmt.Fprintf(&b, `\x%02x`, c)
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '_', c == '.', c == ':':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, `\x%02x`, c)
+		}
+	}
+
+	return b.String() + ".mount"
+}
+
+func (d DataDisk) filesystem() k8signition.Filesystem {
+	label := d.Name
+
+	return k8signition.Filesystem{
+		Name: d.Name,
+		Mount: &k8signition.Mount{
+			Device:         fmt.Sprintf("/dev/disk/azure/scsi1/lun%d", d.LUN),
+			Format:         d.Format,
+			Label:          &label,
+			WipeFilesystem: d.Wipe,
+		},
+	}
+}
+
+func defaultDataDisks(instanceRole string) []DataDisk {
+	if instanceRole == instanceRoleMaster {
+		return MasterDataDisks()
+	}
+
+	disks, _ := WorkerDataDisks(nil, "")
+	return disks
+}
+
+func wellKnownDataDisk(name string, lun int) DataDisk {
+	disk := wellKnownDataDisks[name]
+	disk.Name = name
+	disk.LUN = lun
+
+	return disk
+}
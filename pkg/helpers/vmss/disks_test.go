@@ -0,0 +1,161 @@
+package vmss
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+)
+
+func Test_WorkerDataDisks(t *testing.T) {
+	testCases := []struct {
+		name              string
+		dataDisks         []capzv1alpha3.DataDisk
+		mountPoints       string
+		expectedDataDisks []DataDisk
+		errorMatcher      func(error) bool
+	}{
+		{
+			name:      "case 0: legacy node pool without data disks",
+			dataDisks: nil,
+			expectedDataDisks: []DataDisk{
+				{Name: "docker", LUN: 21, Format: "xfs", Wipe: true, MountPoint: "/var/lib/docker", Before: "docker.service"},
+				{Name: "kubelet", LUN: 22, Format: "xfs", Wipe: true, MountPoint: "/var/lib/kubelet", Before: "k8s-kubelet.service"},
+			},
+		},
+		{
+			name: "case 1: docker and kubelet disks on custom LUNs",
+			dataDisks: []capzv1alpha3.DataDisk{
+				{NameSuffix: "docker", Lun: to.Int32Ptr(3)},
+				{NameSuffix: "kubelet", Lun: to.Int32Ptr(4)},
+			},
+			expectedDataDisks: []DataDisk{
+				{Name: "docker", LUN: 3, Format: "xfs", Wipe: true, MountPoint: "/var/lib/docker", Before: "docker.service"},
+				{Name: "kubelet", LUN: 4, Format: "xfs", Wipe: true, MountPoint: "/var/lib/kubelet", Before: "k8s-kubelet.service"},
+			},
+		},
+		{
+			name: "case 2: additional disk with mount point, disk without mount point is left alone",
+			dataDisks: []capzv1alpha3.DataDisk{
+				{NameSuffix: "docker", Lun: to.Int32Ptr(21)},
+				{NameSuffix: "cache", Lun: to.Int32Ptr(23)},
+				{NameSuffix: "raw", Lun: to.Int32Ptr(24)},
+			},
+			mountPoints: " cache=/var/lib/cache/ ",
+			expectedDataDisks: []DataDisk{
+				{Name: "docker", LUN: 21, Format: "xfs", Wipe: true, MountPoint: "/var/lib/docker", Before: "docker.service"},
+				{Name: "cache", LUN: 23, Format: "xfs", MountPoint: "/var/lib/cache"},
+			},
+		},
+		{
+			name: "case 3: docker disk mounted elsewhere",
+			dataDisks: []capzv1alpha3.DataDisk{
+				{NameSuffix: "docker", Lun: to.Int32Ptr(21)},
+			},
+			mountPoints: "docker=/mnt/docker",
+			expectedDataDisks: []DataDisk{
+				{Name: "docker", LUN: 21, Format: "xfs", Wipe: true, MountPoint: "/mnt/docker", Before: "docker.service"},
+			},
+		},
+		{
+			name: "case 4: disk without LUN",
+			dataDisks: []capzv1alpha3.DataDisk{
+				{NameSuffix: "docker"},
+			},
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name: "case 5: mount point for unknown disk",
+			dataDisks: []capzv1alpha3.DataDisk{
+				{NameSuffix: "docker", Lun: to.Int32Ptr(21)},
+			},
+			mountPoints:  "cache=/var/lib/cache",
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name: "case 6: relative mount point",
+			dataDisks: []capzv1alpha3.DataDisk{
+				{NameSuffix: "cache", Lun: to.Int32Ptr(23)},
+			},
+			mountPoints:  "cache=var/lib/cache",
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name: "case 7: two disks mounted at the same path",
+			dataDisks: []capzv1alpha3.DataDisk{
+				{NameSuffix: "docker", Lun: to.Int32Ptr(21)},
+				{NameSuffix: "cache", Lun: to.Int32Ptr(23)},
+			},
+			mountPoints:  "cache=/var/lib/docker",
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name: "case 8: name suffix too long for a filesystem label",
+			dataDisks: []capzv1alpha3.DataDisk{
+				{NameSuffix: "containerimages", Lun: to.Int32Ptr(23)},
+			},
+			mountPoints:  "containerimages=/var/lib/images",
+			errorMatcher: IsInvalidConfig,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			dataDisks, err := WorkerDataDisks(tc.dataDisks, tc.mountPoints)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if !reflect.DeepEqual(dataDisks, tc.expectedDataDisks) {
+				t.Fatalf("expected %#v, got %#v", tc.expectedDataDisks, dataDisks)
+			}
+		})
+	}
+}
+
+func Test_DataDisk_MountUnitName(t *testing.T) {
+	testCases := []struct {
+		name             string
+		mountPoint       string
+		expectedUnitName string
+	}{
+		{
+			name:             "case 0: plain path",
+			mountPoint:       "/var/lib/docker",
+			expectedUnitName: "var-lib-docker.mount",
+		},
+		{
+			name:             "case 1: dashes are escaped",
+			mountPoint:       "/var/lib/my-cache",
+			expectedUnitName: `var-lib-my\x2dcache.mount`,
+		},
+		{
+			name:             "case 2: leading dot is escaped",
+			mountPoint:       "/.cache/data",
+			expectedUnitName: `\x2ecache-data.mount`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			unitName := DataDisk{MountPoint: tc.mountPoint}.MountUnitName()
+			if unitName != tc.expectedUnitName {
+				t.Fatalf("expected %#q, got %#q", tc.expectedUnitName, unitName)
+			}
+		})
+	}
+}
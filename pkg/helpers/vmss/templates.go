@@ -2,41 +2,82 @@ package vmss
 
 import (
 	"encoding/base64"
+	"fmt"
 
 	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	k8signition "github.com/giantswarm/k8scloudconfig/v10/pkg/ignition"
 	"github.com/giantswarm/microerror"
 
-	"github.com/giantswarm/azure-operator/v5/service/controller/key"
-	"github.com/giantswarm/azure-operator/v5/service/controller/templates"
+	"github.com/giantswarm/azure-operator/v5/pkg/ignition"
 )
 
-func RenderCloudConfig(blobURL string, encryptionKey string, initialVector string, instanceRole string) (string, error) {
-	smallCloudconfigConfig := SmallCloudconfigConfig{
-		BlobURL:       blobURL,
-		EncryptionKey: encryptionKey,
-		InitialVector: initialVector,
-		InstanceRole:  instanceRole,
+const (
+	encryptionFileMode = 0400
+)
+
+// RenderCloudConfig renders the small Ignition config put into the custom
+// data of VMSS instances. It appends the cloud config stored at the blob URL,
+// writes the encryption key unless the nodes fetch it from the Key Vault of
+// the cluster and creates the filesystems on the data disks.
+func RenderCloudConfig(config SmallCloudconfigConfig) (string, error) {
+	c := k8signition.Config{}
+	c.Ignition.Config.Append = []k8signition.ConfigReference{
+		{
+			Source: config.BlobURL,
+		},
+	}
+
+	if !config.KeyVault {
+		keys := fmt.Sprintf("ENCRYPTION_KEY=%s\nENCRYPTION_KEY_ID=%s", config.EncryptionKey, config.EncryptionKeyID)
+		if config.PreviousEncryptionKeyID != "" {
+			keys += fmt.Sprintf("\nPREVIOUS_ENCRYPTION_KEY=%s\nPREVIOUS_ENCRYPTION_KEY_ID=%s", config.PreviousEncryptionKey, config.PreviousEncryptionKeyID)
+		}
+
+		c.Storage.Files = []k8signition.File{
+			encryptionFile("/etc/.enc/key", keys),
+			encryptionFile("/etc/.enc/iv", fmt.Sprintf("INITIAL_VECTOR=%s", config.InitialVector)),
+		}
+	}
+
+	dataDisks := config.DataDisks
+	if dataDisks == nil {
+		dataDisks = defaultDataDisks(config.InstanceRole)
+	}
+	for _, d := range dataDisks {
+		c.Storage.Filesystems = append(c.Storage.Filesystems, d.filesystem())
 	}
-	cloudConfig, err := templates.Render(key.CloudConfigSmallTemplates(), smallCloudconfigConfig)
+
+	cloudConfig, err := ignition.Render(c, config.SpecVersion)
 	if err != nil {
 		return "", microerror.Mask(err)
 	}
 
-	return base64.StdEncoding.EncodeToString([]byte(cloudConfig)), nil
+	return base64.StdEncoding.EncodeToString(cloudConfig), nil
+}
+
+func encryptionFile(path, contents string) k8signition.File {
+	return k8signition.File{
+		Contents: k8signition.FileContents{
+			Source: ignition.DataURL(contents),
+		},
+		Filesystem: "root",
+		Mode:       encryptionFileMode,
+		Path:       path,
+	}
 }
 
-func GetMasterNodesConfiguration(obj providerv1alpha1.AzureConfig, distroVersion string) []Node {
-	return getNodesConfiguration(distroVersion, obj.Spec.Azure.Masters)
+func GetMasterNodesConfiguration(obj providerv1alpha1.AzureConfig, osImage NodeOSImage) []Node {
+	return getNodesConfiguration(osImage, obj.Spec.Azure.Masters)
 }
 
-func GetWorkerNodesConfiguration(obj providerv1alpha1.AzureConfig, distroVersion string) []Node {
-	return getNodesConfiguration(distroVersion, obj.Spec.Azure.Workers)
+func GetWorkerNodesConfiguration(obj providerv1alpha1.AzureConfig, osImage NodeOSImage) []Node {
+	return getNodesConfiguration(osImage, obj.Spec.Azure.Workers)
 }
 
-func getNodesConfiguration(distroVersion string, nodesSpecs []providerv1alpha1.AzureConfigSpecAzureNode) []Node {
+func getNodesConfiguration(osImage NodeOSImage, nodesSpecs []providerv1alpha1.AzureConfigSpecAzureNode) []Node {
 	var nodes []Node
 	for _, m := range nodesSpecs {
-		n := NewNode(distroVersion, m.VMSize, m.DockerVolumeSizeGB, m.KubeletVolumeSizeGB)
+		n := NewNode(osImage, m.VMSize, m.DockerVolumeSizeGB, m.KubeletVolumeSizeGB)
 		nodes = append(nodes, n)
 	}
 	return nodes
@@ -1,7 +1,14 @@
 package vmss
 
 import (
+	"fmt"
+
 	"github.com/giantswarm/certs/v3/pkg/certs"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+)
+
+const (
+	flatcarPublisher = "kinvolk"
 )
 
 type Node struct {
@@ -19,6 +26,9 @@ type Node struct {
 // templates. Official documentation for can be found here
 // https://docs.microsoft.com/en-us/azure/templates/microsoft.compute/virtualmachines#ImageReference.
 type NodeOSImage struct {
+	// ID is the resource ID of a managed image or a Shared Image Gallery
+	// image version. The other fields are ignored when it is set.
+	ID string `json:"id" yaml:"id"`
 	// Offer is the image offered by the publisher (e.g. CoreOS).
 	Offer string `json:"offer" yaml:"offer"`
 	// Publisher is the image publisher (e.g GiantSwarm).
@@ -27,33 +37,98 @@ type NodeOSImage struct {
 	SKU string `json:"sku" yaml:"sku"`
 	// Version is the image version (e.g. 1465.7.0).
 	Version string `json:"version" yaml:"version"`
+	// ThirdParty tells whether the Marketplace image requires a purchase
+	// plan.
+	ThirdParty bool `json:"thirdParty" yaml:"thirdParty"`
 }
 
-func NewNode(distroVersion string, vmSize string, dockerVolumeSizeGB int, kubeletVolumeSizeGB int) Node {
+func NewNode(osImage NodeOSImage, vmSize string, dockerVolumeSizeGB int, kubeletVolumeSizeGB int) Node {
 	return Node{
-		OSImage:             newNodeOSImageCoreOS(distroVersion),
+		OSImage:             osImage,
 		VMSize:              vmSize,
 		DockerVolumeSizeGB:  dockerVolumeSizeGB,
 		KubeletVolumeSizeGB: kubeletVolumeSizeGB,
 	}
 }
 
+// NewNodeOSImage provides OS information for the given image of a CAPZ CR.
+// Images given by ID, from a Shared Image Gallery or from the Marketplace are
+// used as they are. Flatcar Container Linux Marketplace images without a
+// version follow the version of the release, and Flatcar Container Linux in
+// the version of the release is used if no image is given.
+func NewNodeOSImage(image *capzv1alpha3.Image, distroVersion string) NodeOSImage {
+	switch {
+	case image == nil:
+	case image.ID != nil && *image.ID != "":
+		return NodeOSImage{
+			ID: *image.ID,
+		}
+	case image.SharedGallery != nil:
+		g := image.SharedGallery
+		return NodeOSImage{
+			ID: fmt.Sprintf(
+				"/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s/images/%s/versions/%s",
+				g.SubscriptionID, g.ResourceGroup, g.Gallery, g.Name, g.Version,
+			),
+		}
+	case image.Marketplace != nil && image.Marketplace.Publisher == flatcarPublisher:
+		m := image.Marketplace
+		osImage := NodeOSImage{
+			Offer:     m.Offer,
+			Publisher: m.Publisher,
+			SKU:       m.SKU,
+			Version:   m.Version,
+			// Flatcar Container Linux images always have a purchase plan.
+			ThirdParty: true,
+		}
+		if osImage.Version == "" {
+			osImage.Version = distroVersion
+		}
+		return osImage
+	case image.Marketplace != nil:
+		m := image.Marketplace
+		return NodeOSImage{
+			Offer:      m.Offer,
+			Publisher:  m.Publisher,
+			SKU:        m.SKU,
+			Version:    m.Version,
+			ThirdParty: m.ThirdPartyImage,
+		}
+	}
+
+	return newNodeOSImageCoreOS(distroVersion)
+}
+
 // newNodeOSImage provides OS information for Container Linux
 func newNodeOSImageCoreOS(distroVersion string) NodeOSImage {
 	return NodeOSImage{
-		Offer:     "flatcar-container-linux-free",
-		Publisher: "kinvolk",
-		SKU:       "stable",
-		Version:   distroVersion,
+		Offer:      "flatcar-container-linux-free",
+		Publisher:  flatcarPublisher,
+		SKU:        "stable",
+		Version:    distroVersion,
+		ThirdParty: true,
 	}
 }
 
-// SmallCloudconfigConfig represents the data structure required for executing
-// the small cloudconfig template.
+// SmallCloudconfigConfig represents the data structure required for rendering
+// the small cloudconfig.
 type SmallCloudconfigConfig struct {
-	BlobURL       string
-	CertsFiles    []certs.File
-	EncryptionKey string
-	InitialVector string
-	InstanceRole  string
+	BlobURL    string
+	CertsFiles []certs.File
+	// DataDisks are the data disks to create filesystems on, the data disks
+	// of masters or legacy workers depending on InstanceRole when nil.
+	DataDisks       []DataDisk
+	EncryptionKey   string
+	EncryptionKeyID string
+	InitialVector   string
+	InstanceRole    string
+	// PreviousEncryptionKey and PreviousEncryptionKeyID are set during a key
+	// rotation, until all nodes got the current key.
+	PreviousEncryptionKey   string
+	PreviousEncryptionKeyID string
+	// KeyVault tells whether the nodes fetch the encryption key from the Key
+	// Vault of the cluster instead of getting it in the custom data.
+	KeyVault bool
+	// SpecVersion is the Ignition spec version to render.
+	SpecVersion string
 }
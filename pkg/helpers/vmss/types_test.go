@@ -0,0 +1,117 @@
+package vmss
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+)
+
+func Test_NewNodeOSImage(t *testing.T) {
+	testCases := []struct {
+		name            string
+		image           *capzv1alpha3.Image
+		expectedOSImage NodeOSImage
+	}{
+		{
+			name:  "case 0: no image",
+			image: nil,
+			expectedOSImage: NodeOSImage{
+				Offer:      "flatcar-container-linux-free",
+				Publisher:  "kinvolk",
+				SKU:        "stable",
+				Version:    "2605.12.0",
+				ThirdParty: true,
+			},
+		},
+		{
+			name: "case 1: managed image",
+			image: &capzv1alpha3.Image{
+				ID: to.StringPtr("/subscriptions/0000/resourceGroups/images/providers/Microsoft.Compute/images/flatcar-hardened"),
+			},
+			expectedOSImage: NodeOSImage{
+				ID: "/subscriptions/0000/resourceGroups/images/providers/Microsoft.Compute/images/flatcar-hardened",
+			},
+		},
+		{
+			name: "case 2: Shared Image Gallery image",
+			image: &capzv1alpha3.Image{
+				SharedGallery: &capzv1alpha3.AzureSharedGalleryImage{
+					SubscriptionID: "0000",
+					ResourceGroup:  "images",
+					Gallery:        "hardened",
+					Name:           "flatcar",
+					Version:        "1.2.3",
+				},
+			},
+			expectedOSImage: NodeOSImage{
+				ID: "/subscriptions/0000/resourceGroups/images/providers/Microsoft.Compute/galleries/hardened/images/flatcar/versions/1.2.3",
+			},
+		},
+		{
+			name: "case 3: Marketplace image of another publisher",
+			image: &capzv1alpha3.Image{
+				Marketplace: &capzv1alpha3.AzureMarketplaceImage{
+					Publisher: "Canonical",
+					Offer:     "UbuntuServer",
+					SKU:       "18.04-LTS",
+					Version:   "latest",
+				},
+			},
+			expectedOSImage: NodeOSImage{
+				Offer:     "UbuntuServer",
+				Publisher: "Canonical",
+				SKU:       "18.04-LTS",
+				Version:   "latest",
+			},
+		},
+		{
+			name: "case 4: Flatcar Marketplace image in a chosen SKU and version",
+			image: &capzv1alpha3.Image{
+				Marketplace: &capzv1alpha3.AzureMarketplaceImage{
+					Publisher: "kinvolk",
+					Offer:     "flatcar-container-linux-free",
+					SKU:       "stable-gen2",
+					Version:   "2345.3.1",
+				},
+			},
+			expectedOSImage: NodeOSImage{
+				Offer:      "flatcar-container-linux-free",
+				Publisher:  "kinvolk",
+				SKU:        "stable-gen2",
+				Version:    "2345.3.1",
+				ThirdParty: true,
+			},
+		},
+		{
+			name: "case 5: Flatcar Marketplace image without version uses the version of the release",
+			image: &capzv1alpha3.Image{
+				Marketplace: &capzv1alpha3.AzureMarketplaceImage{
+					Publisher: "kinvolk",
+					Offer:     "flatcar-container-linux-free",
+					SKU:       "stable-gen2",
+				},
+			},
+			expectedOSImage: NodeOSImage{
+				Offer:      "flatcar-container-linux-free",
+				Publisher:  "kinvolk",
+				SKU:        "stable-gen2",
+				Version:    "2605.12.0",
+				ThirdParty: true,
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			osImage := NewNodeOSImage(tc.image, "2605.12.0")
+			if !reflect.DeepEqual(osImage, tc.expectedOSImage) {
+				t.Fatalf("expected %#v, got %#v", tc.expectedOSImage, osImage)
+			}
+		})
+	}
+}
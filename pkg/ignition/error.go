@@ -0,0 +1,21 @@
+package ignition
+
+import "github.com/giantswarm/microerror"
+
+var invalidConfigError = &microerror.Error{
+	Kind: "invalidConfigError",
+}
+
+// IsInvalidConfig asserts invalidConfigError.
+func IsInvalidConfig(err error) bool {
+	return microerror.Cause(err) == invalidConfigError
+}
+
+var validationError = &microerror.Error{
+	Kind: "validationError",
+}
+
+// IsValidation asserts validationError.
+func IsValidation(err error) bool {
+	return microerror.Cause(err) == validationError
+}
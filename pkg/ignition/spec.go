@@ -0,0 +1,100 @@
+// Package ignition builds Ignition configs as typed structures and renders them
+// in one of the supported spec versions.
+//
+// Configs are built using the spec 2.2 types of k8scloudconfig, which also
+// renders the cloud config of the nodes in this version. Configs rendered in a
+// spec 3.x version are converted to the 3.x types first. Rendered configs are
+// validated against their spec, so that nodes never boot with a config
+// Ignition refuses.
+package ignition
+
+import (
+	"encoding/json"
+
+	k8signition "github.com/giantswarm/k8scloudconfig/v10/pkg/ignition"
+	"github.com/giantswarm/microerror"
+)
+
+const (
+	// SpecVersion22 is the spec version rendered by k8scloudconfig and used
+	// when no version is configured.
+	SpecVersion22 = "2.2.0"
+	SpecVersion30 = "3.0.0"
+	SpecVersion31 = "3.1.0"
+	SpecVersion32 = "3.2.0"
+)
+
+var supportedSpecVersions = []string{
+	SpecVersion22,
+	SpecVersion30,
+	SpecVersion31,
+	SpecVersion32,
+}
+
+// ValidateSpecVersion returns an invalidConfigError when the given spec
+// version is not supported. An empty version selects SpecVersion22.
+func ValidateSpecVersion(specVersion string) error {
+	if specVersion == "" {
+		return nil
+	}
+
+	for _, v := range supportedSpecVersions {
+		if specVersion == v {
+			return nil
+		}
+	}
+
+	return microerror.Maskf(invalidConfigError, "ignition spec version %#q is not supported, must be one of %v", specVersion, supportedSpecVersions)
+}
+
+// Parse parses a spec 2.2 config, e.g. the cloud config rendered by
+// k8scloudconfig.
+func Parse(data []byte) (k8signition.Config, error) {
+	var config k8signition.Config
+	err := json.Unmarshal(data, &config)
+	if err != nil {
+		return k8signition.Config{}, microerror.Maskf(validationError, "parsing config: %s", err)
+	}
+
+	return config, nil
+}
+
+// Render validates the given config and renders it as JSON in the given spec
+// version.
+func Render(config k8signition.Config, specVersion string) ([]byte, error) {
+	err := ValidateSpecVersion(specVersion)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var rendered interface{}
+	if specVersion == "" || specVersion == SpecVersion22 {
+		config.Ignition.Version = SpecVersion22
+
+		err = validateV2(config)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		rendered = config
+	} else {
+		v3, err := convertToV3(config, specVersion)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		err = validateV3(v3)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		rendered = v3
+	}
+
+	data, err := json.MarshalIndent(rendered, "", "  ")
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return append(data, '\n'), nil
+}
@@ -0,0 +1,209 @@
+package ignition
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	k8signition "github.com/giantswarm/k8scloudconfig/v10/pkg/ignition"
+)
+
+func Test_Render(t *testing.T) {
+	label := "docker"
+	mountPath := "/var/lib/docker"
+	enabled := true
+
+	testCases := []struct {
+		name             string
+		config           k8signition.Config
+		specVersion      string
+		expectedContains []string
+		errorMatcher     func(error) bool
+	}{
+		{
+			name: "case 0: spec 2.2 is rendered as is",
+			config: k8signition.Config{
+				Ignition: k8signition.Ignition{
+					Config: k8signition.IgnitionConfig{
+						Append: []k8signition.ConfigReference{{Source: "https://example.com/ignition"}},
+					},
+				},
+			},
+			specVersion: SpecVersion22,
+			expectedContains: []string{
+				`"version": "2.2.0"`,
+				`"append": [`,
+			},
+		},
+		{
+			name:        "case 1: empty spec version renders spec 2.2",
+			config:      k8signition.Config{},
+			specVersion: "",
+			expectedContains: []string{
+				`"version": "2.2.0"`,
+			},
+		},
+		{
+			name: "case 2: spec 3.x merges appended configs and overwrites files",
+			config: k8signition.Config{
+				Ignition: k8signition.Ignition{
+					Config: k8signition.IgnitionConfig{
+						Append: []k8signition.ConfigReference{{Source: "https://example.com/ignition"}},
+					},
+				},
+				Storage: k8signition.Storage{
+					Files: []k8signition.File{
+						{Filesystem: "root", Path: "/etc/.enc/key", Mode: 0400, Contents: k8signition.FileContents{Source: DataURL("ENCRYPTION_KEY=00")}},
+					},
+					Filesystems: []k8signition.Filesystem{
+						{Name: "docker", Mount: &k8signition.Mount{Device: "/dev/disk/azure/scsi1/lun21", Format: "xfs", Label: &label, WipeFilesystem: true}},
+					},
+				},
+				Systemd: k8signition.Systemd{
+					Units: []k8signition.Unit{
+						{Name: "docker.service", Enable: true},
+					},
+				},
+			},
+			specVersion: SpecVersion31,
+			expectedContains: []string{
+				`"version": "3.1.0"`,
+				`"merge": [`,
+				`"overwrite": true`,
+				`"device": "/dev/disk/azure/scsi1/lun21"`,
+				`"enabled": true`,
+			},
+		},
+		{
+			name: "case 3: spec 3.x writes networkd units as files",
+			config: k8signition.Config{
+				Networkd: k8signition.Networkd{
+					Units: []k8signition.Networkdunit{
+						{Name: "10-eth0.network", Contents: "[Match]\nName=eth0\n"},
+					},
+				},
+			},
+			specVersion: SpecVersion30,
+			expectedContains: []string{
+				`"path": "/etc/systemd/network/10-eth0.network"`,
+				`"source": "data:,%5BMatch%5D%0AName=eth0%0A"`,
+			},
+		},
+		{
+			name: "case 4: files on filesystems given by path are converted",
+			config: k8signition.Config{
+				Storage: k8signition.Storage{
+					Files: []k8signition.File{
+						{Filesystem: "docker", Path: "/daemon.json"},
+					},
+					Filesystems: []k8signition.Filesystem{
+						{Name: "docker", Path: &mountPath},
+					},
+				},
+			},
+			specVersion: SpecVersion32,
+			expectedContains: []string{
+				`"path": "/var/lib/docker/daemon.json"`,
+			},
+		},
+		{
+			name:         "case 5: unsupported spec version",
+			config:       k8signition.Config{},
+			specVersion:  "2.3.0",
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name: "case 6: relative path",
+			config: k8signition.Config{
+				Storage: k8signition.Storage{
+					Files: []k8signition.File{{Filesystem: "root", Path: "etc/hosts"}},
+				},
+			},
+			specVersion:  SpecVersion22,
+			errorMatcher: IsValidation,
+		},
+		{
+			name: "case 7: file on unknown filesystem",
+			config: k8signition.Config{
+				Storage: k8signition.Storage{
+					Files: []k8signition.File{{Filesystem: "data", Path: "/etc/hosts"}},
+				},
+			},
+			specVersion:  SpecVersion22,
+			errorMatcher: IsValidation,
+		},
+		{
+			name: "case 8: invalid unit type",
+			config: k8signition.Config{
+				Systemd: k8signition.Systemd{
+					Units: []k8signition.Unit{{Name: "docker", Enabled: &enabled}},
+				},
+			},
+			specVersion:  SpecVersion22,
+			errorMatcher: IsValidation,
+		},
+		{
+			name: "case 9: unsupported source scheme",
+			config: k8signition.Config{
+				Ignition: k8signition.Ignition{
+					Config: k8signition.IgnitionConfig{
+						Append: []k8signition.ConfigReference{{Source: "ftp://example.com/ignition"}},
+					},
+				},
+			},
+			specVersion:  SpecVersion30,
+			errorMatcher: IsValidation,
+		},
+		{
+			name: "case 10: spec 3.x rejects duplicate paths",
+			config: k8signition.Config{
+				Storage: k8signition.Storage{
+					Files: []k8signition.File{
+						{Filesystem: "root", Path: "/etc/hosts"},
+						{Filesystem: "root", Path: "/etc/hosts"},
+					},
+				},
+			},
+			specVersion:  SpecVersion32,
+			errorMatcher: IsValidation,
+		},
+		{
+			name: "case 11: spec 3.x cannot put files on mounted filesystems",
+			config: k8signition.Config{
+				Storage: k8signition.Storage{
+					Files: []k8signition.File{{Filesystem: "docker", Path: "/daemon.json"}},
+					Filesystems: []k8signition.Filesystem{
+						{Name: "docker", Mount: &k8signition.Mount{Device: "/dev/sdc", Format: "xfs"}},
+					},
+				},
+			},
+			specVersion:  SpecVersion32,
+			errorMatcher: IsValidation,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			rendered, err := Render(tc.config, tc.specVersion)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			for _, s := range tc.expectedContains {
+				if !strings.Contains(string(rendered), s) {
+					t.Fatalf("expected rendered config to contain %#q, got %s", s, rendered)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,520 @@
+package ignition
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+
+	k8signition "github.com/giantswarm/k8scloudconfig/v10/pkg/ignition"
+	"github.com/giantswarm/microerror"
+)
+
+const (
+	rootFilesystem = "root"
+
+	// sectorSize is the unit of partition sizes in spec 2.x, spec 3.x uses
+	// MiB.
+	sectorSize = 512
+	mebibyte   = 1024 * 1024
+
+	networkdUnitPath = "/etc/systemd/network"
+	networkdUnitMode = 0644
+)
+
+// The spec 3.x types are based on github.com/coreos/ignition/v2/config/v3_2/types
+// and only contain what is converted from spec 2.2.
+
+type v3Config struct {
+	Ignition v3Ignition `json:"ignition"`
+	Passwd   v3Passwd   `json:"passwd,omitempty"`
+	Storage  v3Storage  `json:"storage,omitempty"`
+	Systemd  v3Systemd  `json:"systemd,omitempty"`
+}
+
+type v3Ignition struct {
+	Config   v3IgnitionConfig `json:"config,omitempty"`
+	Security v3Security       `json:"security,omitempty"`
+	Timeouts v3Timeouts       `json:"timeouts,omitempty"`
+	Version  string           `json:"version"`
+}
+
+type v3IgnitionConfig struct {
+	Merge   []v3Resource `json:"merge,omitempty"`
+	Replace v3Resource   `json:"replace,omitempty"`
+}
+
+type v3Resource struct {
+	Compression  *string        `json:"compression,omitempty"`
+	Source       *string        `json:"source,omitempty"`
+	Verification v3Verification `json:"verification,omitempty"`
+}
+
+type v3Verification struct {
+	Hash *string `json:"hash,omitempty"`
+}
+
+type v3Security struct {
+	TLS v3TLS `json:"tls,omitempty"`
+}
+
+type v3TLS struct {
+	CertificateAuthorities []v3Resource `json:"certificateAuthorities,omitempty"`
+}
+
+type v3Timeouts struct {
+	HTTPResponseHeaders *int `json:"httpResponseHeaders,omitempty"`
+	HTTPTotal           *int `json:"httpTotal,omitempty"`
+}
+
+type v3Passwd struct {
+	Groups []v3PasswdGroup `json:"groups,omitempty"`
+	Users  []v3PasswdUser  `json:"users,omitempty"`
+}
+
+type v3PasswdGroup struct {
+	Gid          *int    `json:"gid,omitempty"`
+	Name         string  `json:"name"`
+	PasswordHash *string `json:"passwordHash,omitempty"`
+	System       *bool   `json:"system,omitempty"`
+}
+
+type v3PasswdUser struct {
+	Gecos             *string  `json:"gecos,omitempty"`
+	Groups            []string `json:"groups,omitempty"`
+	HomeDir           *string  `json:"homeDir,omitempty"`
+	Name              string   `json:"name"`
+	NoCreateHome      *bool    `json:"noCreateHome,omitempty"`
+	NoLogInit         *bool    `json:"noLogInit,omitempty"`
+	NoUserGroup       *bool    `json:"noUserGroup,omitempty"`
+	PasswordHash      *string  `json:"passwordHash,omitempty"`
+	PrimaryGroup      *string  `json:"primaryGroup,omitempty"`
+	SSHAuthorizedKeys []string `json:"sshAuthorizedKeys,omitempty"`
+	Shell             *string  `json:"shell,omitempty"`
+	System            *bool    `json:"system,omitempty"`
+	UID               *int     `json:"uid,omitempty"`
+}
+
+type v3Storage struct {
+	Directories []v3Directory  `json:"directories,omitempty"`
+	Disks       []v3Disk       `json:"disks,omitempty"`
+	Files       []v3File       `json:"files,omitempty"`
+	Filesystems []v3Filesystem `json:"filesystems,omitempty"`
+	Links       []v3Link       `json:"links,omitempty"`
+	Raid        []v3Raid       `json:"raid,omitempty"`
+}
+
+type v3NodeUser struct {
+	ID   *int    `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+type v3NodeGroup struct {
+	ID   *int    `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+type v3Directory struct {
+	Group     v3NodeGroup `json:"group,omitempty"`
+	Mode      *int        `json:"mode,omitempty"`
+	Overwrite *bool       `json:"overwrite,omitempty"`
+	Path      string      `json:"path"`
+	User      v3NodeUser  `json:"user,omitempty"`
+}
+
+type v3File struct {
+	Append    []v3Resource `json:"append,omitempty"`
+	Contents  v3Resource   `json:"contents,omitempty"`
+	Group     v3NodeGroup  `json:"group,omitempty"`
+	Mode      *int         `json:"mode,omitempty"`
+	Overwrite *bool        `json:"overwrite,omitempty"`
+	Path      string       `json:"path"`
+	User      v3NodeUser   `json:"user,omitempty"`
+}
+
+type v3Link struct {
+	Group     v3NodeGroup `json:"group,omitempty"`
+	Hard      *bool       `json:"hard,omitempty"`
+	Overwrite *bool       `json:"overwrite,omitempty"`
+	Path      string      `json:"path"`
+	Target    string      `json:"target"`
+	User      v3NodeUser  `json:"user,omitempty"`
+}
+
+type v3Disk struct {
+	Device     string        `json:"device"`
+	Partitions []v3Partition `json:"partitions,omitempty"`
+	WipeTable  *bool         `json:"wipeTable,omitempty"`
+}
+
+type v3Partition struct {
+	GUID     *string `json:"guid,omitempty"`
+	Label    *string `json:"label,omitempty"`
+	Number   int     `json:"number,omitempty"`
+	SizeMiB  *int    `json:"sizeMiB,omitempty"`
+	StartMiB *int    `json:"startMiB,omitempty"`
+	TypeGUID *string `json:"typeGuid,omitempty"`
+}
+
+type v3Filesystem struct {
+	Device         string   `json:"device"`
+	Format         *string  `json:"format,omitempty"`
+	Label          *string  `json:"label,omitempty"`
+	Options        []string `json:"options,omitempty"`
+	Path           *string  `json:"path,omitempty"`
+	UUID           *string  `json:"uuid,omitempty"`
+	WipeFilesystem *bool    `json:"wipeFilesystem,omitempty"`
+}
+
+type v3Raid struct {
+	Devices []string `json:"devices"`
+	Level   string   `json:"level"`
+	Name    string   `json:"name"`
+	Options []string `json:"options,omitempty"`
+	Spares  *int     `json:"spares,omitempty"`
+}
+
+type v3Systemd struct {
+	Units []v3Unit `json:"units,omitempty"`
+}
+
+type v3Unit struct {
+	Contents *string    `json:"contents,omitempty"`
+	Dropins  []v3Dropin `json:"dropins,omitempty"`
+	Enabled  *bool      `json:"enabled,omitempty"`
+	Mask     *bool      `json:"mask,omitempty"`
+	Name     string     `json:"name"`
+}
+
+type v3Dropin struct {
+	Contents *string `json:"contents,omitempty"`
+	Name     string  `json:"name"`
+}
+
+// convertToV3 converts the given spec 2.2 config to the given spec 3.x
+// version. Networkd units, which spec 3.x dropped, are written as files.
+// Filesystems spec 3.x cannot express, like files on filesystems other than
+// root, are rejected.
+func convertToV3(config k8signition.Config, specVersion string) (v3Config, error) {
+	v3 := v3Config{
+		Ignition: v3Ignition{
+			Security: v3Security{
+				TLS: v3TLS{
+					CertificateAuthorities: convertCAs(config.Ignition.Security.TLS.CertificateAuthorities),
+				},
+			},
+			Timeouts: v3Timeouts{
+				HTTPResponseHeaders: config.Ignition.Timeouts.HTTPResponseHeaders,
+				HTTPTotal:           config.Ignition.Timeouts.HTTPTotal,
+			},
+			Version: specVersion,
+		},
+	}
+
+	for _, r := range config.Ignition.Config.Append {
+		v3.Ignition.Config.Merge = append(v3.Ignition.Config.Merge, v3Resource{
+			Source:       stringPtr(r.Source),
+			Verification: v3Verification(r.Verification),
+		})
+	}
+	if r := config.Ignition.Config.Replace; r != nil {
+		v3.Ignition.Config.Replace = v3Resource{
+			Source:       stringPtr(r.Source),
+			Verification: v3Verification(r.Verification),
+		}
+	}
+
+	for _, g := range config.Passwd.Groups {
+		v3.Passwd.Groups = append(v3.Passwd.Groups, v3PasswdGroup{
+			Gid:          g.Gid,
+			Name:         g.Name,
+			PasswordHash: stringPtr(g.PasswordHash),
+			System:       boolPtr(g.System),
+		})
+	}
+	for _, u := range config.Passwd.Users {
+		if u.Create != nil {
+			return v3Config{}, microerror.Maskf(validationError, "user %#q: the deprecated create section cannot be converted", u.Name)
+		}
+
+		user := v3PasswdUser{
+			Gecos:        stringPtr(u.Gecos),
+			HomeDir:      stringPtr(u.HomeDir),
+			Name:         u.Name,
+			NoCreateHome: boolPtr(u.NoCreateHome),
+			NoLogInit:    boolPtr(u.NoLogInit),
+			NoUserGroup:  boolPtr(u.NoUserGroup),
+			PasswordHash: u.PasswordHash,
+			PrimaryGroup: stringPtr(u.PrimaryGroup),
+			Shell:        stringPtr(u.Shell),
+			System:       boolPtr(u.System),
+			UID:          u.UID,
+		}
+		for _, g := range u.Groups {
+			user.Groups = append(user.Groups, string(g))
+		}
+		for _, k := range u.SSHAuthorizedKeys {
+			user.SSHAuthorizedKeys = append(user.SSHAuthorizedKeys, string(k))
+		}
+		v3.Passwd.Users = append(v3.Passwd.Users, user)
+	}
+
+	for _, d := range config.Storage.Disks {
+		disk := v3Disk{
+			Device:    d.Device,
+			WipeTable: boolPtr(d.WipeTable),
+		}
+		for _, p := range d.Partitions {
+			size, err := sectorsToMiB(p.Size)
+			if err != nil {
+				return v3Config{}, microerror.Maskf(validationError, "partition %d of disk %#q: %s", p.Number, d.Device, err)
+			}
+			start, err := sectorsToMiB(p.Start)
+			if err != nil {
+				return v3Config{}, microerror.Maskf(validationError, "partition %d of disk %#q: %s", p.Number, d.Device, err)
+			}
+
+			disk.Partitions = append(disk.Partitions, v3Partition{
+				GUID:     stringPtr(p.GUID),
+				Label:    stringPtr(p.Label),
+				Number:   p.Number,
+				SizeMiB:  size,
+				StartMiB: start,
+				TypeGUID: stringPtr(p.TypeGUID),
+			})
+		}
+		v3.Storage.Disks = append(v3.Storage.Disks, disk)
+	}
+
+	for _, r := range config.Storage.Raid {
+		raid := v3Raid{
+			Level:  r.Level,
+			Name:   r.Name,
+			Spares: intPtr(r.Spares),
+		}
+		for _, d := range r.Devices {
+			raid.Devices = append(raid.Devices, string(d))
+		}
+		for _, o := range r.Options {
+			raid.Options = append(raid.Options, string(o))
+		}
+		v3.Storage.Raid = append(v3.Storage.Raid, raid)
+	}
+
+	// Spec 2.x paths are relative to the named filesystem. Spec 3.x paths are
+	// absolute, files can only be put on filesystems given by path.
+	filesystemPaths := map[string]string{
+		rootFilesystem: "/",
+	}
+	for _, f := range config.Storage.Filesystems {
+		if f.Path != nil {
+			filesystemPaths[f.Name] = *f.Path
+			continue
+		}
+		if f.Mount == nil {
+			continue
+		}
+
+		filesystem := v3Filesystem{
+			Device:         f.Mount.Device,
+			Format:         stringPtr(f.Mount.Format),
+			Label:          f.Mount.Label,
+			UUID:           f.Mount.UUID,
+			WipeFilesystem: boolPtr(f.Mount.WipeFilesystem),
+		}
+		if f.Mount.Create != nil {
+			filesystem.WipeFilesystem = boolPtr(f.Mount.WipeFilesystem || f.Mount.Create.Force)
+			for _, o := range f.Mount.Create.Options {
+				filesystem.Options = append(filesystem.Options, string(o))
+			}
+		}
+		for _, o := range f.Mount.Options {
+			filesystem.Options = append(filesystem.Options, string(o))
+		}
+		v3.Storage.Filesystems = append(v3.Storage.Filesystems, filesystem)
+	}
+
+	absolutePath := func(filesystem, p string) (string, error) {
+		if filesystem == "" {
+			filesystem = rootFilesystem
+		}
+		root, ok := filesystemPaths[filesystem]
+		if !ok {
+			return "", microerror.Maskf(validationError, "%#q is on filesystem %#q, which has no path", p, filesystem)
+		}
+		return path.Join(root, p), nil
+	}
+
+	for _, f := range config.Storage.Files {
+		p, err := absolutePath(f.Filesystem, f.Path)
+		if err != nil {
+			return v3Config{}, microerror.Mask(err)
+		}
+
+		contents := v3Resource{
+			Compression:  stringPtr(f.Contents.Compression),
+			Source:       stringPtr(f.Contents.Source),
+			Verification: v3Verification(f.Contents.Verification),
+		}
+		file := v3File{
+			Group: convertNodeGroup(f.Group),
+			Mode:  intPtr(f.Mode),
+			Path:  p,
+			User:  convertNodeUser(f.User),
+		}
+		if f.Append {
+			file.Append = []v3Resource{contents}
+		} else {
+			file.Contents = contents
+			// Spec 2.x overwrites files by default, spec 3.x does not.
+			file.Overwrite = f.Overwrite
+			if file.Overwrite == nil {
+				file.Overwrite = boolPtr(true)
+			}
+		}
+		v3.Storage.Files = append(v3.Storage.Files, file)
+	}
+
+	for _, d := range config.Storage.Directories {
+		p, err := absolutePath(d.Filesystem, d.Path)
+		if err != nil {
+			return v3Config{}, microerror.Mask(err)
+		}
+
+		v3.Storage.Directories = append(v3.Storage.Directories, v3Directory{
+			Group:     convertNodeGroup(d.Group),
+			Mode:      d.Mode,
+			Overwrite: d.Overwrite,
+			Path:      p,
+			User:      convertNodeUser(d.User),
+		})
+	}
+
+	for _, l := range config.Storage.Links {
+		p, err := absolutePath(l.Filesystem, l.Path)
+		if err != nil {
+			return v3Config{}, microerror.Mask(err)
+		}
+
+		v3.Storage.Links = append(v3.Storage.Links, v3Link{
+			Group:     convertNodeGroup(l.Group),
+			Hard:      boolPtr(l.Hard),
+			Overwrite: l.Overwrite,
+			Path:      p,
+			Target:    l.Target,
+			User:      convertNodeUser(l.User),
+		})
+	}
+
+	for _, u := range config.Networkd.Units {
+		if u.Contents != "" {
+			v3.Storage.Files = append(v3.Storage.Files, networkdFile(path.Join(networkdUnitPath, u.Name), u.Contents))
+		}
+		for _, d := range u.Dropins {
+			v3.Storage.Files = append(v3.Storage.Files, networkdFile(path.Join(networkdUnitPath, u.Name+".d", d.Name), d.Contents))
+		}
+	}
+
+	for _, u := range config.Systemd.Units {
+		unit := v3Unit{
+			Contents: stringPtr(u.Contents),
+			Enabled:  u.Enabled,
+			Mask:     boolPtr(u.Mask),
+			Name:     u.Name,
+		}
+		if u.Enable {
+			unit.Enabled = boolPtr(true)
+		}
+		for _, d := range u.Dropins {
+			unit.Dropins = append(unit.Dropins, v3Dropin{
+				Contents: stringPtr(d.Contents),
+				Name:     d.Name,
+			})
+		}
+		v3.Systemd.Units = append(v3.Systemd.Units, unit)
+	}
+
+	return v3, nil
+}
+
+func convertCAs(cas []k8signition.CaReference) []v3Resource {
+	var resources []v3Resource
+	for _, ca := range cas {
+		resources = append(resources, v3Resource{
+			Source:       stringPtr(ca.Source),
+			Verification: v3Verification(ca.Verification),
+		})
+	}
+
+	return resources
+}
+
+func convertNodeGroup(g *k8signition.NodeGroup) v3NodeGroup {
+	if g == nil {
+		return v3NodeGroup{}
+	}
+
+	return v3NodeGroup{
+		ID:   g.ID,
+		Name: stringPtr(g.Name),
+	}
+}
+
+func convertNodeUser(u *k8signition.NodeUser) v3NodeUser {
+	if u == nil {
+		return v3NodeUser{}
+	}
+
+	return v3NodeUser{
+		ID:   u.ID,
+		Name: stringPtr(u.Name),
+	}
+}
+
+func networkdFile(p, contents string) v3File {
+	return v3File{
+		Contents: v3Resource{
+			Source: stringPtr(DataURL(contents)),
+		},
+		Mode:      intPtr(networkdUnitMode),
+		Overwrite: boolPtr(true),
+		Path:      p,
+	}
+}
+
+func sectorsToMiB(sectors int) (*int, error) {
+	if sectors == 0 {
+		return nil, nil
+	}
+	if (sectors*sectorSize)%mebibyte != 0 {
+		return nil, fmt.Errorf("%d sectors are no multiple of 1 MiB", sectors)
+	}
+
+	return intPtr(sectors * sectorSize / mebibyte), nil
+}
+
+// DataURL returns a data URL with the given contents, to be used as source of
+// files.
+func DataURL(contents string) string {
+	return "data:," + url.PathEscape(contents)
+}
+
+func boolPtr(b bool) *bool {
+	if !b {
+		return nil
+	}
+	return &b
+}
+
+func intPtr(i int) *int {
+	if i == 0 {
+		return nil
+	}
+	return &i
+}
+
+func stringPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,348 @@
+package ignition
+
+import (
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	k8signition "github.com/giantswarm/k8scloudconfig/v10/pkg/ignition"
+	"github.com/giantswarm/microerror"
+)
+
+const (
+	maxMode = 07777
+)
+
+var (
+	// supportedSchemes are the URL schemes Ignition can fetch configs and
+	// file contents from.
+	supportedSchemes = map[string]bool{
+		"data":  true,
+		"http":  true,
+		"https": true,
+		"s3":    true,
+		"tftp":  true,
+	}
+
+	supportedFormats = map[string]bool{
+		"btrfs": true,
+		"ext4":  true,
+		"swap":  true,
+		"vfat":  true,
+		"xfs":   true,
+	}
+
+	unitSuffixes = []string{
+		".automount",
+		".device",
+		".mount",
+		".path",
+		".scope",
+		".service",
+		".slice",
+		".socket",
+		".swap",
+		".target",
+		".timer",
+	}
+
+	hashRegexp = regexp.MustCompile(`^sha512-[0-9a-f]{128}$`)
+)
+
+func validateV2(config k8signition.Config) error {
+	for _, r := range config.Ignition.Config.Append {
+		err := validateSource("config", r.Source, r.Verification.Hash)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+	if r := config.Ignition.Config.Replace; r != nil {
+		err := validateSource("config", r.Source, r.Verification.Hash)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	filesystems := map[string]bool{
+		rootFilesystem: true,
+	}
+	for _, f := range config.Storage.Filesystems {
+		if f.Name == "" {
+			return microerror.Maskf(validationError, "filesystem without name")
+		}
+		if filesystems[f.Name] {
+			return microerror.Maskf(validationError, "duplicate filesystem %#q", f.Name)
+		}
+		filesystems[f.Name] = true
+
+		if (f.Mount == nil) == (f.Path == nil) {
+			return microerror.Maskf(validationError, "filesystem %#q must have either a mount or a path", f.Name)
+		}
+		if f.Path != nil {
+			err := validatePath(*f.Path)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+			continue
+		}
+
+		err := validateFilesystem(f.Name, f.Mount.Device, f.Mount.Format)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	// Spec 2.x allows several nodes with the same path, the last one wins.
+	addNode := func(filesystem, p string, mode int) error {
+		if filesystem == "" {
+			return microerror.Maskf(validationError, "%#q has no filesystem", p)
+		}
+		if !filesystems[filesystem] {
+			return microerror.Maskf(validationError, "%#q is on unknown filesystem %#q", p, filesystem)
+		}
+		err := validatePath(p)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		if mode < 0 || mode > maxMode {
+			return microerror.Maskf(validationError, "%#q has invalid mode %o", p, mode)
+		}
+
+		return nil
+	}
+
+	for _, f := range config.Storage.Files {
+		err := addNode(f.Filesystem, f.Path, f.Mode)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		err = validateSource(f.Path, f.Contents.Source, f.Contents.Verification.Hash)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+	for _, d := range config.Storage.Directories {
+		err := addNode(d.Filesystem, d.Path, intValue(d.Mode))
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+	for _, l := range config.Storage.Links {
+		err := addNode(l.Filesystem, l.Path, 0)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		if l.Target == "" {
+			return microerror.Maskf(validationError, "link %#q has no target", l.Path)
+		}
+	}
+
+	for _, u := range config.Systemd.Units {
+		var dropins []string
+		for _, d := range u.Dropins {
+			dropins = append(dropins, d.Name)
+		}
+		err := validateUnit(u.Name, dropins)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+	for _, u := range config.Networkd.Units {
+		if u.Name == "" {
+			return microerror.Maskf(validationError, "networkd unit without name")
+		}
+	}
+
+	for _, u := range config.Passwd.Users {
+		if u.Name == "" {
+			return microerror.Maskf(validationError, "user without name")
+		}
+	}
+
+	return nil
+}
+
+func validateV3(config v3Config) error {
+	for _, r := range config.Ignition.Config.Merge {
+		err := validateSource("config", stringValue(r.Source), r.Verification.Hash)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+	if r := config.Ignition.Config.Replace; r.Source != nil {
+		err := validateSource("config", *r.Source, r.Verification.Hash)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	for _, f := range config.Storage.Filesystems {
+		err := validateFilesystem(f.Device, f.Device, stringValue(f.Format))
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		if f.Path != nil {
+			err = validatePath(*f.Path)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	}
+
+	// Spec 3.x rejects several nodes with the same path.
+	paths := map[string]bool{}
+	addNode := func(p string, mode int) error {
+		err := validatePath(p)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		if mode < 0 || mode > maxMode {
+			return microerror.Maskf(validationError, "%#q has invalid mode %o", p, mode)
+		}
+
+		if paths[path.Clean(p)] {
+			return microerror.Maskf(validationError, "duplicate path %#q", p)
+		}
+		paths[path.Clean(p)] = true
+
+		return nil
+	}
+
+	for _, f := range config.Storage.Files {
+		err := addNode(f.Path, intValue(f.Mode))
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		if f.Contents.Source != nil && len(f.Append) > 0 {
+			return microerror.Maskf(validationError, "file %#q has contents and is appended to", f.Path)
+		}
+		for _, r := range append([]v3Resource{f.Contents}, f.Append...) {
+			err = validateSource(f.Path, stringValue(r.Source), r.Verification.Hash)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	}
+	for _, d := range config.Storage.Directories {
+		err := addNode(d.Path, intValue(d.Mode))
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+	for _, l := range config.Storage.Links {
+		err := addNode(l.Path, 0)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		if l.Target == "" {
+			return microerror.Maskf(validationError, "link %#q has no target", l.Path)
+		}
+	}
+
+	units := map[string]bool{}
+	for _, u := range config.Systemd.Units {
+		if units[u.Name] {
+			return microerror.Maskf(validationError, "duplicate unit %#q", u.Name)
+		}
+		units[u.Name] = true
+
+		var dropins []string
+		for _, d := range u.Dropins {
+			dropins = append(dropins, d.Name)
+		}
+		err := validateUnit(u.Name, dropins)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	users := map[string]bool{}
+	for _, u := range config.Passwd.Users {
+		if u.Name == "" {
+			return microerror.Maskf(validationError, "user without name")
+		}
+		if users[u.Name] {
+			return microerror.Maskf(validationError, "duplicate user %#q", u.Name)
+		}
+		users[u.Name] = true
+	}
+
+	return nil
+}
+
+func validateFilesystem(name, device, format string) error {
+	if !path.IsAbs(device) {
+		return microerror.Maskf(validationError, "filesystem %#q has no absolute device path", name)
+	}
+	if format != "" && !supportedFormats[format] {
+		return microerror.Maskf(validationError, "filesystem %#q has unsupported format %#q", name, format)
+	}
+
+	return nil
+}
+
+func validatePath(p string) error {
+	if !path.IsAbs(p) {
+		return microerror.Maskf(validationError, "path %#q is not absolute", p)
+	}
+
+	return nil
+}
+
+func validateSource(name, source string, hash *string) error {
+	if hash != nil && !hashRegexp.MatchString(*hash) {
+		return microerror.Maskf(validationError, "%#q has invalid hash %#q", name, *hash)
+	}
+	if source == "" {
+		return nil
+	}
+
+	u, err := url.Parse(source)
+	if err != nil {
+		return microerror.Maskf(validationError, "%#q has invalid source: %s", name, err)
+	}
+	if !supportedSchemes[u.Scheme] {
+		return microerror.Maskf(validationError, "%#q has source with unsupported scheme %#q", name, u.Scheme)
+	}
+	if u.Scheme == "data" && !strings.Contains(u.Opaque, ",") {
+		return microerror.Maskf(validationError, "%#q has invalid data URL", name)
+	}
+
+	return nil
+}
+
+func validateUnit(name string, dropins []string) error {
+	var valid bool
+	for _, s := range unitSuffixes {
+		if strings.HasSuffix(name, s) {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return microerror.Maskf(validationError, "unit %#q has invalid type", name)
+	}
+
+	for _, d := range dropins {
+		if !strings.HasSuffix(d, ".conf") {
+			return microerror.Maskf(validationError, "dropin %#q of unit %#q must end with .conf", d, name)
+		}
+	}
+
+	return nil
+}
+
+func intValue(i *int) int {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -30,3 +30,12 @@ var notFoundError = &microerror.Error{
 func IsNotFound(err error) bool {
 	return microerror.Cause(err) == notFoundError
 }
+
+var leaseLostError = &microerror.Error{
+	Kind: "leaseLostError",
+}
+
+// IsLeaseLost asserts leaseLostError.
+func IsLeaseLost(err error) bool {
+	return microerror.Cause(err) == leaseLostError
+}
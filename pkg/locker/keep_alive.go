@@ -0,0 +1,43 @@
+package locker
+
+import (
+	"context"
+	"time"
+)
+
+// KeepAlive renews the given lease in the background until the returned
+// context gets canceled. The returned context is also canceled as soon as the
+// lease is lost, so that any work guarded by the lease stops
+// before a stale holder can persist anything.
+func KeepAlive(ctx context.Context, lease Lease) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	if lease.TTL() == 0 {
+		return ctx, cancel
+	}
+
+	go func() {
+		ticker := time.NewTicker(lease.TTL() / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				err := lease.Renew(ctx)
+				if IsLeaseLost(err) {
+					cancel()
+					return
+				} else if err != nil {
+					// Transient errors are retried with the next tick. In case
+					// the lease expires in the meantime, the next renewal
+					// detects the loss of the lease.
+					continue
+				}
+			}
+		}
+	}()
+
+	return ctx, cancel
+}
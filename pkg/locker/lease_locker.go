@@ -0,0 +1,271 @@
+package locker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/project"
+)
+
+const (
+	lockName          = "ipam"
+	lockNamespaceName = "giantswarm"
+)
+
+var (
+	lockTTL = 30 * time.Second
+)
+
+type LeaseLockerConfig struct {
+	K8sClient kubernetes.Interface
+	Logger    micrologger.Logger
+
+	// Name is appended to the project name to form the name of the Lease
+	// object. Lockers with different names lock independently. Defaults to
+	// "ipam".
+	Name string
+	// Identity identifies the process acquiring the lock. Every acquisition
+	// gets its own holder identity derived from it. Defaults to the hostname,
+	// which is the pod name when running in Kubernetes.
+	Identity string
+	// TTL is the duration after which a lease expires unless it gets renewed.
+	// Defaults to 30 seconds.
+	TTL time.Duration
+}
+
+// LeaseLocker implements Interface using a coordination.k8s.io/v1 Lease
+// object. It provides mutual exclusion across operator replicas and across
+// controllers running in the same process. The lease transitions counter of
+// the Lease object is used as fencing token. All writes to the Lease object are
+// guarded by its resource version, so concurrent acquisitions cannot both
+// succeed.
+type LeaseLocker struct {
+	k8sClient kubernetes.Interface
+	logger    micrologger.Logger
+
+	identity string
+	name     string
+	now      func() time.Time
+	ttl      time.Duration
+}
+
+func NewLeaseLocker(config LeaseLockerConfig) (*LeaseLocker, error) {
+	if config.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	if config.Identity == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		config.Identity = hostname
+	}
+	if config.Name == "" {
+		config.Name = lockName
+	}
+	if config.TTL == 0 {
+		config.TTL = lockTTL
+	}
+	if config.TTL < time.Second {
+		return nil, microerror.Maskf(invalidConfigError, "%T.TTL must be at least one second", config)
+	}
+
+	l := &LeaseLocker{
+		k8sClient: config.K8sClient,
+		logger:    config.Logger,
+
+		identity: config.Identity,
+		name:     fmt.Sprintf("%s-%s", project.Name(), config.Name),
+		now:      time.Now,
+		ttl:      config.TTL,
+	}
+
+	return l, nil
+}
+
+func (l *LeaseLocker) Lock(ctx context.Context) (Lease, error) {
+	holder := fmt.Sprintf("%s-%s", l.identity, rand.String(5))
+	now := metav1.NewMicroTime(l.now())
+	ttlSeconds := int32(l.ttl.Seconds())
+
+	current, err := l.k8sClient.CoordinationV1().Leases(lockNamespaceName).Get(ctx, l.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		lease := &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      l.name,
+				Namespace: lockNamespaceName,
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &holder,
+				LeaseDurationSeconds: &ttlSeconds,
+				AcquireTime:          &now,
+				RenewTime:            &now,
+				LeaseTransitions:     new(int32),
+			},
+		}
+
+		_, err = l.k8sClient.CoordinationV1().Leases(lockNamespaceName).Create(ctx, lease, metav1.CreateOptions{})
+		if apierrors.IsAlreadyExists(err) {
+			return nil, microerror.Maskf(alreadyExistsError, "lease %#q was acquired concurrently", l.name)
+		} else if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		return l.newLease(holder, 0), nil
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	if l.isHeld(current) {
+		return nil, microerror.Maskf(alreadyExistsError, "lease %#q is held by %#q until %s", l.name, *current.Spec.HolderIdentity, l.expiry(current).Format(time.RFC3339))
+	}
+
+	var transitions int32
+	if current.Spec.LeaseTransitions != nil {
+		transitions = *current.Spec.LeaseTransitions + 1
+	}
+
+	current.Spec.HolderIdentity = &holder
+	current.Spec.LeaseDurationSeconds = &ttlSeconds
+	current.Spec.AcquireTime = &now
+	current.Spec.RenewTime = &now
+	current.Spec.LeaseTransitions = &transitions
+
+	_, err = l.k8sClient.CoordinationV1().Leases(lockNamespaceName).Update(ctx, current, metav1.UpdateOptions{})
+	if apierrors.IsConflict(err) {
+		return nil, microerror.Maskf(alreadyExistsError, "lease %#q was acquired concurrently", l.name)
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return l.newLease(holder, int64(transitions)), nil
+}
+
+func (l *LeaseLocker) newLease(holder string, token int64) *kubernetesLease {
+	return &kubernetesLease{
+		locker: l,
+		holder: holder,
+		token:  token,
+	}
+}
+
+// get returns the Lease object if it is still owned by the given holder and
+// token. Otherwise it returns an error matched by IsLeaseLost.
+func (l *LeaseLocker) get(ctx context.Context, holder string, token int64) (*coordinationv1.Lease, error) {
+	current, err := l.k8sClient.CoordinationV1().Leases(lockNamespaceName).Get(ctx, l.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, microerror.Maskf(leaseLostError, "lease %#q does not exist anymore", l.name)
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	if current.Spec.HolderIdentity == nil || *current.Spec.HolderIdentity != holder {
+		return nil, microerror.Maskf(leaseLostError, "lease %#q is not held by %#q anymore", l.name, holder)
+	}
+	if current.Spec.LeaseTransitions == nil || int64(*current.Spec.LeaseTransitions) != token {
+		return nil, microerror.Maskf(leaseLostError, "lease %#q has been acquired again since token %d", l.name, token)
+	}
+
+	return current, nil
+}
+
+func (l *LeaseLocker) isHeld(lease *coordinationv1.Lease) bool {
+	if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity == "" {
+		return false
+	}
+
+	return l.now().Before(l.expiry(lease))
+}
+
+func (l *LeaseLocker) expiry(lease *coordinationv1.Lease) time.Time {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return time.Time{}
+	}
+
+	return lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+}
+
+type kubernetesLease struct {
+	locker *LeaseLocker
+	holder string
+	token  int64
+}
+
+func (k *kubernetesLease) Holder() string {
+	return k.holder
+}
+
+func (k *kubernetesLease) Token() int64 {
+	return k.token
+}
+
+func (k *kubernetesLease) TTL() time.Duration {
+	return k.locker.ttl
+}
+
+func (k *kubernetesLease) Renew(ctx context.Context) error {
+	current, err := k.locker.get(ctx, k.holder, k.token)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	now := metav1.NewMicroTime(k.locker.now())
+	current.Spec.RenewTime = &now
+
+	_, err = k.locker.k8sClient.CoordinationV1().Leases(lockNamespaceName).Update(ctx, current, metav1.UpdateOptions{})
+	if apierrors.IsConflict(err) {
+		return microerror.Maskf(leaseLostError, "lease %#q was modified concurrently", k.locker.name)
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (k *kubernetesLease) Validate(ctx context.Context) error {
+	current, err := k.locker.get(ctx, k.holder, k.token)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if !k.locker.isHeld(current) {
+		return microerror.Maskf(leaseLostError, "lease %#q held by %#q expired at %s", k.locker.name, k.holder, k.locker.expiry(current).Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func (k *kubernetesLease) Release(ctx context.Context) error {
+	current, err := k.locker.get(ctx, k.holder, k.token)
+	if IsLeaseLost(err) {
+		return microerror.Maskf(notFoundError, err.Error())
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	current.Spec.HolderIdentity = nil
+	current.Spec.RenewTime = nil
+
+	_, err = k.locker.k8sClient.CoordinationV1().Leases(lockNamespaceName).Update(ctx, current, metav1.UpdateOptions{})
+	if apierrors.IsConflict(err) {
+		return microerror.Maskf(notFoundError, "lease %#q was modified concurrently", k.locker.name)
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package locker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/giantswarm/micrologger/microloggertest"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_LeaseLocker(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	var leaseLocker *LeaseLocker
+	{
+		c := LeaseLockerConfig{
+			K8sClient: fake.NewSimpleClientset(),
+			Logger:    microloggertest.New(),
+
+			Identity: "unit-test",
+		}
+
+		var err error
+		leaseLocker, err = NewLeaseLocker(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		leaseLocker.now = func() time.Time { return now }
+	}
+
+	// The first holder acquires the lock.
+	first, err := leaseLocker.Lock(ctx)
+	if err != nil {
+		t.Fatalf("expected first lock to succeed, got %#v", err)
+	}
+	if first.Token() != 0 {
+		t.Fatalf("expected first token to be 0, got %d", first.Token())
+	}
+
+	// A second holder must not get the lock while the first lease is valid.
+	_, err = leaseLocker.Lock(ctx)
+	if !IsAlreadyExists(err) {
+		t.Fatalf("expected already exists error, got %#v", err)
+	}
+
+	// Renewal keeps the first lease valid beyond its initial TTL.
+	now = now.Add(lockTTL / 2)
+	err = first.Renew(ctx)
+	if err != nil {
+		t.Fatalf("expected renewal to succeed, got %#v", err)
+	}
+	now = now.Add(lockTTL * 3 / 4)
+	err = first.Validate(ctx)
+	if err != nil {
+		t.Fatalf("expected renewed lease to be valid, got %#v", err)
+	}
+
+	// Once the first lease expires, a second holder takes over with a higher
+	// fencing token.
+	now = now.Add(lockTTL)
+	err = first.Validate(ctx)
+	if !IsLeaseLost(err) {
+		t.Fatalf("expected expired lease to be lost, got %#v", err)
+	}
+	second, err := leaseLocker.Lock(ctx)
+	if err != nil {
+		t.Fatalf("expected second lock to succeed, got %#v", err)
+	}
+	if second.Token() <= first.Token() {
+		t.Fatalf("expected second token to be greater than %d, got %d", first.Token(), second.Token())
+	}
+
+	// The stale first holder can neither renew nor release the lock.
+	err = first.Renew(ctx)
+	if !IsLeaseLost(err) {
+		t.Fatalf("expected stale renewal to fail with lease lost error, got %#v", err)
+	}
+	err = first.Release(ctx)
+	if !IsNotFound(err) {
+		t.Fatalf("expected stale release to fail with not found error, got %#v", err)
+	}
+	err = second.Validate(ctx)
+	if err != nil {
+		t.Fatalf("expected second lease to be valid, got %#v", err)
+	}
+
+	// After the release the lock can be acquired right away.
+	err = second.Release(ctx)
+	if err != nil {
+		t.Fatalf("expected release to succeed, got %#v", err)
+	}
+	third, err := leaseLocker.Lock(ctx)
+	if err != nil {
+		t.Fatalf("expected third lock to succeed, got %#v", err)
+	}
+	if third.Token() <= second.Token() {
+		t.Fatalf("expected third token to be greater than %d, got %d", second.Token(), third.Token())
+	}
+}
+
+func Test_LeaseLocker_Name(t *testing.T) {
+	ctx := context.Background()
+	k8sClient := fake.NewSimpleClientset()
+
+	newLocker := func(name string) *LeaseLocker {
+		c := LeaseLockerConfig{
+			K8sClient: k8sClient,
+			Logger:    microloggertest.New(),
+
+			Identity: "unit-test",
+			Name:     name,
+		}
+
+		leaseLocker, err := NewLeaseLocker(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return leaseLocker
+	}
+
+	ipamLocker := newLocker("")
+	sweeperLocker := newLocker("sweeper")
+
+	// Lockers with different names use different Lease objects.
+	_, err := ipamLocker.Lock(ctx)
+	if err != nil {
+		t.Fatalf("expected ipam lock to succeed, got %#v", err)
+	}
+	_, err = sweeperLocker.Lock(ctx)
+	if err != nil {
+		t.Fatalf("expected sweeper lock to succeed, got %#v", err)
+	}
+
+	// Lockers with the same name exclude each other.
+	_, err = newLocker("sweeper").Lock(ctx)
+	if !IsAlreadyExists(err) {
+		t.Fatalf("expected already exists error, got %#v", err)
+	}
+}
@@ -2,7 +2,9 @@ package locker
 
 import (
 	"context"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
@@ -12,13 +14,18 @@ type MutexLockerConfig struct {
 	Logger micrologger.Logger
 }
 
-// MutexLocker implements Interface using sync.Mutex. For now we use a shared
-// instance of *MutexLocker for all IPAM related activity of network packages in
-// the legacy controllers and ipam resources in the clusterapi controllers.
+// MutexLocker implements Interface using an in process semaphore. For now we
+// use a shared instance of *MutexLocker for all IPAM related activity of
+// network packages in the legacy controllers and ipam resources in the
+// clusterapi controllers. Lock blocks until the lock becomes available or the
+// given context is canceled. Leases issued by MutexLocker never expire.
 type MutexLocker struct {
 	logger micrologger.Logger
 
-	mutex sync.Mutex
+	mutex     sync.Mutex
+	semaphore chan struct{}
+	token     int64
+	held      bool
 }
 
 func NewMutexLocker(config MutexLockerConfig) (*MutexLocker, error) {
@@ -29,18 +36,88 @@ func NewMutexLocker(config MutexLockerConfig) (*MutexLocker, error) {
 	l := &MutexLocker{
 		logger: config.Logger,
 
-		mutex: sync.Mutex{},
+		semaphore: make(chan struct{}, 1),
 	}
 
 	return l, nil
 }
 
-func (l *MutexLocker) Lock(ctx context.Context) error {
+func (l *MutexLocker) Lock(ctx context.Context) (Lease, error) {
+	select {
+	case l.semaphore <- struct{}{}:
+	case <-ctx.Done():
+		return nil, microerror.Mask(ctx.Err())
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.token++
+	l.held = true
+
+	lease := &mutexLease{
+		locker: l,
+		token:  l.token,
+	}
+
+	return lease, nil
+}
+
+func (l *MutexLocker) isHeldBy(token int64) bool {
 	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.held && l.token == token
+}
+
+func (l *MutexLocker) release(token int64) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if !l.held || l.token != token {
+		return microerror.Maskf(notFoundError, "mutex lease with token %d is not held", token)
+	}
+
+	l.held = false
+	<-l.semaphore
+
 	return nil
 }
 
-func (l *MutexLocker) Unlock(ctx context.Context) error {
-	l.mutex.Unlock()
+type mutexLease struct {
+	locker *MutexLocker
+	token  int64
+}
+
+func (m *mutexLease) Holder() string {
+	return fmt.Sprintf("mutex-%d", m.token)
+}
+
+func (m *mutexLease) Token() int64 {
+	return m.token
+}
+
+func (m *mutexLease) TTL() time.Duration {
+	return 0
+}
+
+func (m *mutexLease) Renew(ctx context.Context) error {
+	return m.Validate(ctx)
+}
+
+func (m *mutexLease) Validate(ctx context.Context) error {
+	if !m.locker.isHeldBy(m.token) {
+		return microerror.Maskf(leaseLostError, "mutex lease with token %d is not held", m.token)
+	}
+
+	return nil
+}
+
+func (m *mutexLease) Release(ctx context.Context) error {
+	err := m.locker.release(m.token)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	return nil
 }
@@ -1,10 +1,41 @@
 package locker
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Interface is some form of lock implementation like achieved for in process
-// locking using sync.Mutex.
+// locking using sync.Mutex or for distributed locking using Kubernetes Lease
+// objects.
 type Interface interface {
-	Lock(ctx context.Context) error
-	Unlock(ctx context.Context) error
+	// Lock acquires the lock on behalf of a new holder and returns the Lease
+	// representing the ownership of the lock. Implementations either block
+	// until the lock becomes available or return an error matched by
+	// IsAlreadyExists when the lock is currently held by another holder.
+	Lock(ctx context.Context) (Lease, error)
+}
+
+// Lease represents the ownership of a lock by a single holder.
+type Lease interface {
+	// Holder returns the unique identity of the lease holder.
+	Holder() string
+	// Token returns the fencing token of the lease. Tokens increase with
+	// every acquisition of the lock, so a higher token always belongs to a
+	// newer holder.
+	Token() int64
+	// TTL returns the duration after which the lease expires unless it gets
+	// renewed. A zero TTL means the lease never expires.
+	TTL() time.Duration
+	// Renew extends the lease by its TTL. It returns an error matched by
+	// IsLeaseLost when the lease expired and was taken over by another
+	// holder in the meantime.
+	Renew(ctx context.Context) error
+	// Validate checks if the lease is still held. It returns an error matched
+	// by IsLeaseLost otherwise. Holders must validate their lease right before
+	// persisting any state guarded by the lock.
+	Validate(ctx context.Context) error
+	// Release gives up the lock. It returns an error matched by IsNotFound
+	// when the lease is not held anymore.
+	Release(ctx context.Context) error
 }
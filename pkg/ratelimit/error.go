@@ -1,7 +1,16 @@
-package senddecorator
+package ratelimit
 
 import "github.com/giantswarm/microerror"
 
+var invalidConfigError = &microerror.Error{
+	Kind: "invalidConfigError",
+}
+
+// IsInvalidConfig asserts invalidConfigError.
+func IsInvalidConfig(err error) bool {
+	return microerror.Cause(err) == invalidConfigError
+}
+
 var tooManyRequestsError = &microerror.Error{
 	Kind: "tooManyRequestsError",
 }
@@ -0,0 +1,275 @@
+// Package ratelimit provides a rate limiter for Azure Resource Manager API
+// calls which is shared by all clients of the same subscription.
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/backpressure"
+	"github.com/giantswarm/azure-operator/v5/pkg/httputil"
+)
+
+// Class is the class of an ARM operation. ARM accounts reads, writes and
+// deletes against separate quotas of a subscription.
+type Class string
+
+const (
+	ClassDelete Class = "delete"
+	ClassRead   Class = "read"
+	ClassWrite  Class = "write"
+)
+
+const (
+	// DefaultMaxDelay is the delay of requests once the remaining quota is
+	// used up.
+	DefaultMaxDelay = 10 * time.Second
+	// DefaultTTL is the duration after which a remaining quota reported by
+	// ARM is considered outdated. Quotas are refilled continuously.
+	DefaultTTL = time.Minute
+
+	// Default wait time in case server returns HTTP 429 Too Many Requests but
+	// doesn't provide Retry-After header.
+	defaultWaitAfterTooManyRequests = 6 * time.Minute
+
+	headerRemainingDeletes = "x-ms-ratelimit-remaining-subscription-deletes"
+	headerRemainingReads   = "x-ms-ratelimit-remaining-subscription-reads"
+	headerRemainingWrites  = "x-ms-ratelimit-remaining-subscription-writes"
+)
+
+// DefaultThresholds are the remaining quotas below which requests are
+// delayed. ARM grants a subscription ten times more reads than writes or
+// deletes.
+var DefaultThresholds = map[Class]int{
+	ClassDelete: 100,
+	ClassRead:   1000,
+	ClassWrite:  100,
+}
+
+type Config struct {
+	// MaxDelay is the delay of requests once the remaining quota is used up.
+	// Requests are delayed proportionally while the remaining quota is
+	// between the threshold and zero.
+	MaxDelay time.Duration
+	// Thresholds are the remaining quotas per operation class below which
+	// requests are delayed.
+	Thresholds map[Class]int
+	// TTL is the duration after which a remaining quota is considered
+	// outdated.
+	TTL time.Duration
+}
+
+// Limiter tracks the remaining ARM quota per subscription and operation
+// class. Requests are slowed down before the quota is used up and held off
+// completely while ARM rate limits the subscription with HTTP 429 Too Many
+// Requests.
+type Limiter struct {
+	maxDelay   time.Duration
+	thresholds map[Class]int
+	ttl        time.Duration
+
+	buckets map[bucketKey]*bucket
+	mutex   sync.Mutex
+
+	// now returns the current time. It defaults to time.Now and is only
+	// overwritten in tests.
+	now func() time.Time
+}
+
+type bucketKey struct {
+	subscriptionID string
+	class          Class
+}
+
+type bucket struct {
+	backpressure backpressure.Backpressure
+	remaining    int
+	updatedAt    time.Time
+}
+
+func New(config Config) (*Limiter, error) {
+	if config.MaxDelay <= 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.MaxDelay must be greater than zero", config)
+	}
+	if len(config.Thresholds) == 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Thresholds must not be empty", config)
+	}
+	if config.TTL <= 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.TTL must be greater than zero", config)
+	}
+
+	l := &Limiter{
+		maxDelay:   config.MaxDelay,
+		thresholds: config.Thresholds,
+		ttl:        config.TTL,
+
+		buckets: map[bucketKey]*bucket{},
+		now:     time.Now,
+	}
+
+	return l, nil
+}
+
+// ClassOf returns the operation class of the given HTTP method.
+func ClassOf(method string) Class {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return ClassRead
+	case http.MethodDelete:
+		return ClassDelete
+	default:
+		return ClassWrite
+	}
+}
+
+// Wait blocks until a request of the given class may be sent on behalf of the
+// given subscription. It returns an error matched by IsTooManyRequests while
+// ARM rate limits the subscription.
+func (l *Limiter) Wait(ctx context.Context, subscriptionID string, class Class) error {
+	l.mutex.Lock()
+	b := l.bucket(subscriptionID, class)
+	if !b.backpressure.CanProceed() {
+		retryAfter := b.backpressure.RetryAfter()
+		l.mutex.Unlock()
+		return microerror.Maskf(tooManyRequestsError, "retry after %q", retryAfter)
+	}
+	delay := l.delay(b, class)
+	l.mutex.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return microerror.Mask(ctx.Err())
+	case <-t.C:
+		return nil
+	}
+}
+
+// Update tracks the remaining quota reported by ARM in the given response to
+// a request of the given class. It returns an error matched by
+// IsTooManyRequests when the response is HTTP 429 Too Many Requests.
+func (l *Limiter) Update(subscriptionID string, class Class, resp *http.Response) error {
+	if resp == nil {
+		return nil
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	b := l.bucket(subscriptionID, class)
+
+	remaining, ok := parseRemaining(resp, class)
+	if ok {
+		b.remaining = remaining
+		b.updatedAt = l.now()
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		retryAfter, err := httputil.ParseRetryAfter(resp)
+		if err != nil {
+			// In case parsing fails, it's ok to fall back on default delay.
+			retryAfter = l.now().UTC().Add(defaultWaitAfterTooManyRequests)
+		}
+
+		b.backpressure.NotBefore(retryAfter)
+		return microerror.Maskf(tooManyRequestsError, "retry after %q", retryAfter)
+	}
+
+	return nil
+}
+
+// Remaining returns the last remaining quota reported by ARM for the given
+// subscription and operation class. The boolean is false when the quota is
+// unknown or outdated.
+func (l *Limiter) Remaining(subscriptionID string, class Class) (int, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	b := l.bucket(subscriptionID, class)
+	if !l.isCurrent(b) {
+		return 0, false
+	}
+
+	return b.remaining, true
+}
+
+// RetryAfter returns the time until which ARM rate limits requests of the
+// given class for the given subscription after responding HTTP 429 Too Many
+// Requests. It is in the past when requests may be sent.
+func (l *Limiter) RetryAfter(subscriptionID string, class Class) time.Time {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.bucket(subscriptionID, class).backpressure.RetryAfter()
+}
+
+// Delay returns the duration a request of the given class for the given
+// subscription is delayed by.
+func (l *Limiter) Delay(subscriptionID string, class Class) time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.delay(l.bucket(subscriptionID, class), class)
+}
+
+func (l *Limiter) bucket(subscriptionID string, class Class) *bucket {
+	k := bucketKey{subscriptionID: subscriptionID, class: class}
+
+	b, ok := l.buckets[k]
+	if !ok {
+		b = &bucket{}
+		l.buckets[k] = b
+	}
+
+	return b
+}
+
+func (l *Limiter) delay(b *bucket, class Class) time.Duration {
+	threshold := l.thresholds[class]
+	if threshold <= 0 || !l.isCurrent(b) || b.remaining >= threshold {
+		return 0
+	}
+
+	remaining := b.remaining
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return l.maxDelay * time.Duration(threshold-remaining) / time.Duration(threshold)
+}
+
+func (l *Limiter) isCurrent(b *bucket) bool {
+	return !b.updatedAt.IsZero() && l.now().Sub(b.updatedAt) <= l.ttl
+}
+
+// parseRemaining returns the remaining quota of the subscription for the
+// given operation class reported in the response.
+func parseRemaining(resp *http.Response, class Class) (int, bool) {
+	var h string
+	switch class {
+	case ClassDelete:
+		h = headerRemainingDeletes
+	case ClassRead:
+		h = headerRemainingReads
+	default:
+		h = headerRemainingWrites
+	}
+
+	i, err := strconv.Atoi(resp.Header.Get(h))
+	if err != nil {
+		return 0, false
+	}
+
+	return i, true
+}
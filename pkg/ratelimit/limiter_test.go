@@ -0,0 +1,142 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func Test_Limiter_Delay(t *testing.T) {
+	now := time.Date(2021, 2, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		method        string
+		headers       map[string]string
+		age           time.Duration
+		expectedDelay time.Duration
+	}{
+		{
+			name:          "case 0: unknown quota is not delayed",
+			method:        http.MethodGet,
+			expectedDelay: 0,
+		},
+		{
+			name:          "case 1: read quota above threshold is not delayed",
+			method:        http.MethodGet,
+			headers:       map[string]string{headerRemainingReads: "11999"},
+			expectedDelay: 0,
+		},
+		{
+			name:          "case 2: read quota below threshold is delayed proportionally",
+			method:        http.MethodGet,
+			headers:       map[string]string{headerRemainingReads: "250"},
+			expectedDelay: 7500 * time.Millisecond,
+		},
+		{
+			name:          "case 3: used up write quota is delayed by max delay",
+			method:        http.MethodPut,
+			headers:       map[string]string{headerRemainingWrites: "0"},
+			expectedDelay: 10 * time.Second,
+		},
+		{
+			name:          "case 4: delete quota is delayed proportionally",
+			method:        http.MethodDelete,
+			headers:       map[string]string{headerRemainingDeletes: "50"},
+			expectedDelay: 5 * time.Second,
+		},
+		{
+			name:          "case 5: outdated quota is not delayed",
+			method:        http.MethodPut,
+			headers:       map[string]string{headerRemainingWrites: "0"},
+			age:           2 * time.Minute,
+			expectedDelay: 0,
+		},
+		{
+			name:          "case 6: unparseable quota is ignored",
+			method:        http.MethodGet,
+			headers:       map[string]string{headerRemainingReads: "many"},
+			expectedDelay: 0,
+		},
+		{
+			name:          "case 7: delete quota does not affect writes",
+			method:        http.MethodPut,
+			headers:       map[string]string{headerRemainingDeletes: "0"},
+			expectedDelay: 0,
+		},
+		{
+			name:          "case 8: read quota does not affect writes",
+			method:        http.MethodPut,
+			headers:       map[string]string{headerRemainingReads: "0", headerRemainingWrites: "1199"},
+			expectedDelay: 0,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			l, err := New(Config{MaxDelay: DefaultMaxDelay, Thresholds: DefaultThresholds, TTL: DefaultTTL})
+			if err != nil {
+				t.Fatal(err)
+			}
+			l.now = func() time.Time { return now }
+
+			resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+			for k, v := range tc.headers {
+				resp.Header.Set(k, v)
+			}
+
+			class := ClassOf(tc.method)
+			err = l.Update("subscription", class, resp)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			l.now = func() time.Time { return now.Add(tc.age) }
+
+			delay := l.Delay("subscription", class)
+			if delay != tc.expectedDelay {
+				t.Fatalf("delay == %s, want %s", delay, tc.expectedDelay)
+			}
+
+			// Other subscriptions are not affected.
+			delay = l.Delay("other", class)
+			if delay != 0 {
+				t.Fatalf("delay of other subscription == %s, want 0", delay)
+			}
+		})
+	}
+}
+
+func Test_Limiter_TooManyRequests(t *testing.T) {
+	l, err := New(Config{MaxDelay: DefaultMaxDelay, Thresholds: DefaultThresholds, TTL: DefaultTTL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Set("Retry-After", "100")
+
+	err = l.Update("subscription", ClassWrite, resp)
+	if !IsTooManyRequests(err) {
+		t.Fatalf("error == %#v, want too many requests", err)
+	}
+
+	err = l.Wait(context.Background(), "subscription", ClassWrite)
+	if !IsTooManyRequests(err) {
+		t.Fatalf("error == %#v, want too many requests", err)
+	}
+
+	// Reads and other subscriptions are not affected.
+	err = l.Wait(context.Background(), "subscription", ClassRead)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+	err = l.Wait(context.Background(), "other", ClassWrite)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+}
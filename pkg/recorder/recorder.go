@@ -0,0 +1,35 @@
+// Package recorder provides the Kubernetes event recorder used by handlers to
+// surface noteworthy conditions of the reconciled CRs, e.g. timed out upgrades.
+package recorder
+
+import (
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+type Config struct {
+	K8sClient k8sclient.Interface
+
+	Component string
+}
+
+// New returns an event recorder emitting events to the Kubernetes API of the
+// control plane.
+func New(config Config) (record.EventRecorder, error) {
+	if config.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
+	}
+	if config.Component == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Component must not be empty", config)
+	}
+
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{
+		Interface: config.K8sClient.K8sClient().CoreV1().Events(""),
+	})
+
+	return broadcaster.NewRecorder(config.K8sClient.Scheme(), corev1.EventSource{Component: config.Component}), nil
+}
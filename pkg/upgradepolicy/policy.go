@@ -0,0 +1,205 @@
+package upgradepolicy
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+)
+
+const (
+	// DefaultMaxParallelNodePools is the number of node pools upgraded at the
+	// same time when the Cluster CR does not configure it.
+	DefaultMaxParallelNodePools = 1
+
+	// maxWindowDuration bounds the duration of maintenance windows, which
+	// keeps looking for the start of a window cheap.
+	maxWindowDuration = 7 * 24 * time.Hour
+)
+
+// Policy describes when and how the upgrade of a cluster is rolled out. It is
+// configured using annotations on the Cluster CR.
+type Policy struct {
+	// Paused holds the upgrade. No upgrade step is started and the masters
+	// and node pools do not make progress while it is set.
+	Paused bool
+	// Windows are the maintenance windows upgrade steps may be started in.
+	// Upgrades may be started at any time when there are none.
+	Windows []Window
+	// NodePoolOrder holds the names of the node pools upgraded first, in the
+	// given order. Other node pools follow ordered by name.
+	NodePoolOrder []string
+	// MaxParallelNodePools is the number of node pools upgraded at the same
+	// time.
+	MaxParallelNodePools int
+}
+
+// Window is a maintenance window which opens whenever its schedule fires and
+// stays open for Duration.
+type Window struct {
+	Spec     string
+	Duration time.Duration
+
+	schedule schedule
+}
+
+// FromAnnotations returns the upgrade policy configured by the given
+// annotations of a Cluster CR.
+func FromAnnotations(annotations map[string]string) (Policy, error) {
+	paused, err := IsPaused(annotations)
+	if err != nil {
+		return Policy{}, microerror.Mask(err)
+	}
+
+	windows, err := ParseWindows(annotations[annotation.UpgradeMaintenanceWindows])
+	if err != nil {
+		return Policy{}, microerror.Mask(err)
+	}
+
+	var order []string
+	for _, name := range strings.Split(annotations[annotation.UpgradeNodePoolOrder], ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			order = append(order, name)
+		}
+	}
+
+	maxParallel := DefaultMaxParallelNodePools
+	if v, ok := annotations[annotation.UpgradeMaxParallelNodePools]; ok {
+		maxParallel, err = strconv.Atoi(strings.TrimSpace(v))
+		if err != nil || maxParallel < 1 {
+			return Policy{}, microerror.Maskf(invalidConfigError, "annotation %#q must be a positive number, got %#q", annotation.UpgradeMaxParallelNodePools, v)
+		}
+	}
+
+	p := Policy{
+		Paused:               paused,
+		Windows:              windows,
+		NodePoolOrder:        order,
+		MaxParallelNodePools: maxParallel,
+	}
+
+	return p, nil
+}
+
+// IsPaused returns true when the given annotations of a Cluster CR pause its
+// upgrade.
+func IsPaused(annotations map[string]string) (bool, error) {
+	v, ok := annotations[annotation.UpgradePaused]
+	if !ok {
+		return false, nil
+	}
+
+	paused, err := strconv.ParseBool(strings.TrimSpace(v))
+	if err != nil {
+		return false, microerror.Maskf(invalidConfigError, "annotation %#q must be a boolean, got %#q", annotation.UpgradePaused, v)
+	}
+
+	return paused, nil
+}
+
+// ParseWindows parses maintenance windows of the form
+// <schedule> <duration>[;...], e.g. "0 22 * * 1-5 4h;0 6 * * 0,6 8h". The
+// schedule is a cron expression evaluated in UTC.
+func ParseWindows(s string) ([]Window, error) {
+	var windows []Window
+	for _, spec := range strings.Split(s, ";") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+
+		i := strings.LastIndexAny(spec, " \t")
+		if i < 0 {
+			return nil, microerror.Maskf(invalidConfigError, "maintenance window %#q must have the form <schedule> <duration>", spec)
+		}
+
+		d, err := time.ParseDuration(spec[i+1:])
+		if err != nil {
+			return nil, microerror.Maskf(invalidConfigError, "invalid duration in maintenance window %#q", spec)
+		}
+		if d < time.Minute || d > maxWindowDuration {
+			return nil, microerror.Maskf(invalidConfigError, "duration of maintenance window %#q must be between 1m and %s", spec, maxWindowDuration)
+		}
+
+		sched, err := parseSchedule(spec[:i])
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		windows = append(windows, Window{Spec: spec, Duration: d, schedule: sched})
+	}
+
+	return windows, nil
+}
+
+// Contains returns true when the window is open at t.
+func (w Window) Contains(t time.Time) bool {
+	t = t.UTC()
+
+	// Look for a start of the window within its duration before t.
+	for start := t.Truncate(time.Minute); t.Sub(start) < w.Duration; start = start.Add(-time.Minute) {
+		if w.schedule.matches(start) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// InMaintenanceWindow returns true when upgrade steps may be started at t.
+func (p Policy) InMaintenanceWindow(t time.Time) bool {
+	if len(p.Windows) == 0 {
+		return true
+	}
+
+	for _, w := range p.Windows {
+		if w.Contains(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WindowSpecs returns the specs of the maintenance windows, e.g. to report
+// them to users.
+func (p Policy) WindowSpecs() string {
+	var specs []string
+	for _, w := range p.Windows {
+		specs = append(specs, w.Spec)
+	}
+
+	return strings.Join(specs, "; ")
+}
+
+// Order sorts the given node pool names in the order they are upgraded.
+func (p Policy) Order(names []string) []string {
+	rank := map[string]int{}
+	for i, name := range p.NodePoolOrder {
+		if _, ok := rank[name]; !ok {
+			rank[name] = i
+		}
+	}
+
+	ordered := append([]string{}, names...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		ri, oki := rank[ordered[i]]
+		rj, okj := rank[ordered[j]]
+
+		switch {
+		case oki && okj:
+			return ri < rj
+		case oki != okj:
+			return oki
+		default:
+			return ordered[i] < ordered[j]
+		}
+	})
+
+	return ordered
+}
@@ -0,0 +1,218 @@
+package upgradepolicy
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+)
+
+func Test_FromAnnotations(t *testing.T) {
+	testCases := []struct {
+		name                string
+		annotations         map[string]string
+		expectedPaused      bool
+		expectedWindows     int
+		expectedOrder       []string
+		expectedMaxParallel int
+		errorMatcher        func(error) bool
+	}{
+		{
+			name:                "case 0: no annotations",
+			expectedMaxParallel: 1,
+		},
+		{
+			name: "case 1: full policy",
+			annotations: map[string]string{
+				annotation.UpgradePaused:               "true",
+				annotation.UpgradeMaintenanceWindows:   "0 22 * * 1-5 4h; 0 6 * * 0,6 8h",
+				annotation.UpgradeNodePoolOrder:        "np2, np1",
+				annotation.UpgradeMaxParallelNodePools: "3",
+			},
+			expectedPaused:      true,
+			expectedWindows:     2,
+			expectedOrder:       []string{"np2", "np1"},
+			expectedMaxParallel: 3,
+		},
+		{
+			name: "case 2: invalid pause annotation",
+			annotations: map[string]string{
+				annotation.UpgradePaused: "please",
+			},
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name: "case 3: window without duration",
+			annotations: map[string]string{
+				annotation.UpgradeMaintenanceWindows: "0 22 * * 1-5",
+			},
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name: "case 4: window with invalid hour",
+			annotations: map[string]string{
+				annotation.UpgradeMaintenanceWindows: "0 24 * * * 1h",
+			},
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name: "case 5: window longer than a week",
+			annotations: map[string]string{
+				annotation.UpgradeMaintenanceWindows: "0 0 * * 0 200h",
+			},
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name: "case 6: zero parallel node pools",
+			annotations: map[string]string{
+				annotation.UpgradeMaxParallelNodePools: "0",
+			},
+			errorMatcher: IsInvalidConfig,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			p, err := FromAnnotations(tc.annotations)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if tc.errorMatcher != nil {
+				return
+			}
+
+			if p.Paused != tc.expectedPaused {
+				t.Fatalf("paused == %t, want %t", p.Paused, tc.expectedPaused)
+			}
+			if len(p.Windows) != tc.expectedWindows {
+				t.Fatalf("windows == %d, want %d", len(p.Windows), tc.expectedWindows)
+			}
+			if !cmp.Equal(p.NodePoolOrder, tc.expectedOrder) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedOrder, p.NodePoolOrder))
+			}
+			if p.MaxParallelNodePools != tc.expectedMaxParallel {
+				t.Fatalf("max parallel node pools == %d, want %d", p.MaxParallelNodePools, tc.expectedMaxParallel)
+			}
+		})
+	}
+}
+
+func Test_Policy_InMaintenanceWindow(t *testing.T) {
+	// 2020-10-01 is a Thursday.
+	testCases := []struct {
+		name             string
+		windows          string
+		now              time.Time
+		expectedInWindow bool
+	}{
+		{
+			name:             "case 0: no windows",
+			now:              time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC),
+			expectedInWindow: true,
+		},
+		{
+			name:             "case 1: window opens at now",
+			windows:          "0 22 * * 1-5 4h",
+			now:              time.Date(2020, 10, 1, 22, 0, 0, 0, time.UTC),
+			expectedInWindow: true,
+		},
+		{
+			name:             "case 2: window started the day before",
+			windows:          "0 22 * * 1-5 4h",
+			now:              time.Date(2020, 10, 2, 1, 59, 0, 0, time.UTC),
+			expectedInWindow: true,
+		},
+		{
+			name:             "case 3: window closed",
+			windows:          "0 22 * * 1-5 4h",
+			now:              time.Date(2020, 10, 2, 2, 0, 0, 0, time.UTC),
+			expectedInWindow: false,
+		},
+		{
+			name:             "case 4: window on other days of the week",
+			windows:          "0 22 * * 0,6 4h",
+			now:              time.Date(2020, 10, 1, 23, 0, 0, 0, time.UTC),
+			expectedInWindow: false,
+		},
+		{
+			name:             "case 5: second window is open",
+			windows:          "0 22 * * 0,6 4h;*/30 9-17 1 * * 10m",
+			now:              time.Date(2020, 10, 1, 17, 35, 0, 0, time.UTC),
+			expectedInWindow: true,
+		},
+		{
+			name:             "case 6: now in another time zone",
+			windows:          "0 22 * * 1-5 1h",
+			now:              time.Date(2020, 10, 2, 0, 30, 0, 0, time.FixedZone("CEST", 2*60*60)),
+			expectedInWindow: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			windows, err := ParseWindows(tc.windows)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			inWindow := Policy{Windows: windows}.InMaintenanceWindow(tc.now)
+			if inWindow != tc.expectedInWindow {
+				t.Fatalf("in window == %t, want %t", inWindow, tc.expectedInWindow)
+			}
+		})
+	}
+}
+
+func Test_Policy_Order(t *testing.T) {
+	testCases := []struct {
+		name          string
+		order         []string
+		names         []string
+		expectedNames []string
+	}{
+		{
+			name:          "case 0: no order",
+			names:         []string{"c", "a", "b"},
+			expectedNames: []string{"a", "b", "c"},
+		},
+		{
+			name:          "case 1: listed node pools first",
+			order:         []string{"c", "x"},
+			names:         []string{"a", "b", "c"},
+			expectedNames: []string{"c", "a", "b"},
+		},
+		{
+			name:          "case 2: all node pools listed",
+			order:         []string{"b", "c", "a"},
+			names:         []string{"a", "b", "c"},
+			expectedNames: []string{"b", "c", "a"},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			names := Policy{NodePoolOrder: tc.order}.Order(tc.names)
+			if !cmp.Equal(names, tc.expectedNames) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedNames, names))
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package upgradepolicy
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/giantswarm/microerror"
+)
+
+// schedule is a parsed cron expression with the five fields minute, hour, day
+// of month, month and day of week. Each field holds the values it matches.
+type schedule struct {
+	minutes     map[int]bool
+	hours       map[int]bool
+	daysOfMonth map[int]bool
+	months      map[int]bool
+	daysOfWeek  map[int]bool
+
+	// anyDayOfMonth and anyDayOfWeek are set for fields given as "*". Like
+	// in cron a day matches either field when both are restricted.
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+}
+
+type scheduleField struct {
+	name string
+	min  int
+	max  int
+}
+
+var scheduleFields = []scheduleField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	// Sunday is 0 and 7 like in cron.
+	{name: "day of week", min: 0, max: 7},
+}
+
+// parseSchedule parses a cron expression like "0 22 * * 1-5". Fields support
+// "*", single values, ranges like "1-5", lists like "1,3,5" and steps like
+// "*/15" or "0-30/10".
+func parseSchedule(spec string) (schedule, error) {
+	parts := strings.Fields(spec)
+	if len(parts) != len(scheduleFields) {
+		return schedule{}, microerror.Maskf(invalidConfigError, "schedule %#q must have %d fields, got %d", spec, len(scheduleFields), len(parts))
+	}
+
+	var values []map[int]bool
+	for i, f := range scheduleFields {
+		v, err := parseScheduleField(parts[i], f)
+		if err != nil {
+			return schedule{}, microerror.Mask(err)
+		}
+		values = append(values, v)
+	}
+
+	// Sunday can be given as 7.
+	if values[4][7] {
+		values[4][0] = true
+	}
+
+	s := schedule{
+		minutes:     values[0],
+		hours:       values[1],
+		daysOfMonth: values[2],
+		months:      values[3],
+		daysOfWeek:  values[4],
+
+		anyDayOfMonth: parts[2] == "*",
+		anyDayOfWeek:  parts[4] == "*",
+	}
+
+	return s, nil
+}
+
+func parseScheduleField(s string, f scheduleField) (map[int]bool, error) {
+	values := map[int]bool{}
+
+	for _, item := range strings.Split(s, ",") {
+		rng, step := item, 1
+		if i := strings.Index(item, "/"); i >= 0 {
+			n, err := strconv.Atoi(item[i+1:])
+			if err != nil || n < 1 {
+				return nil, microerror.Maskf(invalidConfigError, "invalid step in %s %#q", f.name, item)
+			}
+			rng, step = item[:i], n
+		}
+
+		from, to := f.min, f.max
+		if rng != "*" {
+			bounds := strings.SplitN(rng, "-", 2)
+
+			var err error
+			from, err = parseScheduleValue(bounds[0], f)
+			if err != nil {
+				return nil, microerror.Mask(err)
+			}
+			to = from
+			if len(bounds) == 2 {
+				to, err = parseScheduleValue(bounds[1], f)
+				if err != nil {
+					return nil, microerror.Mask(err)
+				}
+			}
+			if to < from {
+				return nil, microerror.Maskf(invalidConfigError, "invalid range in %s %#q", f.name, item)
+			}
+		}
+
+		for v := from; v <= to; v += step {
+			values[v] = true
+		}
+	}
+
+	return values, nil
+}
+
+func parseScheduleValue(s string, f scheduleField) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, microerror.Maskf(invalidConfigError, "invalid %s %#q", f.name, s)
+	}
+	if v < f.min || v > f.max {
+		return 0, microerror.Maskf(invalidConfigError, "%s must be between %d and %d, got %d", f.name, f.min, f.max, v)
+	}
+
+	return v, nil
+}
+
+// matches returns true when the schedule fires at the minute of t.
+func (s schedule) matches(t time.Time) bool {
+	if !s.minutes[t.Minute()] || !s.hours[t.Hour()] || !s.months[int(t.Month())] {
+		return false
+	}
+
+	dayOfMonth := s.daysOfMonth[t.Day()]
+	dayOfWeek := s.daysOfWeek[int(t.Weekday())]
+
+	switch {
+	case s.anyDayOfMonth && s.anyDayOfWeek:
+		return true
+	case s.anyDayOfMonth:
+		return dayOfWeek
+	case s.anyDayOfWeek:
+		return dayOfMonth
+	default:
+		return dayOfMonth || dayOfWeek
+	}
+}
@@ -9,4 +9,4 @@ import (
 	"github.com/markbates/pkger/pkging/mem"
 )
 
-var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffecbdd972e248f638fc2a1dc4efee73154298eac211ff0b6383803272b3494813131dda2c09524b6b01c4c4bcfb1799da77c9c6ae9a6e5fb80a4927f79327cf9effe9a8fa8b6177eefed391554771f9af82a1756595d31dfbc4595a97bbb896f4c530258b730cab7b1c40d047d5eadc75fe6ffabc18774f8675b04d4e9032a09d9bce4c330dcbf9837394ce5de3ea6f3a24a7499dbb8ec6a97ae7a6f368089dbb4ee7a6b3e12c597260bbc4f31ff79b699757f57c9b2bc3709af46dc13982d2b9fb57e76be7df379db5c301a973e758ae143cac24ce36f4ce5dc7864fbf899229e9a2a40bdedd6f0d47d27d019cdcb9e910c64405920ddb8243fa2a1b9d9b8e79902511fefc77384d08403074473a3b9d9bce8b06ff6dd9545370f320774dcbd84b429b466cc93a4a56cb02aa209596d054c13224cb32ca2b452007d5e90a86a671ba580f58d34b04070c59ae80394a96ad1a3aefea22c8f45ee3ac03cf39920de7305b45e623fc57d5e5ae26696938db7ce9f5bb47d54415689ca3742d7f6c8eaa4910251e2513e103efbea8105d78cf91ecce4d473034d3926c1b2eb823255fc817d544cfbac3a9ba647515894bbf00aaed042f7c14132ccf748ce84797f39bf01f04d55450f7826731f951b4b9f84112d28f223e18f486b9175d5577244be74057124f9c25da59300054d35185f88da27189a7a8389c2ad75141c127dbe51db462c1074d1cc40fc114874fc26de22139005be17aa9277cf02df53ce8e189e74c930e48ccd379800dd34f5df3a09e3b371d9173389eb3a5aefd17e88a96eaa3aca40b86a8ea72e26797b3f55ef21996fa769b7aa3ea9ce525df08f631f9281b7cf25191cec9c73da473896713a16bf478d6e04ca35d0a47269dcd2307fb1a909c1c9dba8764202006a66a4a40d5a52fb2d10d7f9743dbe2e1cb8b6141e08072d870db9bae2375355973ba38d61b7ec17eff82f5c2f76faa0cc7be60dfbef4b1d75526ea76d4abef5fb001ec95a8dbed2ad125079e5189e1f57ab0a2e07dbbca2cc9365c0bfe4acc16ea57f4a55d85b663589c9c9afc5b585df0be5165012dad80f52bfbc203838705b80bfc55042f1b5f38d7312cc976bae18fa6705d4ee4407360f8a91d347c545a161180dab884c81523682130a2eacd408f1c5045ce2959a26491a243330fe5589ce053b004d882b31dc9d2545db4bbb6a41d25ab7beca74178c9b0f4dfbba6641decee5f2ea73b6af6ece501a7cb41f9f41741d20f1c70d42ecf0907e3e525fbd536394bea9ecf0a672bdd239ef96c589261c341045d2b6a41e48e92202b08ca944e5df84f0642d50485d3ed83d7759d17dec89cf9a2211c24ab2baab663a9bc0b27bc2baab2643bb6e4d4835ad28b64497a9699928e9c6e2a6744c3bf9888a54d7d7f312cadef4882f2c5b0edeefee47c9133a8f162eb86a3be78d18f0c572439b6a43b96d7f5ffcb952f0088cfe3c4c91b9cd84d4b8a12ef5670b4a62a9d1d498794c5ee1e71c4d072ba6e3805985c5bce546dc80d1f5511e2658f03a6cf01b4a843b0ca39d3b202d160db95041c2fb52c624b96ca01f5d26a72faaf9cd47e3ca99c69025540a55bcf6ba21ab8a95df32d35c02dfe96f2d299d34cf0a62a54fdc5e26cc77205c78d3b83bfa226cdd055c7b0206ff686febc1ae11375581290200ffb862a6c49702dd5f1de52876318e0f5b3210055d221110e5915a9f1562eada06b0b8aa4950bbe8deb713c5312afb08fcaeb7ed3e62aaff60d3baebcd2b76dc3f27aafb237cbab7ff3862daffa8dbbb8bce2b76dedf27adfb8dfcb2b7e231130745175d09b02debfb674f3d3bfffead3df2fa9b66ca915b7d06fc32de4b47dfedab42e00b93f51940423d4ccbe93ce1382d76a24ab8bd5e14683c25dc5b01d01b8501eba5a455d47d2b956535f509f66ab6fabc0913413e4a4d4d7d6d275ad57f7e78df31bceaac00155c88a3bad6bf1a5b9ebd4d21539496bb5290b2b931ca1828c34abe3e0f292a54b4e56a7f4969a203dba626daa2e5b927dcdfec19f202baebfa946db565e5b5b8d885c5d58d56d8703a02d8d2fada32bbb396ddc5b6aeaaa26a75db9ba62adea2bab7d1bc92da8ea0a3baabad6b7ecaefa9a0bf49f57af3e62773faa9daea18ac26b1bb32459b51d2bab506b5cded78abdb6f4dbf033981b55d6d5b75088a09a7644aea9daa9b8207c1b73f32d4b4361189953dbb17988a55724e160bb6d68962f0948a2a43b6a951c505810b2498627b52216b09cc2e92290acb6f435595437c457378bca766d810392ed589c23c96d30bca026a71dc799ac219071db9696802959adc7ef97ea1eb5560c092aea386681f6bcae588de4575ca62daf0a0be986a54179b1ed44b6774081a57c92d29ec37f95f30ae49575d134d45694b4bd90193188060092d04e0c8ecbea8e05cb5b01117cd51455d414ed9be4cb5791da5736f4a2b661775fd3886bca1627be6ee59ab4f25e63b05d5e6f2596b4abf835a27d690b579b035451d104bfa83aa24757c1fd742b3c3078837f85df5c83ba05cebc08ad0e96a615fb8b5aa53a7c75d5a177996bb5396b1ad62e4a90c7d1da69155bd77d7dec8e1bd16df8ae4a3dfcdaaa251d19d755433f486d58a8c6d5fbc7de3ba0a3869c41deafe2775c4f9dd324e44f7cfdaa43172dd9325cf3fad5bf8527a9acf868b611d19a57fa8eab783475c1d0754968295f36acfe645807c9d254d96a2bc0beae81d869a6ad79a2ac3d8d13949ca7e65b6a4a9fd2c1cbabb28e419da6615ce31c4ad456d875f8e1bdba9f38b60d57f4973eef1179b556a018fd8eb316567fcded5cd48e6d72ad14bb0575c3496e6dc32ca827b16e6fade80a125c96a30f9ec37896b71ef025d51b275db24a7c16afd444a0bd29f42fbe5213571107e39f71e4c51baa43569faae09546b504dce35bab894e9ea3661fdcebd565236deadbea7b335b7cb523a5885e25de5d6b2bd63473cd2d59d3d455f64dd969623b9ce3be71aa6cc971724eea6d2b094fb437f625aae635d69682fa5c5d9138e0281e9cac2b56152d82efc9cf39d25b5baa33c7163af3a7be8bb065517290ce14a987c387d242826439b11f167c28078d38bc2fe1d833be6282a19986ad5670354dea40f36d024e972c8913cba95683ba2c89ab44ecfa2ad2be906fed50c8fed96fadc892900bec9bebb15d4de32aacaaf535f8c4ed15931c85d0b52af5c241746edde1e077db62b54e8925e5aa0edbc4dca5a7b2b4843f5375266ce96c1a9623597e74709de1a4d2de79f86e07ce88c75b7f3cdf6d4bb29d1a2e3e516c1016ab9120ca8b3855fe3385c52cf1158dbd7258756c362a13c93ddd630ff3f1a9ee482b2957854f25456a054ce4bc6508875c005802c68f480f749f9112b4eb9f7397b6c5ea262d5d2c3c16db3516966ad6d69563ed03c03ab7c412f86a0fdeaa42dd1a0cae2cdb24414075d9aea4737c363af15555d438f135a908a8b623bd6eeea32a8cb775c18f796f58be11a2c202cdb0a3e96ad6f6b126274402268884d524876b049ef85d49e4115c616c75022864a4e198c258c2b4b2413a3b5da4331654a0220db1c0e9820424b14e01d1b46edf52f1865a534e620dfc1e9b5751b9ce2daa39e56369cb2abacd4cd4eb4a356061eacafbcb237082221d24afd5b294d616d9d2ed83643ad7a9f30ac85951f71b90b3b456d7143947e200304e6fad324e63820cc3af469738e789f426b449d453e96e5a504b38d7af29d3152cb79cc1a82a78b238d34c74fc4d95689263a982fda691847559926379b535a5fcd7423d4433a7b66c5687c4a78a8c45b2f105f61afe95f8b2c500794620fee6589c6e4389ab1e02b92ce6c08021bf68a88d7c6e2bf4151dacd9e11b5f6c87130e5df46ff61befbebc70c0e8be80bc474ef451917246514336baa6653810c6ff5105601b967390bcecb962a07414c85e8f686e17585915b80f52dd5206c4f1ccdc415808d3e5f4ec6e2d8613dd42437431b0a36a92ed705a6ef90c19a0441f82667685dacf89440e6a4e83570e0c4fe3dce0cba15dbdc8845f5ee0c80137870a01f48b7bc98a5cc94f5d9481ab686bfb50ae9b73af421f5080baac1bb6a30add6753d2efff98e5e4cf3ca46068a69ae725f28071f86616d45201e0ba9a7b4e7f50385b5105c332030cf892c3da2288aead6a26902a601501547c427f5c96f7cd83989c6567475d0065a125ad03b3054ed71b80399660e8c73a30c73864c5ac34d0de36f4060340600dba86e00a1a553591b354a3ab495636170bca02a33a81a63dfb55e3645530744eb5e0ae3725cb51b394a65576bb3c5dcd42441b4fe3ccea968254794d60ba92c6675334344dbc5702673ba291eb9fe3e89088a8926919672fffd5312dc315833df2674847ff4cc4539b7cde6d5f531d41910040998414439344353bcd11049cb550119f813144c9d2bfc806e4e404d7b2725ac018c292d0e998a139866eab5dd9d024992bfd80b207714ef9f7d8f1c8b6213a65497109aca70b6d0a181627004983025916250be1a18cadeab263bb669e5d499608aab4eb21ba415d90ac72a6d2e55593b31cd59164f8d8bebc246625fc26a5f266b626a5f238982c55c06e18a6a4477ebd282f969fa72a0d657290733e68689320fe27f35d0240725409d2a12f8ea165fa506610312d43931c4572ed203dc09f31a3127c685da04454685212fecc33b3f99270bb811cc54dc2199a66e85de96ce698df3c5484cabceaf0ae70909caf8625774fa7ae6c70ae63e8925c5705ea5029906919c24bf944fa9f138c5673d03cb6f9c94eb917c9324a3f94a54815722c43f0dee02daee8c3fec40147b24e12e72892a5717a119099d72ca633b2263fb8bc61ab8ed1950d47f299042364648c2eefaa40f47f8a86e0ff8839002379cc1bf1598eb00cade839cc90c603e3f4a2fa6192059f058513140ec7ca3ebbd6510ad3a91601c439598bbee6d3a716419907c146894fcb3f26941b4229a401bc5e1f1b947cb64be7c0b695c4c6402fff34f983f852011fb82a802c882e39b1becc39873b3c0ba2385a6151f8becb397e8abfc28f8202b1c029feee98e81fd9f57d0f8a0072b3177de82a662008673faba2ce15bd375d1ea882edbebca0ccb3a9ef909c28b9c6fcb729b299fc0c0f7048bc2357f5f4c72435d06dee4552242ed07365015d3ddf25b866f97767a79b488e5bfe11cdbcc6e5ba9506826ba4eaa254d07812ac6c028aa1ba2a8ae47d51f3634d17d87326a74b766ea3a5a10e862571397a91864152196c5144be5275553a16e79bde39d008ded55521e0397240d1a8e10737b058d44321c99613723b230ded70c5ab9c38665ebe57ae4e65af2c5797725b0f7d41e9a9a42eaf8aaae5e6492182416a2ec81a177e0de60c555109a017d5a06a52d70a8cd8c90fe798572a789d4654cd105d20d95fcfde054984282d6860f4460a84af893a22fd4f9cfe19be0915dc4de14faa2fa294c327327bda42c003358436cc80503601778234df4da0a33188d2cb3198bd16a534c9b639590ae97c8b92217eb72952bb2271991857ea815f540988762027358207125c9416e050c6680a1e2a001bc0ca92ae365d6948271b8242a9bbf9749816276b5c43e0e698623b8d1730614f2f878e34cfe520819ec07f113cb42a11673ba928e2ea88c6a117ea4b1049d714be76117d1df641374e7a97d33d936f0e1e6ac9db948994e54121f3207f55a1f8f6f2f588a5dfa85f8fbde41b4bb21d2ff3cee334f015d16985436c304a572d58423f7cd77d412248c097224e33e1f61473c79e2371404ebe124c37f9289d2581e3ede4ab17cd09f03ede61866518993731ba25cef68b6749b27436936f75c9712c7f8163558e1d7a20c51bc80020f56c192f7ee6efc4850fe95a02bc036a7ad4b6aacb407a01aaaca4fa6c7bb6c0017fccbe0897fb14f0a12915926f0b2b6365d1ad1caad1558d40e23d7cb7bfaa869f4248d454dbf693b5f292837251167d0eb64c98d7b519545185a669e7cbfb2fcbc1e1173cf3c5155527d35e944d3305e72890d5154aba9efd5cd409d7510c2b4832595843f26b7105065c3c3fe767c537bcae305e3013bccf33f58adf16d4187cc28ba64b902c28160848f15c585a300c4b54f5e2c9c87c2c2c8eb2d6a65f8aaa2dc0ddea952c62f27b419dd251d29d92ee267386167d7f01c629703128693c70fd2f5abcd4a782ca512c4249adfea78242a60154a164a416cf09b95e042f8b5a093e15d4640b8a24ba852899fa54546b0aa0a86e3f64c52e2b1e5cd5916b377a5f5d2adf62bcc85f38530d3cfd12a9ddd594e9f155e5b2bd6d57b445970377e6387b70f4ebade5e34cdf6fab264cf4fd9ad9695d6d7ee682602bcb0ba71bfe058694c680dd17f7e2675da98297fe7239a03a5e1d5828c45401052e98552009879c0a301b55d568000168c818c4fc50eb3245b85356f458b35e1118645442a3a1d8b44cea12982625ea304830f4fa898981ba7fb992e5999cc569554beecbaa150028e7591540204fd443f86b53855c1160902ebb126ff2c0e1b55b2d4a843248bb52962418b2debe7fb663499c56bdff0b8a052b5a5dcef759acc68ed03259f61df2ddddd0f0580d040ca431af040afc942a616a6912827ab138ad72b21194aa3bb6530b5583240846932c590aeffea984d4ebd6d387ca9c8a0520818ead1aa676ab21305b726a6734c814a90a8d4619b86255c234227819489ffcd4c19f38b576faa0a05f01534f394f15f3e028aa25fe697296e3755f0ceb20853e2a55c8545e28d604c5e512bc47c841a2cc483190cf817c918d58cc28fce84194148a3ea5a2022abee60ed142a0800faa95bc5f5138731456d59095d3eb615b579e9165ab0a3410f62b8b97ea00da946a31c0528d418b42ad9a2bd42f342d92553bb42ad76c05f34a8a06c0cdbb55a6d2a82852ade9a82a58a600695ea64d63197549056c9516a561b1e61d2bd3b95415a990eb2a8a556b682a0a96286e9a9668dec312354f7d89e64d9429852a8ae47445f5b02d4650ac59aa2850a2706a5aa245d7aad45355c5cab55655a50a94598dc05fd546e968a0ac2b72490d43fc2d1288fd57e973ad7581caaed7952ced7f212f99f80c5c59d5931a2474f78174960a19b2e0b6ddc2f7598634fd5543f151455f731aa6c427c3007678a944c9d78cf497fb8c9e054d6c00d2f52fc76802d645d97a0ae7a208ba18897d4880cc497961b81a2ed269a5e5da6ca120b6ad1cc0e4e49265419fa3ccb595dfbb69fb571e284e389507498494e53efaa2bb54f5d1d0752bbe26a2182871d295c2c47ef8c5df0f9257364a5fe633acc35faee4260679f0cd878927f48f9579774c707a90447d314c4947068b50560c2df701107c677779f7e525b974fe5b55772439ff1a85fce55f87c659bfa3b033b667fb0f1a87761dfcafcbab72fc1389eae877a001d07c091ffed7d55ce020bff8f0c55faee148220ad609d225f8aa85d09731f11332730755daa39be5535e92c1564bbf0bba9e7a19ac50f42ee11315bd334dcb78095e38d2d90927173efb19ac511c88618754d0b0bbb62afbb51876d7f53d7b4d7f7ee07fc8c125788e45e5c81aeeffe8da9eee7067f81c294542d599809cc6c3a7301d44f81c474d38862f28e7be048b957b6fa380c5c881221879f81c4ea1adcaf6d708ab51fce917ce54bf8437e07c41e9bb024286cea87ef35270e8af2be9ab7d901344498964c57833b08af61311d136e2a903cc69020ef94e4b7a09f0afac44a43b2c05683c59dd9aa6d0e4c537e854351ad2d13823531da41f16d20030e63c2a214d4b127d81310b194df19778d7540320aa897cf2f33d2c060e3988a6a049aad2a44c989fa9312c4481a2e5ad2c1365c3695ee4853b341d7732b1452bf8c4cf16432ac59c6278243337038d73a235018eb23035008eddac5a4e5641c104dfd6a602ffe46e53c2bfc7a2f9ca1465ae68502cc7e33629d374301aa7074c6c73e0e0446f38c1ad56232266cdc02dce9180aaa94ed3118499539ad6df6aa1620e22b8dfa4419948f9de0436b4c337866d496d4f12af18c6a11570acd66f57ac2d990acbc51b5eb29c5314d3fa9a1a8a313336fe7f4186c12fa2faf2d23df6a3e40749e0c01015f87dc681f0b663a93a72c4b63d5d08fe8b79d0c06db273d38905783f3482e34f5680cefe8bd83b35f5ec47c9c1b7feb91e479a84e111aef3d2fb967efe8e1e6dee05c21d255d4441ee95d1720da012016955d0e83fde73eada8ee0ba9cadf76a8093717b15708a1fe55601910fe1ab00ae1931148644dd867f4184430560369aad162e8ca6af028c83dccaa08258b7a2cfb66707dece455f4ba27c4a4193c13ea54099989f5a3814faf3ef9bce46b21dc298a840b23b77ba0b80ff6aa69986e544af16289ea773f79fce1f50d4bb2b490194cbb3dcb9e92c3855efdc39962bdd741e55ab73d7f9bfe9f3628c1416e81e9b4ca9ce4d87301686580bd8958daf9a2122782aa083779dded7dea0f3dffffef7a6f3e28fe93f4dfb7af7da1bc9ba1aa7ea5f9165fbee3f1d557f31e0ffa2e4702a401dd0394deadc75623048012f52e70efffdf6fb4d478354e7ee16c7d0cf3f1139baebe018defb82e15fb0dea6d7bbbbc5ee6efb5f7bd8f7feeffdefb7ff1fd6bbc3206d51ed3f4538a72f1cb0254414617b8fd2b173f76d80e1b7379d996e74eef0e1efbf63c33e76d32181aa1f3a773db4a452e7aedfef7dff7ed3d9aa62e7ae8761d84d87803f87df07379ddd9f7f9a9c8875eeb09bce4a847562379d75a2e32370f0c7718b0dbfc1474338d89dbbef379d7b47d5604fd692d0b9eb7debe1bddfbf61b7fd9b0e69c337df6fe138f0effdffde7416d5a0e190b1ffde741e9a83eefefcd3d55d5b123b77ffc26eb01becdf0827e0490727a8011ebee17e3a7ff3b4db296f688ef4d12bba80efd1103a779dce4d67c359b2e4f8bf5786e134d97b0ba445bffb57e72ba40e6b8783dbdedfbde861257108d13bbae1fc16dc652c89bff1aef31b77e454c0f140fa4dd57f4322f06fa16c1b51987f75c28e7f950dd8424468fed5898353ed572491cde68df9f74de7513251c5bcfb82622550fea4ce4d47303474c36ef72598c1e8857c514df41ce8185066d3e0851ffce69f5fd18f2ee7d7181ca6aae9f352c1b398fc28da5cfc2009e9c728383efd221150279e384bb4b36000a8a68318a2f09cd6b8c453227e45170331abf4b80e3e68e2207e0874ace193709b78480ec0f60d47f1133ef8967a1ea0b8fdf039d3a49f303378380fb061faa96b1e506c4c22fa3ac29580c3899e79ce96bedda6dea8ba9f6d3d0ebd9692b585e8164793a2441091b22c9f29ae4146e38acc6fff13098ec274444551602d62bc0a02ba5e1993f53f1180f5d34c178516898cf5a191b1e1fdcc0a6f3623bc4142fc14f03e05bc4f01ef6f25e005a40a76f820b7ea6fc02e5f41dafbef4d07f9e6dc75a49321cf1e660701270d96ee29b3bd21cf340513a7a3cbb3fafd18be7fd27a0aaf4d7496ee015e5fbaccceec09dad665f0a1f3d427f71c4139cc6e715cecefcfe41a3b2dd0dff0c0ee4636df070e4b0f308aa01c81382b22b175399d3cf2ea427dba87edcff73c3ec0108c4679020e8ebc3afb367b989d9ff6f7eee2e1f634534f3f660ff7aa404c3c86ee0191a03cc19b7d9b4d6df81ed6e132bbd594d9ad80884f066b7aa93eaba3df1f54f80d7dc7a4dd08a0f1e9242668c065bd999df8eeb0bb95c212138c5927eb457f2a4b5017a63f3785e9cae4f15bf559bd57d7dba5cce38c2c120a981103457c18e961fb33823d0aeaa8cff7e716bb9b5f66b211d4351afa6d8e86fed8efe5993638b20475e2f1a1bdd1260e9b6d5f5f0d04628bda14fa2b8fa3077a301f4179381f9303b35ba1f5f3d7341ad781dd917b4103279108e775be11e9b9cbee96bae08d5c389f4f0fa33e47803df7307298dd0aaee765365d19ec7ae4f2f80acca6a3238f9f64deef5fd4f6cbd26feb651df667eef17d0a63a995c26867f041e37986639811432de8b3df87f50863e8b9cdae471adf9fc9223ef4381ce14d55ff2f2c4df6040d60d25684f59c7ed218e27eac47baa00d7bc243bb71f86bbc7ae4e89597e9832a4ec1098d09c24de726af096a0e2f75d614f4558fa1cfcfec6e8570f983e682e676734ca427f68c1800712a1e05cd86fb0ceeb1a3880f42dc0cc6b854a37d3cc57ec4ff876399e3cc6e6e225cd82d92744185f32a12d4167ddb8231a20fc0f1d81d791477f33dbb05871f0f22c9d1a4c7f7c923ab2fdd8d4661680dbc610ad705efbbfef4303a31bbb9c2d31406f7fd8f07b1603f08e60f0a53e379267b8c3600e278a0f0b43f8f344e1e798d54c407255b1f1ece4dd40f0c95d37fac4ff2bcb80f680f0bc4f0c88f435820c66b340fe9d4268bfbebedd2ef4f7f0e047cd8133412ace9a531c77a26d39f1f057ca8890f83083f9ef070bd9c5c5d8227d8b3e94811e01cec5640d017c6bc4f02a64f79dc6e3558f5e747713742fb60ee1dcc1da233d11ac66be2af211fe06b92c62b02016838668e1eba682df7b3d362233b8bc7a5b3781cc7739ede0365eb9b9febfee228f42995d7284c489f1f888e06f893c43738c6e4faeaa9b57fb8577962b86776f1b9929c6b7f4eee8d1feb81cde3a482f608489717a6f3a340509e4800903e13fdef0cbd3a081a75117a238fa559537a4063e6d367a9ac87e35ae2e020a8029cffc4f8d039a58863511109aacfec0e99f3d56f8ba3437cc9ac339e2c0be6ec8310acef7d742e26c684e633c4a92d4eedc5dddc14a760ead3c1dc1821bcc906e312b589c7d11395272888fb55b88dd6223986987e16d0873c5f50d56e6adf376a0ba73c51037b764bb9ec7474e4e80186d673926933d707444380a0b32683fbf4634383bda00d2f3cce624f80c4f8fe5c61f1ad9a5eafec9cdf676873a65f057b65db9fd8316f5338aef6fd3d4cbe89bb3958e2c30b4f0f2fabf1eceafd5ee3d480d2263d7ebabcfe9c44eb38ef25cfe8e05cb233ed65f790cf83ece63847933e3d39a468ed44d428571c2b3d46bd7add2349a73c763bd98b04c0d9edca14346a2f12434f5a5fbdad47c8f3333470d9dd7ccdd2a2097987776867c7d279fc493eef9679de1cfebff3f15885fb5898525846de08f9abf92a4b8feaf8219d55f829050a798d6921ed0f78f47b3dbd4f22dee8286ac013a794c28fa3f3f7f2149e3bd345114f50c94764cf065e43b2dc91c7cf99f339e6b51e74ec0752c1a18cea91801b4bae48fa7dbb51d3bfba30947245c90486a749fa35ec9adf700c1fb6326cf67abf63bff77eefbdceb2391c5ecdb21974bdc4b4d9c31b183707bddfb16fdf7ab70d8c9be1b01b183713a0ef69dcacc58aabdb379bb418607ee2cda791f3d3c8f969e4fc34727e1a393f8d9c9f46ce4f23e7a791f3d3c8f969e46c6ee46c23fd55da39556ebac284e9e2db9337bc30b802787aecf2f4c4656811f034e58a0f0305e943d6833d8f63912d54f0be7b8bc771ef69737f467f48b77e3e4ac836badab274efc413138c5d0ffe12f0a13b0bf5c41a79e4f5158449eaa8d5c5faf6e4db3903383da53b559ff548e7a0f2c4505de24357242626af515e688f29b4ed34b073d6da620ea8cf0a470f80a0fab6c2c0b68971c456e668d1e57cddb55b6de3246d714762ec6e8ef425efd65f40b9dc6ed263d7a3f9ea617464513fc16546883d7647623322ecc7acaebf70dc1e8f9f973c3171590ae9548000425d3e18cff666917db9810dcc4cebb0b4151070d2e376232cc489b46d39eccb491688b3c26b5b79de0ffaa30a72a8b77f522bed81538e9eb8e284ec33bbb94311135df04ad6411baa9c46edc587d43a1c589a5544fa8c51dac416e96d022fef233b84a88103bb9ba9cfea9c787e1c8d178f93c7c503d65f6e278f4f9b25463ede3bcf1b852055acb77864cee4667c7ede30de725b68c30bdb57397a608a0450f8c9c8e3fbac897c047c5c69834bf931a87396dd2dd2f6189ac2187aa588c43837c6bc1fc07cc71114c6119417d824533e0f33887bd3649fa9430087b3bbf96046b0a6a093d80cd6476fab71520ff5f8d488c1c9a3480fb077dfffb1ed40666872cff7215d7c031da8b029bfdf18c2364f321fd9f6c4cb8c40facec43ecbd1884a9c2cd0b906b6f6f7a30b14ea674fe1e85b798981f1561d21fb21e78d3c76876c2a800dfd0408c514bc115e604baf5b23974df8d26c03fa526dfbaff023d0488527c09eef85b49334d9e92cbdb71ad5d35384fe2a41835f598f9ea5e5afad873df16fad43538e427ff5c84fa98b48509e6f7baddd0b79fad8a7547687ec1140c026cb35e5dbd5596a7414f46559bf4c5e5f16f469e50a3d13d2aa4b192ef3f8fc2f9626b137e3f278f5bcf546df787c0004cfc7dbd458bc9122100799a1078719015cb63f07427f5173760357f06dc660db5f1d056da2b3dbc99ec1873dbe7c2e541e1fdae9f1ac008b6c842b9aa1cf3db40604abf0531294ace352a4e7364793f3ed7451e67fa632bbb9c7ec0ee5674ed6ae9e9f3b7976389b423fc1234c473d463b9b8c37826d57f20ebe2d6721ff20e6479190659e9e5c7c3bcf48e1b565c2bfe8d0ceaf26e6531dc6e7f596824669dc4e86f34b32014dcdefc3845f0208f99a0adf0f10b493a443dae4c4512c1074323897679047c79f36f703c8a3a7d735f4b349f87a5143dfc76a4abe08388589bbfb625f91b46d719de897efc3470c074876982e2a7d3432671690a6887e239960b543f641dfd7e330f4cfb1941c10db3d197c18fab098bc3608e62db673667c2a1ce4cb07651f7ab017340af1280c7d523370582cc364fb7a2fcfd455282fc13934c4e9ea245c8c63e407ba1e380c3d807c0aaaff49f3edf94f90a75e87b2d6e4f274999d169badb3781cc3bff4fc4d5680df8d6c78063d69e685c76ff7a935f4fb59ec37ba1f239fd1a7fdbd9ab5e597cb56c9fd56e1c384686b02cf8acad7f22fe9bd9da331c8674970168f4cd66729b596e53c5035dd5d52a3f96c1a8d41167724e4195469edf3726536e68877eae57cb62eb384ddfa29eb9790a2d389bf9c6d3f58d3e02c44be67cde7370797f343817f07d164e801b6ed8b8aa0f5b60c2d5e726755fe6c38329a0998feb26c2dea64a324ec49d0863847afe05efd363b30b78bf1f2b4d82e4e4f1b71b4dc2e1cf271892d1eb0c1f398c19f36db13b91ff7169bf180dccf47ab8737cdadc96b00e3e889bdf56535446f104f5a3f07110fd3780ef2b2d555f097c21500cfb650f648d18d8711e479fd39de41fc1e40dc4670a24679d27aa471bbf94584efe8de5b71b5c087a2800fbc06de36e1a1afd24e031efb1aed34e1c1af329e5afe3ab99e79594dcec2e5fdc35af3df45e3d2802b16ecedca35d2c96766b79a52bd80f7c3c0b2cd3e6e88e306df27b1ed945221feada9d125efc39d9fbf90c72d68a3c6bf0bcad5b11f65f17822fe32773e47322a70206fb067764b23ebcbc4e0e71e9abf0318cfbd833d7b109c4dc003e77d4d0bfc606bfae0fb4ccf7941a32e7c9ff2189c9ab30f72a9ef56d5b93af74e72c6afb466ec19dff2e4bc067c7d6e8c91dff99c67f0a1cbe013ec47d64f1b4fea6f05f3e9e15e7f8a75a2997edf677d9c1bf401f9de5fd1cf9c55040d047ef9b25e33f68c3f7c9aeeb253d614f0a1b725869e086594fdf2fcbc29e4055476b7dab39360cf4319e4220c8aeb757a8c46d9ec6eb5e4fb730cd90136f71eb929e059a17c0865b62d752bc03e403c8dfa323e2d2ef7a78a363622e4e3b73d00f73dd32793ed5d207f59e7ff3ad3e33581b20b9ddbfb453e99299a53e65f5feee33d2de51b423f7b95eea7d7982fdbc3c57ef7c93ab3710345feeb4d6582aa76621f7ea4974cfa7efa75fd7838b8713cc0a01827e13ecaebef3373efeb9c5982f2fcf1ac8ed46e746277b31c3d5ce140e1f5d546c4271e4fad14b6bfd07fac07093e1588f973b0ecbc08db1e01415f9969bd406e3e12f2f7bc17d07bbeb0ce87123ff2d41e09687f2c53cd796d7e14e9c161ab53365b48c75fd3efa8ff05f6b2b0eda52ca03621ef39c166c40af01a40fa2c5e3fc81c3d50f93ee542de54d0261aab81fd935ad3bfd0c6420cf73c7e82f4e5af12fc48cd717006a17dbfd5262e8b7cee1b9585e3c3387a10c49d40bab65a33f44047f3ac1a25789e9cd310bf270716ca887d32a1137a451f0eafae4761701208fd05a44763961e14d3e54cdbdc6e0e987e64eb89f0a941d993a081a31fbf87ce8cd3f386c9f34389bf9765d95c16f29cd5b437fc8b755d917cb6e95318a30d7bbcb65a8bf419d48ca5467755d4665e665b1194c6ec285b7c1879a8cde9ca60764b99a1cf36df172f3362e24aebd151dcad3ef7c5cfdb17239e381fc5325d5562be5902782c4d46eb1be354998e2439f691c7d143c42fc2b2e4e3fd80ccc67d64f0bffc4c2839831e5e795e1c223ef757db1bd17e984d45532414930ff41f21df339b06b2f4c36da335e0fbab23830f6dd8871f4df658c00322de733257784d044dcbb20494bf811bf035afd973e17e1db1c40ae9cab7d3396035705bccaf15ea312a78b3cc1a419aaf4dd01a2d8be22781d3209ea64d9b933d83531714637498d83c31ec7ffcf9948d854bffbdf3f974117065c6f7496c19cae760758ce4f2c006fd717c5cb006beaf06d28124fc02e09edb730470d9dd421689efb2a0510a83cba1cd5d1769ea223e8c225df68c08f0518d62d6dd666b34c4789c3cf20f2dcf15ea15675206efb3fb7cb19fbdf15ccbc71c73c4f0228e239a8bfc27e65e892c9e5fb39af32fdf1e9fc1a7d258e7021ac6d0e41ed21d644341b4b94c179e9ad3208632c42be0bee59c5c14e83d93fbf07dcfc9c989db9e8f0cbd7a60e8b3c26b24100094dbb6356341787c6277738ca3cb747ae9360bfcac02de04ca4fe0c86bdbecbe033302e93b3cb8ae705f2ea9d1bc3def38c7987ea10ee6c3f619dd0f75dcb9dc0f9648cf8100cf3382bac0f16d433f07a40f2ad5fdb5d8a3d7e23dff5e7b6f71399c7ef2de43e7d186182aec78aef0c4c46570cafb08fe34986fc48fce26abc76d42369b11acc7e398bc19cfd07e8c785102b8427f8568c4ffe21e6cc30f17f287410e8396ebf5cfdd5b8576d88fda5b14c6e0ab058f4f0e4b6d6233f4601fca7f6bc89f3c7c20bf59e1af953fef60bf97320f718c8871ec7f71bf6575f9093b4a33f9a9f44c53fc9c3879792d67d7fce43b2bf6e74fd5cf94efcf4856acc3f9ebeed1480e0c632f66d3d571369d0386268d40660422411e6604e297d5885f56dbeb6644822cb6efa5fbfb7efacc6c3e2b1cad076aa7e19e69a7bbe9e76304daec978caee903f4453f5b77b3182c1eef7fa6ee06e17a96d741ba9a6de8ab5266cf4d8ee96a3262e023314b9c9de5e7e56cca9a4ce0fb2804e7c16c0c969fe768f3734dc0507eb75d7c9e96fa80548ea7a1ae35f28149e6b86c488bae62ef5847f6db463862f2fdb9294e0f901e5f9e1f0f83427b7af897f5914aeee3227fb0e0af5ab68bfd2602bbc22ecc8b17e6d0fcc0fde9fb63063e3933a2a730b862a27893fc391aefe584ce95c76f65861e0c6653b4872eff3c1b617e2f96c5a3bd275ffbc6367f81bd787ffa05f6e223439f4d061ffe8cbd78e2f1d5e7be7bc3be63f089cdd1e4f135b2ddab78d537f2c73f5f9e144e8bc23886f0ef6374a94b7c72103570e1fb7576adabeeb79c2d713b9d1f216fc311544ff04618ef41de67b2e71e468f0cbdc2b91d7914f4457b7ba1beda0b4d68e2bbd9f5733971f7d178c0fbf1a49f36c5367b71fb4becc5759f5259fa0c3e56d7aa1c85fe528ee201d4d152d08690ce1aec2ec38b8e237fed579c79bf9e5cc885f3fd8eb2e1e73e6cb90f7faa8e35b02f66e20c3ed2be88f025e9333319793cde43b17e62c6fe81f43aeb1e907c3e14fc4dce47744fc36aa79c201cbb9bbf9f8e152f8a8bfbd4afc67892d2aff6168fd91ce2e9bf2afd6a71b99158f43e5f4f917ef6d788b7a98da92a8f83d9f3f8e0c045775ecc79763781f2cc251f7706505e21863e2f1331bd26af09fe7d273d48772af0369717a2e0dca888bf5b52a3799c5ba0aa9df391c127581c3fd4ea0e8fe4dc609531e9f93c5bbc480c29613a02829af3172ac8f925984f89d8a0ec9d14111e16eb369ad37b3d9e4b640748e6ed28f86baa2f61435fe97178d6fefc180696a05c2417fa7eda664467a62b8fa1598da35f7136fd645fcf37fbbfbca20fafaf27793eac00af1f3edc2f93fca9fe2b51fcc21f51fccce4c3fc32c7615cd16c8ada0cefe95290bea3bff0f585c4b027129ffbe2e7ed8b57f34d314eb5d7af638bc743ef67ead793e7451c57f08bed8d783fc8220e308e006ea0830feeae9a25737cfee3f5ed9f76ae57ed43ec67ee43a14f1aebb43e68cbf73239603eeecc4ad9a1737a870a7b34b75bc86c9f0219bde1fffe3eddcf9af826ffda3a86ff71bbd82f10df53e8b3be2628859fae8c25ae0006772e1fa9135cfaf74ccba84f0fa338ee481d192c3db145429699a05fff737beeed6763705f5f2626abbd5eb2707f35e1757f813df34bd8afb271aa1fb947c4202774701639f0ac42f9fad3b6e4729f2b62e806ba0ba47716bccff8f1cff8f1d63af2fe4ff641f6652a2c9327f123edc9d3d1919d16c4e94cc39cc6a9bd79e4553f1710b2b54dff81ba90fc1ed8a7fde05af9f5fe13e5ba0bb9ff55fc1703bf9e0f8dc369e3bf11e7f17bcdf9f6ebc5e064f3123693715aee4723958fe3f0790666ca16d8897f853330f455fa503fe2367e8d4f223d073c41bd8ad7fcf5f6623a97e7dfc25fe37f5c97f2e94ff5e94ff5b7f6a7fa3beccf9f6a334ff81d5324c6ec5660435398a081bd50c7c3ff2c1e16e537da3a427fa588c4d6e1a3fefe6dced0c25cd59ffbf517d9afafb74d14979b14bd2fbc7be347a65ceece00be4f61c214f2938567a65a9e1b3c7b8f67720e1adf7d824777f7e4f03ad223667d0b511efd5d018f52735f46413d2deeb328cb271edfd9923d43fb619c70c13d077aa1ff60fe8e871fc9f5cddc359592010bd6424dded750721f4472fe733aa5c47d4205fd2d90bb1adf1154d96ea1ef656ebd0befbf6872af64a33e54cad3b5f3d0e6ce191ddd59068a696d613d0dfbd0e23e9aca3e94ebf36bfbd0e6ae9aaa3ef40beb69380f8defb149ede5c63139191d629e0635be07a6befd497c5f4dd33df1aafb752af121992b921c6dc720a06f54cc73d4cf49cbfbb42af76a695d0dfb12e6c4b86cb409b6ea89f15d94c5e7437a9d344a637773c06b2448e4d7b8ecf081224e86bae809e69336ec89d3514f9c2edc8abc1fae5f2f95ef7352f7953813727755bffd5e47783e612c35c4d0394c0d2fec6ed54bcba6f1d998ba970724ef3a19a6ee724ecb0535ba338dba157cfb03e41127fe9cf8bad04ddf97d50b79159ddc43d902ddf1a90197d7282f358fd9bda1f58e6c7897ab467aac7f07bdc267e5703dbe4fb1e88c9d4fc3bb1ba16ca460e2f4fee3eec54f9df543572428579ca4d6fcecdf8f7f5b20b3d4f20f9f770a7ede2998394b7fe93b0503dab5f2e3ac3eecbe51c6593c1e7ea9fb46a17c2612d43690a5e4f83eb5e43df9f785674ae199537c6e96dc619c1cd36bef4783f2d90a500494a54ae3e1548e6e775f5c665ef4a7875146e64cdc6956cf3714dfad9cf8ab932d1bf6b3fd7d5ec17d76e53177e491d75666e23e2d20ec28859f2e72775c71f400c229fcc48f7d43f17c689ff86b45d35455fe4f95d98dccd41dc379fead6adffe93ee04abdd83bb3c0ec27d721277abb2f33bc091f7d4d3c431ab4dcf3df67df52e265ba61fcad2b465057f587bdf7503dea85ab64fd1bd26b25c016eb56f17cbf35cbfbcccf671f24fa8b34bc86f57957de037c06babcb06f60938e23fe3aefb7b959bae30e1f117baebfea18d6c54acaf11629d4e64cb087422c93b31cbf880021efd5e5decefcfcf0fd879a162a745d9f91dd1ed7b755b4327e118187aa588045c87527f9802ff94fbd7f39f85f3f24a3eb4f01e675f77b2ccf216c536cc98c77f788ddc84e8c6a0906e94cd73b9bc5964cf5caed7233cc297297561e9a5cce807df07765c7a8f2f9c034524a83eb30397d934a6416f94a30af4eff5e7358fcfff6269b2cc7f3f8e279d846753e33c0dc4f3e368bc789c3c2e1eb0fe723b797cda2c31f2f1de79de2804a962bdc523732637e3f3f386f196dbe2bbb88af283d4ebaf937c75897dccd7193e07bc78acf3d6170efcf6448bc5be747aa4cb8defde7e88cf9f277aa0880f3d5df47aa690d53314f006091908d1abf6770ddf97cb23c5fbb9e99dc072c1f97f8a74ace3c8dfb80defef46f39de547fbd9796d9c7b44be860c51ee4791d1353d7c70fe11dce9959f45491e089d290aafad14412bd94b0d739c54cd4562fdedc4fc92ec6e65f0f832ea8748808233bdb09e108fd2b877004b71df9c2634ce1994e32fef8beebe2e38a72af0b1c15e44f4069f60ec4e54a47add407e7f047dfadc1bff137b23a68db0cce4d5798090cc0de7b5b1aeac98e6e7756605f4b66cfed15fb94f4b992f4f92cf4edf4d3349c94f993503079ea0b61cdd037c9fc2d6f480e4e8414f24fcf3912c2a57e61fa7ad8040502e3b25ff403c5be93a24f49c0f62592eb192f5fdfea6b9ddd5f337f9b98aed9225b241cc8f04b651e43373053e3bd60db4c0c90ced2bd4df2669db47f8d87ca84ee6e3f4200576ec4f3dc8a71ee4530ff2a907f9d483148ce7530f92d58368d1fd55afd18344e7cfa71ee4530ff20f91f53ef5202df6e2a71ee41fb5373ef5209f7a909fa907a9f4117f077d48ec533e4dfb764538b01b215e6bee1dec59d6a72e9eaf65943f02a77239d97fac07a610cf7d815f52616e0535ebc38efc5fca64aa042d6feed3739fca03bfa697c61c6bc6ffcfbd939c8d656676ab298595fbf615f1c0c9e70c2d53d33e5f05fcf0c7c641fd28ec77f83edacbc9f5c9f1a648de2655ecf4bccecadbb19f61145f3589ef43d8f549204ea9b4eee0bdfd0b4bee6bd82678ff8c0f7d3af762868ea56584d21888a87cca0faf3aa6596577cac9df7fe0c81f28b4de019ff087af631814ea15059c3c86fb86a3072e9401ab7092a787073f3e1bb84c7feec71b10133bb3d75491a01c81382b2251e4173f3b84310948ef355d61c274f1778a67f8c7c8f44574bb203e327326bcdd2f333f17116da9d6e56990264c0ecc6ea554cd5f511e9dedf880e209fc318c7a024e1d66c47c10e4022fe33ff2319efd2beae6f47cdc7aa8df683cc70dcfe37671afc57ebf0d756285f747bf433bfdf41dcbefd60ece6b542c4752617c6920e75c3bde49674f7c9358d8b7b4a155c5cdbf03ee95de65d42e3fc06b627cde25262df2df229f7dbef15dc6919527debc36d7d0e116d011dfde9bd2798043854c574453a13cd720be3ec19f179d2db978f606b14505b94342f911c5196d337596e602ccd1bd705ef63c3ed833bba59191390ac607c648567b108e61aefb425937176f5f332fb918fb2bcc0b95a9b37c5eb274fafde6259703e0d788d52ae2a94a6d63a17da351be89dabbe072325c93dc39ffe0f8aa125d73d39c547325ca57d41f290c5e15578cf4e2993cbabeae2e6f8f4ab5d12ecf6e69fedae23b122b7365eac1be9a5ee7beb95c4c6226cf7baddebdf5fa14acd3bbe5294fea9c0e55fe04c97ef9bae7fcde2fe0058ae3d71ae1738ade94ddcf78df601e7ddd71cddc35cecdd4b6efa918d8aab8e94499281e38b38752756de17953b577fcbfaa1ccfc17a5ed8dd1ce76812ace8c141208626d23315da9c73fbf0f76479df3e97d26f6e4462e831b408666a457eb0cabd1e8ce37df3e696e6bc0af88140475d9b87ee15f93b1be6290fe59357dc6d9db231aeab6c550df64c3f2f575c090fcbefa62d95299aed9b2ad9ee4a7d4fc9730df22857d1d1ac6c581c93dc780f047f3a09049d35199c9af0daeac4e3c00dec447c6d5fa776a27c813d1b9018df9f2b2cbe551bd0c426f59df987598379bf9725ef5e1570ca1335b00fec4e2999cebf3f887ae4fba4c3f7c9f152bd4ebd6b9c1a50288fc6f25a630ed7e741dc2dae53e781c2597a80ada7940ae96f2d8ed4d3f3e0bea1c95e2400ce6ec3fc92434f5a5f69bd90be7c62f21ae56d354ae7762426e8872bcdb10818b8fea56789ff97b7bd26db78dffb140a641f4817a7cc6e05447c32f865f8ce7e4e07584ba7def38ef456b6f1c21c2f593efac3f28246ed159f4535faca001f5aea104b64c8a46f7fc95def717b1f92fbf327e78a28d367bc22f763b9aeea7f2b27e8673ecea0de0abadc3e2fa788da8ffc3ff27247035c158841033b53356e64eb68997bb2284770837581e70ce5b1f460cfae655d88f9f417d697c72fa17fc6dc3bb871aee74155bbe57928f3fd2f8c0f6b9933b320e74a3a6766484313fe466dfad8d4d672d5bc9eaf9cc78fc683aa76dbf4bf995daeaacfbe8ee6454439dfa81701a7307177afff580fa21c574f1a383c0575fc4c3fa870ad7738ba3ff485d1263603c7b19b87fd4ef235311f919609d6099b443a9e6b9af267fefbc7c3fd523e46f7ea627d7bf2fd8cf265b2be7f853a21bd89ff65b9df68810f8dece79a949dc56656956b32b457c9335097cf32e96f53e5f39dcb4b29bf3a56abc42ff58a315b368f4f0ecb783f7ece399eb9e358bf66fc6132875d233fe84d4b3f89427fbd366bd8d0e6285f2bf76f2d8e1c862aa7517bb1a07cb13f0abaffa52cf63653f7c413b4c9e01afe218573ac6570e910c783d03879e43552111f72712ae97b24d62779eef546dbf1c109739f67ef4d2fb51764db07a4256e8b63520afdd6b3f7b36f972d7dcd737ba9c07fa3708e2afc5ab2f07385c11dc06bab258f0fed444c64bb1cba5b482f7a619cc2224d1767ce529bec399c72d9c9e8c8e3a7d21cbbd9394b973bfbfb3ed5b78cdd373fe71007f4273c1a677c0f10313cf2d3c571ee9d6be6b4688efc98902663d84ee74746a39e13b16191bf4a56be4cdea112f8a938f04c79a2c2b32c7b877c6f296843b55cc7983ba37cf82d1867fb92b1ad65cb056b2d1c057f3c97274fb06bca046dd5f91441596165f29a584577e01eae8ca54fdc6d5063239f47787485b8acabae651ce7538a5fd178df6baf26e6b142575c61a738b05e90fbe15079263edcab114d8ce979cd5ef4e772ee0d3541f3f969760c964b7ce8b21ad0c5dd5c096490e3dc3bc9d79c97953e3ff2fa0af0dab21c97a339789fb3ea691bcf6d954db450875f669b3950db25561153f53fb2b756d4ea71f5d010afdf6ffd26d4981c37dde3a5be2be576343581878dd7a2fd7919e3d99a1a3df2f840e368b12704f744073e93575d9f0fda5f75eb434474a57a7d9aca8d9f3924d26d54e49088f980b97770d159096219fe09ad3955eaf719fb86dee6f8ecc00fcdf8b11ec47ea6a0b48f981f43d9429e030ec6f7a89340505eeeac29b46b1ddc446c56099e95ca7717aebfada4d77c8237a8e1d1365c9f2ab55f54d2a2accdbaa23f493ce48938c7c106dd3b3e3c84fa0cb87e4cf9bdae85b99fd2f9a5e6f3ed94c1dea267a8977b5bea9b9ade5551e08fdb24d755d57d1815787061772b8ca3077e1e079f077093f78321feb2a9bf76fabc0ffcc164f32991cfa222ff866f2faec49f641ce3fd07fb6c939658e98f5795f7a3548750ca5b7fe67969989325c3bb94de07dd28674c56d772953d96a5b575fea9d73cc3d2390fcbfd0713fc536b1efd5890ff204357a04c3548f0bc03340795b26d422751aa1b2ba1b54f9e60cfb2392c700a1309e00674c0e7ff727cc5b6ec0c2ec975f2c92334a39dcd790436e2ff57f3ed18e2246bb2f03cd985790baa7cdd9bfa90dfe77ca632f801e56f37218bd49c8331ad4cdf3359a9f708e7268fc395743d391e70781d0f0dc60de2252abebff37dff0df994bfcbfaf832718a3ece57937a3ff26bfb6f96e7732ad59b57fba4bf7ebdb3b4e781a1498ba50707d4eeb89a77aac4df8a5c570c3e3ca1988bd7e7fa8a79c0b48d05aef55aa4919dabda0758238f223dc002bcb1597aa08b849ce36f0562e889d345bd8e04c55a0c4ee2ae4e4f945f93443eadd47ac07db8d5299b5d63fad3c328f4ab35187a75f8419047610ae6bcb602d24356b7045cb637efc13546faa0c7b1d9a0ffafdbb7b567c96dc318baa6fb18e4e5b6708d2867cff74783353d38b03b39cb672670433077ebc145a4598ddb29e2534287de8866378a7fa9d8cfe5f6f4029c5a169ea3f5b6bb06b17a4dc781f88ad591c18776999e8aefcf3138af90bf6d8697407c4ad4db20660be2676c879804779c17c9177ddfae0e7105d19a2d705982ba2ddd5f3b883b989bc0e3067b25b5bee87ef4abce4d5c77c3be50ae1f03c9c231a2333cb01f680c7dbeb04dd7199f57fb56a4d723cab9d854e71dd867633bd01aca10a16f8da2b0c4d268bc66eb93bc581faa7331867f957cd64fe7c34ec8765baa2b8ee67bcff729570ce69c87fba0bf32f2392042fc3fd4c7c268c015a614d668cdb5423b408d0ec6c7a9540e8afa350fe723b5c6f5f17c8de59fe49cc2b6301e8ee7a1442f5876bef4fdb105e789df67aa354d3bbd8676847e01111dc5e7600bfb3969304f3eaf02dbc019fa3ce70109e0fe65119f79df6b529ed72987d1286f83b3cb10779fd57baf495941a3ce224d5dc4c9481109b92d0d8f7183c2dc745d4a7ddcea6be3d84aca15c6c5bdf9bedfe2bb18d8dd6acf8ec1a9817c54e897f7e6fca4b5ba1d302ec807d4cc86b53eb8dc2ed67715def15be8a7383971db5f664e903e23a9d75f6f971f9ec395c9f0826c4bffcdb7ce49b19dfbe046fd8ac7847cbc849e7ddaad07265b248b5f39762e692b7f452c50dac6dd2426ea63f3c516f8b4a3b3ab2666c32cd42d54eb5a237940a508b220676734ae98677f987d231f97977afdc7527dde2fb185576e2bc99eb5be7d44c1c4e97df9f9973c27e19e78944fcf8f8772fb679fd28258dfc047bbc2565a70a63de10a60e833c64d8d16b6a71abb1c31dcf3f8497d56e7145b35562de517862f1eef4f8b521960ae867a9f06b09f73afceb74cbff1dc7b8b8b306886f3b30b79a998139fe75b66f8d5eabee4e6bda68dd4bcfbbe6ae575cf81b0a380d05f2d99ddca8065789db2f9e2bcf3b9f9cef24e1f78079a25d273206803c8e35f7638ac6378e075f233c6ab558cd7dc10a7ab9370f985ee3cdb8f510ee9a77dc19ed0537c5849ce83952bf4cc23afbd438ed8c6bc402a9fc291d14cc0f4cbf661c17d5ee579eed277905c292ea661bccf29cae574f5bcb869feff7d73ad26ef412ccaf957e763312fcbaf0ae9cf371e1f94db2e12b9b9b23a0a96185c686de856df77534a2bb1ca7bdf527e1273c8dfab79ba91c2b1840dc1fcfda1950dbb229f45110ebf939dbf114ed7c95465be6bf8ca15fa90c6509727bc34f630ce7f53ee730b7186833813e6599d7b2739efd7e6e7aca9d4077e0c6e6095342f9f43ff43ef48826710e4d9046ce896c944c88727e7cf149d19a5b6d2bcbe271e6fa3dc7860b5dd3cccbe2d2ecc69f150ca9b8fb61ab5e7fbf343753ed23aff0d7012f7b1cdaa38574992e656db9aaeae3b4bf389697cae3de3e7d19e692087b7f1b977b98b72795285bcbe27c20f648f7599ddc87cd24afca00a64f89f9cefa7fadc4eb409c7d5a0be844eabc938d2b9241ae467c8e495c8ea0707fefa16e86e92cf31ce85ef635e20b147f37ec528ae9c71168f876c5c796cefd19c1ea3511eaf415a46be20bdeee453fe08cf884ff9e353fef8943f8af6d0a7fcf1297f24df7dca1f9ff2c7a7fcf1297f7cca1fb04dc44737b8473ee967d0600d3c966681a0512e836f13393a87c82f6d87c776ee923c7c4024c8c33ad1e6af90e78e49e4942ebce7f37defe18cf2adeef028776b24fb44fd4df990179cebc975d3a85bc1f72930797c30f1f31b0671affd5110475f40df7472cff78358530db8703e523899c51fad7764fd7931798df4587fec0a9fcd27a7c73244911fc27c1aca2b487e4272ccb3fa3dca81f7a4f5145e9be87efd4b974139dbb72e830f9d48d6d92d8e68add13ac3bfe181dd8d6cbe0f90ec43c5f781ba9c4e1e79b5c0bf10ca45b0affa2a1d6bd8468e29be57199ecd6a3277799443a5c59dca0d79fba45fc63bf0f7688e3c1e3f2fe15e67b37768bdcf7d78ffe8fb4cb3770a35bb87baf51c5fc2bb589634e9fbd6bedbfd904538539bf7adf59d8d1c3db8f8f95ea94d6e6ccdfcc0541e1fdaef97676eb2876787d05b99617cf91247311145eba330b81fa74325fdd4e01e24261e3b19ae975b71bd2d9457e71617e4449a1de6368fcf13f7ee14e6c5ea0953dfe7748df296925b419b68ac06f61b7c60fb77c4ac3c91ded6fb5cbd36c7482a6e32eb3318de27428dc239a9bdefa02cae3bbe9be428f45747019e335bb82ec35eee1c4f8c2b9f4301c968b74f9bfb7e0b19ed0db90b9ac7b73234b947f92aa05ca316c6be41dc82fcc296a32bf21296e361517d0998ca7b2854a14f9d7cdf3c72867498bd95c7d0acc6d1e41fbc761e94c441d5e068759bd19d61d864cf97f904c67b39979b3f88bba8bd1f47f24aeff1413a53411bda5be2acf01ab5cbdf3109c730afbe97656a97de6b54706e2099b83ace69f47beddd4ed93b29f4725ff112593bd8e32bc0a23b725623a61fef098e668aeecfafa0e57e2cc5923edb7c5fd0e1ef1541b952d1dd0931adfaa898f6a2bdd0a6ed9876e7727c3826ca59559e2ba56dae800fce6f92d6df96c6c056c50e15c6e1c87ac4db3d7cd79f723a0074063ec2fdc7d0c08df363d4c5082572f168fe391ade852478c37da2beb27323e8733e2f08945d9e1fb0d3c283b24b0fcaf9384753fdea7ad27265550c71a1aeacfa1c2ddfd3253aa442ddd2e40af11ffd37fab9c3f3f480e41685a307713edfd6779294e14d23dd4dbd5c56d9763a6f64135d15d26b4ce7477e32b2197a00a8a6770255f7a356fe6b381f4d65baca7b510a69d2abeea969140391d67fc577f6bcc477900e2fec6ed5131e047bf620a4fc6fcbee2689cffb727b7cfd1d371fdeff823bb1dbf4bf8cf6b4c78102bebdcdbe3479ddbfdf95a5f27d7a457c4a657d857dfa69bedec065fb484ff822e2c066095211b4d50b4b0c2eefa207adbe6b4e6577cac98fff0247fe40a1390e72f0fc214cc35c3c79fa2ee0e43194ed387ae042593ae5bf925e7395a78707dff704b84c7feedfbf414ceccc59ab8ab1ceb0804ece0ea17e12f971fc72f77bcccefedd1eb705f148b5676603dbcca73e2f5b6f537d9e10dd9b78f579ddf3e534f4d3efa29a6e7efa5d54e2c17bfa5d90be2dae2ecf61850d38b9f628af825760fbed8f20ff634a9539eecb6d88e443d68698c4ab586787ecaff8f9c868b933259e9f4a9af793e5f24a7b65e22fcffb247c604ada2ccb85d5f81edaf9961a9fd4e7fd025f3cde97eaf796d860b4d9526b96268f82566a070bc64b96dfaf1be0e62b72deffed7d27506e3cdf8fd80d736bfef231e095676fa24d351b2b5b24438678d37cfee711cfbd708bfcc3de7b3deafcbae1ffbba5397c90ffdfffebdc744cce9274a773f79fce1f07b973d7e9dc74484e93e0affffef7a623ab8ee2f25f0543ebca2aa73bf689b3b42e77712de98b614a16e71856f738b8ebda92755405a92b18ba63190048960f2518fa8b2a77154e17e13b8db31dc9b2bb8ea4998073a4aec6a9fad7bd6de8b00baafe62c0ff45c9e15460c39fbadf9918eca663ab17a973d71b7cc786371dcd10a5cedd2d8ea19f7f3a2a02c731bcf705c3bf60bd4daf77778bddddf6bff67abddfb1df7bbff7fe3fac7787619d9b8e6aff29aa56e7ee8503b674d3b13dd4e2a374ecdc7d1b60f8ed4d67a61b9d3b7cf8fbef3decb67fd32181aa1f3a77bd9bce0235dbeff7be7fbfe96c55b173d7c330eca643c09fc3ef839bceeecf3f4d4ec43a77d84d6725c23ab19bce3ad9f51138f843b9c586dfe0a3211cecce5d1fbfe9dc3baa06fbb29684ce5def5b0feffdfecdef810ddf7c1f0cb0fe70787bfbdf9bcea21a341af57f6f3a0fcd41777ffee9eaae2d899dbb7f6137d80df6efff427450240b4d119cb5ceff4d9f17e3eec9b00eb6c90952162b5e89119d9bce4c330dcbf9837394ce5d530c7c437301be27de3c1a82bf15369c254b8eff7b65184eeda83b379d05e7084ae7ee5f9daf9d7fdf74d60e07a4ce9d63b952f0b0923884ee1ddd707e5375dbe10090c4df78d7f98d3b722ae07820fda6eabff1ae0ac4df044e5060970863a202c986f5861dfd2a1bb0057fb6d01749170c51d5e56eb0538ae74e5305cb902c0bf53601a271d681e71cc9ee9a0759b260dd8f92892ae6dd17d5e8dc7478cf91ecce4d473034d3926cbbfb12cc58f442bea8267ad61d4ed525ab0b54db095e4867f4cbf24cc7887e7439bf46ff41504d8863d1b398fc28da5cfc2009e947111f0c7ac3dc8baeaa3b92a573a02b8927ce12ed2c1800aae9a842fc46d1b8c45354dce274d1755450f0c976790748f1074d1cc40fb05ce249b84d3c2407602b5c2ff5840fbea59e073d3cf19c69d20189793a0fb061faa96b1ed473e7264290c4cf2e67ebbde433cfd9d2b7dbd41b55e72c2ff9469192b585e8163d9b92061f218ac16ebd684e6b64940dde7d79e180d155244baa46d4aa8ff5c5e375d438d3ae06350fb23f7bb5305ddb110d589bc2d94af05f57b0843e5cc4a845b89f3820275f09a69b7c7cd11cdbb09ce42b5d721c8b13a4e43bc30ee7307a651a00249fb3452ce9054882035427f5da567519482f40959554abb6670b1c005de92c09927e2cfae4ea08c9a2f78e643bc0488dced56dee4552244e44eb86888a6a745523d85a3eb80649bfff5f9757e5f8a76387bf836da5c1f3deffafabb9c0514d0e4d167af1976b3892685aaaee40a2dab9e9e892e3ffdb551cc74cfc44ff84b31abd0cbb1dbc8304ccb40c44bce0b36bc12f68950d1b4d0c62a850dfe17fdd171548c17330dbe8972c9dcde847d7f6748783f366b9bae30f27f8d5156423f1144d22e7181aa258b92fc1c4e5de43d6e6a6132092ed58828156d0762c5597d1274f1782ffe2ea8375eddc74827eb9ba2a40c627fad5759d97deb7f4f377f40897b973d3394aba68585dd9009c2e7f352cb97bee86a45ee10485c3b16650a601bc5e1f1bd440a3ffe0ae6a0a1792bf2a60d73a4ae1b15101a71cc4976a88fc8951015c33628880a26ec33f4db26d4e2eab2e85e2b28bb6502d9c691967af0610ef2a26271c2aa05451e74a3edb9e1d90baa2af70a7756d49702da9cbaba26ab9a5b385401d8bd3ed17c3d2aa80421c85153681d3617dffbee96c24db89d82fdd05c07f15f15dfeab8521c24e4241aa15e38a3846550f59c426ac356207178658cf83cbc657cd10113c2559b68af8ceded7de000977883445925ff3fe060cf31564beffde7444cee13a771d5f4df6934d2ec5eee1f96bd44b5537f3d0e4bb5866ae76cd99e20b4c00a9f0699ac2187aa588c4386f4623a80bd39f9bbec90ca9abfff0dd299672604695799c09aed346d76da3f08d1911876fc0df412a2e594069ba47f3d5c34811bc51c2844c1d6604ebf138565dd7d4372526af3ccf99361fe6fe5a6d91da5c1189a19774af4c869917b81d274323e0bc2b2c31c1989c19647e6077e45ed0c029ba3e0a44a680784e083f4c7a46c47d994d5706b35bca4c7f0e98dd0ab00f238c23b6722a54fae1562d0f53a94ab758609649996f93d7a967433d7257a8ab143d30851d056663309e11430df69d5d8f749416ee6114b9a1d4ac479d892e5e072dbaea3bb90e75a1d0d733c115cc75e8fab8c4cf47915e85d7488ef37398987b9cdac375df5e610d96dae4c26e18ec8986fb64784057d8a3be2c1d26b8327646a0fd9bb89a3e9f5e217253390c8e705f97f59dd9cd3d6677504bcb97ba895c17f736817bc86c1a986063fc0b68df4c1602f7231f3f2797d93491d6825eca28f5fcdadf5fa2063c714a29fcc3c80d4d470de909ceefc8cb8622e72b7a80dcf0d98732fc9d1f79fc949f3bdd57add6cd79922e175dcd4f5f26e21b68f748d426264f4c548e3e9be2f4204bdad065770b59dc91880ef1de682f689422c2f942f42b74159b15e216fc3f982735e9aa9a1867492a0fc1593ccae9541ee9f41dc973cdbf0236697a68782d6ffe7a35c19e3d8829d79530c4ba348dae37ccc0275dda7ddc88afd15f2d049ddc703b3393fe35155e315aa4e95bfadbbae2db269dc224d1eecb72334e9b9c018931f4e0c0ece6871d363eeffa8c57f1bdf25b5d599e1ae20bb5a2df5ef937b2622ec84975bd24d17b11f7b38a39d9567c632abe0915dfe48a6f4a4d7f14a7662e07e5df9615ebbb3c57e0cd78b19954f66bf5e8e3c7ae37f278bc77ac6ca77c3dc68bcdbcae1daf713be538335e6cc8ba762e4ddba9c0bff162b3aa6b076bdc4ef51e192f36ec8bb85f54b455b78655dfead6057e9fbf6ca7f3234f7faf81abea63e537b8f6978a39ba54ef8d65d335c5aabed5b4d1743db19ab1f46adae9356c074734b0622f3c577f9fd4e2d5a6664d3775ebb6ad998b6ddd18267563782620ee2f2bfaa828426f8857ec65a2823e128b8ab36055b56faafab42f3f27569771c5b78af62e15eb74a9e8cba5fc1c5c3f8ef1f2b593cfa4865596e577e5fd85df85729a377b2e5fafd93351deeee6b0a83a879e979759050d183c2ef6ab8abd3978242beaa6f655bcd2805e54e0c4063078c84723979d50ce2c7183ac4c89970be14ab9236643b9de9cee2e4eb317c8816a0ff1be4f74a0f34aefafbf6f5a094dc1c4e9e8f20ba595c8eb0d137d4fbb05158744a45c52af9ee6c1d7816dfa232068138cefcf137dff7407bfa63bb8a8f52e02d6dbacb7c1b5757483b41d299d45edfcfaba902bb88697f6bfc73a5b1c7c7bcffa9728b4a7c9dc00572c4ca180688bc9834837732e710176989d4287ee7b8bcdfda9cd3c535a0fe9195704b870e56edc89fe4e3c419b0c5acd0736bcace99ec2e2947f85ef8e3410ee5e3d5507eb087df20f01030e438b6083b3da47b4b3c51cea1de88cbf0ffae49ca7273a9b0c0378a7f188f0ecf7694b6bdad938c5a83ac7049d02edf6533e342d4ce7f06efbb74f5d4462e8ac0a6d3305ed04faf857b5879dd5cc35b04dd2bb2a823e57a4a6d7adf96b4cf31a854521b54d68f7eb70891208d163e815d812439ba3c9c13be112c9d0831e43373e5bc2709ddaf52cd097d7f465c42fa8b210850abb4462cedf4f9f9e9a975a57ff82b0d3a41c5092fe78d21309e52868e05b100639da8e0b42533cec44e6d2db45edec79bc7712772b90be522fd4e91f9cc5a3904dcf1dcd5f10426e323a05e9c59ac7cf6045501a470f4c3f2db648f9e9a8e6ea62bf1c3c3f2a0ae361f862239f9e364b8fd9dc3b0c3dbb7d7ec0bc05c1f41717d96389a5471285d7bb36682f484d9248f5f0d4277b8c9eb0ebea0b3ff56a9f5293f6a91f0f0737fb6e4d2f911d21e6ffe697272c256b8dc4ddcae0fb7353d27c59e7a93f3ff204356669d6e43510c83ddff5a7875146b6f343e5397ade1389a1b7d58636bb5d0156032ee7afe794da2237ff9270b5b907c7bf44e9c84517c977e338fc8ded5326fba0948676f87430b8b67e7d92e71e8652a308b01ff4e422e040e73524fb945fe9ae93176e425d02be58477cfad4e7d38be111ad9a485328ef9061c82e5f9806c6878d65d96d98762696abd7f4d298a7d7e331c4e7a7387c9d6468d2e0e801d8e2a4c213d486ddad2e45e115e939291b33ead7069e393c412d7962b20f64d0204513a4e128fd55210e87e71de2b7a930e42c6d0b1389e182ef8b45a97413bcba6086ebd628b4e6b56199b990c85c0a9b121ad224dd563ad473890f1d614a61ec7a18a5bddcd0933d470097a5c83d439fc116a7b0d7847fa6d6b63cbccfe6715289fd3f72a14589ef55e30b6dc3282d8fc9aa8aee9f1994f78338035e13312e9f223a382bd1ba9ee0da66c7109c4568bffafa045947d7c855872c7e782ae7f22bfcc4cb131d5c3340c7fe334f9a1ff625a0b02fcce5e8ef8e98d68b65fb51e10fd43a85929fbea93ca4b03cfcaf1ff07814694a5a155e219c0fd2f825748f6572283aeb26278626210f12a4bd1a60f9ebcb83f6b761fa8843dbb1c335734502605229cf9ddaaf85e9b70a7414fe99d22707427f05f86d789e50074877e7d8a032057759da6f81382b081f4a533ed5ae554a86ac4965774ac9cea534a550d6aec18591c7e3ab1ed32f4ecd96a9f70f566381d01eafabf5b58979e5fb90befb7bb862cf05f27116b63a959eb81be9823639b0d4e8c813605f8967c9f41b25ed53b9fa2ad3b895a715c481cbe267b025ce0a135c81b2eacf8fe2eebee88cf37d42cacbd4ac77012f329d1f590dd8e53275b836e452d0861a479fab7494017d9d1c781ab8d48e0442e22ccc8ce522e00a25e075a91c433a149cc9413aab6d14220bf758fa3aeb62dea6123f827e933da1bf3af2dbc0efa96c4fe4f4044dea3e9bbc4edd2e03bf3a119d1739bfd192b22b53c0d1bc2d991d7911f1a1c78e91dc05f93d3319d22f4ee73db6ae2f289d2569d4ae6392fed0e413bb03978a6b0e527fb5638ac6e600299eef8b80cb8ea093ca6c3c192db793870536f8c66c1767895e78ab2d7a375e5293d1f2007f93a3ed96ecad75f656da90c41a133df66060f478d66377ca841d3b0cb79b9c45826256d48890c008637732ce8e17277eac0c385ac43617d1fe810d189a985f9eb7679dd9ccac35cd5e1693d5376673f036d4dc60bd21b1566d5cd2c617be472d56daf7fe0f5c1c4bbbe52d7958cdb99e696db6ec810724febc76586902c00f5cc0247da2703d92fe81cf1794c678ebde3dcef5e7d40a3355b2bfc436dacc62317146eee7238ea26e7f5cb6b71b7c70cbef294f04e071f1301cd1e321cb11ce88dbcab7db2938d093d5787d582db909397aea4f76db9e3861b7e48adb9198303127ab31eb32146b2e886d5fdc8d8fdc853428ccb0c43d984afbd159d892c67aecec286cc80a8fe48ed426e67abbbdac2eacbe190f99cd817a6027e25ad0cc2752571c01900e7f015bbe3f31165ba1cf6d01bd981c70fe02fa242dced763f396dd33d6f344ee2fc6ab479162c7d223f86333561c4e3349692bbaec837d662ee4801ab32445af7a6c7f85f33465ae7ae26431def669cd7cde6283b9408936afb3ec7a3f9a2c2865b9d4c060bb115d8e00d8a6bfa245307a2409e7b405f77dfe3024b82df9f44cdfde6ea6b38bb4274fe494b9acb0efa715d85e9efa137c3391add596fc4300e0697119e36c7fd5df6226c93e38b3353dec2df1b9b2d626c4aacf6e1797d589a527e7c58eb2c5ed70f98499931ffd39b1c10717099884302659b6b76217da0ce327e25fec7e6e099a337fc2c7c7053618afc1c8ddeaa3bf786c7bd96aa6c368d489eb99604daffe58f7568fd4583e4b13c02db0efb7ec447cda02f9967e5cdd6e7a737c8d997ffcc087f4025007be67da2b6d7ca43073cbefc6dea26f60c298a4f9ff9fbd776b4e1c691645ffca8e8ef3386e81303dcd449c87c636606ce8018304dab162a22415924ce932ba0062c7f7df4f5495ee2add8cfbdbeb3b6b1edc8d5499a9ba66656665656ec682d05b4bdb77e171613e0fa5a33e8053ed0467f395f0a83e6e37e3c94b6f3b54f93eda0ca4b7e54c3d2b4feba5c20bb3ed76b97819cc37d03a862b0139dbcdf2e9e714ade1d43b2befd2f6e58a9650446f2b5eea8b1be5aa3ceeaf52eff92a6f160370ecafb7bdcbb36af606cb5edfdd9a4369df9b0f97ef138cefc237df53aef3a7c54c7f91acc9bd7aed8d9ea7920e78a6de5925874469c0e7f29e1f05989fbd3c2827301df9d21bc3663148f4fdc866f03dc0fcec954f6d5192b83e005e1836a51ac9ca2f8d7c8d1126b2b0cfd785acada65f2d1fe4429eb7dcab69b8387276904dc5d7629fb1c6fdbd7971145e8f43f654c85ef1d94416bec51e88e588f86ca69bbcd9bc9ff17eff73e4eda40ff9ac0db3896eb3de97ce83fa75313f293dbcdf7bf5e16028ec7b24c759b88e5b53d0a5891a82ddba2a4c510e37b205fc99b13b4f1573e4d3fe6fb17e13df84f513d82ddd96f2443984ff24d5ebd8f256d539508bb5ddb0261bc6e2983b23ad9107891cb8c5e3e633d3e4b4fc661af29bf8ef498ecc0ceb5781d34301ae97644edea5edf2249b92230d8430b2cbb790dd1ad23cd13e6993662c671f24a1aec4a1b30f5bade52e3a63613e467aff16add6e62854f949284dc919d6372a1737ad898897cc08ff0c490a816a1b2aabde8ef2f4917eefd2ffb78e033ac7777bdaf1c1cc5febf05715fd1adfa9693b9e19dcaaf0f1d1fa0ba4dd3a09f12ab1420fd7f77b031f28f53db93fb84a53d93eecc5a52b89c36392e2ac1f9fe756ee1d8b7de17e657b5985fe55a549fb105c936cd3824e6d790dfd2abcd621cb18ebbae29cb71436af683bd8ef841e988e42b073a294ba93d55bb5ef6396f6bbcc0f8f209b36a38d7db2c65e1e9f49e7ef2015cf5bef4fe4ac6c9b3d2bab4e099209535890a9c979e158192c1d891f129b528b7425ff2d4240de6a7754ca679ef3281d46d7b3d298f754dbfb88afc3d251a613ef2de2695d6cd98a2998d26e8e6473895e66ffae33c8a3f73c2bac93d81eb72dac0fac8ff5a4be3c3d5b2f6fc3796ad71f9e1513057b1e9df7e2652e3d60bdada1af9290c9552968d0b16d5f67d311c5e910b699f28ffa4cdc181e3177ced71852bc390d4f636841ea3f2cfc89d7e07eb73e29a8c1bfac36cc7eb5ffed7f44787d7a86feb36b78c416e110fdf86ef7eb1b2b24623e4d4287ba2eb73d34914d620b3e7e202425e3be71bb90942dfc676bc728ee8f8d393a92b363c13befde863c3db314dacc95d86ff5314e8dd65407792a04d2606d97f61a3e7f5f9d795e59d9079d7c1273f5c17c3e49e3692d6ce6d90ee3be2f99538d6d40f262d20b54b34f70081fee67ef8f5cdeb6f48ef24a31051fec043f7ad769fcb37a6ed3fc6386e4ad3e33cdda32b46734477b71bd534cd4ab39cf30e25817f1dd0e9a3e4ef095d97a5873a67a04bba54be6f2567dc0388bcd8f73b5bf40d1ce61579cf3d5a5e7aa9e37cf0fff4173a37a8e246322cf04320e0b567f56e055da26cda5bee775e21ffad3f8d1d2c6c5b26dfd30d662ffacceaa7c3be373369ff4d1baf71ca56b42e6cb540d2571d26385b5aee06b47995fbad26e2ecafca52f8b424cd37b7efce1bc3e8c8b7c398eb9f353e6d7c4f746bc8ed557737dc2faa182a4933c137c89f2cad9ca386bcbcd8ffad471518c21e929b22556d8a3eace7c19294bb6496a29ea4f94a4935242bda04f50ffb4c4c7e4e9122afdc83f85e15354bf3f6c89ed2277df6d32df101f8efe3894c4bea38afde85d4d08ec4afbcfdc534532472eedfd406a5219f291af5848d6f69b244e8ec260eea8d31a3b12b94336e949db511af7e7613e9177e31eac1967698aaea01fcf8b949748115f64f84e55cc351223c39376eb1be76c1d9da7f3e2a1d6d7c5df8b43cc238fc4e61a3e7f63b7bbce4e56675f8ed7739266ebfa3263f90ea4bc71339b5fa9cca3b4ef032b9693b02ca866ef782eb66841fc5bb7b3399245d49705faaeca07a9d636655efa649e3cb64c8d50b4c154edf35deeedd4ea07ec7d488ecf8fde8619dfa6796b1930397fda8e4cc914c20fc8c11573376ef736a0e7541fa893b07c153e4f3e3ec5e74bafd6d255dbeb098c73a95f51a758771c76e8a7b28f5743bdccbd78b94a1d64d41677785ab59b1597aba55e4bdb3a4dedc2dbe9e85499c2355943ec73826ad9a822056ab2ee2e0f85fb45ecb47cb7f28b92fdfde37d5e4c03db72beb7bc7794e109c7be2e5b822e3f34cfabc8dfb93bff1bc4690f3e904e0dff3fe9bd1c56f6ff85140727c7fa84f406fcf0fedf99dde07befd3b21bd09a572437f8fe3f2ab741762afce2bc06854ffd93d3e09f9c06ffe434f827a7c13f390dfec969f04f4e837f721afc93d3e09f9c06ffe434f8bc9c06154adeffeff21924b1e4dbe42f28c5d76e4c05d910ebcb5c23c99cf4e5d93a89d55488f79ff7913aeeef174fabf362bb38bf6ed4f16abbf0978fabdee2a137fcf9b4e75f37dbf3f2fda9bfd83c0d97eff3f1faa13ebe78727f3df1118eeee375ed078bd87866fbdd1aa9fc6418db6918f1baaa63c86462dfabd63858f3939eb45375b8ed56a736b1c1959960c853f4fe268c7fb6f093cdce91e84e495fdc3e0d93f6be46771df63db42ac4e98eefc7cdb7246e7d636c8834bdf020a599f88df202f1c72a9cff66bec18a0b31e7239b59d14fa836867912b7fe297ee75f499c12133f2f2cc67947d57c629ee9a92496bfaaab5361b0dfa1d83ef893a6aacdc513c98ed7589aae4369b7bc4abb65552c99cb229fe6d860b5259d5f391fb5389df6e075f37426e9b4b56ef132ab7d28d3f164fb4e56f974a663c2ee877cbcccc6789d15f3aa3e2e4629e64498c63c514379209c5f1e8e411acf71983fc763fb827e7a4c87a4be393b2d3376423a4e0ff387fd6ee9ec33b1ac7236fb7abff6dcdc94a7a9bfdd4624bec2c406bf9ea1602ff6f3df28f2e31993bf55a59967acb5642de5fd7bebe28d94f96c297e6bf37c4afc4713dea5a475c9add1d29d8242caf12c6f8ceeacdb2f6fc374eea1426c59a64fe0aff32bcecd8bda14e3d9bb8a6ad51866f87692fb0131e3303178476ebee7529237c5c045e7d23d732c13a1c2f905cb5f293a5362e65969b75eb26bdf51cd494fda623e7449c7f888e7a77e223ed6c7f510af532032636dd1f37cb26fd4a5479f3b4c7fed0191596acfbcaa7cc7cbe356ebe75ef1fd744cd9e7598cb3a0ea7aa5fcac2a365fce5f79ace669ccad589649fcce9074968d62bc62e92ca38437bdc903a1276de7bac20bc7c25c2032dc8abf389238ecb58a6b80691f971ec07ac204cfa1f5694feebd307d058d6d7fb9dd3263d3cce78017c48551687feebcb2d88755f175d27b5402e69503e5529ef3649d853918edbfd5f99d0914ddb0a063db28d1ef2c5ba52f3e215139dffb366c7394f7ed8ffbe11ffce8ebefa3dffbfcfde8f7ee2779df47dfeebf8f3e2f4f39ad79c5511efe6ef92c6f74cfff7e1f9fba7d1b0dbefffefbe8dbefccb3bcd1fd801fc5a071a37bccb3bc4ad05f7896d76a5a7cf6a95edb8ffea79defd17393afc4f6eb001798d087f1f387cefe7ee026440df1d4e3ddc176ef343bee578f73a167072efe656aa6cff1bdfee8ae37bcebf5d3121641cdbe03816fbbd0f3b9e407318edf76ee9835967b86e67d3d7ef7be1a36a7a0c0f3a17b071ce3ce71ed93a1e207da36c7e04e7d801c1d0c3ee9dc5287e09f83ccffb607992af0810c3cc8797f234e758d13e9ebcf3ade54bc53f7d3cef8f162a2ece127bc3827802b774040631d8636ad4e3c4503bca9d2b5c9f7ee7adfee06bdf8fdbf65a997089e226b724bb6d09a7d00959cb6b504c645ada155c0eeac566cac06f40490a102bf456f205b2b1d5797a17c1728a5436619daaef53be740f7e8717f07c0f20d54688d023d2cfd71970b396b3ef1f962159ca0a2e9f8539e03cf1cfe270f014fc072f40b99df770ed9e572e507db35073e54f43bdbf3b8f7b37fa7157ae9e059b66f1cc2e4c7078efdef90adb9b8ab3a380468b66673e464560e0e5c7c445b09e0d9ae7f84a1578441c0d238cdb50387ecca1c72032648fd970a207ee814d7141b860356b1bfd8706ae032a65b0530969d3d1f984e11dad61099778ae9704a6371ba47a8c6e1d01a18f3bc52e3aba1034b6135ac12e10450509a0a11f421b85e6b8a88eb05e5784ca82030545601700c8fd32cdbf30d85fbe940ebc79fcfc5a5c680c4ccda40c5c5cf0084171f5a98b516ba0daf6943b15d271ae8bbd2e46441709e613a0896600d5305ae617326748b6b98ac7ec34f0e10ff835d6f7290beef3bae1da851effc152f94bfd21ee71c39128cb298b60a5d0bef798a6d29814bf4eb0a88486e2d4c07e7a8718938907defda26f47518789c820c68f97fa56b382ae88c907559e988897f465e31b598b8b9a83431b270b669da16072f4e49d62943a5ae50862f07ca11fae428fe7ce6341b6f8916d49a48900a550239aead1caa3b92166778507bd0f25cf19c437fc03991a4a7d91ca0a28fcda9b6427f38c0f5e8bab1394f0196153ff8f61112cec7700591917d3e18c48facc193a6d17f850590aa1ced9c5698de2a47c523727d75614605512a2153a71756b157d9079ea767e61279f99723538f9c2a781fbaa611ad95a2eb4aa4e6718a7f89174593530ddb49a6b220f19ea9729bc9bdc78b412f11a36f738bbee86453e57a57028cbcf70afe30c4a5a68d6f4ebd534ea3374e831b4e11c03021e74602bd6d12d71befeb25bc92ed8bc8ae91004c76d8af19e444404af537fc26d2e95bc39f0dbab755c3a71213f4948813b684b69d6842b501f723c5bc0d74d206151e4ed17ce98015399a79b06d63124c3fe2885d501a4724c54976d716c00703223572856c078f201e940ee058f46e0b1e4bc82d603568196d471acb7d2d41b1ecd5be3b1c1768266809dc7ea6787eeb01ccd81eaaa113d5ac1a2492d6e88bd4e4d81e43333c9f188eea5022575cf2c2385007e3d6f08d834895bca3659f2dac3f3a727bf0588dec82936893119273d4be1a58883b7c3df5b26f4260a2af8403b3fcdfc9c321ef44fe21577804ae61e24ffd3fc2413eb28503c7e0806a1a1e5e09dca92f439f585d59c5d1442583cd9d5a42b1083a8e57c6a72fabc171095f280954c32f7c8fd8ee8b14025f87966f2815552f16b32a11f8baed1ad76a0ad95236011b0f1ede9118e86919df84cc337a42a6924a9ffd9641312ae259dda540d7370eb83b60c59028b6edaa86c5ee8c422113dd852534d5f014fb04ddb06210b3e50c9af0042dbfa2ba19cd9c597e40f6393a14acf8b805fdb3ed1e5983972b6210b7b0ec5941951631901c1b194a454b5d1928a55a442f595f898a18943c45876ac09c92b92216d51c008b36f47dc3d2bc2a74df7681569e05e9fb7aacf217d341be038ee141f7440d391c318be5003e8ac7fc6e747aec86311207ff0e0032fcb0092c1636eb804ce883061037353fd68079845422fca4b24f671c32f466bbcf9d1afa2a01c3fb95ef068a1fb8506d8b933b316983d1347a8a6d35774c0ac4fd1d40372447eb75e34815851a00046488ea00d21b5d0d10746cea664c02085d0320e34aa483f6c0f1a165078c5800ec86e542c5d6aceef5f37c1702935a783ba045235a8fe7412cc7d5cf8ef898a6aa1c8b5f5cecac510f846c62d6a9058a4e516a611a190d813ab8c0aced6c026558bee73742354c120263425783f1e9602da4d5349e148aa888b520cdcb888079d06fec2d227142cd505ab5203a04aa8569c5cc0a9094b534c19f81d1d83558cfaa8169e68ae79a7ef075c355ff7280eb87dcc1768f303e3ca99b28d548a98a9d38f418d0f2ef343b15129985219e490aabe818c8d0b5a09f5dbaacd2d2dec704c23c406da1377d00b9b083d551286a59cdb09d891734913a8416aa5a2d7aa506d705ab43032bf5bd0e489d3ec7d40edba21495c64e78ed46b0ac62b6006e5fad2a85b406a55e4fad43ac525fdbe374f95841d9ad81add3815ba2b5af5895c65c8752a30ad5a0d5ebd73588156a775b8cf635ac50d29b31da7fa24aa5af412969facdb01d5ac0b60bd42054980bda6274a85a9d71a10eaddae65087c53045b402ffd0372a5b835554728f9d5196e8b1f4557e5feb8c505bf526cccafa33c5c44c310a34c3a20a34794976cb38d849093c72f664be2fca9af95213384e568f494b7ddb461ef96c756941332b159367c5545b80e06e6c09c621bc87311bcc8266cf540a8988c5bfaca8d6c32546a4bcce594432a1ef1a0a5346a6000ed0eafa1e2bf56e4ddfd1722e7f4451063a40175a0a7b985c60790e3ddd291552b51ad615da96e5da7ee5baa29a72ba9d55c2e8b6095583d915a4fc08c3aa56529dcd768f7f0730c834f2484f78324fd435217e11c8e47a8945ecc7b15e171f5f4640f89dc7c9c1e1901d26fad6b07ca8955f3b36b1ff155fc70763ffd7022d29b67d34e03b7189fffce84bb6c7798646916c8f0ba8afd5af8bc9a44239d03e3546d3072ebcb4c6a22610721e5b81d192706a9bca012417b4eed27eab0720339edc842203d50238e6f46d41b3d3a80d0e59cf5d607197453ca13d0e0d91d3122579dd153ef3b3430db3c6f416f0441f69071addd26b090c01f2f56b3be0d467a06367311033db65170294a376c1a0db66db9a96248436386deb64022b1201da03479cb6653f75ea54c7852a3110b40377810f91611a7edb169018550abd42d312bcfd40a59cfd9d7189918d93d827dbc0c6c786ad613bf2c0339475db3e76024e2d9fddd0ba729b182f5db7d0f58962d176784a14d833333dd6bc23471e77aa713870a74172e9240b1c99e16f08b0481c66817c76a3494c5fa4579673cfd48bfc9fb88cffc465fc272ee33f71195bc765ec1abca33642a3f66cea3d7536befe34be27d1175f930c667d245bab806681dc067b7ee4bf0e96ef602af8fbdde244a2abbdf5ce0bf2373a4abbb1270f10c9062b4c055f995e7475ba0d80b53cc9461cb18764beed11986c14a987e70b8dd0787f8e22aa18f9e854cfdfd20844731a35724ba21ae9ea7414e668312367fd6047293231fd09c9dc8efba31039ea28ed96ef8a89b25996d20873d391f93c5bdbd2db388a62393e2599328d710f4c27bde76994a9495c69ea5447cfd36c24cb5590468689336a6422d198d9cc8fbfbc5d826ca28b2a6eb5b7ed4a93f97d54dfb80ecf9a3c5003f21ddae68064c3998e4ef2c37d36d25a297aa394cb0298ab4b1afdf161ae2bd65ccf67af9afbd26ead4b51669642042a439a0ad7fd60eee4b2b6d1ecfbda7eb7eeed453c9f71bf7fa76d9948cb6d7fd1d0e77156f8c9fb9e173c3abf846312a58b8f3275558cc5dec4fd916bc35112255d152f3d21c968328eb2d4dc345e3b301582e7d9baaf9843243d8c4fb271a6ed9c2ddff7e205ff8fd487f1008817ef79aaebaab9d5f6e2f25d122f24032bee1b39d7ae318ab385be1ab59142999958abfaa46da4d3e2f8e6a301fe30d6efda79fdf8e3b2bafef097bdc97815f67acbc7d5f97573bc5fbfeffde5e607bfd86c2f8bcd915f1c274ff5e34c328f85b8fdd26e998d1a59353f93c8a2d98873b228f4f6e25a57a74f785c0b91d5d648e19721d865b34d66bfbbd0d4846fc5fc025d9fa7cb936c4a8e3410423c4640bcff00ef2059b4ce323ff2e2a8abff2efe41bf373e49a44d5b4d364747e96d4cead230afded5dd3cc47bc3d614cef214bdef778b42bf265131b5671305eac36dfc62351042c51402f5611ca862df207c8edf6bc2b17f7d9ea220e275e246586e1aeaee0371f886f740202ef5baf500c461efc6be5e0271e8c83bc17f9e0efbb238478a41fa5bdcec16e97c69c5effa3a9cccf1bc72f6fca48e3f3bb2b5ba71eef7753845be2af634d9127cbc0ec81e3a91964a3876e468be08c7fe661bd6ef2975d9267fe9fa457166d6ad96640c7c18d36fcfd6a7e7997095dec6a63c78d692ac9a0f6392415609efb5b5887a602a84ac4c7fcfd351c8c8aa5adb0f346a6e1cd1b3629da411006f5a2b9b81d0536642ef791665b14ae51f12e513af1d529f87318d92379d5c9f67732499988f0ddf312f93acf9497ea3e39c44e17d180771a450b267cd8473c35abb66a303ff7a9e3deaabb3715f7d184719c152de46f74e217c9e4591907f7ddb938c7a5b1a69f64ae6d32f9ff7343aa5c2eb98d7384a18f3f602af1fccadbd3874a01945719dadedfd6ea5c59997a5070a07a2cc89f5bc29c92cf9a7644a347aebafddc3a2ef8cafca547807a2e4903d9aecbd648d91bd61ff36b668467dd2ee50122f44467c9ed27151c2719c51f8f83c9d0fa33592c95ef9dcb6dd9bb7feaf5fd7db9e2f3ccf9667495c3a928970fd75d9183bb245329bbe47e38bdf9d68ff9c356520bc8387b18e619fa77d1d98a310cf77792ae80a9ff413e67bb88e864aa240dfb76df7bf4f1e4349a46f4d198cd19e472689924bc78ccfe97ad349108ff1f36ce9aa6ff57b94ccc85ef91fa437e8fb0189e2ecc137bc1e50a084633ce77d793047d19e8d65d6e079864eeadb5857781fd3c37b9f47fa4fbcd7a429c9ee8b627e21edf49e623e45f2d528d8f3dbe8db73a4ec04a40c088f60c86213ac5f5f55acbfec9657c9123c7976d4221d818c912436c8c4d6f22c0fd6f3645eef969358dfa9996706965b1b759729de1397e8b6b9a8923e5367443f38e2fe798df8a5c22f7599fc8ff75fd591a7674ddd2df1589de501d61186572c4fec793c77b1ce4af8341d9f299ebf6b84e51132dea6706dda5f95e9a8f796a1b91127f7dbd9dcd9f39df911631eff305e374f37ae5bb23efdfd4ec37300d74b83e2a81fcd13a2f346b68ad3f36cacc3b7714f0ec76775377f07d30996d1f472df35ec47967096b0ec371556327f71f683e3a7f4c506ebd2e2c4bb71ef7a22ebccec3bb2b9d5c0743488d6a8ae60bddf9a9f5451b5d507bc86fb3d39ec63da08cf2575aa457b1cd9c7aeeac3f8bae7271e5e6fd26ea5c9e2e82889e7e07922e9f2141d63f92fa61dd7fff5613c5677eb932ce2bd7fe13d4fe627797ac13cbaa15fcb59782bf7795ec03a22faa4fdfe61bf5ba2e5fbcac7329acc13def32e4f477de9a1ff4e33f92a1add4beb79bd6af649e6f27f83fee140739bdae73ea03b4559cb1dd9543d49187b7b7149b3f13cd1cc0d37f3c2d93a54c5ed4d3289c0eb484ef73bd6fef4be17efb53d9edbfcc857a6a32bb167f17b4dde099e3a4567c22fa7177dcf0bc426fd3ca5ed23f28db9d2301f789e3e698a3909a44866593cf42eaf8f3fce8b07a24b07cf13ca9329dfc5bc84f09d641d177421d26e4233cbaf639bce6e7c2291d1892c81615020f1b83d9931788865cbb3b61787c7e7a94e65acd95c974d957ee7f187bfd8fc38d3b1af1feb241b71a50d65122ae664d838a693dc985d2a320d74b7bfec241d88177d6f220fecd6c3e719cd824cf4e8c8761beb0e51df27d977987bd82a9b9d259bad3f777e40ce2022b9f4a420cca7842749941cd94464adadfb02b5b93fcc8dc5fb6af8f351d7f7618f5f6cb4f3eb6615ee373ffcbdf87cfff3a1172ea6fbc1e2aa85d274152ea799cc04341b5508c4795f9d8ec2ad39f2a42dee5b14009a158564bfce6787504eca4030b2eb3eca008175de6cffd92f6fc3c23b4cefaccdc3519af1c25a9cf2d963267d75aa9f14137da39959ee4f4ab95ed7d750f1f0dc4efb4fb3701d310fdb5b424f1eccdf64fe82d653c104e2d0a1991a54816644ca651b0a31dc6ab7bc02510d289f89ed153f0c9157fb405cd949fb67cc8c1f0e6d578f91098364f48eb30479cf0f8a3f0fcfda7c40c65457064b47e28764fd97b2080d96b6b05b52bdf2616e119bf68cdab433f58f6d0d6f69861b92f542cb67bd41c79acc1ee5ec2c83051967d9147a0ae9eb623670299bfd88511ed91baa323ced96d74d9ad1a69c419d64e92759dea36f94f1d73bbd2789c32b9d83791e50d85be3ac08daf3b1bf79db5e1cd912eeb3f8c56c10586e57a642a84e112ad94a29cdacbe1cc949d9791c9f51aee7d25408d429eae5cf8e4a7bd7722f0eb16e797c13498638479da1099ce1df58ff1b4ee5c17c01c4611f96b348503a5b5d97cdb527ede639fdf4f9fd29cd2c97c1d9ef04926926921997401c9e1494cd2c35ee299680ca59ea9748c5ebd9548bf6d8b4ffa6a350c5bce3717b5e5c576173e67b924103edb17e3529d01fe4336194f69349c6ee4ace88d77dac33ad7712eec7eb76363f4926f2a46d747626ae4afb8db423f6712c5ffe94076b4732519c196aa5982313e07dbd2cb305f260fd2089d24931852d10fb481e08bdc81eb55d6e7b852c30f3e8fc6a9bf42d636f7c49fb679eae5fba47ca916c9aadbbae4c5161bc9fcf8bcdd15f3c2afee231afc764e75b9617acb08c32137ad25b86178893773045812450b966cb635e90d317d27555ce5693e17bb55994b4eed9b9a23d98e23a92a15b913d2b7c995e906caa3d50ce8af74de687480915e7e5ed7c6665788b641192fd8dfa0468968cf5f8079abd8895c9a9e94c501cac4fc26e7c9676cf76f17b6b1ee9b2b5dea8fc2494059271cc7a791b66b24115fa0daf59fed25706b8acaf13d980c87142f82a66e736deb790f932152e781c95b0d41785b320c5792df26f3e7fee82f7a6d7871f96644e3c85df5af8b73a9bf7cb59e1b27e01cf3ee131033a7f14a39f9d2ff5590b85d2dab194b01ff931f4337e0cc3dcbef86aa2d3eb2ecbc3f01814db36f98675b4cd604cce99e5c1bc3ae3d7c30f030c0443da913d1629619fcfadb5723b5c559c2305ef7d53e15a9da18ee8834ea4e7c9401cf64ad9bef8be23a3fc183068e8f0a19646691c4b3488dd29336fca7b79a55d8aca342ae16544aee1a99d89f46346a7ceae1d85f7fb2c5e5a33377859207a256b5ec4d98cc8d917d649f6bb7929bbd39e9f9cf7e2d25167c78a39b1ec2bd61cef17b975519d4d0d1db1cc467482725bb27696215eaff2767255786411bb5955bf4c2f7ac9069bcffc57969306cbb92c4e2c4958be0aacb56865cf459a68646199b442995ff7f7f15c29f561f13ca4a6ff8a59d74ab685115e679417eeead672098e3547faca540df7e21a6da7233cb68c31236be92445769cd2770625db5a29035aae7d649e53fd799bd1f1239da1e21b64cfdc007168c9f83bd3c93bf5715bcfa8cecd1a93249be1722f2e6d200e5122ef94755c2283a8267aa7d909c727798adee15bcc3ff6a53d4a198c4fea130a94c15a97cd25527ac93e4333cbf6d6489e62b941f19ea7ce15eb7d737ed897a7e782cc9fcacd8a89b07ed7bbe9bb7dc2b3c83eb435054b152f3a73cee2b56f224fc6724c1fcf5fc989e4afdf1999d8fcfd4e5f29267a976ea89b84e59bddaa051ee6cde310884b44f7d731c916fdf2a006aa78f1225e5dcc8a576a9f3c58d6c8a8511f886bbce70b0a2f84597e52c8cc7b55789dc030f6c1d466b01bf7719b88fe268c4349941c58b41314747362d3cb64d7c5ba72799d46752dda5a193c4b35fb8f58c690f9fed37eb7d6abeb4b753d56463f66c64862074257329ec218ebe70379303fae76420f503d2e9249293fa232102bab1fd957afa0e81759580b5166c6576987883ccbc81c18ef158cf99a6da78fe036b6d352dbc1eb6e7eddbf8dc7abed64bc7a5ffe84e6e471b143ef8ba340dead9f26ebd5768e7f3fae84c9a3ba7578383b5ed7477fa698d2cb72a23f2c45e17e2dae5fc529ba28bb35129f84d5da7256ea54b85f9aebc7c5f4f20c4564a94fdf07f283ff539ce88672450b897f0a5f7ac854aee32d349f868b63ff0d4c95d3fa68bb4bcbd117fc48d83c49a765e85beb19eafd7c949ede441f805e7f2a4d871bf5fde88a3b5d92de7c6b39732e7bd1df08866f6c2692b938faaba5e987c2567dd9bfabbde5d3d078e1d5d9dbe33e5c8948800f5effe7d32880d3fbbe6249d2eafae3b412f43f45517757227afe39190fa589be5d3f394fdba9be59bd8d2e427fb95b8bc2e3564457753606ebed7a2a9b13116cc66839102eafbc770513f5e565b01fae07f659d94d1ec193fe2af4d53fc5dd8fab709574b04593454fe1a5dee54ff169385999ebddd69afffd7695eee5dd7c238bdf87c27474018f93d9924773e17d2c2dcdef43f52a586f5bfd054ef7fccb55df2fafe3a7d54010d7336db8e95d9e65d1f97bb34333d95cb90b717185c27aba1184a32aaa3dd5ec23b05577ebcd78b2ec3b7f6fd0f2e7db6eb293a7435edcdae7f566b2df3ecd1d283c0d645377d4a7514fb0a4d5eaba7695c97cb5e4877fae4df4f87645c1cfa74bb818d857e571cd2f7aea9f8b27495884a38b7abcec5f7ac2e3663097deae8bcbd2547b6a7ff9b21157eedb133aa9dba5b1df3afafaa8f2c2e3a2a74c055dd94cfaf24cf873fb307a59bff9e1fa380a948103d6d6f85114d5adb8991bcbe96507cc7d080673f7ed6da4bf5e973fd74fce4a46f333304793457ffe37f11d9bfa40daa2e3a6e76f57fdf5f362a74be2fbf2cf9fd3f5eead3fdfff9c683df8347a538ffecb26f44fc2fb5c90a623203f49fa6b5f7d11a6fdcbe27df96d391b3f0a66ef2c08ea93283cf757d7b9f153949ed5816ebf0ef4703b590d56fda7f3facd9ffcdca9beb41df1dbf7f540998d9f36bb255af7278f9b073fdc0e9ce1e2389180f0745d3feace76a7bb8bd9332f3c78c3cd0e39dbe3d25485e36031f8c12f78f561c1f7ae4bde596e85e5e26d26f9cbabba04fd79208acb07b8d95f30fecbe0e8cab345b87e1cdfbf99d205ce94d19f6f63732ffa88c9d7b272c674d2030c3b2d988e7ce98d959d77790513e12aed9e235efc3d20fc824f6daf92b83e005e181665e0e25f39db2bfddbb1dee732bab2f10b3214b56f4e4721f527aa936b884c4efd8e4cc194767384f7d88afd9069bfab91675719d8aa7d8bf83e257e407572f676644a66cd1e9bd19118ba50ea5fd5f3853a1a6aeefe429ddc9fe8cbecacbe659923f2f35a3b0aef55c8524456d0f7bc4ece14891f8f29e8d2440dc16e5d35a78df82c6533c8fab749a1ccf7695dabf6dae44ec8fa09ec966ef5b8937d39f29114c67b7e7952c5614f48ed62a57eaaf2e5ccebb80df3bc34afa92ca5107d34b6ebe5ff2aeb6fa6e713b9bb0b957c621ef981d2f6c9532190066bbb6c2bc0f2c3e489fa82966c5ec5b516105fcbba7ea67a37b52186e4db6f9238390a83b9a34eb7d5b42be781e02bb3f5b0961f9aa42fc859f7ba57b5aef3ed14793c0e682e9b6b041f342bdba7788c5f7334e755ebadfb3c2cf0b7c45f7792fa139475c4aab9dbc0a759f32fa9f3a55fc5230a7d81f79733dca2409a0af72f0f2af16588eeb460de11c83321a8ac07635de4ecf9f1dfa4d7acf3c4e754cd3a5de2bffa260e310f35f7e2123133cadfb00ef11c92cda5ae3ee88de77fb289de6bf83623237efd1a93c421d1b3de84f1541ecc07fbddfc487c4fde6d8dea277563dff23e116b6e44df5de5eeecac7fc6fd8deb50df4e6a0702625f57ac63b33e45fbc6519ed2fb27d1f94083ee84e750950e96ce938cfdf15de687ef58872fe8c799734b85d8c35f4574de4773a9b1adddc73619e3f82cb6257c9ac59fa1e3b3ce8b2bf7b2a6b5daa1bc4a567c7e18ab6c3c36bd329d223ee37cd114eed5a91010790835f20c943977bdd69c6f65cf67afac3e64c9b37b5e47d24cf024713d514d21506779fb4d74a65594fb4a3e8feb68fd490f0acb7654f28f6879364aed76d97664f876c91733bbaeccc91908f9b317720737ec9de93ddcdcd9616a233ff69dfd607e52f891a93e0cc7ea6e6dcb8379e2a3ff4a7d25c6595f89f6e79065dc78fdee0af5d98bacf3ec79e63c2e5a4fd391a0ccc648298f11e3dea1e2bc463ed6d2540873f2756e5f6be20bd44f25efcf92f879e4dbccb7f1eb61dbf781387c07bbb12e3f657c48440929789fe0b7b6620a5719f35d5e984b0f9a95f779886d83db53727fe4a91fe900c286f86c0a4ba4ce164d7e32ceeb83122e1e9f86af9ba7f075f3e342ce967a5264571e3aa94fc5f0ac9828d8f3e8bc172f736952b2fd5fb1cc92c8f669fd239bbae6bc9ae858db1719796ccb0ba16aa27749c8943f54f8794d7a2f8795fdffd20cfbd0f2d3ebf6e93dfa2ffffad7bf7efb429298fef17fda0600a0d1073a871a883e6902c3faf2db97475ba135d90057833efeeef4e79f3f36334e36acf237d7b6edb7a9db82c45ffce37f7ff9fae5bf7efbf2e60304e3f006e4610d8147221178f8e97fa9d081960a2d25fce37fb56c0917a5be4cc232fc6fd2a4af24669a73d4a08a7ffe57dc4d04204da85f4a17dae6536dc16958213b0ac9d3168be615e9886094720ab7487c5d00391a3ec96f1ae5f6ab076ca8258163251fcfc0444178e4c0528b89c53be5fc8d52f09a24277e2935eac9a0313eb39105490c9bfffaedcb2374c87c908303c97a4512347ff9ed8b629b8e0b3d0f0f38891f93bcd0ae86439e2d1f181649380cf22f9041a273a6538cc670497e70807e220a286338348a50f4ac660b550fa40f50c93f260954f32f32190ad5337055af088690e1f82414501cabc60499a74c6a324b8d22b85586ac890a4c75983e445d1c3f29f799876c033c1a55367de287df72cf4392db357e2e7cd247997eba0c7ba3fc13e71c49da3315f840061ee4bcbf11a7ba069db271bec7cccf38f64ff28cb1bedde7de181620d90292378a77ca3e6ab69c7dd4e125fb18e52f48537a92e99a3c5e4834a724c621bc382712af326239253e4593f85366e0180e44860549f0dce87735b4a71eef0eb68b8123ce41d21a3b810f3953337d8eeff54777bddfef7afdf8fd4dc4f8de5defdbdda0f73162aae525b5fa7ed71be25aa9c54ce54d44a250df99e6f5fb9850f4be1bb138fead97ed2d52afa4a41bc138e27386dc3d2617bd6f452c93a0b2029612bb93912d630470c5bf58f09a7d0702df26e193e31f6de138a016f380d701e3a26ed0714ce62e280a325a63a8803d4199c0bedd1a3497b2a51685b56996a17c1728a5dcf40be0d1fcd2aac779d03c41973b0df22032b45deb77ce81eed1e3fe0e80e51bc5bd57269953287ebe4481d61120dfe064a01c6d924d2857ea61c196bb5c48aecd532125bd62bbd0f67023a2aab1bea0821354349d4039f0cce17f0a1086a9e8c0f28e2117f807d92eecf9aaad1ca1cba986e7bb861c9080d5aaa141cf8ff2c2d6836603466740e109588e7e213cfc2e4ee393293fd8ae39f0a1a2dfd99ec7bd9ffdbb62aefa8367d9be7108931f05a908fa1eb47c37e4e87f257c0640261e61baf39613c4d762c6b1842b44cd7c463b9e467cb7ac34ea75173cc720f9ec4970e06c5cf90e3414b75a32ad42c8652a6f8f4912ad754389738475ea9cc1073b75904d3ce82046bcfeae64f0a20e9c5b2844f94b3e8c0f2fc074d04d240cebe082248e664c89ff0025d3b60cdf760b0929ba52f9f084cfd07021825886bd8104899f68f8e12d347cdb2ea6e7e8408206a1f6a01f8b2ab0f552ae249086b0bd914e92a9eac675544dfba6c5554df68615574df4b665584df753d66635f99b176c35e91b577135e1db967635dd1bd77b35e11b99806da9864fde3064ff46ecf6bbffe0c3bb3fc5343a7ea993b430e8222d94ac7d49b6806e0858fa5355a8d8b165f617d93c3178a345b21ead696eb440e674dbf3a3e4119f4688f3a1053a753d839ee919b711c804e8fe042a5ce07eb83e37f66fdcab0a40865254773a53a1dadce750e15400cd4e8b92490cfa4a0d1b694723976df4932845d9a63e8b9a61692ef43eb37ef8272aaaeb3751a409683e44ad4145ae47362ccf070875e5f19534382d2859e36ea1c4190e303f991cdbaafa41b2b7b15c06a94f5851f5546f595dcd9419f6cf4f279f88bbffaeef70b6a12a1ffd589429b868506b8d4fad621fc5be6d7e467d636896710b8788c87463726dcd4e6c44fc3695e63b626365981ca77613f38848af43e5e8055d7816d504a00a2ddfa8d3039888584cb243d8895964d24e75e5af5954cb563ffc5982cb790a40304a3fdf65863328f9dd24ce2c8548c7ed8a0d9103ddceeda758dcc9ec24901054df7718d6f326b406cd8f8dd35556c54896ed9a585fecda91dd1d5030166529dd25fc0f39af6059d95249f6c88e98dd94cc3449094250e9a606572538f95817d5504ad64df6e58758ed073f449304feca8f048ee602f56323d7e62bbfaa0d5e205b9dd4926e843fa2da577ee1d3fa80106275f0c1b0083ffa94b99fff8a8c6cd9963fe037d782b6029cabd26963694b980e6a9de9f0c3a463ef329a80f693a9ab10cb386637ab6267da9f3fbbd38f585e922efa9349438b1cae1bb675845d44a8d6e4e9b6f70ba6a3499c417e1de15f389e163021f127fe7cd2b18b96e6da81f3f9e46f91496a099f9c2e2a5a7ba2bf70144f8ea5d89695e48efd64f267db3d42d73434b7ab02fbb10f64f26c773c9e68c88df77994f2bb74f4f25345c74c3abfcfa5c6ac3a2ef855d5cf6cdb76a0d2a12f7b447eda57e29c87bf98fc672e67d6773c077432ec3268e34eee7c86c9a09319b75b097d82065794e8a3e7f83ecbad1b7c0579fb6c41b7c267f1933e11596f98fec59ff4894f5107d39fe9cd8b1bc891539fbacb2bada844d2e3ad64929de7647ac7e0f36879c49a7a1bbd9bc5e24fdb5258fc2af3eeb39662c3673e7349367cea53d64dd56ee2f9c00f6eec2a0ffa7ec949bd2b917847bbb12e09998f9cb630e805960e01f2f5d0b26f1d821ca96410a8273ff0e1ad5f6a3a8e653af3e7ca55fc6515fac4664accc3f1432592025d3ff5c3c20fd5a089847717b7bde02ba6d8a6637b468d54d38606e96f07010bba10a8d55cab052d1782da89dd4c22ef0b796b8562f1cfbb95900b890beccd74bcc03441cda96a3305cadc3ed0c9c915ba4e580780a773e70a47bfbba2353a2556e0d56db699becb77652506eda9a6236c78716cd7872ebd1ddc7470527bde79fcee45ce88a77bda9eef9e0b3dbf418acfa00d63b4060da21ac5aff39f61a2b9ea073ef6c1663589d90427d17bb853bf47e753d396568157379f2a501a154ce2bc652bc7d205b00c0cbd911ed93e13232847f7b96b57b4a64ecba3c5db62b78fc558edbef5c977ed23c026b7c40af87a0fde3a24ae6106d7e2b60910508fcb410bc8c5db891f22d1e0c4d78610323c1f7eacef1312f66d55a077de5be2b79aa818a1ddec683b9a8d756c88099181896ec29ad007adc033bf6b993c8163deadce00c582346e537c97306f6c80179f233663c54006b1102bc05220826a9301a22d6d7a527103d59c93580bbfc7f6246ac7b9039973f92e6d15a1fb42477d0cab8508d3844f8747018a0e8f30ec342c95d492b374ef081dff73687ec2e4aca17dc3e4aca41a382af02140c83edf4a320d63420e863f3c5dd29827f0a66993a153eb6ecaa012f7f5477038c50daa058c3ac4b30b1c2753f19b8898d0770dc5bba925312d17fa6ed84829e7bf16db21da39b515a33a648a6a221669f61dae35feabf0654b01ca82405ae6bbc0f2b0c6d50c415c164b60c8d60e26f94639b61529251b6bb1f9f69de703e5c8917f8b657270380064730754f6c8490a49d0b2429966738e6bfb1886fea803f06cd73fc2b0b8afd8241c0539af273c97436ed1044e41eabf5400f143a7b4113261386015572b1b4e0d9807d16c60df30a1e703b3347cb68648a00fc57438a5b13813c8c12859f0aa81f16e5c6a7c357460b18ef0ab114e0005a5a910411f826b51e5ca16712402176b6953a82028b957910272415db36ccf3714eea703ad1f7f3e97f4cf32a4629b8e519625ca80e9f5cd22a86b20043833b8e40b74e0e98662bb4e3403ee4ab39605c17986e9205803ab2ba8a688fc81a2ec5b067180eb155bcd8072c9903681790ab0ac1660beabd8d6a909ccb78f45352b0ff4eed9568b0610b0165523708c8f1aa60a5cc3e64ce81663b1902830861f59da8ba526d00cc5b680e1e255ef40d7378a9ca65374bb325f2d42240bcf044efd97a250796d603868cac5100d6d03ef55c079be6a97eae7fb16662206745cfb12964b7dc7b503355a237fc57cf4afcc7d6a472ebbed9b86afe81021124948b74da81ac56e4e2070afc586f8028cad42d7bad36c2cc92981eb96ac8029840bc9ee58e039b6e5199c669b50039505247a10f0abcb53c723cfc3d3a9c88a2b60434be98260bb4041d0c40a59714a32e1b18e6d589aef054e595cc9624424bd66082ea285d92a70744e361ce0fa860f35fcd81d1faa450dbf0d56f998ad0d56790e66b118e286ed402bf1eb2571b1689caa3c9403b0e47c34c92221f24fa11c22047d03623e74e7db66a10e5507228e6b9bd0d761e045e101fe4a0595a8a0334285aad00613ff2c0bb3654cbcdc5089e366e16cd3b42d0e5e9c92f05b864aa6b26cf872a01ca1ffd57635ee7ce6341b04be6d41ad8904a9502590e3dacaa1ba23697146d06a0f5a9e6d34d8293840d7ae2ca80a91aa944486e8bd2dbb8055f07e06c887ee19025f87ae092c169053b62ce623b2660b02d9f60cdfe634db875448b06341c6e6e4c0402afda9da0afd914a0076769bb7d3bd9ccc3232a29738429a8cecf3c1a0d72419c58a0e141df0bdaae2c03dc1389c2a0b208dc9ca2a2d874f65413947c523814fab0b33c60da512d246617fd01b56147b957de0797a666190977f39f2513dd4c047ae0aa80862413fb597f9977885174174df64a2e2f71cf069883f66a1a2e359e0b3cb7d87fca305d4f7800550eabda480d39d48112e161baa0558ef9d404686e2058703893c9b2bc7ec442f7d8cbecdb1cd6c31dec031f34e5cd5f385596e6079e0007508223b571130b0ca55c263567e77f1b94c70dcea42d2f32628552b0f84c7c8b054c8f87816acaa03d8509c416ef21e8c725bf308efc00116f44a0b2d0f75b45d084afc220f43b432fc4595f84a3591f45d408fde016a051f588612c91c25a0a4d5b820884e2c9aa188660b94d2cac843fb803dca996de6f0bd76746a6be506162c2d3d5242c253414e3654c30dcaac90c0103317168d99a5519f1112b500168b826142ce8d0eb1b30597545662bcce4f54d3560304bdaf97f04a34421216343af4260684af191a89fd270dff8cdfc406eeb6f06783aa28d5f099c89e9e12c9402da16d2762946dc0fd28cc771be8a40d2a3c9ca2deeb806542cf031a8cf97c07cc787e7741691c9114279d2bcdc0070322d58bf4a456f008e241e9008e758cb6e0b101b005ac062da3ed48633ed912146bddedbbc37181668296c0ed678ae7b71ec0cc797a35746279ae0689ec04f445f4d009238d765283125884c79117c621ba49d716be7110a90dfb68d9678b0356e8c8edc1632b79179cc4581e213947edab81d5b7c3d7532fffc6f87aea67dfb8d0f3c3c2bb1098e82be1d33a2062300957adb8ca207ec71d880a12c9a544d2ccb83da5d271e84380b4ec2bc509b28ff00215207bd95707d38fe67dbac26cd7b60b6fd2e996d9dbafa10b357871b26f2de8fb2e1de0d494e3c51e48e902b211ca3dbbf68146fece247cc85389e61d32f2adf60c4b43f0800c4dcfd5d90b3d0520da66aac2958a2239346742a2676155a22cc9ca61d89c61471aeff1bbf7d5b0690821d5343c8f066b95a14f6251b28aa32513c7756d07c522e8385e199fbeac06c7257ca124500dbff0bd249a660eced7b1a8ab5454bd58ccaa44e0ebb61b05996452c896b209d878f068cccf9a32be099967f4844c65a63efb2d836254c4b3ba4b812e560b14627866622bb6edaa86c5ee8c4221139d44adcdbf540d4fc1ab35ac18c46c3983263c41cbafa86e366628abfc80ec73e46250f1f1c8f59f3578b92206717217a1822a2d6220393632948a96ba32504ab5885eb2be12153128798a0ed580392573452caa3900166d7a65c5ab428f527594be9bbcafc72a7f311de43be01891a75f26b4bb913b7afc105eb1b6dd503b543972674ea30727bf6ec54f237ddf46260ef4fd91dee94cb6dc73d1652b378cbb1bff450729ad01b94370a55157eae0e1df0140861f3681c54a4c1d50e48259079271c8a901f308a9560d884063c12095873ae3b0e64e15eaa961bc12302ca8c487866a5b9c5c129836184d3348b1ade68e4981b8bf03e8860e70815937e45457ad012031cfea00227da219828e4ddde44a00a370d9b5f3a60c1ca7ddea8011eb20ddb05ca8d89ad5bd7e9eef4260d6af7f065a34a2f578d467b17e76c4279355e558eee6e283c77a2064138b792d50e4a7540bd3c89308d4c105666d671328c3f23dbf11aa6192101813ba1a8c73ffd4425a4de349a10abb220324b2b1d5c3342e3502e641bfb147a3489186d2aa95912b562d4c2b865780a4eca709fe0c8cc6eec38a7e0d4c33e73cd7f483af1baefa97035c3fe40eb67b84b18f4add64aa464a2d41295e46f688254812192905a212c89d66a76a06b330c453526115e56e05d49496365126502407356ade1f402e6c8575148a7a7a336c67e2055db60ea185b25f8b5e6903e882d5a1819516830e489d3ec7b42fb445299a1d3ae1b51bc1b291a20570fb6a5599346a50ea2d1d7588550690f6385d3e563097d4c0d659515aa2b5af5895cda50ea546afab41abb7d0d42056186eda62b4af618599a719a3fd27aa8c423528255b51336c8716b02d4b35081506a7b6181daa56679eaa43abb65ad561318c59adc03ff48dcad6605d5705590b435a9628c4f4557e5feb8c505bf526cccafa3365c94c310a34c3ca5a9048ee0378814c812ccab6cb7c5f1448f3a526b91fc52a2d59983245b68dbc38a944456941fb2b159367c5545b80703439461b308e44eb61f6050b9a3d89292422c7496565b81e2eb169e5f5da225274b7ad1ac0015ac5b090e224726d6d39973fff2a03a501a7ca20992b65a542aabac3ba42dbb2dc344d041b28b3d355c2a47ef8ecf2230cab5a49753edb3dfe1dc020d3c8233d3ecc3c917fdcc2bb5346d2c32ceace76a0450e2c625d313eb98f80f03b8f9383c3213b74f4ad61f9502bbf2657fecaafe3c3595a515c192ff4e88309c8aac3ff71b2a1a53f89aa4e7e471600936af8f83fce0c904ffce2e3177f07b60f557259270a97404d0bb12f63e62716e68e067c2799e5735e92d152cbbf8baa9e7b198d50f22ee31395bc731cd73e442f7c78f1e3cec5cf348235b907627b3117b43dce33344ac5f6b8807af63ab47ff07fc4c1257a4e55e5e4349cfee0bcd0f2c1053f274691d874a610a7f1f8290e07113fa7b7267c9b2acaa59268b04aef3d72613171a0885a1e3fc75de8199af73599d5e4fee91d708cbb3803ce1d09df153132b2470dda63e1a67f0c939a7d88134405469630df0eace6fb991bd11e91a9a399d3061ccb9d2e3c44f3af0a23b11d5602b4ee2caee153a4f3d20c3a751f8df9681a91a909925e0b6901984a1eb5908e0b55aa301621932ebe4b574d3d00e19ac427bf5c4336702c41b405cd72953638717ca6d6b0780ab086b716278986d31ee5008e6ddb9d0d6cd1093ef3b343932a670e1b9ee8cced40d398686d8093284c2d805337ab8e9dc540ccc86d5d08d09dbb0b06cd63d17e6458912b5aa09564dc36386d1b63022b1262db03473b7acb0eee341a09336b07ee021f22c334fcb62d8823a7b4a5df69a0520922ca6fd2022731beb7818dcfe15bc376e4b66728ebb67dec049c9af5bba1756553315ebae0a1eb9f933bad1fa1c09e99e9e1ff1d3918bc538dc3813b0d92e00759e0e8202af2fb4c2fc27bbe6b58c411db0b2d25fa2f954123b7c92fbf7d4915787a3502c867379acef445ea9d9a7ba6b7e4f05bbaafa7374de2eb11817fe87fcb3f7f278f1e3860b813b45472c9bdf6b65c0ba8cc85b43a68f29f1cfa4ddf4ee038e059fd06e0ecbdbd1a389dde72ab81285fe1ab016e6831568654cbc37fd10d871ac0e26db646b8f8367d1d607ac9ad0a2abaebc62af6422ff276669556dcf2a904cd5ef6a9042adcf9698423577ffeebb72f1be8f9537b6220e87df9c30a10a2af9e4dc776fde4d582dce7f9f2c7fff9f22756f5fea80801548ab3fce5b72f0b60585ffef0dd00fef6e5d170bffcf1e5ff99fd5c3c118305c96353c0faf2db97a9bdb0d546404eb3bf9ab64ae085880ffef1a5ffb53ffcf2af7ffdebff030000ffff010000ffff9bbb4e5ede960300`)))
+var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffec7d6b53e338b3ff5739e5d730ce056607aace8b811942b290398421b7a79eda9265c516912daf2527315bfbddff2559767c8f4dc29ea7fe675e046cf5af5bb796d4925af25f1a76579469d77f6916e676607c82d4d12d0c5cceb6c07774f016f8e89c7ac8079cfafae65240bf615fbbd6749f52ae3bd40c08d2ceb4a1e3519fff0fe0b676dd58d89936060ed2ae350760573bd3be51a85d6bda99f613f816e2492c16d50dece6046867da84525e4cca23e0d0d6aeffa57dd2fe7da63d73409076cdfd00a99709028cbadab5c604e9bf4ce421d7442e0cafffab61c27586fc0d864887d4e53e2504f93a243430217557d8d2ceb401bdc30431910a91b74f16d5ce346f6d21533cfe3b2e2f091042d08e6b67daca117f9b26624580d502eead2dddf3e92b826d221139457e4b060c51258783a14f91efd36aa112b2c65c87d471806b1e061e48a51448a865d56036c86798ba46e09a24977a07f86b0370c4746f5d1091230a08762ddd414e16c7bc55b7af6fb027d3e0006eeb7e94378e1d2434f51bf2a43e18c10a0b7531428e9876a641c7137fa9e3f988317d450047e900eb0d47009703ec225fb711c80610ccb80a88140dfaa1c769f2a083282219aa43ecd9c8dfbf9b69a2c9c0fe0541d3cebc658866eff2b27b950a20047b1cc37dc80a7bac7bd1d907d86b73957a73400a6c7b6bb47fc32e47be0b886e501fbb562541370c5c4365a544485dc681cb65cd14c948347a2fd437dd4f9d4f9d1240215f794ab6c0cba8ba059d3a04c1a04e82812d879a35006823b8aea19bbe61d590b3355f4666a08e9ed78d12c416f8266b03d3571891ba3c67b5ab48cea85b81ec90fa3c39648deaaaccc58ca3ba082280bec280d7a0fcda44301bf42e3fd703faf5e4cb6eaf0e10189ca01a0027ac5680a0d7a4000268d7883791c774d13152df44fe011cf48203088b9ac8086a145da22aba0105b101ab690ad4256109153b1e29095623425970c07119070b5996c9312f532f599dcda96896d18717a997341bb34137f39651b1ac46e51528af2f9ca4ba2d4e58a1c03280dd6527d5fac59beeadf14e3bd34cc0810118d2d99f44377dbc417e3e3496ac9d69c885d48c8689f85107cceda6df85b47e2f1ff2f92213825de087e910c836e9578b1ae9571bedd2afafc2eeccbd9725334b906fc2d863f510eaf103882df65101f1ca12b3204bd8640ac3434efa75e7c8040b3b4e46baf33640d480324a0b96ec5761282a73d1c31e22d845e716d5e3e76a3433d7e72bea0bb0b22d99300cbd8023ddb11caef73addabf3ce6fe79d6e1c7e94b05ee7bcf3f9bcdf799f30d36549aabe9c772e45aa4c97b513e222bea5fe3a117475deed0a412abc9d301f311af810edd3f5f9bc7325c4110ad7ec5861572a9309a59d40c6a90fac4c4d5e88b4a9f046c294e95e838d849d1b841a223be04d3c95e12d7a0e024e7dc4b81e3f34c5e9c004a4395894713bb44891dd920512dc98c3041c350673da18ba01049b805754519aa56c8e5644711fc0a8334fc11e01e3c877b06b329d2167837c7dd3cf420c447df737dd43fe9ae97f06c0e5383fd53308702dc59fa540e4ae01e15837005cd3d52a4f651ef091bedb097340dff47264ea23ca4441aaa495c560820d82962d511edaeacc43db1c023bd0062e5b877ac05706cd4d314d0ad7c8d74dccb88f8d4014b86e620b31ce103f0cf5d10af9c8cdcfddd106b89ebd9363c2b927d75632f415f59d3e47d03ea78ce9af5b7e6ee55463c55ccaf12a4c1eb2640b7126a654a11efd2bf09700f6e39ab20d52265253ced8f42b5f0f001e463b8e5cb128c0f44d4f4cec75e0ba949768f2413e0f33b1f8b2c1a6d0cb2e205e6453b590017db3354392d9769c0418a8250b433e0604bfb52a9cfe3b0b55f18942059e473094dcadcb35254634eac03b4602a43e3a861fed80e391a3446077e503c6fd00f2609f98de3b2439d4c53c5a2139223def56f8940c1f11240cfa23443004031ff3f018199c52f2fed28004235774c2b1a982cca305e80cdac841c7cbe1a187cc13b4a36ad94735ae6ab147b4b86aa1c735c36ab927699bd5e28f6eb0d5a28f6cc5d5828f6bdad5728f6cefd5828fec04a86b62d1be5899ed7f90db375b33b41efd234edc32a656d642bf8db550d85c8aeaa63583b0fe4c13411a6f1c7ed0169b80c753db77b21dd28d06ccba4d19872410f3a19309d2397281cb8f93e7307c9c008e1c4f6d7f9d408a1ef8ef4ecf91e51b972a0404437ab414b1f71cf7d3c70a8b6691a791a29b0039d43d5618e2d03c850c9db9c06336e5ec5869ebc040be8b383aa124d18f9f501a762db13d7c4289e291207e42898cd9ef957660f65ecf8cc5fe2e216d879f4a19ba1514160a8f91a4630f38271657be7afc4eb16c8b9087fc93c83a6e64291195d2b08f917a4c4b3d2cb96499f7e4e213abfe9f8a47a7d884ef8dcc479658d30cdfcb1fad01be97fb38fd5465832d171fd3db2831ed3acca6ab6be58c22743f6969c92de6fcd25fa99d352b522c5d5358d0a6ff936c3e3291cb31202d1985354843d4aab31011b6b44a048b0d847799dfb67b4fb3bad444ec185e9d414010e33ee0c80a8f93c4dbd9e269096af6df961b110ff9adf31f71e91ba7953d245939f74af6150eb1bda3b937984a97f3b4b5d745442ef51d40f01bfa700752c125548d6007b7e61353f6768394608bfa4a656eb6e40d3ccb0726f228c130fc683f59312d714d8fe256c38b1a12da73e850b82ac3764b2025aece12d4be780f484a7a8674e0bbc69f774614796e7f64244ab93e2c968fca030b0c17f10f139c2cc89c22869395811494a43415b6c2aeec3afdd3c722dc52a8f10e17fd06b221f0dea0cf3e407054a9d8fc00d1b10b7be093d34b3711416290f67cca116c395c378e4318974ebb55ebd6b24fdf82f691b84c84f9e6e94523573a6f60eaae51f811e2a3a1f503545e98fef15ae2e9a5af51b80101f940c91fa82f8ef4c3621f26f80393ee0207310fc00f101d7b475a3e0dbcd38b57b0d30bdeb8887b08a92336a7164e49e02003bbc2c1d8c196df7699a2693cdec708fd4055dc782ea4aefb51a392580746fe0716792e826427baf5f666557c0e8036764f2829497a3af0a4d30f25d3a3949c565a69d245341f95fc24c2d4a1d7a247f5c962118b4d1f586ab1f85336e7b2788487f0fac364532e97e4dd82077edb384445b6f6b3387820fa2841275869c8cf3cd57b7cf89bb30f114fb72ef22bfcaa4f14855a4755be4a1f12c549962df68ffb63c8478893dbbf85b3026da5a819c8b16292d12d1eb33798872712e9f96845b065f313c9e3d139b25388da386c1d9c4e168bcf249f425e64801c3f6a1c3d334df5d627139434d054d8a97ab203d19cb2473b10d549ba9db238c480cf38e0c19145c510e7474f8c62a383bd67cfa8445ee0da08106e872297271495945e74ca0a70746c4cedfd5162ce43de27a547b4327453948e89c4b21ff5e5c64ffc52c90491cf130756f9520d4deceef3b8d4721ec0e26c296598a3a364c84af70870918f80191e23cb47a056970f8bc87ab81f9ba0d82867c70af2913c2074b41c16380ef08f9110f567ef28e4e494752bae1510eadc3ac1eab92d5b3cf0b6e5ab1b5ff72cb9a2ace4884a4a6d0057a376e2ee25e48b9b830e6f89d6fa6aacbf30e562beb990895c7f61e2cceb81794f8aed32663b30e7aa66e188b564f3cd7744f6ce6c1d9a98c8042633457dd3ed3473a0a8e0abd3a70a9683d37e797d54bc9b906c2b886b9608b7dfdab21d2a902c5b3ce4b58b2ce66a16d7892fe352c043bedf15f8fa3317754cfa01edace56d7283583daf8e5c6010740211077c9b9b0812377e21f73811f4b8244417b934e46fa4a8a2f09a6947d3da3c98c60397c6a530eaee020771d0089e7aaeedc025aef4368c14283692459ed481ec941daf165c74b94a0f31c1724d1e02172282cc43cb314d65471b5c47484d9447f45c7b5fe21388a8ade71662b6c5db0faa045de40aea7d5c0d3cb60ef1cb6ad0e5b55a6b14b6aa964a6989070c5b238f9f46e60994f3e24394b3526ae0890b530021747bacc864d9eaa0c76343390c1da5362939b59ef32552e2b27e0f8f0efdc07c17e3d6079e974af851421cc47d0c591c7e942c1f713f3c2829e3b11aaf31347363e5b4925473a5a945cf45318a5f6c34560208b52a69dc072e13b3a9c308dde63ceffa40cf09b5568ebcf8a778f9ada44a43249f7d7ace38806b5dfecdd38c60b50284ea2b52f4a34b88362a6c43538b8a63315c60a2873a00a33e5fa3303fae5079819074f3907dae4efcfc127904a98f290711e7cf59138c0edcb011ce0c4ab7fecbc1e20244c68153a83e6a11793513743c1d1e24efbb2713af568dc1f1d5770dd1815be63451cdb001244015e855f0f656439237519636ed08150405a7484990578a582e651c43fd8787dcafff33cc5f19558284d4f170d1962802f707eef3501f13027427d86509e2d22a0ca9ef290d382f686d194267e21a4b5483b521a921c91f60fc10c4033e43fe41942f35e6108c41e0ba0d60dc87d4dd1c8271ba466e1d48dc9ad5200312d6206912571229764ce063aa3bc8cfdf9e2558ce3157abe879aa032c0ca90bb02fba1b0ff91c23567bc3752db1a45fcd239286e700af3e26f1b7b05c5a8ed1916320b311b2703377058e719316d2c7b92bfa288c3c9fee723dad0338e79e4f0353b5913fe27ef48fd40d189e513c4ee4600e6d4488bcfbcda60e32b15f8510a5162fb2e730d444be2b2e47139674e0fb8515be3d426c2a23c8737d0e7519d62dea200b5412e47d6f8057d393fa058c21bfd81557604317b661a03e800439624286fc067831c7c6aec559e015cd95348712c90e2374254bb7a80f3c5b37b0077c8e39b2c46b7b7e645aa83d5771f3ad09575107d35c25e606f5909b78e3cb9b0ca39b05b3280f08cb79edc846125fe59ca623225cee912fe89c3ab934546d76783e7510b751c0d4852e7fecad1a4568cd90a8467b4e81291ab3454ed1dc886ed16a1c75c4aa19da7905e3b7884a54d9c0dc08e01af14fd4b7f4ed56b728083875917548844c5025c8f3295c55977c44de27a305b4a86dd1d710c00af9b49250f50d05583019543835fc5cbf15115eb78070e46f11e036f21d90eb5f2290575c59cc7eb2214d080cb1794b758b721419095457a9a2ba116062a61ec5045d1cb755732c2a2edf4c1e64edaa8e9aa60c059a3654e87ec8977a2f2b7e175f7d6910ba5d61665790a10da00d7a9d2a72e06f507ccd7e19009975d4a48e938bbecb50de1a46b7c25713f7dae2c34a242561b7dfb9ac20abc3f1e594bd7c4306fee119d1e7002a24c57e0e240f1107d6e22520c87771479087d8dc296515e13ae0d4a924425b68012fa7734ffeb102ceaa00bd4a826e7b6abe9c2763d30565e15e60100c59b05ae15d9e2e7a1dbb1059149a94769ecc4217eac8f79383105962bad3701958211b01b51c9607066e3149a2ce8a613baea72e80af26ca927780570f1275845d13edea615505508ed2b1bcbb6085915fcff00a3ce02286ea516bea23e0d663e4e40daf3032a587d42191dc07d1ee3b208df0818b2135cb4149ae052100564394e82b3d00793d9a03ab1e10f0d597f8a514599b2a3f7051a1e9498abc7710e90636b11f14bb428991eb65c2822ea5aa3293226a016e9904ec20dd57fbd869c26e6f52950427e522e9d147b4d8a75df8262f7c96f73dabebace53ac3a7948c649928a9741912af8337c5abaf12d4e0e324ea266270e51c909e41ab8f2234838bda311ba2933c9868b501a42d97831803168afbf9169cb17eb76141bbc6d1ec75e530587e708751bfa9f015264854610bb8988a3485c7eb840db0167271d39a56df6a69021593f3e6c5e1f9c072404370734d61bc7105a6b6ddabd1c9027535442d274401eaa515c7fe7ea71a96c0150bd151005ea9739a4df1072b51681ad3d72eddba6215dd339ac3e3c5f4363cc99aba62f2d6d6272c6679ab4f9b4e36047fda74d321c2132bcc8585c0219f643fadbe4724fee9d087fd384c5fc9998ab24bc5bff481a74425808133af0cb8e97703b3a86ef721628e035756262c141ba385a0786b33098ce6265f54b7bb0fa61b243ed8a1fb1cd24d86e205e957d1a17880db046733b172b86a87499095cba745810fed6c48ec77930f62d930b4f3908fd599fe5438cde1c4b72bb321fbf6960412f016fac8423b2f1deae48add45b19b5f26c88f1a41124659eccc9504890a5606c73e8c129279f7a928c992a0e4e87f42c847a91a72be06e2c627663a189651603c052850d00e739bd27519cd2a956541395d2e23a955e692706e97857b22e7c9454c05320b4ba5b150dcaa457482dd60970688398c8f692608bb16d91fead987275b0ee920ec5a2c5fb86212957fcfab479c22b44310b99b32929a41a5c3c5f7607341eae39149908828da12de0709a588fe6e7a69426e0e1795038d968e30d531552b43627b423bd3d65fd8274ca3cb034d07337167b4bee91a88836e05590d1df1c5f5cd5065023d8f15f9a3c06ab8a0f47294c0c43c175f725d7806c76d31e54beedc3f402e4b44c06deae3b76a09696ab9002ab44418a49b3a5aef1073afa4248c68eed02d0f2d91a848bdb2e212072ef00a43b93b54ca0d29f54dec9617468e58caeea3029b89991c8b3277c957d04b64a28d388f5541db6f0995d257846e95474e45e4eafc4c59e5654825c2c5a64195d48854c214dd89564ef30d00f54d7960592c8a5422499c0234835295cc90caa4660065b2a3035bac8a5d7d8b6c53195ecf558c71bfef772e76f2a557eafea32219c07bf9f2a5d48eb545929567fffef308c9d3b1fcfb4f991c2726fe92c97b4aa7b5d862c9a9a3867e1817b7f8a97dc7c6405df89520ff001efd1900129d31ae85c593f93a90f258ae83a4fcd76a604c8a6a9401058ded84bd59dc9aa74c77aa5837dd8630b1f61cefb19b4d79325fb96bc2714883843178b060f620fdcf00f9a1077ce0d45579b466530390a66f1d40d9bc871151dd802640f53d10e4b702c71f546dc111cfc5db71896990e5b64f1fe33e024e7dfb2f6153355acf17b9f8d66b47bc915f4517e6b71eefd3d783c4c7420f81945b5f2d26598cae45ad7ce0d416b6446197337e107540492446f84da1f8e386b548f7507d4628c40f41d4a2473de66053933086f8c11255173e63d82897ca73b116d3a8c3cb21936fb4d7e2b7001f2c3eb1e05583514da806111fe628a3731bfbe61fc29d2714fe4e6b14bb74d5f538d54c6a8d24c397b23d620b52de3fb707452689f0e74aa619a5c450a8242c23ed8faed4530b83682948d9410767deef60ce0d857512f2f3f4c3d8d6c27373d93a860693fd5af6ca3580365c2d3258b962d082a95574fb258277b0f4de19557e35a2862f5e8e68056e9eacaa258d1a96fa958e3ac6aa0590e63c6d22f351d348d2ab242d0a22cdd63c61556b2e752c35f3ba1ab6fa159a1ac68a859ba61ccd5358b1cc7398a37914558b42352c6aeda70db6450e1447f3e464168dda73b4485a86af4502ab57adeab89265a996f077c551991b31a336010765b464421c0565c7b5d60cb5493fc45999fe525b3245268185ddf40a92c885dc7429838b9ddbaaf0bc419aa53af27c6319b5b0c29422514a58fc39a90a6a6ef65720cb77e8980d20eab3584d60bab87083f1a6e872258e90447a081627c3f5b8644d2b3bafcd33a9a3a0d5000f5815d5221397ec9fd6d26377fc4ad0febab522247502b34014727588ea88d475fdfd07a2ca41a991ae12b33fb6524e5fa3b02a97225c17e3db9f010a52995c47db8ca937f9271587788dbcd3e280c0905786b9426b92b962ecc1a240228ce946b05aa5ab2e0a15cb8b991a8d82e5b52ac560ae76c3a3848ac4c4cb93ea55ec9f4a9ada8656bbcee29f6e606bffc8636abc2e903c47f973a21537f14f7702c2e5599338e0cf807264ca0370ea0a92e8731db1e36fea51587c6b8c5e819f0e147f547bcc86a9fc650255352661a92c17c274c020c6a594d855b99c121d7aa824b3d5a6942632a1f43d21ca1d7d15e0228ee3e2107e8ab16688f7e81b13d28983b2b80ba74c67d88adc2329d383c83d5ff89ca87f890f8a76a6ede7f9894747f4a0b3d0e5403806ec5774d4930e2d9a7a8b5d50e2f738f1f17b5c230c5bec93d24575445c6c949cc7dfdb3b97b7e7a9ce530ee9fde65c68e7bd9353e886f2c3afe0480bee3583d5c49fbab4405459a2884de0c23af6d14aa9731547b25e5909685c5871375f474e7dafaf2ed2b8ef36715364f41d9506c0bdb5538bf47c640afb0915224f8af85c29ed4180b49fe4b119e43703c7564b5368d24334e489af476b8c152a5056bdb53cc98555cd595660dd34df49705b7ceab145962a35a71c2fe7e9cda0eabec086e0e4a2b406e064dcd8d3dfcd98b215db08884c87361cd107a29ad74cd9e5320dd80a7675139ea6997180ab0ce7e6603510372ce056b5917466cde0c9f70c9be620bedca861d36d5751f13376d587c31af0240bfe4db0f1de7f636ca272cd98b6c850ee9ecdc1fbad84766cd5035c3d5fd2888475b94d8e9dbf4742b966ee1d0ecee566e4b9d84c155701c5f793a4c16af38b111c7dee41393bef3d4895e3a8088b7c45858be8de195779806a67da7ef54098c33a07c6d6577a1d05ec5dc433efd15155818a0678753269ffa4077cd5fd9c7dff12bdfe292779916fa87888f61a37c835e50515c5e398a923ac0d50a953a27568295a5ce0d214a703e676ebc1491d0382016b883d905e3135315d263ec4a60e0dd500f307440fe2e27b2cea80fb73a35528757cb48c2c8e6a422fa8a0561c9cab84a6cfcf558272c7e80ee2d469ba2d026beddf67da4fc4f880de61829876ed068444414347acb224418ff2a49c76fd97f63f62f2775d710757e11273ed4c7b04d8d5aeb91fa033ed1bf6b56b4d174705f4e8f09d76a60de8233573c1ba453f39543494019daa1eec5aeb7eea5e6a7ffffdf79926a6a14c24a7613aae930b0e0b9f7252d790557ca4537700763fc97df0ebbf34e9307efd9766220e30910910dbd8dab5b68789beeb0d69d7179d7eff4c13971068d717bd8e7cfc43f493dab5d6ebf43e9f773be7dd2f3fbb97d71717d7fdeea78b8b2f1797179dfe97a57011677f88e5a5eb15200cc91e4cc4f50d6db4ebcf979ddec5993674a976fdf973b7f7a5f3e54c1b13ecaeb5ebee99f62823ecf7bb5fbe9c692fd8d4ae3b67da40fd9ffff18707cc8e7c9e98425ae74c7b4e25f786ace3d45f7d3ed36ec47a21d3aebf9c695f3976441a9e11d4aebbbf5df5fafd2fbddfba67da9889908b2f9dabee97ab7ef7ef33edf10034cee8df67da6d73e8fc8f3f023760c8d4aeffd539eb9c75fe2d35418c4cd77f9568d67b6b5c3bd322e56fa7e94744378e5428f9eeec370ab56b4d3bd37e02df423c7a9e88e335d91ccac62556d1afffa57d126df999038212a5916f130484524a114933ff9716c7fec9a2823169edffd29263944a99db5da59cbb5d48c8fe863c29d80856f24484185c44671ddda006a9233f9c2fce3372940eb0dee4da553299d7d5d18cfddd93d1d0923ce800b1fd0bc49e508de4dd4c134d06f62f089a76e62d434cee83880308c11ec7701fb2c21eeb5e74f6017674d542fce68014d8f6d668ff968c8a06551f1d2c27e886816ba8ac94282fe2002e57364b9e8c443fe889ede64f9d4f9d1240215f794ab6c0cba8ba059d3a84b21baae806b6a241a00a107f43a78a6efa865543ced67c1999813a7a5e374a105be09bac0d2cf119ab0267b5ab48cea85b81ec90fa3c39648deaaacc15576dd7451001f41506bc06e5d72682d9a077f9b91ed0af275f767b7580f8fa962a0027ac5680a0d7a4205eb0ab209bc8639923a275b8c89cac43ec8f72d6a12aba010551e7682ba8d4256109559d15cf07ab3d9db260b58e9327b19065991cf332f592d5d99c8a66197d78917a49b3b168b37cff9651b1ac46e51528af2fd10ddbfb9742816500bbcb4eaaf58b37dd5bcbc388f1609b7a8ce75dc9bb0118eaf7f2219f2f3221d88dbed69284d8282d3f76e4ccbc2789ae2448587cd1400d445d3b518350b75e6411af2c19cab3844d26bb9eec90929d89c2e5610dcc92fd35b9076f6cfccfbcf031be9eb1ecb87b1263fbc3ecff8107d73ffaa07ad95973b5cb587bb0bcfc10f9af13e3ff874e8c9fec2c78b4e258ea46f11fe33951ea10914ae8ff82f3436b7786d33b2e9c620dfae825e4b225bedcaa7033d4afb5e35f6bc7ffc7d68e55a72016b2d756abb4a855ba132c24ff7da64927e16b0d6da935bc1dae616f4c97b3ae3d7ca5d6d0b13be6fdcddb0ffc6513873f385ddb70eedce5ac4b0cf72958ccbd2e745e8245ef8a3ff4c7af6030e58bf9e3e6f1f5eb6efcdcd93ecadfd57a39bf61469ff0e5ecb2331d4c391cec6c73f0120077bc31f0237ef82ae21fbd1abdcb8ec438d310f6c8c6c0c3cfc3dbe1eee1f56bf0787bb11de2edefc3dbaf180eeec2c5ac4bccc13484e1f0f3f09e897029c39c8f3b46affbb298996f221f51debe5a82cfbc27dbe5b39039da2c1c8f2cfa4f2aee88be1c4c9dc57ccaccbba56ddc4f89e4ef77d82dfeaa3023be9c4fece5e0aeb3784ec79bf0bf2dfa230fde4f3ca377817fe0af783a1f1388bbebe56ce919ce743dbc9fd8cbfea365f4169639b0c970306622cdcbf91027f1dc777e57ffe3b8b1e1dc7526bdbbce726edae885ac6bf2f666de8f3cc38199bc19b36967319bd8e6e03bfe816f7e4be212746742606f1c82f94d07ccae02217bb826df87832b67783fa1cbe79b60317fb29671fccf378ed11f5a66ef2a043d59074939ac9ea234ad9eb72a6c1418bd098183ab8df1fdd236662fb9f8471d34bf91653d74c71de89060190e8f2af397fe141bceb43312e5ecdcf1e5f3d6327bb6b7e8599631bbeb2c7a3681e14d070c5e2cc3b95a2f9f6fb646ef8a0d0751faeaea013a575d73307d59cc466cf9f2cfe4e7a7ccc3cd6689a334476978b1ccc11d36062fd6d2b90a87f7e6063a9c40f7b13efdbde9ab391f79e63db91772e0f7ffa53c24e9585bcbfe6863cebfb6cb87aae3a71e59439c4b833bb98403512f5f31ec4f4230bb748756412f7b603eea98b33bf6d39976a42e77fe99b2983a2434efa7b6717b132ce793bed11ff9a29d893666f4cd20d6cd388f71da87b7375751bc375749db76971e7427ddc56cf72396f5e24cdf8cfe345cf4a6511ddf36281f1c974bd41f2c9dbbae713f992d66bbaeea570c58906b790f8e28b3a999e5177df3dd7a319fd8b97e4ae8ce7a391fbf42876ccd41dcc78f9e97f3f1c69c8f5e97cf376e94e6bdae9827282f516eaaeeb1e9dc856026dace343b46b8420f27642adbd57434b92de47bb4bcb5dc9f33f20a9dab37a3b7ec3cac2f892975966d327dc3fc71330ab75676acb2dc92fec3fdfd99a4ca6ff466ce46229f3f049f48df90703136be2ee64f342f2f299bef713ab8e41b856b36bc85a569907ddefc6663f47631d69b3f273a8de37efda5d05790ef2a3d61525f2f64fdfbad3906b37168f4c79ba5fb1424ed29bc4aeaab20ebfed17db8bdd92ee623a13f9de57cf4f6fbadf9b69c8dbbd0211df46286467fba8dfa3ee8fd3eede0741da6ea44d6e14cd5614ad7f0627ee3e56c89f0f1dbf7cb879fdfb70f3fbfee323a9b6a1399fa2523027b575de88c090cb3b60cccf6034193fa2a297b514f3cd6dd87f99898f7d3ed284ceb8448df3e1d93a8bf94699df56f6c28ca683e117d261df59bf407305ddf22ed9dd85e1a129eaf938c4d554cd74d68f46f087427de72fe98eb3b655fb059aa727d9e5dbe42672aedc0c56c9bb64f641a96b3eed618dc7596c57ef43fc03e4da5c5196f0c77420c7732137a9bd84cafdfa59dfaf0fab5c893aa3f69b3dee5f397d1ddd46f64c301c9c533dc3efeb4f8e3b727fef8ed7b31aefcb8b5ee7a8bfe68037b578e797b99e8c54389aec0f02aee7fde32f5bcff095b94c76342ba2f15ed37dd77157465ffc3f07eb4818369680e08c9daf0f9dfc85e0e26a2bf797bb91f91a5432e2af4348efb1b984dc29ab845d9048bf9e47e319f10b37777591ca3323f0c664fe56dad77699bdf4ddb1c4cfb8b39192da376551ee7de76c9ffe49825fbcbee982cfad310cc2797fb367ef35b059fc887b7bc2dad075997cbf9e867be3f7d7e7972e3be342b2b6757e47f99b1316f23354e97e8d33bcbe9c45e383bf2feb48c097497dea237bd339cc9d6e891c0bc977d8f5199a64afd52fad89b86a6435e955e67c6b397fe640345bf5255b719bbf2abd5ac5dc7bfd1db723eea81d9b8acadfe84b36d45f9d4ead451f97d92fde6f49bd11f73a33ffefe84fff17c3f2ce76426e7e2b7ff7c99c7b42777fab6b7255be8e76d5ddb48eb6f89bdb19ef6c4f8f87c3fc5ff7cdc779fcdf9883ccdc61d305f92c98084cbd9b863f44797ff7c5ae4186b83d925816444963d22d635fef97410932ceaf4ff8966dee3dfbc2c3c9ec7d6f067f852f81827ffdfa9762fe612f7375dd5f7fd969f1b3fbf3cb59dcbf62ae67ba3e5ad9d1f6f8bf3e76ec146757f7fde5aa3cefbec8fb2b94bed7ce7be303e6f9683a95cdbc9cf239239e19377756bfdf77fcb0d1af9f5be645176bfda2a576c8ff7f1a5ee0a2727ab741379848662a3f8046ebebff57efbf2e59ff0f3fd7c1a3f5f95de0a47dfeec5c5ff57aebe072bfee4debe4d6254ca9d0af9e5f2fbcbe5f797cbef2f97df5f2ebfbf5c7e7fb9fcfe72f9fde5f2fbcbe5f797cbef2f97df5f2ebfbf5c7e7fb9fcfe72f9fde5f2fbcbe5f797cbef7f9ccb6f9b75e55aaf5f0cee271d78fff8f921bc7a135e92c6ec7b60ccee82c5cc24c66c1a98b7973672a7e1f2f9f2d5e87512cf0b187e119e355de155237fd2e373b741d21363f2b2f7ecb8fc13f6ae8261bceb50ee49811f9f2fb691d7afc2b999957dfcc38d773747d8185ce1a7de55600eee84876df8537927947ac736f0e03be8ad96de99c23791d7cf60b981ca5b0dcccc00cca5b75f50e68996ec5239b1e7ef487a9a7d587ac93400f3bbeef2f9663449bceac8db70607697f37127e5817c28bd22dfa1d1db3d1983bb6039559e9a64dc5d3897c494de615ed96e53859761da4bd03be4918c9f5f9ed25ed32a2d628768671bce8b35eaabf4609878023ee06171072dd9351edd83d95d60de8dfb8bf9884f07772e0c33e5b9af07e70a0367fa6ac6fa1897f56c699bb35d67eadc3173f692d24bb513773fda980e590befee1f7834f8f1ede6fbe3b7bb6f8fb79dfed3cbddb7879f4f9df1b7affcc74f7b30c69deee3b7c56efcf3fbeec7cf45f8f492f14e2fa4f7a9b7ebc2fe8448ef579c4ed7576bb836bdc5ecb2f3d2376de87447863bee2c663bb688f412ffc0f5dee68bfef4cd1c5c9da84c78892e4d2fc07cdc119ea7d3c28e1ecb9663e29937c26227ef90a7ead2b963b0f772447b31e9723ea1cbf930d2b57ba5dff78fd6623e12ba172e67779de5ecc902b30b6b31bbb09673db83fd89f0ba2ff7561d9040d4d56216e9f0e2f926d93d1d0e927ca7f4bb6ddb24b2cf59cc764fd0b9ea81f9d8337a97223d5565597a4aa0e404049ecda66fd5debd87dbedb46713518e71ff287764075797d17872e3c1f0669b4a3359dec65ebfd3103ddf38603e7a3345d8ac4b0eb467a1b3a118ab9e66e357a33f0dccd8bbbd4519644e4aa4f94abdb9473fe3388783bbd745efaa6bb84fead4813a2521eb93b88643e4e98a0379a8f64affb83ccc4a74d185613cb625a7405c73361575f16adc8bfe611ab6f54c8fbdf53f6eac93e9e48b190986dfc7a3c9ddd05a38bbcda2c72ce1cd203c7b44bb8d4e1a4c28983f96b6d7d67554f47c38beddf50b32e9efcf97b24ee6cfc794d15eee70107905a6eab857a20befef97dc65b04c795ac62773621b6d785f5f460579ced83606e4d5e8c636c7d85bde0f0f9675514ed716fd3124a796d3acad16e4b8795bea9de929c8796f7a965be3d8b438f606f627dfe2bea261db2fd822a03fc5cbb9f40822b073f7f43c8dbc6497d39b0d749faad2e519ee53499a2601ec7a1bc399be65c7aebdad6af4467f0aafb7a3c63cd156be4f7ebc84379fc59806c3682cc8e425bcb1e1606d2d6697ebe180044bd1defb8f07c77975728a245ea92fc9b87394dd341c88317f4c8a71ca7a7c3267230666e3d14bd6db2c53768bf9285cccd7d56311be395476d670bdf3603f65ebdfdf7417cece5b843722eeda394074bae2d1fa7d30da980379da4f8e5330bcb10de7297562715d66fffebecff7a8b7988f3c75922755ae23be90fddee8093a5307cc2d51bee3853ac1596c8737a138f989849736516178988e677f92469c9e202a9e74bfe8dc6dc17449a03b56e3f550ccb57b0f3fbf5e164eb138aabde3d1fe24cdf44a9e3a82f7e315ec4d3bc2abfce16b491bcc7ac1ee4f64cd1f37199bed3e7322a2e0c99f1b7f08babf11799473fbc95ce8d8d49663d1fa2ab2b933f3f984ef6dd1bb8a4fb47a8673a9ca2da6c7e597e0b938652ada1e6871ca249bd6afd6104fe2750f5186d4bc9f6ce11bdd24a7479e2ff96276692f7bd129960727f2987d1073e3e778cde4eeede14d9cd07811a733c42f5b7e771362cc6fc45c8c3c38de9bd1bb78cdd4e1edd7ead3d0b5274caad648523f271ed379e16440d4b7a6f4ac8cbfbccf4ed9225fadda3ee655940be48fdf167527576aec99dabe033f4d6f46c3fb240f96391f8bf90446cfd19c22eb611c9705db549fc0b8791bdeefeda5b45db8ffc5fd74eab71fbf52bf5162374bcfdce6e55bc0ed6dd1ca39bf3a019f1bab8a6343d949f8d4efe01a47ea378ae67133b526b15e5c3c7e7fda3ebe3c6e1f7e9a374f2f8f7cfceda9f378dbb9fcf17dd17bf8f9b21dbf7eef3efefc7e397e1ddd4c6e8f2bdbecc9ff8fc877bc8e71302d5e34d7bb632fd1fa8fecfbc45a47837425f654e374e1d1528c51a76e4b1f356f7f47dd16e75b9d129bf4146da8c9fce224f134987f9c229ec27ce0a3f273d0d64ffdf6f662b22e6cd11c263fbebf632e50962f8704e66dd33ca93a72c73fc469c16957d9a11df2d4a61d6771ff8fbdabeb4f14f7fe6f4971fcffd64bb18252a52b0a087702b34a01dbdd56adbefaffe7441e9310026adb99f1828bdda9e490e43c7dcf53e91d7f713a6a4b1f193ef8b77343c4bb9750f72fb1b7296b3c56ac8955c1d3be27b575095b21f5df1995ce56824f07e130a9725ec4f638510d4e542457d38074a8cf5f9dcfd2f1506992df8fc78157f1ede74a12da1d4d7c0cbdb4d386e258426f670952eb91a8584970158809b9af93417f3bc9e22c18ddfd6d7d1ac2218e2dcdcdd94b69858ec0b0178feedb78646fdc2844d59ca83a87fded58a783dc13a91b7b64bfba42efa8cbbda307fed2f3ece36941d5cfbebdd49e6d29e6797f7c9c9edc2efdbdef6d2b32deeca536733a4a0bbe7dbae81fd505c57e065f15fc47ddf8e1020db291a36578989efa490722da1a0b0f7c0abd1d02df5b1d35bfde096cdd815f21dfb00a71f380fdfb40ac9239852a4ece2e0cb47dc87733f0cd4ef18c139fbaf48ce9779256c94eaba8e6f54f58ebbc3982bac9f0ce7cb78af3dd7d1c043b47ee3d9fe361ddb24a71df8be35fe3f0bded44809f6e2aba23046f697c484fe263eb6d2e0e085d2f5a4edb3840f53bc1ff2dd286578ec12ef7fbd7521eaaf4c573cf369325fad6782b600f94a7f43dd85d4971ec61b8b3a25edb89b4b9677e8425f4d6a79b61af6ac9da03f188d68c3bc4d8b2b15b410c4b96763fe7e2ab13297b0f30b79176b44c3b5a99d35d057d896fd3720475ef803cf05f4aee5eeed9c6770ee194dadc32bb5041cef75bf83e144f94d0f7219928291b27f2d03e3fe2f15ef2f113593233b50074a4dbce61510d6868fe1ec0278d13c4d6c70174cd28e083f427d25e5d882d273c1c24f76956fddbad785c993da42761afd4877e57a5fa1f0c3fa4dc6eca9e3c46c7ea9291e1207f7b4bcd773ac6ce9694b623eb15df82eedcc15e2a2d747fcb6c4e766c6f98f88be3115a13b0d38d3710378efcb1f73a53c09fbb63193a17ddf9e2ebf8427a73e45e8763bf83d55209ad4e7abed99d2ae461501f90b3af4e077476003920ade943d0cee20d9407f723aa7ca60bf4459acf127e33dec8f861ed09616b2587bb38561afb08e37cbe11cf19ec3db9f7ec080738837f39ce3cf16f904da947d2ce1674dedf427e0be4d99ceda1b001cf25fcaa4b810d586847cdc73e1cdcef4bedc3784fce5840997f5778e03e9c3c39dcc5769948e9fc43da8c942e0275d6b44cf519d68077cfcc8f37a75386bddd503f51b1cf4fd24f5bf5b41a16f11b43eec5312d65117763f8343b2e3e8338c60774cdd62996e08bef96a9bea0ae2047b1e51c45c8c57a5e0d4a731ad318609ac735f8c175464e47db5b1037ada9579ae824ecdee37c7e9c5eaad7c86e59086f4a656e807041864f5553ff91ebbd63f789a34b57f2281b4b5021360ef24644b2b92ce6943dbe1d77af49eed5dcec5ea2274f5fa927a193e142ee6deca1b281dc5b0b3016f0dbaae41bde9d8f8e8fb2f38512db04fc27b3b7b3e738dfe9785e83a80f83fab6e3567b7607fcf7fcfa7ca638091e4de27208473a0286682f55511f86313e61205ec53a6856d2dedc66fce378eff4b4e87f2def217de44d1d410a6691f46699dde742aec7edecd378bfc11e3dac8da13acbf9666b7babec9db9389df9881fe3331eaf5766f7e42119a1ff823c58cb1ea6da8771f7ba9ae7f5c7f2562e87f7f3790be1f8c38fbd656a03cb843a0e35f6ff8c31d8279f696f5a50433452f6ce403c808e85aef7905344da99fa1ad13d10dfe08e59d91dfb25751e16f70a3d590d906e2d8dc9d16923f805c51569183f914f78b73be97627e8be8f6fca9fa9aff8b93c9afa817197c0d9da93ff82dcfe8d25ac639fd16859c26ced80bd2c67f6727d6c4669591d6a9c908e8fdc00cfc4636fb9b8271fcfd4c46e3ca216ebbd0ebf14b1a6e0f678d1576337d35370987e257613c15dd7305b4741584d926b51ec527f6b1f31ce99f133ddc9d097507bb389b1d4c3591f4cd773a37fd7a3793dcad66ba7054c066a7b993ee55caf11d62a50f3d638f5f755e21d4a1abfe5ba23e11ee5ac00af3c4f7f3c2d28b952b927a9f5221f6a3e1a3b2f83ccc93fc7dcda584edee013f9f3cc6b717778848d3e433c036a26483ddade5030d7bde38b1b671bac518ecce80f8c1192bc58969fcac917457a3f67cdafe7c5e943ffeb79b185e5fb7e2a2f8a7b7b74e7bb0bf8eed9323f5e2da1d7c4b76b64ab5e681f7fb93fa9420ee3d763a90f96a9418f82bdeb7f22bf91b1c4991bf5208efc622f8dd378a4eda1d607786e3cccf251dd63fd78a127abf45c513a8ed9040365c7f5c3f77cfd22e41563f9b537b149ef3145fe98e2495d7c0b5e9c78a6123ab2f1a958eb4aee15fb4848e2d1110013d8841e668bce21b6687e848d74def7f30bff4bf6fbce87df860f3fbe011f3e015ea22d3707d0059f1c5f44723b9f3303758b70be2bd96863f10f84eb4cccac46e2f7d08fddbd2d1bd2cf11fabbd00d6f87b122d9374cf65aa9c32b8d6cd65f1b5fd50fd3e6b9dbf4df49ad47aef7d0f0d951457d61d0a48eaae41c325f957bba5f529b567ab6d0a720d25ed3b309df4377696c9cd194a8df5b995df8bb8d03ba19722ca10701aa3d3dd7a5415f30c6bd25a7af927a83519784726cd25a21d63a591dcf0fbc1e30ae2d8429c5d003eaacd34af7668b26b3a3777866177230c89ac6425f3cd807117a4dc004c8bfe37af217a573de2f5b368ea8ae50ee19ee480c5db27688d27fd07d9d6cb3df3fce197945dcbe51be17088add413e8a7379ec21ad6b4871a0cfcb494bd69ead5db426c419a4d658d642278238e3192b59a57681d8722329b2a3f0f9cfcbd11eff7b1ddd32feb7a63d37b4a1b6e45371c5d9e169617d25ae98f66758748cd677a9818b6dcca4066e9fd58cdcf9e20bf8a2b19f93dda9fab614aa339837b6a5deaeaa2f82d4fefd6ebc91f2c378e4bd7af2e6d589fb9f2435f5bf701d411d7efd94da9b4631e87bbdcf2dea7d96894f1163389fc697f1195caddee737e0d3dfa1dee7178f4f831d39fdd2f8349ab3a063b997c126b4cc8fd66a08331bd4ff3e35b76b78ee753846f9dab3752eb77ebd920143d05ec67242d79fa71b6f5a77e0bffc123cf33d723ab01cb5c127f2085617ceccaf1ac57960f73c8faa3c8f4be24b8d30f37b9ec7b5f23cf2f939b3cf8c691d1c412be67430721fa157ecb9d703c2184e7f1e1642f2802548904fbc6f1237fa13fdbae9c9fae25a80b39d3613a4c08bc293d3f9b631e42cff6bfb5be4743ca7dff31bc58d7f791d78cfafbae757fd1ef955bf7aae7f9bec7ffa157e2196e3e37fa25f5827f758063af5773bcd05534ebf43ed2abdb7ee9d3fbf077fe6e6417e197fd2fa2897cd35f8721b16e999c9526da1392e664aef6fa343f50ecc4034547b093d91d4d39d5fbf13bf368f4dd07f277a039fe73df8ef458fd8afa8d7f64662db1b95e8cc6c661ed6cf5ec4e72053671e243dba4af6cdf7b2b928f8bd4e71443c6f72aecf902d35f079fc72a534ffb2e65c1f3a1f6df333208b3a34896d92bdd761c642e88dd795e755f86fcccff18b3e20e52cca675653f71ff72953fb27a0d34bfa5d95339ab9d6d58470e36cb585274847c740799e94f3a6e8b248057b6d1fff867eb685f3c2302da1f07bbe35f9e6a6727d37d387afde7b6a7d255dc72a423ae3ab4e9d66350d54ecbf010dd4f770d290f2fb853450dfc349034d4736b88fc5bc8f1cbefa5821f77c54b720653370800fc7a346eb13bd9f2a792262f75f6c7016c8c671a11fac6c9c606e5c2c53c39c9d53bd27a539f2f57995956fcf434b52e7e1b6ba1b6fe82db35942d82c62ea39012e6f1c6de81f33cf6a46dc760f6cc67fec8ebb7d9c77216ff4e02db5d384311b6912bf9742f323552f25ff3ffda6bcdf40ce0c993ef73f54bf75789ab70ed3822d9a616fa0133dd9f8c79311467b9f7bd960eee593ffd7de15209fa3bd81f37622696b9bedd0d9ce7616ca75d07796d07b4f67632ea77b7436e85ce08159ace29bd309114d866cbcbbf2c7c693f5dd6aabee1d9fe2db417dca16664468c5339fff384c9efbbb29e92755db2c90f397b33b6e30bb8bd746a99e333ce833668297d09d603fe7f930707ef2d383389c3e480fd341ab33d3a587c962d6521ffaef4f8b8dacfaadf6f4c1fa5017c38fa785759ce9c99ce46adb9f382fd8db424dcc6dbe9b97969af2b84057955f41d2f5e2430d54d9df3366f0b331c3d07b39f72418c739915ae8ca1ffb9f67de5bc3ccfaf8bc117fc03c7bf47768ee69b0b6a3f0087d0e3c397cb7e73f2e3adb588eeae73ac9624d561d3ee29f65c9986d7c8dbd0da4d97c2e0ae9378c8c13ec9fb50dce7b3d2ca9df43fea9161a723c2fafd94cd8c70a59c233932fd57126e04791baf106e44caac4be437eedfcb0568eedb9d3315ab6a1215fb23486178541adf970d8be50e6e8217ae3fed895df9fabdda3d7a88d2a7ceb808fced27b95c4d2c9fae0aad96630073158e5ea10eda5043edc89ec251eeee0ef2cf363969b9f0a335fcf67d586fa1f06ce46cc03276d4916dffee1b5948f55b27049fa5ea9ddcbe6c98be561de5f29bd7fc41a241f01cdaff66db1b4577be072ed67e1bf715ab705dea0c8728e99d9053f06e7b53c5ec5e74751b0a2faeb52e7f871f9f98579820dfc5a6c1e21d79a35e75533d72f7d179596e2dd78c4ef4c4a6b5eee13f28f61336431a6047b392d85eec6937adb623e4ac61385f9b161a607dd63d19f29e6de437e9e183a91765a004de1bbf767f88a7d7f35d25aeec3cb3ef503e7dd77cbec6e6ce1fcfe4974ae1b98806f37efc5bf954e93d3f8305de8efd387213c455f5bd2426729bea1d85af47a72841fcfa48c423ab7056b14fa0c0cc61f675ff107a5c78852b8af2533967d379bc39ce62e92f66fe97c668a5ddbf7c1377e1ab43ea63e8e5bd07445dfd72b6473c106f64b63ad947867df07bc6b3c4a7968ed2dd5d01e88fecff9b9d6b16c86327d5fa6ebcc4ed077388fe3bc5d2e87cefc0ad8d50cef1d5165f70d1afb1add6fe76b807c1a7a107bed58cbb0a9af41b95fdcb1948bfd7572ed3f17a718f815b46df3313e2a5d90e30b3d9e9f62df2f9bebbf9dbec3bf4d4c8f1e83dea6b86c3cb77cfc7fb9f8e5690239a183f6d63bb65fdd41b56d94f307a07e765d7f4675dfaf295b786749af29b648dc535c55f4619af31ad698239df8da6bc21eefe0fbcaf2d7327f297ed7e5f3a807dfc9af3aac317b378d2fc1bbb962b1f36e619d34cee513ef569cadda82fa92d84f8579da7c3407ccf794cdf387bb765a7574a68e4d73f0070acc81dfd9b274b4a9b50054d944c9632ab32d0a3c3d43750ec9acf300f678b307bf2fa6a3b532cbf8077f4f923793f205b213e68628a8830b74cee88daf37d480c430629927ba321f7691b3054ae7e05b4b6d0436a52748ddd2dad9d467e85372183339cc8fa3f40b789a86b0a8fecbe3bc9b9d5d587af75a30033e961bbfc60c7ae1bdcdbcbfb9fd851c3327d2366e54a2db693622c59f2ab10f115f517500fc462afb4d659ddb6bcc63797c4cb107eb6d4ddd06e750a957a8980fc3ae8e7bdcd1ef76d1b789eb3f36f17e147cd6e2138581231bfa0a62101da33537bbeacaecb63dc0529f5fd62aed77347990e4ecc9c6ce1ea97f3b3eeb1c14c7127a3bd09d8f03af8ca7e93afef8d7457bbbacb6e3d8796f545d91d95df1cc0e94437505df26c3636adc49245f05a9652fbdcd4fda5d3cfb214be65ee1f7aff0dfb8aec37defd7fffd71d8dbec85ba5789df90d27439de95e43bddf1ae3bde75c7bbee78d71defbae35dbf2ede95e61b36c2bb525d78c7bbee78d71defbae35d77bceb8e77ddf1ae3bde75c7bb6e8a773166d4de04f78aeca512429fccc751518ea5776029c6b22c781be372a043d10fc2b9362893ddeeebe3bcfbea667b4f603c253a8fa8cf41f96c7eb5fdc89fa3d7e7cfdbc57c2e6ade2ed4f5b4e2bcc339896551ee48e1bf6bd79616ed95a6181cd516e2c9fbe3f0970af99055762865cd47eade25ff3fa5297f279ad53fa5759f526f979cf9b2a386dec8286244b79e0793dee3a28da2e7fcaa8990af6fd08af51498ec2cfa5f0ac4ce5bb6d16b219967f44ef6526be7fdba422e2fbb9f8b0ffd5640bf213e0f0c74c762ffe4ef3396d4a5dd47df15d47dc2ab2bb3bb83dc16161f38662f40322b0a7756470911fe274b6f85ef06ac27ab97a2e030e320a9cd82f3463978a3e9ff4d8e09de38dc39a6b4b34ceffcfe41f7dc4f78de7d7684565ad7e51eff024ca80df94fe8299e859ee1a2dd7f5da1b71b9336a40f3acb938d9d27e56de93a982495c7297c7c1b0c835c3b3ed73f10bba1d142a921c77462357d553a8ca42f95736cfc3802f92b05d652dbb0ce92c48415511f066b2ffd06b1ed0a463096956edc27b4ccfe227b8774ae89071765e167edf3d3bcf5311d7cfd3e33f5c2f570e1f0a71ca27e38769becb550fa7d992ef31da1f7365e37e69f18d7ac5c27b57f39bfabacaf0d457f60b676d69384ef9e527a54e844ad508dfb5ad3862ef6a4bdfd3a09967ced75d27e2e37fe1e7c9d9b7d0ff453c8fc8aa4cfc5cdeebb2b770bbd346eb046e2c35edaeb8793afe2bcde4b7b23719e57a14e50bf496d3c35e7e556fc5af0ad2f399bd94bc5592979ac85be16454622ba8a75958a4dd6ef9272bc4de0a9e75ad1d2ba5c726df7e852300ff2eff258511dd948f1c553dec9cb81521c39c2e6360cc32156e78ce15b24ed59bd73ef14af799aa0efc6706dea37947f7399fca47c7372dff9be39c9e3ff846fa67f030d13a4f0d836bb7fee11f7c379e2a0a5310af2ae97f67cccf00d9edef1d5714a02fbd8399d19817bd8cbcdebb9c7a06712b41e4bef0c15bbe1a1bb46bc368ba79cb19bcbe3acbf4acc85995f967ba214a75db8b2f45c6ef3c47e37de3fb26a562e3ce17bb5fccef5972c7d17752e75995e8df721e6653a4f56f026119f226abcebc9ef26e7439e13b3273dae7beacc8728e4bbce5931345a3f0cecfe0a58ffab5659ffc57e851d813f4a8ad7123d1e4beda6c2e3afcc197befb87b47d6a63dc663cf3d464a6d495a6c91e4a1c2bb4afb730e2af416fe6cd5d0dddaaf9660484ea41d1c21dcc5f12b368fc3337acbfd9e92db13aa2da7a36c6046040defe1e2f5f83b4a6929970565b90764ce0691db83db2059af09f67ee2391c95e74dcad701970d95f54964cb1db84f859c07665c9e8367129b29e7575ce71e46291644f89517f24dba77147ff54a3c94efa5438b4dd490a3c5be3cf41e1d7578203e4f57308e5e143edb3ac4ddc5fdea9cbfcecc81489e9fc7dcef29f9357a47dbbb91047df2396422cffb20f6c3b1ef03f17f1013b7978ab032d510ee36d6ef4844311e5dddbb427befb6b4c195de3bb197a18970e3c195be39f9375d69bbc7ebbc535bda10b31a7be6d8e7b823d5f23c38f7ad98c1fceda51d6af12c6ea7a374afb4af0fd0bfc232613ea032b74d0ffc8ce3cf2bdd2b43307ca093bd0fa2c7fa773246943cacefe7b7370979cbc00fbfd2eef4b6e28e627336d5e18ffc3601e53db4bbcd9b7748ea8b628d4ffd5c613fe71fff53927770c636462aad0f2bb3875a5d5fbcd40f28c12198f126fa5df35d4a9c83cb07a46178e5fbcb6b5b35cd5ba851575571f7eae535f0d75d658faf8c925a2bc89bd8b4bc9178fa92feb417e53dd4e97b56e2e717f993c3f6a260ea2cba2a6b87861f8cdaa1dca300d6b3b165a965b1cff66ab1e38a18fd3eddc3a398e16425fd5199f237f754e8e9fab1df9a710a3e7d5c8c63e57daa6f40c757ed07d5c7fc06747cf9fda0f88dcde40c0b4f2ad723785ca4461c94aaefcbe39535f8983b4e7abbf3a91147bddd3ed488b35e72371e1bd15719a3aab497a934177dc2448ebfed29b2fd34496d5da6ed40f84169de7f8df8ec64d0df4e6831dd049bc6f1bcf2784acd9855f28dc5fa704e7cae2e668868e29a1f4d7c778d995e4de3031c787b3dec904336c6fed78571021686c8d205d7fc8606f1822b6389d5322f962d17c40dea628ab3978af789deed6c56915faf15b1ac8adc932c37814346a4d8422ef7a3dabf4e6b5520ffe32f5a9d2cd5d68a7bd357dd571e2c25fdc662de2e5f7c12fadfda6637705bbd5d65cc39ae17e3adc7abd055b4bd62ee6f555c36d151d5b282525776f11cde1ab81e961353239edc34d6c413bbc9d97857946117c79c58b29765af5f530e37883d3174346ed7f3c4c1ab6df5d867b82006c5113b587872ef08f53d7cb108aef8f587c319dfb95d2c8a83cee03dfc699c7b395ff1dbe37f3306de72ca173fba454c8ae39d33347bcb106196ac1719c378aeecdedd06d75b03619bd2ab1319473d32b6aba5dabae6fbf962535579126c5f91690795bdb7fcfb78e68c5d8efbb1fada317c41967c65f2339173cbac17e1aa83c56d019e78593d1a63bff98a345ebc8f441eefe5fbc8b04dae44e3e5fb88d378c5fbd800f763d68537f76999322fc36f29efbdceded6ced763ea441fb7792e9349f57c6eaefb99cba9bc8c36f27d97ec1b05cfb98a0ce2c687ca741df5ff53741ffe7764cf141ff0187764b44af45bba0709bf33f680867fd3f9a87086c59a05ca7af43da2f2a882fbfb0c1942c1a8e93845e14cb17c69ca7a747ec0319ec27fd79f91c55b2bcfbc8fd43e65c45e7f9319e991f1036c52382783b0b5de1ad0c07c1f1f4d947aafa44ea8ee5994d98f34f945ca548582dd30ec919a3667a91d42f220cd0ecee62bc77ab2418f17eedc9aca33236ccb86fbc4883dd4a7816197d5a221c6eb7868206cc3a6f785fa9e863434dc0782863afb60efec1ca687d7fbd5e7e38a7c460e5ee6ab7166f30bfe0eceb5593673ed99f26e66c7fe63231e154f494f22e8b797d91e5dd6babbf37e1b3cf453f0548e33ccf7111b7894b981eeeb24eb7db64becf49c1d5787c6e45b2fa191d2ebbd4823c5d66cb28f9f7d0f58ebd6a11faf25aa4f339cb76cfce39deb14923cd6ede3bc9bce869d44613089df41d18d8f54db3cf9ffa9eeccc7df9af5fe4ace7a297cec2d53fbc78aa4370bbe63a9d0f26fcbe279999e5e4e8bbdea8bb90dbf7fafff6fd557abef4fe73f0ee7de5ae46f8afaa7248761cbd3e7b034ff81d643683d7e86599eebf7e9620cb30db03d277cacf538acf0090bfd86187135e80f27a8c7d552ccfa4d37ed435fd2fff18afde8df1c410a66193fdef7fc2ca30696f9b181be9deef69abdb4f2b39fcbf736957f3033ad2c478e7e9e54bfbbce1996ee71aedf38fcdd38b07e4c87b3c3549f1e260b4f9ce9d377f561d69a0e5adda7a1254c16fa417d1eb6a78b61577d5644ada48775e51d09925afe31ef1e177dffb27ea69116da91d476469a99daa9a3f7ebd3b315f7ee5663f413cfbf5b3aba91d425ef18cecfd5330ca8671e61773b88fd19bf9fefef81dbeaf979e7712e4b1bf2e0dff5b887ed42ee6deca1b2716469670946795d2cbe7ea8fee7e9f45ed49473f01d6c1d84f5d5ea318badbfa5f47fc6f93fa8c85524f654d958c23bcc219a414fb6c44f1b0f183995d84c789447a983ce6827fd89a745393d7e9f45d2f34a3076b624ee1de150965348ec59f1771fc8b62fd286e19de49ec31dd84e84f43bd37edabadcdb3ba3e95e397e54ec296d8fdc57de6fd047cade8a8c387e89fab2439dd6b3b5247ba558c91c8300b0f6e06d3c70df41c74d8c44b762b4f9ed991bf5fc3866c4a333cf7f8fe216455a302c16ff5d7cd6ee1eec552b32508f9c8adfc46b55dcc92df8b0a8268d25778087cbe5e3a0ef27fc3d37faacbf8377a5f78837ff8bd18ffdaa6799f5f766f5c5418f7f2b5ecded2305f361c8f4e409ec633c672b283f4ff4f8a94ccce479052f9ef94239f62237821c0b63670fc3d94ce8edec28dc7a4b6513fb447be8c97dcd7dd1b6caded96aa113cdcaef72f2dc48574df46c6f691814351ec2b4c1a06fb8a1cf5ab35f9eb734437bd0069cf7fa76e727194375c8cbe304ce51755683be9fbb87dc67515f5f66f76c6e8850671fad4cafed463023a6b78be7255cf57c3e89bfaace474ee50afb7c78fdd8c78a73bdcfc84a6764657600e0d9c8ee0993730a6713c493c61f3b4307745421c6ac273a6c4ccb3fa7f8d6c44cab74ee16f9ee70e7c26c69d43f1fe5e0bf8d396966bf87512bb055fff3987543cacefa7ff6beb44951646bf8bff8b96e0ba87ddb8e783e9496a05449b528203cf1c4045b29922c23b8be31fffd8d64932549b0aa7beeccdcfa40774926b99e3ce7e459eb6087aabdabd5f230c8fb2f421edb3257507083bf01a7ac794fa316d93dd16000221f29b29df44c15cfa10d16c6ee2331f1ff3b7148cc130c72347710ddc33e73effd97e7de8b73ef690df7d795da136b75cd35f41ced7383194ffe7c69cc0dfe569248a8ccd04e65cf70eef265d6264f57764e0bb2f131cb0a5399a899c7731bfcd82c136c2dcbfa08fe68d00360fc8f70b9fa4abc70ce16f797e38aa2ef1a8a074c9e9cbc8625eed3c3a0621a47fa4c98c7213ed7f0ee79c8dd930ef11ae078f19f9d73ad1dfeaea3e9857c457f795cfc8fe2859ae431785fdfda761b7136cc5915d91dc67403ca7006b93b5c03bdbf1f5f63f16d83bcabe44f57c5414de77129f0b5329f7a7e108fe71b60a04d7ecb7bf9b17fcafec4b284c21d154c6a65062df600294368fa0e93bb534ef52737bc1be9799afc28dfbddf65dc53a33bc1ad511dfcd6e076087f3bad371a60e966ddfd07c11714f9007050489684b8a69e0666f626a4e62670439d81e618845a95499db41e4be8d8b8f7e9c3fa9a3b228d7add7bdd9edc7884e27ec073b834a4337859425e20b567d86e1566e1c9d4f0640ae0a03062bfcc6341fc2ab8223cd3919c73beb45b8cff5de7b69996d4db79bcef1c8b61ed1e41d9a23e05ace6f0c01c6fdc3ad880f7831797231547f14d9a38e46499ad7036569f727b6acff3ddbef3cd779fb12c717bc893467ad29631cedbce03f2150633dc69d429d99f926ca917db21b017a0b5864b9138dcda3d6378d6dce3dce4dc0233bc18c9da9479431d964da16f4518e19aa534b095f5a6f67c41d8592f073738a609ebaefda5e1fc173f756d6e6db71b8b220da2f3c2c37b0ebcbf38469c779881f6371c68d306dc0fd9c1dec78b8f03ec320e6ba763bee919223946e283f4ccd0b632deb6deb397f1e339e1cb1b6107cb673595ff6a3e0cf24b140b6a73d6a78fc31d0d6940246b1e28d2c035988d570bff4d7ab308e70e4ec61aab4f44f2f56d7554797b8ad67bde4bd7a3b0c7f8b5b9ebfe937be0daf7f8a39ce0e519684f5f52db8a989ec46316eec569eebb70078c2b66e5f1a8ec880b38ce16eb94f02a103f295b6d020e30a696460dec88cf7c12da7c7f3024d252d6ec0f0564b0fb75f6d4aaef8bb2a64965cd11024313eabd383c071bebe5a0d8164d58f8fe31fc3a1607d47d878e4d556de7a7e4970706c3d9cb759bfbd11d76b0373f00c88bc23c154077c4834c091efeee1ed92194eebb8baadea946bef5b3f2ccc338557f9d3589e41979f917cb8fab727ad45d575997e30bdafe8b034ed99a9108d846cab359abc40bda7ff29a94697e648bf6bc1c64e3bacd29b213bc4ad79101fd495074bc7c960abfeff71dcedb8020ca0bf3ace2e3921d5265bcd5fdb0103a99fbfb45ea6eabfc7d9517610b7aef77f8d295f4e6adfa4ce865835f17089eefb8f797ee20ac20f7eae2ea17ef09b39d47704ff3f2be5479f8f1ec2bf7b4b8d6b659a1eff15d68097d66aabe29259e3aa6cdf01cbe3e3df65f2b6b963eecd59094247edba30571643d0f81a2a3b3a3ca885b6dca7b668def06727eb57c5294f388d028eea8c1f68168e3e6aadceed1d6eb4e3ecd9f1ecfb5ebe9a4b2a61675ffcbd73e5e7b7ed77eed6757ee6ab782f9f96edec3ac091c83a588651e99dfddb3ee0d7d14d63dd62161da76c4b3218957831e6d0d06c6391c1d0ce98cac5fa61d557eadc897ddeaa7ef6f7b96c365d5d898916f921cce9fecb26fd2edcee484a4ec8817e85fadacb937d8c69ae20f3a39847c15595ccf8c2e17ed3cc0cd464abf14f33914ef8e0df9341cb1afc7f265a8e7a4639c9ce8b17ba3c4661081bf5d0ef2e29046c1bbff01c64b9b6d30f4d8218f4a64f306fd8cb84b9caf82df6ae5fd8538633d0ae4358f8a19f6d7c987e170317e73f9bccefb2e1fc5ca9a46fae4c1557286871a9ffb023ca4f7e6321daec12fedf98fbc6f9893f92195e753ebdf649e30b83bd1a14278e6779b13fff4785e5c1f438ea0478b0b41704f8bd3cbcaeef33b39e4568fd47c259ce72b9b9adbf4a43a4fb43ca695bfd6af9877afe558dc5b0ec09fbdc76ae1fe53393f25db314c6cfc29d1c8cf16ed5f11717ba70d6b5c6b73c21ff49e7fd49c5addcd0d9756f9545b733955a306b87cad18bcbdc0f99416f30e3b50a6c95671589d0ec50d9e51f5de9a6c1ffe6ab9895bc1e2a809f6ea6c4ee0fe7d85fba75f86db16fb9fc99bcb77eb1b2ec5e40ce8a56711a7d7ab8f47c08dcbf1086aed8c2c853a1f614c8299f537dbef56b64fb571bb117767c8ebc0f32db8f7da0395e5bab5b406f0c20af2b457f9341f23db82e7792438e24eebb1f6c7f2488393b1e3737817716fa772f29bcb3bf5e88b96f46fe1d57f77bf9c24c3a72d6406f7f8951cd4ebf6fa82b24fcfe003cad3ed03c4d52f4e6407555db74f394b2467c962bd37cc13ae658bf672f2d7f9dd31635ac46129c58fa14faa903f1f83787d2ab8e13f774753d6fc4ea187f02c7cc692b92b960ceb1953fea45fbd6376ff5a0e42591a6c152a6effc589f3cdbec03bd5328d37435f5fae709f04c88fc1a7b87ef4ed9ef8e2f8578dea23fc34d85d22fb238afb3f89f214beec1072c3467c08e97a46bfd0e57978b8c500add4ab9e3be81f57c53b2df8f86362e75a9d4f5d5e43f4b8537901a54ad02f60f6f567c5dfa88cbf8ab75be2ce8fce3b6c3b163c4efdc81ee77539978fdd018be32e9fe736b9c17e069fde64b3cf6a38debc564fedd027f5e65794e6e2a45e568f04261767c99f9075356668557118463e5659e73a1889d62989fff297b34f6f058b4db057e75b06f7cf7460aee3f9515e36efffcd46a48ed7c3e62f4acf22ce8ee54fbad327fca4f537f1478031aba01ddb94fba159a8f39f3c4d72948dd7de7e1921f7988d11f5c6ac204e4ed6eb6e4ecd9f1ed16d8da1ee8b053235bc28d3798daeb0958d95af4f65228f7711bc779eefadbd9b26f8e0b97d7e0d24fd7baeffaec29715c689a08b4d72e3420ccdfab358d46947321327e8eb17a4ff5f061f11fe81b87a3938a07d7c1074a9359f83bd2b21cf469b3b62f18c36af59d3196fd3a79ee5706c98a7231232b5685ec3663ea24decd4b7c84e8f1e5a99ed3f48f550c558aad11d68f257bba7457a98ad2a0d72e3cee3c20febcf6c653d8271b6ae2baa5fc63f564ddeff28a6eb0d678e8cff8ebb5e1237f4e92f74d71be3f2d2b3057c52631b65c9d08edacec15916c3a489777ebc5b7f939793a1f1446bfd4d507997ac91ee0c038181675d5ca771869318d97531985bdf473ef58e7f61bd6325e63f98d4c0fc87f65b4f73ba0af42ef6ade2f139c0de0fcf3d14fccea60d6b7cff7dfd90c4ee0642656e187bf96c5ed03f64086d77aafd2dbce2ef4aff6cde4fafa62f683fc5411b0e4195d2b8a91c8cd76821718c046d3722bc9a8def1613937f136c7a2a0084cd03f411a0c021f1431ac37b752e7f2f6a7faec67a14db0012b1ad863865b7d0d74a2686076d6ac3f910ba2b82b1d50057253906ca07b2068e6fbe1555fef19ae6925ca46b32b9f95bbf3c62cf456d5eca971e47683d16ca37473215fb69e897b6328ec748b7f83a264edca5bd6ef10331934af28f3a7c00fb84300f6d75a14fe00ce99f274bdc0ef22e229463e3f3c922e110d55eae0e665f208c70a43e853e14fc35b2c59c7282eed08ee280dd8a1a04668d1f7a038ce2fbbce5961dc9d4b95e66999c6544fe96d84715eb030be94d90f84422ee22305efa943d6af408da590331cd9f56ca1d85c46dd933fa776d8e5baa4a3722db0a1785936f8f7969ccf79bc3db1056d8c2bda195aca124e75d48dced4c50c0a9de71b1b83c964111514ca81e94c3b1040f34d7c6c8024643d4fb9ab5b6742a24f1672ce52ddb9d857bfacee1eef219d8ab695c4aeb84a69d7fbb381ccdf40263a354dd8b2c56e1c6cde2998fbfb92f15f862a1fc10c65d2464091c94359bfabbe1706191ae38511bab34c7b07e19ee72ede1fdd931f4641ed11312ca522955127bf8768a72c3daf54be0a382331034b04d0c01a48d03824faacaccd0f5aa76246ca6778ece6181772ef31de5fb6105b7d7df0d1b75e66de1a695feff4ff765694173de330eb88e178d3a2fa0cfa82296f3598149cbf540e08e16f2b1123e43dc5b1138b70c332def7cf875c87fdfb24fd4ddae41ce58f2ddcae5bc7bd332fe249305429b9d54ff3680fab7badc55379a5e2f1f6cce81f6a78f1fc193dc33fe3a7c773fdcd5dd9f5bc2beafb91c907be24511ab636ab1a6653a8c6d0f39a685879493a4b8391b739ef6d6eb7a07155d6f4697d9bd21b140873c29235ed790bf81f7a01ef766502050182ebacf42dd482693cdb753a3e3167276cda5fc4545da8bd77359ca7a0b69ed359245da62044b493cb91f3a8cad440d90344587b14b92fb902a0d0ef0ae35b66af7dcd2a4a11ded9d030e728f0591cc94a9d8995ac6cdaf0181936676ea4301ef007fbdfc4ff7c87191309ee9abd0e50578b8570ed596dee4f93c4ba3d8df158923aaf3a9cb9b5333ee44a790d8ec4278665e9f4693f913fd341f13bd85403fbdac1604f7f418beaeb60c6711e4fc493e73abc9f975255f16c247e2d3b6d2a57d78de6dc782d7b17d688f77a9cee923f2d2b1d530874f7b9d4f7b9d5f6daf43c5f4f3e5112b2fc5c5e7cf706962cb7d44c5204ecf22f6eefbe7c04c1a17de927ae201ca879ea70d3cf7f2b481b414ea1c5709be4a62ad24b691d06e9d3f8aebd14959cfbc567787e520f73d2e0e1b56e79c7b1acf29e2bb91d1fa5ca1f66bfc6889343f9fedbc2bb77b3ca1db82f61ddceb4220e9d4bf02d35e01b722f8cfa4ac6e3e685d1c522ef78fb3b949f9ddf9414be3fd6ebc061cd29a57f9e7dc8df1fe96b979ce7e6fb17e19acb6de737887728cc87e1fedeff28b6160e121cf40ca5345ffd3c4f3dbc2fb9fce43c757f7a61b76beffbfce0f7bd3f9dee93c7438d531e15f7ffcf1d0d958e1f6a07dd13da7bbb154370c4eeadee9aad7c3defc97e79b7b35f4f6dde3e07b3730f7474b37bbbae7867b0f00731fd7d23df7cdda74b7aa6bc077b67939aa07107643d3f1811a9a5d47b5dc2fbbc073e1182cf7cd83ff1b66a85a20807fbaf1686ed51e3a8175353bdfa9afc3e143c7f10cb3f3bd4f11d19fbf8556549b22a8afff22897f91df56e4e07bbfffbd477ee9f7bff5077da2f74de93c74ace037c3da77bebfa920301f3ac125eaebc93c76be7f1d1054ffa13373bdcef7af5f496af8957ae870c072edce77f2a1338f3aecf5c86fdf1e3a826574be130f1d26f97ffddb6fbe6a10d1dfbc015b231e3acbdc7047c08e47df27865f1f3a23e0e976d0f9feeda1f3185a0e1cc3d2d43bdfc97f0fa95eef1bf56ff2a1c305f04dff1b3124bf0d7be41f0f9d7943d574a27f3c74c6edabae7ffbede01e02d3e87cff5fe2817820feef0f08015b730f87f50457abd3dd7b5ed8753ce300cc776f79e7a133737c6f1ffe50c36de77b5b18fb487f0948e7de3c793a84f187ce4add6fcc30fe9bf7bcb034cbce4367ae86fab6f3fd7f3b5f3afff7d059862a3033c0897ef1a60a01336a82f1680b9801ac9ef6f665e3c10fe3394725a6ab7b86e56eba0940a357c0b1f4bd67eef7debe58c551f7b6a68666d0f5ed8db9876d3f997ed4b07678b3bcce4347bb8466d079e8e88e0ffff51c7f6f0641f72d997cf66273b5e20a6ea85aaeb9ef022b089317e639fa6b7ff1432ffba3abc6ed466fbbbae543f0c87e1bf94223506f3f4cddd8167e150a0d6a302087b91700587e68e9b7376f961f907de2f6626b1b6fb95f8e9aabbcf56df3f6cb724373efaaa0ab797bcbddd4167435cdc29406c842dd73835075c308ef548b4d880cfd4bf7487e21be10880a9579954b8a0b8e2aed6e74075703582aae05cdda389e81a9a06f4dddc6941b7b6d83292eee3caa385071e565d840d438a97b23b8a75af7cd32016ece45e8aa1617c0ad52ec00fc9c1c609bb82d73ad2034711dc415ba6f961a626aedb18308b62a35f88aafd0c3170f480a57e1a085c0c4540841806d00966346a0abfa16d3bc61fa4117e2416f6f98fb867aba7f68a8b1f10c533b60003daa558306922a5b35c01c05cf051744a9e5f800f17aafba280086af0fa185fa22b804c58f1c6390fb5184d91288163fdcebfddc8ffc67c156250bbf0a205684a8320095e1250439b41582a0b260850ae701913bfdf057d7b7ad73e72123b6b93fbb6ae092f9df9a1a983daafce66bbff0c672d5fd25ff666be6db4f8979e17736e8da82a8da1b503701be8ae7870d354ed6deacd480ad27a4bc58702c4cd78f1052c46cc05ede9cf06eb664e36987b7371578ddadb9378b6565960557d8fcf96dda8eea07f8aabebd89897e639d6e101a1e6c2d39a6f0bfaebed723b8c87a5435abf03350ddfc6fcd0a4c3d2cbcb984a60a36e5572956ca5eea5b55dfaadf9293767bed1dcdbdba31bbfb50f78e8512ff90fff96601d357c32db042b3f0de09036f5f18d2c653f7fab6f826c56ee55741f19d79f6cdbde5c02b63e1bd57a8e79456c535c370afea857179410a43d92bdf03a0f07befc159c14be4beb028e5b6f6e61b30f5b03cf5fdc18508b9ab869e63e9a8127db3f70e3eaac43c5be1d6f36c54d906d9d646ef06baeaa28a123045bc0fb7a8f7bebff7deba40d54c802a86b755f46b5d05a00b2cf770ce5708d437736f79855796bb01e61bb036dbc24e06e15ef7dc029c0521e47f83f2e20617b7b00cf0776806c5d6921199675337dd23aae8e05a85b1c226805780c40874e27f8f85b37870e1ccb6a69a1ca568865ef72d5a9d307a173705bc4d86333a0f9d643b92d587ff7563d63ff9334c4bbbc969ccfeee460370622a0fffeb3a07105abe1a1db0e8c5ef072f340d7f6fb9a1aa45f4cc3561a16b86dd6d18fab93fa3dfe9c1c85ee6065a79d75503ddb29025f017555ba27b8ee3b9b5c5c1db312973cdd04ac708e986bff7a2eb1f2c3bec21384647dd0ba24d8d0448d12a42d493e1a0ce43273992d15f1bf3ec677f74838b1baa70cf13b8bdfdd5d537b0ab00587a74e949f0d60d26135084ef62e88340773bde094c751e3a49bb07d7d23d23f757f710be915f8bbfbfc53f7f3fc4f52048751e3a47d335bc7d77e301d5dd7cf1f69beeb99b301731a6a68876b57c0f5cc81e3168a81d350dd9d5b6f5521e065339dbe2f40ed8a66ec378211c186ed035dcc0318340ddd40d380334f8cfe610066deaf97bef7c69a84875b7beaadb985a96e1aa35c5c12565f651a510e0bb81a91ff66657b30c6b7f0075d38baa867bd50ddebcbd83ab94821a6cb04d3d376eef64aa3614edaccc20cc844aee0180f855264d8a5fcd23911c14d4dd27548bc45b96dbf91eee0fe60342ca1789b4e69e517adddd785f62b101e389e63eb022f917f9851c74a0d01062839b4cb9fd581239ddcf9026fff1d031d450ed7cefc492fbffb0c54bdb08a2b511691a35390d5e65a8e828c1f32fb12a49b50e37ad3cce6ae41de34ea3bae4b43f4e084c319e1fc2120fe50d96ef03aef756616842ae687aa07696dbe90e38655123ec48a3bed1287963305b309b84c05c8ea4c87a673cf234ea6c47162153de53962318099f5025c587da216509bd8bb98bb98ea3edce981098ebf9e1a6c51955e7e68ea047de4e5d8f60d68e49fdfad117dda107052dba03b5c5b42daff96d45ab09a34550dc454dc7022d0a526b9da89fd151b14684ca081bd12657c265947af7da3306d8d0fb565d43efa0f9c6a0b6be4c6d36f29a053326f6a850a4c5c660be6d60f413651cb703bd3174075a60f6b1f092d68bf6b26c6d77b37ed9ea2ebb35971fdd4bf13a63864eb25f5745e22eca9abfce98ed51636046253dde67868badff1c00ad11c08c11a105de20b6eeeb6f544a1cb49d577c06cb9af79c5551e2b592edc707f634eb0b39c710989268cf187610cd5f02971903e15bbcbe5833b4f6309d8f9bf758c9ad75ee2c16b37da45efe76e6e53fb632ad6b4d84d911727d0adefdf6adbf975efc7fd1ea2967e552d130dfbecd792ce4bfad44032d7beac07d17e3e8c0a54c1e250f9b8f79f1fd3d22863658c91af0cc480362997ab7f5442b0faf306bfbad0e5f6c3bf3a82ac13cb4ce70e85095ce30bba4b528ec5f624194d00468b92d4b035b5eb37641737e3ba3c95ec131b08122f18cd66327f98811b39defc8d2f9aa14ad05b24c6cca4439ea36efeb544817707d9befc02dfa019f8f8a309e7d551c3ad0a9529626873bea53bb6a3594b7b6ae5afca51ec40b8d3afb32056aac04d9d863137a00e3b28e45d6b39caf3374b014f86bbd05d82d5366112fa70f8c3e318096266ce249783524f62af7583fc1b1f07cdcead466c84b23c565ed94cfc909660192297092a533cb233306a6deafa323ccd803ada4a0f53b7a5e118d06ba43fa49647d940551fa6434006df1993d964289e82cf0759e5329bdc93d29de46fece5944a5ef330b982992de16ac4d9719de5b1c8de87ffefad283de082c2847bc6c13c1398747130f088cb561913fa8ecdfb348fc423c8e8bb2c56a393ac62ae38ddb4cab50564255ba54b07e2a78c8a699754b30773bef377a5fc2a7b7a806aca6c1c8613ddeabd081744f236f79313ddf593b6fcb3bf03fc6caaccc1bc1cc99f7ef3b6914c6f697b07a72d42034f7c14f307a2263eb9d66aba77f7f2706dffbc497e180f84a5024759fd1538f187cfd19464fc9706bac9efa04caeca9df23fb9981d280caec93aa564fb02691d64c274a20ad9e6aabfe2aaba7f296ff62a327447709407fda3c7dda3c7dda3c7dda3c7dda3c7dda3c7dda3c7dda3c7dda3c7dda3c7dda3c7dda3c7dda3c7dda3c7dda3c7dda3c7dda3c7dda3c7dda3cfd7d6c9e30b2e47f9cc9531a646cbeb0d3a4f1fc42a3864125e01c22d04b41fd2489842cf15b839914bf83ea5746ccab18611b3fe2a0818b4de2e09d33f5a117cbe528d028da9e31ec1606ae9329f13263b2a4f61b9d81c19f472c3f1e6df5cb2817b40c9a5928178d22f06d4de3e05548f38b4cd5c2c67b2544411cb630b071decc02691ef4334c9f4016bce3b6264c9cd06dc6dcc6329bf29ebc5e64413552f39f42a0ff31de54a63e383e22f8ce47cc63a481afaf45309b8049ce0cc8852a28633cca822d36ecc74e6386a442df02bd2ca8e14171807bdfd8ab01df67d647f60bc212346132f2e63fa3087696a378cce3512e50c96923af47278d0140198f7aea9af7926f0eca7a4bcc9824f0a03582811b8386352998ba4175f12f3eb7f0cc65266b509d3c9b8ad7d40c0d26acd45d7032125884e7d66080a34a3068b6789d4d73892da5c54695faa9d957a0f544fb45e28e9aa3f8ca6514c156c3dc3fcd35b1e69a091cd9f1daa66785afc2f5adbf5c60c20fe3319bddea9440716312c2284c3669bf739f531af5949aca20c7ee8043e33aed1e3f309f01a9c1e0885664b2998c69b651a5c1d588e9caf52587cbf4cbc8d59d610f06354acfb7219196b29e45783d4dea3a6306706d20ee3828cb110c0e49c8f07c2c475b633cdace20cd9404bcf96a9a88c24edaaac187f29abdc86bdbaafdbe3658e8cfa507ab2448e86c9a04a6b9e1cd6c5df524f06d4c33e80aee505cf6a82d633c93250d1a8fb2a4412d693ca5adb9eb4ae4583e3511ab852f364a0051593b3749acdfb0e6597d64403e6f235d69e30378796438b4af31b4a54a67df98da9b2438d9c6587390ce10da250dba266438d788d71a095b79b3d17c70f3dcfed62498d2c3f9d3a69860aa98fc37cf6bc60946f2264b58d39e5b00368d196e95c98da78b4c7cc6462180691a4c4d76e89d4a8907254b46c7453cae7e1996eae7cd9062d8c825f298eb2eb752d73e2826676757f0fc2b0c7d51e8e128e3b951654b4cd9aa9800399f4064b19a1403b7819b89e59a989cd73df98229c796357dab89436a6e61c67da92fe3306bc1d1f87639867c337633cc9a0898321953a663ca3698b26dc378b661c35a0eeacb1698fd5d9c31703399af68ecb8f8a7183ed624e427c923b69ffafd98cc576c533f97d6fdd4c3cc64bee29afab9b6ed07037f93f98a6fea8768dd0ffe8c4ce62be5cdd8cd317d35ed21aeac695f6039fb26c08426d2b7867ab83162cbe0de5f316b74c59f8d45db3d2570650d7db4dd4fa2612e64433f64cb7ea8080762cec22bbe9c6e84ab55c39eae9af64d68580ba1690e74d31c5e1908fb0bcc18b75b9d1c5298b3cc60f02333c7d0021e776e7063dad5d309fe3ac19461fabb62f6e98a19cbb59e0e2e9f2654fdde6dce9c4360bfd5d6f5e385e57a3dce9bbdd6efd7ec95a9ef7765cf7174e875719d6170c0e069bee3316773f0c461da167713dcb7d21c03132b2053291fbd2eb870a103506313b582309fa40eba4ae483fa961368ddee28c5046a4b65cd1d8d35bb53d6f36202d03ad781f41e689111effb222572e8e2f9c207b675c4be1ef3d830203f1dcb77e3c46aab5e7c072cc93b927b144cc69426ec0107cd112fb77b49417692f4431e95581e0d5d632e4a2c2baf261a776fee3e95b18e1f2d769a26568532843861d9abf52d93cdbf38e4567368376e7f7190d73ea93bc241a686619694753d3fc22473dc122604824f3101ae784b1470505deea85985f54fe6d352967ffbc62a9aee23d6b41cd8b9788facc2cf4d7e5da977bb33e79e24f9ec0abaa33934a1f5d8dcd82b6b5d2b8facd42bef752aff4d642b1519e74f994bded574f0a2acc167f2d67f72f2565709f51ec70904a03527926dd82d60d6827283b67b6d38e455271217a19f0daff1f8254de47cd3f985ed0bb8443785b5f1a19b13621de07909556990edd11cd51fc45512ddbfc96a27a7f9e31deb4c2a6124db1622b7d02a5daa8e37756dbf673d7ee80408a13ef74e1cd41a7e673979e53de311a6a2a531e0aa46e7faa7e3de881f58f53856936022a5a1a3387f423f22f7222e7f3e0e8ecfe5f0ba9448186ae157d2acd27c784f5db3b6c0d017636a37c38fc3d6251daed103f1174312ee821f03ca6f633dd7dd6bd07a5c164be8ae08eeda1fb29a1c4b4cd6e597c003a9848b442787d091a1fb494248bcab3fe26c2d52b9343d3a6ad4f957e12c09266fcb12094a8b66b87b1fad1075c6b8c8120f046618a812577597adcee73db0c4c9d28094a55417d37806d3243d8dfb89d0d5348c65a4cd45549f0d3ab13f4597d3c09f2dbce2efa644e928987168d260b647dd015f93446c05b76e7816a37bd18538c57723141fc7ee348a3c196b1ed48732d1a35026887d491367fab22b427cb1d4a833e019d151a5811fe96e6d438c68b5c55af3dd62f0fab4ddca17829aaf36a797d5e222af1e43599af55fc7c465cec8bdf975735198c58563e6efec0fd8c5643f1bf7a5c791b29bd327bbf338c10f223447f9dd525a442efa375b04f6fa4214eef92363cd7b5a8ff54d27be67bff4d8a3c6881345527ccd01c99dfb1b0c4552922b4477ce8b2ab124b429129c61a008d03e061c60d8218d1a4c45014c30e12622fa96e2cd849e2e6ff658f57c695df2ed126d462750c5e3214e5ec364d8fc4963e89d2224fc1173de96ecb6720f267c4dafc46711a1580957707b2cbdc087b5682f571fdf2e7b54a6e22e0915520eb781e4cf50c924f13489dcc2a4a1c61426a1e5c03dfbf9abe6ad518a93d8df34c1ca0fc551f049f25d6e6f2c5bac5fc477e2dac964716dc695e1c37bf6438fce220d935dba9a03654f71d2d2686d298354a585579b042986d97c888f10e21b36c60b5b184245a106916c0a73b6af3ab51575682fb9f32c25965b5ca3e4bde8fad11af2eb2d0c1f13cd17caa424124993a3bab9c4a06918994b26b31480fd3c360ae15016d43084c96595e5f096f85fa2772a030e8ac8ed64e90c044a4487fb28ae49dd9ce371c1c4a7d41908cc792b5362b44e49f8a81b2f6b61f82d01def9c585ee888ebade941256f1c71533eca1929ce5e405eef332ddb79ab311f715858caae7b38ab21ea9f791046bf567b4f0ad90f122c8b391b739c59d7ba5370f13596ba865f2c5d9a10887035fbb90549edfa8e01daa5e46d9d47f66cf08f9088bdc2b6b3baca35b85ba305c9a804eae7db3b1c19cfff726adac24ff63b5bced4e94fc0dc96be1705dc6abbff32cd6b47b0b9da361cf4151d7917bd85b18370b89d373e5b8f9a5f65bde6606425fb1b66e929cf2f2cc9c81e618845a4dd898dc2974ff79793a3d2fabe193129edd8565b1cc7fe36aae1868631d97a8f05d89154b7b1ce1b0343126a6afabdaaba5e76d78a254f688d923b8be304c5144bf6fbab63a792eb48ba5e8932c71f0de03bfd154695047df32d96482cf11f340d256383728ff3e180c20ccda7b7e01f63555926bf035b95a0a6928bb45b41f6c8f1b401ca765f45bb423fa4b0c8e5ab25ea8f923795ac85326bc6b029f5bcd5940ddd4c998da5e03ef11f1cf28f82cd51354065c147ab4d55d7e00d7b37257e835f1d65162d7c83fe5796c1c0ce91cd4d3d9c63056b9873d566554b530f9a35c1783e361db646b994522c7d6281e77262a3210340dc0ee37ece70ae5491a232ea23b4ca47be4a7ba3324f531423f1cf7bdaaff0683ffc68f5684ab84d496f206ef4ae25ba25bdbdad084373c670773c8b34c2319a4771fde83f0a0f8bacb93b274e66489f3546900a0cd92e28000abcf827a831eb7d09da1a34a675c52ef9446da9a040ee29a037a8e06957068c6fbe2e1279309c4b470cd46fca59025cdae86c04b658d517835314da489dd9fa2ee4c486c82ebe866458ed9a6edb3afb9623ff51130d66c465731eb9e7c0bf52dd1ba2de4357735a8e1459944722108b37e3e1cab316549a5692c10dff538af711f135b044362e13983fada5c485bfcd338a7f48161766feb7dd5a94da8bbdc7636a1470b811ecf89c15759989f4d697ee185e8dd6421d2a3850dffe64682c0914b57e99b2b8e5912c645b13d429acc4865bda5954928ab6bfa6c30a2cc8b23c6042342596f2865323f6993ed40950c627535826762204b0c7b7d15ceaebc9aed9792729dd3fc5779655f5622eb299721b3b402ca7426578d14e7bcf3adf74c191373bde87336cfaaa4bf5f098aad018e7a5d868a4903f04ce984e9d25b95e4a4678a9d8b8e7c59928f94da63459ef02daeb72056ce6caf10c68cdbb1235514fbcf57a1bfa2067d6d275e0c009ee6e3e1489a0c15950947aab0e90b53604b343f59dafc42a5b9d14b8f5e0ba4412b02c7ab6b8ed0699fe627ca4116157fce083d633d39aa57ce13096f6fecc0d4dc8dcebac079cb49b81689a1a23f716bcea1fda5205cf9abe2ae264379658b63853696bae3bf70ee36d401176a5720683dda9b0b7a4f158034a76d4abb821e2719ec72e2f7959dbc7fa537bdf9847f324465623e811fabc936541d9f3305e3a08c83b37ce506e244e1448927951e4f6992e8f3a441cf27424f72fc578118b0ba68049aab28cbdd889e8bdbc5c2010361651c540610ab1e2f1960f4c431e149008f3dcd1e32aac0bdbc4afdfe6a3abb9a3beec44de52b4f7c3bf140b8bef4686a456ff6bcc0fdd00178995f2794d2e37b02e173ca389c2da521b9a0d8edd2a119bea708f32b7f5224fa3c5f8b81210c172f844f3ff75866450dae26f0197dc2290ac92b73674668b4f1bbb263f7ba13b22fd4e43827069325181d0477f4bb460857c1f143d9114f2ae983a5c4ff5892fc9338d99c4d1aa873e25b5fa18d17016cfad213df5f912cb524fc1fcfd4509a03d1d6483fe09dc951247c415b4f2ef39e47e8134ed2562351247845d8894f73673650ec6dcf64364773ca2ec427e349588de8674218181409563d65c94d8d933ee1399d12a782c0cd9f7becca74edcb4204beb0e226af0ce04d2638e93b4578be02ce94c0724129a4b4d2affa937c5588d9555bcd7baa4df202719e190ed1e308722f38034526d801b7a3799309f6e6320cf42b3b994fb7cf8a4bf78d2b319c31ca56a5847a3a547edcd1d6604a77f8b17e549961a82c1132d51e775569f1aaac67312f78f976d07b9cf742dd64e58ac4bfc5a1cc3149b8b17746c4930b358b7ca6b8724cfb489d4afab090ce5c62795e2b5a0d71b61fe990c59c3fcf32e36d81b61e11e6728b97cf2532e9bb7854477494350b7dd1a0fc27984d11749948692cf29b16747478e5a12cb756d7947b1c6e2b53db48f7f56ab14b45a26db1c7fa0653b0e7443f4e16723ce79f06fd9ec9587ed982c6a67ee9ca04d2ef00c37fe71eb75e275b2753157afc5187f673020cf70c43e1f202d4d3e2f8e2b2bfd6fd7009d717d26d7aa2aeb9bd7ea91f9fe89013a8a38bf60d9900bd6d9f6ce67b76c759c8be594a03c83f3a50ee5ee1c3dc7935fc78f9c98793ae7b72fcee4d1e899593463248cd01bb167cf09df7bac70d2a44fb521cc170f0d0a7d786f799d9ce8fe4adedf0350bc38e87f29a1ddcc16f266b034eef5af77bd6ff83fba0ae47d04fce51251d2f73a93e912fb3ce44fed8185d78ddc35e0d09c277aded00e6617d658c3b7f0360d099dfa9dd062fb43f938807a68890f8b12c9db710afeb375fc491c2f050ff7015607c89e91c239bacda6edc37ee16f4fc8e7ac850f577b5832faf6fbfee3bf4fb2a3e47c9e7de2ddbde96f5e0ad65dc307594c3fb192dad81d5a29ff03b64e66b915099e1455dfbc9fd7a08633c80c59abbaa927140d8e6d7c8638bbc4b9dae1083b3ff1ea94ede83bfdcaaed439a0aa5042f1097423b27cb58f3471d20bf6b906d17d24625761fc0a9c1e5055be388b6e67dda97db3f4df759e19b29eea8ad47a4c18805fb9d688c63834964b987a514f92740d9fca1909224b6516958abccbfa32e2d8fafd02dd79a4ecfd7a325c018230ed82962ae7c3c7b6e8593ca77a2c21daa8c9bd8c2f940c8a79a7d2ddcccdfbf556aa2b1d5802beff6bf60a9ccceb88ae330f18e10f7d42addc5c4136ad57fdebf03c187a3e6dfda67039b16a3adeeb62aaf6f4c19d8aa7fa4eebdd5fc5bf8531460ae8417cabac4367dba375cd2628f6b53d114ed00209ed1c33426cacb1281c3a8d4ae808d755aedc7dac2c7030b9775b4bff13ca4f27881025f95e5fd7091aec7ca81fe64102682d37a39a062bdabd8063ed33b2e2a760e720c25bd4f792d4ab178a0ceef11a9ff4ec7be88d3df44b2b0daf43754317e0d94b5dcb1bfab5406b590208d110f464a1f9ace03059c6726b281dcc2383ecf28991079f3015d4d59681bf415a60a7a198fead6e615ea08a1ee5dba8e8c1737dd7f08e346dedf742e80b9fb327e74a1be489300a989f1bb3be62e694251ce81dd5fe45db686df8ef079c25f403e71dc524685924d8d593a96ebd5f0cb911d34b8aa6404d7d305fce669729a8fcbf09c3e6c96263395b7a1db1dfdbb8decb20237d6e3df08363030923ece9984babcd9d3e373ebef6ae519ec2ed1cfbab1cd0c0b64895feb0e2030b2162b8d5398fa0047dfda62a84ff9625c94c2c3da507e179d1fc118236d741a7109d0e63471509821f4b5b888ce3030a08e3669b3097f9570afad51dc5e59b392469d494d12a378403c310b664fc209cbff667ec47c7456673beff96e39124efe3a8e75f650ae6730b4afb973af74df49e5b195784611fd6db98e864346dfc0356373f0bf26ce4b01de3dc6c64277c4505d8b61f2ae4a2beb6966fa58da54843ae3cd7ce1b5fece3cd5ad7d5ed6fe6809305d2e6d5c54e8135e0f7b898f85f8e3e69bf168f1b1dd0d669fa13c3d4ce122874b8c182f226c2f6b602d8a4da53122f14198c5b5739e3f3dd6cb31507a828d87aa87d3a95b7a8d0e20779e219f48c81238e8972d4aa773c38d93f3452713bbb3f66b90f04c02e457dc42dc069a5d45b659e408c610f00d894cded5cbf3aa7c56fab081214570726929ff32c656032e6eeb778ae5f3d167594bf569c9ddfbdeb134fb9c62f96a344d74529b34e190f72968cd6f57fc019a79de9630744ce7fde2c4feafef18d36a490abf62ffae6aaffd7de0e6e79093a7fefc316576d9ef58a7823de2af802d83d9fabac32fe4354b98edf9ed8a7d61e3d81cb8af1c68bb37a2433e2572d749bdef60abb93f45b1872749ac5502de1326f7dcf1e7f24d3792c44bfcb9f78b3a5bfaecfc4dd8b12c717b451ad8914e665267538fb66f582fdaae795107f581355fc57a321eedb75607f7a47852205f07ed3da9b32ff7ecc6f3b882b18f253a68015738ff572c1ecc6c3b113033b650742d7d1fffbf5ef8c3f1e67ffe03e9608fbefb1352c1525fbf516d32c10ebef7fbdf7be4977e9a4bf5be54b0d47040fd8c54b0f1706b32c17e432582edc5c963e3f4aefd6f7d4c26d862d55cd2d871fbaabf2a136c7eb77f7116d85257090cfff91960ff3f7bdfd6dca8ae2cfc574ecdf3ca18703c7b79bfc5894decc4cc8a6dc0e6d4a95d5c144c109705f85af5fdf7af5a080c1830c4ce3ea776e5c1330175b7842eadee56abfb3b03ec7706d8ef0cb0df1960bf33c07e6780fdce00fb9d01f63b03ec7706d8ef0cb0df1960bf33c07e6780fdce00fb9d01f63b03ec7706d8ef0cb09fcd005b613efe8fcbfe5a1d51bd2442e7a355382db2cf3dfaf32727a7c89a1a37f95b91857ce686b2e8ddb9138a4926fa1464e05bdd4f876fbba938ddbd2e8cc19b388d84a73766fac8f47e0f57dceb42dc091f4376ba18f6e0d6fa2ce3059e9c509c4e4726b9db0d57b7bbcbbc144e78ac342bdd30c95a169578cd9eea2a4691cdb49583c8ad336ec4284b638da4e4a48ade226b3b6eaeb2d36c033c5bbaab256ed7a65c668bac475c66ecdc01bb72f6fe8ac16f8b24b3444b4f60090f7ea7df3b67df6894878998bf7593441dfc984b83c9ac4104b985233110a1423df47729cd535638f04238bb199ca923bd41916d8391664ccc7bf5cfe5376f928fd4f93bb9edf9da4da39da4eff4439fdeb29b1dc123a5d84f55f3a9d47babab009d67f0b833b8512f89fe1b8f4721124b66bcdee499ad3bd251e7a4637934563d9a2e1ef2d158dd926f61d2f9551511763f7d2411615f4e7373721abbe5f4749a9cf588cbdc9c2c78b765c6b3347258559694b4cdafddf27ec8affd8bb7d0cae715337a9b9ff0ceda96bb39624db44c16441ac1c7f45fe1e6228da692997f9f8e1a967a64521e076d3ff15bf0c0bf265aa05d8cf663e95cc4e6f94c763c1fe046e55195a7a793e6132fbd9c75263787f7a75b3436b479bd253750ed594f734651a18e02df1e94f2c1aa2c94256b325d73f9c877b51166cff8f1d9cdbdcbf3ee742b2ce1715d216d4bfd4dc8b3487a191e6a1cb4aeb47b79b437a739dacbb7cda5b74fa04d720fda77cee373d98e89f77cea5db9727cbceabe7993ee295a25f03f83ef4bfaf3a0ec1642c9ad1bdd7fcd44bb7c9917238f4146df0178b21c17dcfd89b7d0b9998dd65c35d6997d20bd290c9995485b2ff1a279d66b6590cbf45a7fb371e2eb89070f644525b7cb7a25918ecabc6ce8cdebda2c1417d655f273070775a9c078015f0b3373415096334fe38827cf10c9b09ef1476e7e9c7e569ad5f7c3bfe815549cdf80577e33eca1202f954626ce8ddb27eacf8c693b2fa4d2769df6b1829cf650f0a4a19e4c791a9692ca82a9bc27ebfc7d96dfc2d8723adf4b3c6d2dd1e9b3062f3dae4814f9c25c2032a110aa7003f96cbe95790712da4f90c55e7367107d8dd1600d3d67f6edec0f0b0b6994cb709bfcacb91c61e9635cfcfe9c676ca10fcbf7926c26330cdf63744b22ce5b649d59399897d27e3fed0d20a3188f2ef3f26ff06472547d6db9c8f73c9ceaa3ae67c42faef76962efd95f4c13a7a67ffc93e9fdf39ef9d9efb1ec9ffd5f7fb6756afa47af770ba726dade0aafa62e57e6d674df65ef5307a41e57e3d504904c02997e69a9575325e8cdbc9a1a8dfcadfd9b9a564ae778e6cdbfc1d3899ce4843f89f5d65703d541114a9eabbda0a8e5317544c89d4f961d423e4047d03e0b0dfbeedd0bee4c2fe9b2b013a0d0db04f097633a518763d8fe1dd3bb63d853491941d3bb53379117a030eaa47f445e1eb4c10168e1583167040f2d33fc69ff19feb4bc8e8e376184823bd5b7eefcc0db5a063cc0577554dfea6c5915fb70527e4bbfae3552bf1dbdbe1dbdbe1dbdbe1dbdbe1dbdbe1dbdfe231dbd0c35523535449df06fdc31026b8b82e2db847246dab8bd5b981e6effb3bdc492c7bd83b34e6368ef6f55e8f277ac9a9f91df407cd980d2144b6f1c73c7fcbaeb32c9fb7f8b307846704b4f371b0a8e4de13aaaa1e2e6c0505f6368438d9a03475e63d0ad8a2d438d1af406f6cc3337bf7328707d3973ced39017b8ffe8f828b0c3cedf1bd58d2c5cf81a1d85704fa9b3df83ab5e67cbe58b0d758b74730d0d0a7db4ebc03f7908b4555d7fbd2753fcce274a4faefcdd0b9c6e84f4f59d17869d8f5d7467167ae93d74bdc87a3fa47fb4d6164cef0e7b66d0817f9a3b529a9ee9815f5c04ce969dc439a71220f482c84687c24c8f1d193ac4f78d6cae1d1c6c4a41ea6b2a8044071f854d603aaa7b6804676c8292e956010c927908f1c28bd09e49fc15ee74c7efe8178b4fdcd2b0dedf1b0327bcb921f4c6d5cb3eac1261abe20daa807edf1c8f354544368a395e29d46663196505aa6f851dd3f5c2c8d23bbf7de43efc35de72172175cff12d8c828b80681f2117586ba1db604d5bba17f87456dc9d4dce32884e08f2133a83b51c430d2cafe3a0a0b886c9eab7226a7b31bd4bdafcff6197e51c641481d7dec6a0bdf3af64a1fcebd4e31d5fa3c26316d33350e0c28eac7baebe09c84dd00a086ad9284c07df363ba938907d1f780e8ad6681376746c2137fad7690dd382d608698fb6c70418ea8a598b09374ac1b9b91a8e785576d0de3f9375cea1d219a05991b6d16d141157b2dd0efcb13791e722f31209d2a04a203ff0f4f7ea9e8f8b4fcd68017a3e5742ff9ded767c2ae9995e4725e624d3eb681b0b1b60330da340b55cfad6f0f4f40fd221746e791d5f0dc2985b789d50575d3779883c1b11ce9ff594a362bf86bdddbb45dcf4cb8a331e9aa5c59b608b123342190032ea4ad36e4915993228dfd663adb7baf04429d02b214fee9865c561651f84e1fa445f232fffe56bb1b9a30a3e428163d1259505011f4d6a29ece8d13e593b956e9cd4ddb31480ab2c48fd3aab1c3a73ef61cdaccf88c56fd30f2f1683fb6795fbf81920754dcfbd871e3a7f57ea355aef2e5aedff49fd442f3a88e6012c0775022af77bf10146f8737f3812199788b8544e267bfbcf0c722a479dd43c7843d5c1c6f054afac814f3bde40a1feee5ca09e83a66a6d33f0881a319a40a7df60a0f7ad8adb625117e810456d3123d56c8d82f68dab4937e106c0c4464a7ded9b107fb73082216c010ee27c53f044906e006b22d76a3ad2d4bcd6041444b4e6dde107aae9a80d819bcf94306a3c801913453574aac1558350a12e7e411f5a6198561805870b28f4ae0741b1dee3cb2f8de12f0e22ccb4b063bbdece0535d3d79a8327da661b9c54e9a448be6dfeb440d67bffb965b26f0eaa837f6eb98aeb85e4e1dddd7edf346c79d310abc7437ac1e8fbfee1f7fdc3ff33f70fbd8ee551bd89de45a4c7ff70c6af1a8e1582eda3b3653514a96c453165a9c408d6d936842a23e8fbe1397efcb21a1c4ab842c9c6b0a2427dc45da1486113ad911b597a0274a1b8ac119b68ed05d6b19a42b6b49c8007830cb2d3b6ae8cbb84cc95f48416cbd46cf9db128ab4882beb2e1d0591f50ebd852a8644f7bcc0b0dcf2ce281496a207e80ccdb042c2f40f1583982d2fa189b6c88d2a9a9b31359596bf636f475d9c2a2a7751b4f302bb6cf0724525c4c12faa8a6a5c5482e47bd8d22bbe34d054bdb32d7f59560b2d2aa114ea6b646c4aa764aea88c6a0ea08c368a22b8a95d851e79647bdf56beafc73aaff134c8e0cb04c778713005280bf3009fc52bad97fac2058704a983fedea8d88a0e97c012b5a80ec841917a01243941ac070b09a9544c3f49e9ad71c8d03bcdaadbb20dc1c0fe11051b3dda04c8688a933b026c827169f460b7bfd83127a0cedf1b141c888f61dd38c62a6d0d0089ad500740859acb10f1d8a84d005160a9d83aa2a01570e231d002235155da61419c0dd36ddfbe300a90eac407b92dd0e888d6e3850804ebfad9919c3b56958314d6499c88ea81c05df912103d16ac85490f416aa1dec153b6aeb30994e546617411eac224213070068692e3ee5a48f7d278c65028ba0442c7fd125888a28bbd45244e645a7aa32fa0a79ab5308d98590132f528ac85dfa9d6c5ae018b400d0c5d1e3510bb9a7e88d65660fc0be2921c3aef5e60a3e434b08e9b54235105f784179f90c159612a2496161e6026e96545f64643818b2214d6979eed7da540c0038c067ad327900b3b581d85a2967519b635f182265287d04055ab45afd4e0da60b5f8c04a7daf0552abea4e0ade2750b84f5655d4256bf01265b21570f3665529a43528f57a6a1d6295fada1ca74d65016a5a4956c76dd11159b4e60dabd298eb506a54a11ab47afdba06b142ed6e8ad1bc85154afa658ce65554a9f4352854736f03dbe20b2846f3e6e454fef6182d9a96c36bd1c06a9b431d566a546809fea93a2abf061461701a2f2b4bf5d8f8557e5f6b8d50dbf44b9895ed2f151333c578635a2e1115e397b011ad93b86d67e0d47bb9f47d51d6cc973aaaefa3a0ac34f23c1c926aab4b0b9ad9593179d61da3010888b30dc13a7027318c9a4297cfd41812130f927345b51e2e3522e575ce229283a2c0d24b65e418c057cdbabe4f0faf6acb3bf4a4a212e81d05c8d5512948a0baa11f9f979f1502dd8e8eea0a3dd70dbca8725d11a0cc765609b3f61c04979eabca6d74a8fa4a78df814decef0dda643ed28e4f82324fb1134df262a391cbbb2ecc9054af4b0eda2910bc0b3be0d19d1da6f82d98f972a317bff63d28387b9d1c61fedf8e69a97b9e6da10f35c8befc4f0874e9859dd032e3f67a6167137b2dde3efc65f2949c937fe6ea70632c9870d489b002a321e1936d3d0790de62bfa39f751180ec57c4891405cd80930da429683a231ae21036d20616ba8cb29ae6387102938628e9ebb6f0993f5bb4306ba36f004fd49c66a03494414360a4e2687d6c069c2efb53f9a71133bb701b0231a36e8311efd64d5b7a267834c169da264775a964d11c9832c886fdd4aa53fd00192012375ce8602ac696433d2f9a20406c51ddc24de9b71aa8e46fcbfd280907518e939a3d9bc026a7918d61d329d70c698734ea8cd41cf864506d87963b116c81972e22103789be82824f52289f99a7d3d23b7292720727419d6d174e0736c5dea7d6fd5bc4b206dfef48d576019dcd741ba5eee09f096e4d0f47ca42119fdfa06800752166f459eceaa670c985ec1ae074cc92f0174d602fb4f73bc6f5778cebffbd18d78d223d358d766d8e9d35633c0f8ebfad3fd348d6af698e73885cfdb6592d7d5677c4cd8aeb47af5de143e5a568b59c6ea71f0f7b610ed15fe107397607a1d6c59102f9cc7929d2f9fddae0c58dea0a5bcd9ad2a871138820cc10986c54dac7f13e8e767dbfa35107ad7cf4c9f1af5304d9491c815b24110fd706df3fe422dc3e9745527e384541ce46357480fec88e73b47a85fcd7106d52f8d01dbc4ba36a3e4e4e5170f9be337e9e79ca7c4023820f4804c8d572b6d5ad01a3f22366cc0b71be70f9cd34f8351ef3d9a8e06f9b4c34491ab12e89a20bd10585d088732bc75191bff6bb24cdc17b4316cdb9f8666adc8ab63769c3d8d4bac686d4137ff386e4e4e5fb5bedf1de2a89b69744ecb356dc7e6bc824ba601c05dca691090f6dbfe93c62f7f8719c8d4668412ed7954c72e45abfad42344d6786754e38a8cb0143a3184344436c405e78c73866c67340c7f383e4de7d1c6422e2eecc15b75e6b8ee16b8e4efa489b0f0e1ab70ff5038cb9686a71cef20b630b6d1c0dd5a510e835fdb05a4e0eaba57ddd37da2497fd71cc8f18831fc1fab5212a30fd567921098b57ab36ca3a9947331e1f5586dd1a728f11f9beafb9b363616e9da2333f9e226266dbaef092b35a4aa1314a73e09a632b1bdd7102d18ed70a3f6256f342bf40c44c5e3aaeba133f9bcb7f21f759cd7d33f5a44da77124ed1ef3f1f743b45a951f6d140e6f14325652347e9e6c357e7fa4f0f19c3ed4cf6745ee7de82e5d3b76849188876dfba1fd18e261e6bb0265699b0689003a60b40344c4150e68396068f9471c0956f15724c2e8d4d41dc951961380031c5f23fc4934259b3193a8be639eb4e7c2bc953624a7bf38fa58715218f35fc94ea31593bead99cf0ef08bdc78db8aacac0d79cf48690ee381b392f747657e153f5baabcb4193fcf58dde9c1376f350ba211aff1f859f858c97bf81f1b8f83ae2aefc331bf5e1b8e68ae64e14391f75877629eade5be0bfa994492b52fac9792c8c6d57dd2343b410e9f461c361c6c2bcb31e199b30f73377b7ad8bf1d1f2281190dde0e0c233cbded5e17f6fdec6315098b076eba10f7d385cd4dedd1b07e9c7391c60b73348da86d8e9dbea53ad28771794cff51d24f81214f308c8fc14bc77fe31efe9aad77fc9c46e486f9b1361e07be7e18809ce2a8b2c1ea8e648f79bca16b2b27e75c9807a73d32c9f250d58fa5d19ddbf34249eef9fa52c2e3b8becc3ededb2abc6836daafbbd24177a48d319a80ac023ca48ecffb9afb76256f1358dd9d80ac696aae14ad1ce94078dd4811f4439657b10bf1026fd664bc111d690791a257cb69a1feecbcc51be3f1babe5ec878a3ca2cabcd071b43662d2223712b53b2d96366be34d95fa3d5723d8779a5cac2ba8e57a8728fb972ee0babe5dad7965234e67bac06ebc01a6c156b202f96535395efe3f932520491bd20c3b802a341be7b4e1aac3881c80552322fbe76fd2e40e6878c3ce321ec43f1fe1fd74df64356e7445371fa87319fe8326fa602f24b77ba190f251fbe51b71e5cb13b5b6bce6cad3bb3f7c568b2981c065bf2fe79823519b39ad49f8a78ead6f703c9d24133b054ae931bc9017dd6781eb0c6e3808bf7053276642f8ff729e9307e86f6bc999025017413fd303828b282614daf38e065ca41e318ba1726593f76a7bdee30382acb19ab5fe01306f0edd12953caac3bd91acb87b63cae64af78b0ae9c1ff334f3c17ce0c699372afb69331e91757aa8c35178e029fd5e9c018aec0fac0e7df96c788a7c6faacb37535d4e4dc8f2643cdb97d64d3ef3cf17ef098baec4e8cf1203197188cc7d922349c614e05b69447f3ede3756e732a3a33bfd88e2a5592ac67c9a59c1d4398931960ff5dfeef48f7399059ef1099df60bf4bf44aee6876692e58bc8c7f2c885b5a55b83f5782478abe50464c8097dffbc92f720fbf6c63c8e0027234324f349582d2781b29ced347e04732aa1e9aa4b056bd6c04273aa2f76671e8c81d61512fab94c19631e1fc7cfd2117898c1ad01d7a773106bcb0183e617f6c484ae146757d139e07d5fcea3df48a685a540be0dd60695430a7249ffa070a38dbaf469e6922abb49dccf17f6d1adcec4700b4e7162b9f88bd7565c8fa9770778c5618764dfa0ba24d90fe8dc5262de48be5b77a41032baa4f36c394db3f8288fa77941335e1c555e3a34fd6e9189a42fdf83b0f02acd07479d973e40c724ed97ef4d55ee1d0d7eb4597122f9ce957c6f6a71ff84e367815d7126e12babf9205ac93ed8c80ea06b41268b640dc21e0d6d5cb912d9f39a7ef7ff8afdef59d829b2e02b0ec689edc748db41f69bb566a5ebfea876c5a6bc5154797c504683b5eece7a35e369695c3fbcc8239f67074316af9ae712b7860c4c202b129eb5904707959318b0f1c278aba4dd22ec197b43c6073227e6831decc12a3f3a2af301f0c18be349fa2fd66b934c63d77d3b0f7b9e80af9beff11e6f3c4b078de882fdcdeb235da39cb0d640afe260ef1c7d902c52fcbe377e1676b01ec6cfd26eccb35bdd21fa0bb133c1ba40729f1df3a3a30a3204af6c756a9bd0ac810d6b22d64b091fc1e8797658cd63f95ce37ab097c47593be06db8d6497c9f2b067c5eb4e80ec658e21ef4334a7b6cc25ec556017a9df3bf4ee606b0cf1462772b2807586da7fe4b742df6578ab33d96adceea2dd4f7146a1ce5d3f2f413f3496930dec151a771fd27e3baeb85108eb0e646183c3a1f618eb05e49b80f7ba02b3927b90a5ce047b0ff0d9647cc016a0c03987dc23e37dd19eeb0a3bad3b9b9c680aa067bde90efe68af0f95cce3c77194cf82f389be22fb2dbb46c49e0ded1af4b4ae48e789e16bfc9edab4ff34757ed48b6dc40390edbd98470fcffbee82ec612c41fe1ed98a34d8824e8e6ed217136125f7d895bcb3ae5ad7f68c6436039b81321f785a57a7bafb08ce722cd01b146ecdc01a7e5dceb6af444f03fd01332a5dff24a3eb52600a36c548e366587bbc37c1a6a982dc46741b29a19db43f1c0f472cd85e210bd7eab03345a71f6a3cc82517fad5619f68e6d361c9de95e593474516c0867523fd73b2d63991131e59b0256f15c27b8450eb4af6ab9c9ceb0c882e7f81d7739a24f8c8f97afd47e4f0afac9efc09fb0ca72d85a308b66d6e8f45b2e749c4fe7751e76dc80b8de7097b137b77b2df95ee4f02c823169c0f681cbbd3ba44af03392502fbbbba1c84749ffea067bf546e1dc0bcdd288f038fca6f8795dc033d65ab580fbbd7c5309c3e3dec627bddbd497972cc77f93fa9fc9eace3642d501d20fe6e7355e0d7a02facb87ea4f37da2f7002cc000af24fb6d660cc67c9cddf0f571b0d69c3713e40dc2ab1c38eb89eb993e32fbe9d30359df17c6fa17ec8b6dcfe4cac654ce8ed9d330a5b5bc6e9c078633f2357e64a9f2de379e6d1339fd0dec6199f3209a95512c9ee196ee618f56fafd56365b5eae3d7196ea832a4f805f1d804f29228c29dea824bb5cef59a2e76063677c109eecfbdffce8e375b1daff7e7a8884a7c97afac8ae571fe6fdeb62bc533ef4e3efa789ad3c8d99d5697d5b2baebf49e47fad3b996bdc1ecf78c951e59e4fe6ba8d6d68573613f124ceb89b59f7bde32bc9002cb02b37db7fa6ffea16ded9d87e7d7c705f33592bf5433f976df86d29312adf3f9c74d4fee1ac5d6e6906496823d8702c037c02309c354a4345567ccdc184a7ce5869327b2c64f24dce5e44aad365ec30853942b31c3e9c6723e5b276169229f46c0fd17232bc94d808c2957c5fe46749d653738ca36216cf9cee7996f1d42dd81c1e275a31ab68b97de22cbb29c8adb601d9c72fb483eafe990c931939cd61d7ba1361e37910ae64c8ee4cfa687eb28f9eed47bb8c1de3525f676d1e6e31732ed5852fd1f84b7194b2ef07fdf112ee62ce96644cceeac6b5fd9fdab0cafbce25f377a077055fe17a84f7a699c11f279ad2957ce5717dca105e9afd98aeb7f979866ec3618f3a93645add999303e302ac0ebc461e1d750ebb9a43f6a538136b3acf26479d5b4b3a271d607d8c9d38a3bd4e32da33d699dd3563b39ea7eb27f7ce53e595476db96759702becdeeecbdc0ec7cf67efbd97798fac9fd7c74171ce928cf0e2593fe93ef9fe9b661d4fedcd246b74b15cafcd2cae447a57f85dc3038ae79cf93918e38fd0f30c6bae4033df66f7c7ec7acb67935e48c264c1e38db15c67f0cf32a416b2dfe67864151fca67944f6cbf436cc3bc5697b35e8e8f15e53a3b39579a4d145eda183c669028dd1bbcb42176285bd9eaf6ded75ce9be8ace1c642a7e8f759cae3b3217a7f3fb5d09ce1af61029d1a744d6d79ca2df5bd1ce437578b0cb834e71768e9ffc263bad3b217c55583c1c85a7937c54d833925fec7bc04b1f607b100bf413998ae29ec95a27f967725c716bac3c4ba122cf46069c393f0be027e6a8f21ecfe41ec9deaf9c9f1360dd81ef1f318ad8db1a3cd85d69e66b0cbe588aaff1453e4ee6d5d6184e309c0b2ad2ccd764696b2ce3f52f8eded8458edf667c5d4e36b4b36fc9c88d444728f7f538c91674fd301a2bedc057f02cc33b13f32e91f02e565cc9c67172b037195f8fe2be90f33579e3f6203b54eeb5aba4dca6d98fb3f32cf5371c47ab25c85fb14eab5b6ceaf742dbef2bd61aeb4b69ad3d4fcff863e10cde7d99ef8bfca270de4df91d07b2b380418e9a74c95c3edbcbd2f65b2c590fb17e231d5ee513cf38df1f93ef9a24b250b6ff5cfdc052ff4d36e3bfd97349bf3ec7fdfaeae0edeb32bf4ecfeb19fd329613bce80e88ff90d63dd98acffbda38c6f6846104366ff5c0465a17ce16d99dc6e18df13cada8e3e43718fbbdcdde28fc595f29dd6994f355b358f01b8baa654669b3ea4e7a54867e5596b8acbf723e3b97fb2462c19f03f6166529d4f407eb27f209f8389cad0b8ef5357cf247991cce32c347aba519f719f4c7e3faf29c2bf4576cdb4adab03a9bd735b650b2a726731664166adbccc951593d2b231be5f6c2ac6cbf5a0efc1c7f7f1c1fa64fc3deebe2a1fbba78d8e7fa2fa39fe633df0b5b6d39600d5e049bfa81d8a787ec7ac5ad7dcd9116c4462a0918e65bb62fd20cdc8f6719d4099f4be484c218841a47f970c27fce641bd37f75f65bf04b847551c03fe9de740c147a2ea65beb50917baec19b67e342f55f227f4d1f41062bcc9daebfd51c09f86838e6fda3c6dd7b13aec76afcae2047927ea4fe6e3097a50db4f17c2ee67ce3c8d897f551bc1794cc3392f5bc28a3d4ea5889adacb8c6613d82cc43f715627bb657cb899dfb26c23747bb952c80bda0629d263e58b3dc1a49f5c6dc5e07fc2b2327e575d2bc9ce40a3de0d79a98c8ef929db541e4fa85dfaf4f3c60e5c1b7bcc03d82e759efe5b952e7213caa4ca63de935334f5d4e6c911f1d8c679bcae979dda3461726e31bdf61305dcd9542ada8af537908f60e83c7e7b623d0fdbac0276339a05e172bc215d718cc5169a780df152fbd69dcde5f75ed32b9ced2e4beadcccbeb39b7cbe7c7ba303630a6d4f626bd9dec8333baa62bea007d8e15c0b73e8cfb52c0c47f6618dbebcef600382f38e751a93c582ad73b12a7ca02b1a5887c1fe66d2f23a314d7d151e7fbcc3c3da7968e271953f7816fcf78f0699e1119e4c298d3b1181c54196c43b3abea15616f79d4c3f1a331576483d5f8d1a1a47f601cc0ee17c25888cf93ade2602a9fe6e61bed1b768d46d03e017fbe6d0347e3fb6be3f132de421edd8b60bbe3407fafe0b5e9da2fe129c9993e5b23c3535d48e1411e908eca32c737b9d572e2933d79097c5f38aa23e958628b497e606f8cc0374a594e887e2b3e837e80ef29bdd49651b707527bc6f93a25bfc947f19ca694bf8b89cd0fe4bb61657be16cc858ae9f4ebec9a3f46ce66c6d501bb2ba14c8788afce8a877c1576b363096338ff0ef446f8af92ea3bb123e9f7394c77505afba9e938ebd62228c62df5bedbc3fe82fcf1f8b3f4be5a4de2c39e321f61d33d25d613d1e8e066fe2e871caf47eadc4e91ec9d3c34c24ef866fd268f066c3dfc240140576ee2af76821f073c63828b6c7c8c331ab2cd7236518add4e5686ff0d26a260d7884078cb234396538dd69c3754f950d667134c217a6b792f9c9f1b7b877578b71309795e37434fbb55ad8878534f194439f9f5b21879ce15163a5e9ccf9b3fbc21943b47cbb17ecd94465fd60212ab68605eef73c52d008e3174e67903b5aabac20bf7093a9e4ac0e73f68153bb1369c6f896d07d6316ce385018632c7c4c06aa24ddbf1cc5fb05d7bbd73ea48381f1d3f4b13f90877d45e5a3812a9af7e233b6e5d16c38b7676fea4818bc76474b9135468a28ccd4a5c0e8237f341b2a9b95a4f8535eec1acbe1563d0a9ec47881f1819fd1c760af8b82371f464b89e92bfa93b0149c913f17c5e3eca8b88b617fb5b0a5476564cc75c77f15dc75a46321d28e58d4ba236f2aea5d55c4f2746473da117705d998cc87febdf2b10a7e8fccee74387b322465889ef05f8be11a72700a483436ca63b85f1d859e345404499eb14a77c669b2e4cf5863341d8a5dd9f17f8b4c6fa24b46a8b98a32ff188ca6d2faedcdc13d71616c541e338bee4c36f0e049e0a39d881fba9adde7555178fd2ddfdf2f9ec747f421ec84e7d571c6fcb99b61f1f8da1d718b9119cc44e12f1de3d7e971c829dd5957647c41798cc673b9cfbe7193f5dc19f1b3ae224e8fb39d228ff6d3a5141a62ffed95f1472fdd09bfe07a47847d5e1f0a8ac2ce94a93366b491f1b7f2310974279abc72c3ed94e90de778b011ddc1df1a231e45c707dff69dcafa782ecffe9ab3b3276968eed108ab53e6cf7b6564bc8ad8bc979f66f70b76c2cd19ffaf17ae2f4fb1646bac1fce9ce156627c515b0e0fd3aec7e84341d616034962668af8213d4d9d714fb1d75dc49b5bf43c79939e8c27713118bd3062cfe058bce82a73e1d9d8e9c399a073d2b3280ad397ee64815cfbf026615f5c08c3df3c9e213edce91f8af872c40292f1fc8d535879a11ff5a7d55161c6476d31edaa363b1399fdd87098aec0b081e8f4941533e9091f23c00fd03c0af5e364387d5ebf28eee8de3832fd31afac554e2ce36b99df64b75acebc22ff9d1cfa9ec6b1383ed7c9cb6e7a57f0a4a5406ce684171f7ac02fb6abd3b90d5646fd4059e2e3e470be079d7ec99958f13730cade2736a06afca20c95f399af976b8007969e4d10fdd669231717f6ae28e7975a72c644f4a4421f2777c4e6e76d3ad7a1929fd33f923b83e7f270f203bde443e5e99db1c7c95c9147b6d49df8065f354f26f46c53fa2b7bc63f7327e97959d5fe95faf70ee13e6058215b247bdd6cab3b23571193fb053331d569cef7ef63721fe14d163e881d6744f4f18cfe7f79ee947c2fc8273b047be0a8acffaadb9fd1df4f7737bb391d3fff73e2fb1bf4fb2a756de2772142ff45253698dccf52e5de2eb6af56b613d6fbc74a163c9067e3ba277825cf96ba8319a59a76f53c88cf6caabf13ec62d017ace023477a7eabd0c3f2df8935320e22de28bc74fff26864fb14c67893a38999dafa5bccc3cbf76c4ada5e35772ff1beda363f4b9b8ab95fe88be843eb0e7a73b9672b4b3367af045bc872de0b0db957d98ef375913f43487ecbb78b7ac426391bbbac27257718a289e6ceb0ee28701e7e2663eb87abd621cca10dd8bd5e2e9e7bb21b559e56e900744e808d60807577e62b67f7dc8abf4962bf9f884365abbb70b60077de75c023327fddd82b541f7ccbdd8d9da5678fa7b57b3637927a61de622db6ad636598f637b4a1fe3b63db0adc9d38a0f9451d858e27debd71fd8de260d720f793897f46bd3e92b7bd96ff32e7923207be6ec2da785c1774ced35929d9471ff5485d2677492f7eeb27c636f94de8f9ef5b437898f7d8aed09bcbcec92bf7b24b6bb5797995fcf5608e47cc4b737a257446ccc5334d65b986b824e47ce232cfc89cf5ba89bdb86c5de7e04afab084efba24c68a6dc87bac0c250ef4f4bc4d84ca8045d9af7be6833ca2ebcf2eb77d9fc9860dcf6375bff81d27be9df4fde9bb1a9c69c07906dbfc4c63c41afc7aab3bf817f8cd6adcfd96f888a43666629b3ce66865d76e619c4a70e9fabdcdd94072b76741eff9bf3c1a997822a0cf9073fd9db21c9fc95b330eaf3577b630b8d1e144b397c1b75bdba2f573dfbd8942cfbddafaaf257ce1dc1f036f561cdeade47d4a3b7b9ff025e32703fe36055f9727f04f3596339cf17511a89c88c5f8cc7791f8bad4d9e65ee63b77faf1b0ff3d67f6530be214111b2b0f7721e05c1de2d168ee0cce45e08cddd71cc1d7f951389767459dc6d239619bcc47b95beae7e32ba3dabec8e81002d65d88cf21bd9dca77a73595f035f2ffc0787499971f7ffcf0d5006267a661a54ef1a27efc3f083ab546018980551ac42a8e97d53a3816ad00224afdf8e3c793a7c7f52ed4c044515a0ba4f6b7dc02811f7ffc98795e74de9429c4dffbf1cffffef1f3c7fffcf1631ea91825f1b7c8c30ca92109a715c2d37f19c847ae815cfdf0cfff6ad8f0b2f8593af636461a48358d28f6dfe4db7e92e8babe6d2203fefc9fa4bf0800cd6effe38f1f343179c346bc63d56c010ed106fdc0a35120db7c290a5a22583aaac4702c3df092f4b73520b60571691d87e653af07bcd04a42107ba6590343e33e6a1b887a968772d4c0d620643bc47c44416d2180400204073979b8d07f67bb9dad1547abcf46c3263115ffe78f1f4fc827f341dbbc5b305d200a61f8e38f1fbae3c3bf9ee307280c21497984b22fcc2309260dd348b55c1474d648cdbfc01689367f9a687150c2f48f8e8ac2d3836ef96b149c9e8d6ca111aaa707a41bebdc53aed0e07a3db69f7981b1e547967e7af36ef9217bcf9c5eac6de33df3e4a819e0b56fa3d3531a4f51f3209665654147d3ac9ad2b0b450f7dc3052dd8846bb2c1623089ae743fa959fcc4fa604e0ecbb8a25f90e2f2bed98ba530741234e56956b96e978460d80be46ba5d536e049a59539c1ff9b2e250ad2b2fce8d12889d1a18611bb0343d5a15707e769d17e7a6db59b183ebbfc9c136aa1b32d70a235457410cd079b7d4a8062aa86d44b856b9deaf7a806e7d718fe5ea00365a84510d4084c35a02505ed38224a07a45b181fc309759be0e4ef73717204e19e1eba02ad80005a139f52b4a3d171f4a4a2dc7c725afe98e50f69a864c2f168587308fe418bdcc437ece16a6681e31d0ef330f59b470adb2b9a7dc14cbcfa8e2042ace970867d85684c3b30ecb01ec7b4c66f5c353c7b749d274083baaa921ea847fe38e11585b1414df26947ffcf103b9ba67c4db44f26712df377d066a5daef8e6d77dee8de5aac121fb460fb7d947d3d3b28f6bb4cf3ed2fc87b9e7b266e60bc813087b613d88e7471720765680ce203ec2542cc8176c739de12327fbb82721a6d3c40b68ef6f49da0d2a949e49b20f20285271d1b77c842d1741fa93e4ef6ae8d0b0efdebd0080a96c198260e86f22d4714c27ea700cdbbf63fe71c7b0c9fbab8871cc1df3ebaecb7c8e18c46e4e5af5e71dd38356196ed88e084d6b9612eadfb12c10a2efdb114b72fd9cdaf5eb8ee90339c8fe135e4bac4f3f322d69473049959521770f6da3ef1b113b856caf828d89dd69d8d3e073d423fc55066f7a77906911324b75923f9ac2755443c5cd81a165eda09364566d50746c35c630d40835068ebcc6a0b95cb7b528653ada395414a87acccc336053358c50e058ae117642e4405af76d370fa2212f70ffd1f15160879dbf37aa1b5945554f83a8e1143f5fa223d7567164753455b7bdf7f7626908e693ce7e0fe2409ca9295bec05c80be15369d3ca6a30d42dd2cd3581f2d1ae13fa685780b01c7dadbaa17d80f8fa9a5750310d4fb751d0312c88efaf6da0c33b8665a2300a517419349b692b038ab6aaebaff7644fb84bf21f67ca213e7b3742faface0bc3cec70eb25e152042d78bacf743fa47bed84451082ad5a113ff77865f0270dad7a86c9011919a6226a25fb93d40f5ad6cfe4b0e14fb8eeabaa774616df020f77d92fe28c968c4b6a3a107466b84f463db61920cf5ed5092e4eaad3aa7fbc94ea578d0a9aaefe39244876dc9c0a2def8d750a0895f3f8d8ff6aae3e3ab4858ee7ba0a69942124adc2728399e6b45b185e48af67c7ac2676804082310e8af2041124e58d1e11a1a91e715f39ab62011a7e60a519458199171358153929e2be9d03cd157afa36ada572dae6ab257acb86aa2d72dc36aba37599bd5e4af5eb0d5a4af5cc5d584af5bdad574af5cefd584af64029e6b58b0bec232d9ff227660b44668bdfbc79856cb9a5a490bdd36d2c2d9e1529a0fb11d02487f860169d3e8c1e1171db10178a2da7e12edd2dc6880dc597b6144d363de8c502742aeea46d7d17342eb3a02490aa2db50e96c824fb7e7cafe4d7a5557b1a57b575381b3e7844f5f4b2c560d6f43a563a8c8f1dc6b89a148376e41a313baaa1faebd28bc96da29e5fbed28d10ce2b7a266b9269c17df9022fc895174438a71f6df4f51bba0bdd7235b70be8b71dbeda79246c7dc9c190aafa1d4b17cd5b931b972ebf127c9863b847c14dc84d6753b4b09a9cc0cfb1aaad7acd4cb944bccbc37279f4af5ffae7a3a9e65e89fad2c4026182a0f9fc58f6d809fc5be6e7ed2beb14cd7ba86db5032ed186653eb5a3922bc3d292d2db141e727ee49eda4596831714d09376df81f410b9081dcc852714b449029bd036ac52ca0c296524926e5785bf69e4585749ce135b89d5057310a2348536d1eaea314b593c5b314a8f6df161b611f05adbf3fc6ea6c9d56f210696e14f925e70a97d03eb1dc1ba8d2e5386de575a80892e0aad83aa22f7720cd65446f8b77ca74de062de69554dc6c89bbf1cd408584b7d8d20f5fed270b6a896bf89ed56a7ba15b427b8c8e0eaeca7a3b134889ab33016adfbd1728a59c21fbf253fbcf272b7ab7cc2fae844eae2fabe5abbe21dc682e8abe8c706a90b9450d37eb0342286d69e6ddbbe512d619dcbe16704bf1b44fb8e837a0adabfe510fc22f201c0faa657c01e9c4857d13e0db53371046b049fb811721bde576ddb80e102e9d7656ebd6b46fbf824e95b821bc0b8cdb93462e71deb03cd74687af201f6fad5f30e541f44f6c89b7a76ea3c356dde02fa4fc85f3c5217e58e19711fec2a6bbaa83425fd5bf8074e21d6906dec6bf3d790a767bc25b17453e42f48acdad897b78e320cd72c1c1d8b1cca0ad99a2693dfed710fdc2a9b8f55ddd73ddafda95c00e8c822fecf24205e94974ebe3cdaafa1c555f5bee0d29a54dcfbebca9fa4169fa9e876f4badb4e950cd57353fad3073e9f5dca3fa66b580b1e90b7b2d217fcbe55c560f7808db5f46db8b8849de3df3c06f5b070c646b3f8b8b17a2af2274034b4351f3a4cfc9e5ef28fc12f2dece4541855ff58daaa07654eaabf42555dcc46c71faf3740df90a72e4f8f7ecae405b2a5403b9964cbabb257bf6d68a0e3722e907e81d5be63aba11bd28be47760b525b27b437b7a3152677926f412f1640aedf35aed64c33dcfa6684d2059a79772b4e76a19a5b72b40b55dd84ed94d5011b7e18a9d1e6caae0a51145dad18254247f89933a3127a1b778d541cad0ff095372495f65e7ccb4a8dd0b535b5f74749302f799f945ed1ca951bd03b0602b39f1790839fe4a112494741943ab092876ad054eebe4b7aade0010c774bbdd08ad05534c8a0fb5875518054a3c0a8fe3f6d57d323398ab4ff4b9eab8aae9a9957adbebdd26a477318ed1c567b5bb5481b3b99b4c10d383f7a35ff7d157c19db807166efa1abd3114f8431600c4144b04f972038db97b755cc3ddc9f2d909b94cb671509a2031b9ed623c7bec7e2190d663c7ba0927d94f52ea9064377de5d60fb7baf98fbf0ee95cb7d5f2791455526254c4dd90de034ea06b9978880cc41db5ba2595f8df367695dcc2f3feb429e3f4b8864dd58f70462bf38b18d35575a4411b9534cd40fdcecc1c7da5a98e802fa9522babc7f2a73a048c8e5fa53426473d9afd347b9dd04bfad0069963a75fabe576cab42e662ee93b7ef664eaaec5e3f381997056ef97e27f0f9988b9c10dae89d59d9920c62795944183e76e407a8d8884c2e510419bf087b4e057fae0826914ba17c514785ca2beb1da5adb959c68da471010619684f142e8207bfb303b8c645b361042037498667b201d9c13cde1a5cb43b15ab6847b54dbec2ac221da9b7cc31a5bacd06d7135a7de781916bf225fe012ab2edbc43cd759dfd20a5e8e745453d2655e0b1b525af9b01e9b45a6772dfd52c496dde03469ec9a07e8cce1fd0397ffe9f74cea4d671808429b8ebf8f55995de6cb5e9f158a84792a7ba4da027eb391fd1e2eafa11195489b17e48f02af03004057f4a494f94a09574f4a77409a2c47d53d3cc63d5d918cadc58154fb232294d5bfe0ad508ffdca43109e8789be429819984d5d436029d945aba3ef0d78eb74dafe0e3b94e7eabb97a22b27c7cfe2a15aece48ff5df28e63d3e08ea3a65bfbd179a64e66bce0b51cc2621460cc8f1c4072a1cee4befcae709d4048bb79e83117756269223790fc9d1610883f97251884d9bd08578fd1adff381812204a385a6b89e66da7533355fd80aa4df6343cd5b4698ac12ef55d217a6431a789b4c005772349a09bf1fbf70c4b67a28cbeda06358e2ba748cdd029455ac6a5a215fac740d8fffff1db3265540459f17ea0ebb9c41a3805dc2fa182761d46fd789b33206915adb8186c0f785df5da18024948634932d853d56558fa1f966a0b32602189d84409dd63b660b2c28c15c094a838bb6cc1143f13960341d6ac8207d0b082a2695ce4a6b4afb1a01cf5442cb36781c82b55d68abee4f6b8a51567980a186e06221425329be13acb8c8cab4b847ff17a3ce4ef047f57e6d2380691fe48ea22e42a3377022755cd57e5538ac11845c920f86d31d2f6582935083ed6f61df9eac6d1af41068ce1b80e27eaa9aa4ea4eb74eeb713ef494d450a01b5e68cec0b0caf8960901c0d66d2a3102b0bdf84804d6552a9c598c399a4a8e53d697192a1f3bd6195e6fbf6c55212b11e8a13d83babf6087081ab8ef4b02023a2000f6b6cca5a25c7613d5d0925ac4ab98d4056176ab9c0c3091de98085a28ab470b95f9ed42dd92fb5de7c2b915af7c1502a32dde00361de1b5f6732349905e7a801c3ccf9dceb97c4a5720ef9a403977b2280af78bf28436ab36310bc27ea44466913ba7c9d663596b15bc0778dfd9280594f66d792f0ba75a8e5691cefc16a466ec36af2bb46f9ae7ca4ea385667a2deb868d1f58a5a8e47c51969b754e802254183e05593ae79c39e8ab103baee6de63404dc10c1938cd4190ad56aca60e9fc2816e39661fc79c59d22e24ab03a11d1e3c5f86240c3dab2383fb221648c47d8bce5a8e58a98490247b6541c1d47dad5c14f58a043b8ad5d637154f3caffd0ad6b076a1e4c14783851e1d3275ff77bddf03797faf2d8f16b43e529c1ae4eb83ae18f4f29f6282ec4a5d98f01489de3fa36f689be63a8e15c99acf069e6d45b449544f2eefefed3a75f126c1b1c1fe74cfa8f9af875389ae300129a9c9f43b78440c09a330155eae6068225e4a4faa828d01156bc4f32ab13f40215e7ab41ff69472553808f24039d06bb5e5eb269cd708c3e8cc78e56726c1a7a5bf261d439ad6e66a8beb6976c7967152242f8408839331c3498c40d39116ccd614be0c8d64582365bd36e0a0509e0d34c5df33d1ef2206823ca6a72cbc35215104721aa7317349488bcc09f78c08c4892479db92098e5317af1461b4a6aed21b5a552096c76df7157841f19ad781d07f9a706c688db42148c9503ae541ead709b078caaf9ec2ea2c86ca9c4c8c8ead5d31c9d7790a023ada918d743a1c6687b19cca0a35c5b675a4516c0621a684f90b0fbd821e3364da922645f2f9a6f0ed1926fb7fb779df059e77bb6e9acb59de12dd0e1cd44bed135c5d9c14bf1f654820cde1511d544564dbfa17d86b6872294c1a175ea42b47f869a3417dced95ea8994b8256e9cdf21e9faf71e11722bbecdd457b6c1fac01dc945a9f28676049a70071c9622a57067272cc0b684d1d296b667b5944061715e5e1d83c06d8f0bc1e53d45aae2060cb6ddd3686fa04e43ac39c110ecc52e8929bf5346646460883604dad838cd52fc6623424f93e8ccf89581157d3896c39d317d8f8cb7a95ba1e1dcbe5158e5356f974f730a7dbbbc8714f0c4ba2f6877dc776f7a9cb6e711c17fa812d54f8e861abd52b1f352f82f0c78f25d021fe9ec5262165e1fa9346d3b51608d5335ed8c76878dd115c96d6d7aa2599b7cb6c3ee44e61702077620a12a7e99718631bc840165c0ead4d1f94334bdb2efa127b58be76c3916d5694e717e374b929cd3c86d2082da98fe80ce173838bb724e99de374fecf0f7bb202db90d21b55f543b23cecd6f4612e625f0342e9d3397274103db09c744e35d37bb161c6a3242f2a1ff9eb1bca57d91972de05e3e58e9d02ac6a9dc1260c52137aa4e9c9f63bc36aaabadf47239c6b256e6085d9d62f4019edc27625ab1e53daa4dde21ab56873acac65b0880358ca07c46a2aceda6a09e89eeb71c421265ad5c562e2ca296d7cbeee14a446ea422ec1263d9155448a7ac9997f52eede1919e0437325bc213093a85f97bf908198b359ca9076e4c479423caad6508b6270e2f87f367f946b9491e58f75442ce6874793f1285df136cfbe97089ebcb503185c320d7f286988603e763c1196baa16f7f3e9c267387582259fcfb9bfc18e156254272ee8f7b486901b57c0a197c084f492e37d6c097f446ae268d60eef716a44a3657dc4aa0b022e68432bbd3b1495ae38173565f1ca5830a3e282acc46a2af5b768964b3ec18fe8241788c74af0a62da128bfe9f8d57ae4246e6ee367628d37634594c3a6414aab6145844c4eb4384f1c71852e7162ec2e9615d1045180f518ed9233564ceb0c10d36d02b6644adc9e457649d2f352eb3b4efb7eafb093afbd52a74345668047e596b5b44f744791ad67ff743c82fff5acfc7494c9736adc49268fd4ce6eb5eb9ab3a186e2eeaa1bfed97dc7622002bf122236f0e4db883b13639c85b9c57c0e643d967390c07f2d03935a55d10358a89b274cd3e2dd32b1be9312bdbc17c2c0f6ecf6d8eb5299d9297725125b3d0826839b153381d0b79188fb8005ee734d6e6c3619809efae60076cebb8d306d834b80f63c10227681dd81aa3b24dc5a7c9f142c835ab6bf7c520982fbfcfb1f11b32d9a97332ebef9dee136f2537c987e23b74f9f07c161a15b20ebd697c578637416d508dc672b5ba32853526da2363a89c680df1471871b66916cab3d0d8aa82d88357ae4319baf9a8649a2366bd4267ca655d1535acfc52ca668c05b20fd19ed59fc15d3cdea03835706635fa10cc20573c4f8ea4445fd15dc79eee0ef7426cea52b37e2a485ac8d642617cc3ddc0c52e79f9b40664a02fe5c7e991165dea14b5631d614ba92e7ae3ea251909d076daebc1f105e7c0a731a96ebf46dec6ee58bb56c4ea060b19f154fda00f648ed78c0a4c56087d0aedb4d268207443e1ebcd5d21a919173e6885de0f262a54c1a1991bca5232798328094cbecb99920a53709ad243b2a22142b2f58cae69213c9aceb3262790b4d463061b82995282f61c2ccb32d517e8b94512823626d3f7bb03b9ec04a9417676634da2fb1a36833b91d054c5bad7252de2cb513fed03d924f032bea1a2b1ce3f905b121cdbf6bbb05b245df924c963f3a970cd8ddd852165a90e029f4a64b0c0e3bb729fa72423ae7f63abe31c65d59980216e79d74c74925b88bd5df8aadafabbe2e80d863b14a6008126e48558a8e776283ecb487e07a319cc7799bd67c5dbb14b2a1a069c080db44b3e8c2f9fdd32cdfb9e3274153bab5352488c05c31412faa488ec91913d301517150f0a54b62a6b09538ff4ceea9a7043a82efdbb7918cc1439ecd366370a5ff04f7804be39de608e351a70c63d06bfc5ad179b05810d0243a8e4d13369da1827971d6a286acd3aaacc9caee869b8242619c79d25ecabb343cbb0d6d779de13f74a4edf45339aeb30bf8dfe6f97a637183ff503f764ac79a38c2b7912b52eb00389b82c41cd7e11c7f839f30e33b53f227162111fed8f7714eb3cf3723da66f4b4e09157348465456994e35c95e31c13f49064cbe612e5c143d8feee997a47df121851d45507f829ba9e01d7e68c09edc4c1a51bc2b94492b6c63d924b341af77cf039b1ff791f94c3cb615ae77b8f0ef303c93b53181c03268b8efd85aa960757ce05c55dbbc2bb6bd72292b6f2cdf6451b220e1b25afeebcbd579d3dcf0e9efa93fe53b914b90d0f4a42dfb07ef8098950f147192c73ff2069013499ef882570981d0bd2d8ee9c92f0f6ca24a0b8b2dc309f6307e7f5e56eeac6ee9a9622cd392a05c069b693450e82d4307f22ab9bfb2a7eb59d7613a0e74f3a6c868832b09bb59442fd085128e3d2a31563a10bc49a372be31356958b34f85cfadc9ebc171ffcdcf148c99e13c7eb757a19d4e60b2c04fb44690560ffdd98f80f0b0673c53d0accf4608f843920aabc6562c9650ac456f3ea1299d287e931b313e772b0fd101756f0aed6f0835919dc9f6758fa042eb951e1abbbafa1dc6fcaecc1610532dee05f82757bffc558dfe5ca84aee468dd3dcbc1d356c23eb1f4072e2fe75f22985d5e7dd8f9231ae23d73723878d59b91afb0990aa9805c7e92106c37bf6447cd710fd6d979f220b58ea34033bea2e0223a39e35a0fd0c3cb61b21ec07418297cbc0adbaf0d6172119f5d9b505540990fbc8d4c9a7ea15135efff37bffe6c2ebfe9459ef10d851f66aff14258ad1354acc3318310d6025410259a436bd590c0a51487b064ef79b06f63dc512c0bb11be585a549cd241cc466838632c06580e826cee5b1c801a7b8d114ca868fc6d810aa590d63829b089c4b42c3f8b924681146b789b3d1745782cf877fbf1cfe49a4fa95ff9d76441ebeb0b1eb0ce9b71eac2c9ef4bb8e943b7cf9cfe10f58fc7d49e4e05a25313fbc1c7ec7941dbe28319297c3dfa8387c392008154026f8eef072f895ffceeb0519b5fcade7f0a2fccaff6547b02f87f7b7f75f0e7ffdf5d77f010000ffff03006d2d6307e95b0400`)))
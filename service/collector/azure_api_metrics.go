@@ -16,6 +16,7 @@ type AzureAPIMetricsCollector struct {
 	logger micrologger.Logger
 
 	counters   map[string]*prometheus.CounterVec
+	gauges     map[string]*prometheus.GaugeVec
 	histograms map[string]*prometheus.HistogramVec
 
 	mutex *sync.Mutex
@@ -30,6 +31,7 @@ func NewAzureAPIMetricsCollector(config Config) (*AzureAPIMetricsCollector, erro
 		logger: config.Logger,
 
 		counters:   map[string]*prometheus.CounterVec{},
+		gauges:     map[string]*prometheus.GaugeVec{},
 		histograms: map[string]*prometheus.HistogramVec{},
 		mutex:      &sync.Mutex{},
 	}
@@ -42,6 +44,10 @@ func (c *AzureAPIMetricsCollector) Describe(ch chan<- *prometheus.Desc) error {
 		c.Describe(ch)
 	}
 
+	for _, g := range c.gauges {
+		g.Describe(ch)
+	}
+
 	for _, h := range c.histograms {
 		h.Describe(ch)
 	}
@@ -54,6 +60,10 @@ func (c *AzureAPIMetricsCollector) Collect(ch chan<- prometheus.Metric) error {
 		c.Collect(ch)
 	}
 
+	for _, g := range c.gauges {
+		g.Collect(ch)
+	}
+
 	for _, h := range c.histograms {
 		h.Collect(ch)
 	}
@@ -78,6 +88,23 @@ func (c *AzureAPIMetricsCollector) GetCounterVec(opts prometheus.Opts, labelName
 	return counter
 }
 
+func (c *AzureAPIMetricsCollector) GetGaugeVec(opts prometheus.Opts, labelNames []string) *prometheus.GaugeVec {
+	k := opts.Namespace + "/" + opts.Name
+	gauge, exists := c.gauges[k]
+	if !exists {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		gauge, exists = c.gauges[k]
+		if !exists {
+			gauge = prometheus.NewGaugeVec(prometheus.GaugeOpts(opts), labelNames)
+			c.gauges[k] = gauge
+		}
+	}
+
+	return gauge
+}
+
 func (c *AzureAPIMetricsCollector) GetHistogramVec(opts prometheus.Opts, labelNames []string) *prometheus.HistogramVec {
 	k := opts.Namespace + "/" + opts.Name
 	histogram, exists := c.histograms[k]
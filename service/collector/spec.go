@@ -4,5 +4,6 @@ import "github.com/prometheus/client_golang/prometheus"
 
 type AzureAPIMetrics interface {
 	GetCounterVec(opts prometheus.Opts, labelNames []string) *prometheus.CounterVec
+	GetGaugeVec(opts prometheus.Opts, labelNames []string) *prometheus.GaugeVec
 	GetHistogramVec(opts prometheus.Opts, labelNames []string) *prometheus.HistogramVec
 }
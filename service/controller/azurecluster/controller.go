@@ -15,6 +15,7 @@ import (
 	"github.com/spf13/viper"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
 
 	"github.com/giantswarm/azure-operator/v5/client"
@@ -23,6 +24,8 @@ import (
 	"github.com/giantswarm/azure-operator/v5/pkg/handler/release"
 	"github.com/giantswarm/azure-operator/v5/pkg/label"
 	"github.com/giantswarm/azure-operator/v5/pkg/project"
+	"github.com/giantswarm/azure-operator/v5/pkg/ratelimit"
+	"github.com/giantswarm/azure-operator/v5/pkg/recorder"
 	"github.com/giantswarm/azure-operator/v5/service/collector"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azurecluster/handler/azureclusterconditions"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azurecluster/handler/azureclusterconfig"
@@ -45,6 +48,7 @@ type ControllerConfig struct {
 
 	Azure                 setting.Azure
 	AzureMetricsCollector collector.AzureAPIMetrics
+	AzureRateLimiter      *ratelimit.Limiter
 	CPAzureClientSet      client.AzureClientSet
 	ProjectName           string
 	RegistryDomain        string
@@ -118,6 +122,7 @@ func newAzureClusterResources(config ControllerConfig, certsSearcher certs.Inter
 	{
 		c := client.FactoryConfig{
 			AzureAPIMetrics:    config.AzureMetricsCollector,
+			RateLimiter:        config.AzureRateLimiter,
 			CacheDuration:      30 * time.Minute,
 			CredentialProvider: config.CredentialProvider,
 			Logger:             config.Logger,
@@ -139,12 +144,29 @@ func newAzureClusterResources(config ControllerConfig, certsSearcher certs.Inter
 		organizationClientFactory = client.NewOrganizationFactory(c)
 	}
 
+	var eventRecorder record.EventRecorder
+	{
+		c := recorder.Config{
+			K8sClient: config.K8sClient,
+
+			Component: project.Name(),
+		}
+
+		eventRecorder, err = recorder.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var azureClusterConditionsResource resource.Interface
 	{
 		c := azureclusterconditions.Config{
 			AzureClientsFactory: &organizationClientFactory,
 			CtrlClient:          config.K8sClient.CtrlClient(),
+			EventRecorder:       eventRecorder,
 			Logger:              config.Logger,
+
+			Azure: config.Azure,
 		}
 
 		azureClusterConditionsResource, err = azureclusterconditions.New(c)
@@ -185,6 +207,7 @@ func newAzureClusterResources(config ControllerConfig, certsSearcher certs.Inter
 			Logger:     config.Logger,
 
 			APIServerSecurePort: config.Viper.GetInt(config.Flag.Service.Cluster.Kubernetes.API.SecurePort),
+			Azure:               config.Azure,
 			Calico: azureconfig.CalicoConfig{
 				CIDRSize: config.Viper.GetInt(config.Flag.Service.Cluster.Calico.CIDR),
 				MTU:      config.Viper.GetInt(config.Flag.Service.Cluster.Calico.MTU),
@@ -9,6 +9,8 @@ import (
 	capz "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
 	capi "sigs.k8s.io/cluster-api/api/v1alpha3"
 	capiconditions "sigs.k8s.io/cluster-api/util/conditions"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
 )
 
 func (r *Resource) ensureReadyCondition(ctx context.Context, azureCluster *capz.AzureCluster) error {
@@ -19,16 +21,36 @@ func (r *Resource) ensureReadyCondition(ctx context.Context, azureCluster *capz.
 	// because it's created at the end. Final implementation should include
 	// checking of other Azure resources as well. and it will be done in
 	// AzureCluster controller.
-	err := r.ensureVPNGatewayReadyCondition(ctx, azureCluster)
+	//
+	// Clusters using vnet peering connectivity have no VPN Gateway, their
+	// peering with the host cluster virtual network is checked instead.
+	mode, err := r.azure.ConnectivityForCluster(azureCluster.Annotations)
 	if err != nil {
-		return microerror.Mask(err)
+		return microerror.Maskf(invalidConfigError, "%s", err)
+	}
+
+	var connectivityCondition capi.ConditionType
+	if mode == setting.ConnectivityVNetPeering {
+		err = r.ensureVNetPeeringReadyCondition(ctx, azureCluster)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		connectivityCondition = VNetPeeringReadyCondition
+	} else {
+		err = r.ensureVPNGatewayReadyCondition(ctx, azureCluster)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		connectivityCondition = azureconditions.VPNGatewayReadyCondition
 	}
 
 	// List of conditions that all need to be True for the Ready condition to
 	// be True.
 	conditionsToSummarize := capiconditions.WithConditions(
 		azureconditions.ResourceGroupReadyCondition,
-		azureconditions.VPNGatewayReadyCondition)
+		connectivityCondition)
 
 	capiconditions.SetSummary(
 		azureCluster,
@@ -0,0 +1,93 @@
+package azureclusterconditions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
+	"github.com/giantswarm/microerror"
+	capz "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+	capi "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capiconditions "sigs.k8s.io/cluster-api/util/conditions"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+const (
+	// VNetPeeringReadyCondition is True when the virtual network of a cluster
+	// using vnet peering connectivity is peered with the host cluster virtual
+	// network. It replaces the VPNGatewayReady condition for these clusters.
+	VNetPeeringReadyCondition capi.ConditionType = "VNetPeeringReady"
+
+	VirtualNetworkNotFoundReason = "VirtualNetworkNotFound"
+	VNetPeeringNotFoundReason    = "VNetPeeringNotFound"
+	VNetPeeringStatePrefix       = "VNetPeeringState"
+)
+
+func (r *Resource) ensureVNetPeeringReadyCondition(ctx context.Context, azureCluster *capz.AzureCluster) error {
+	r.logger.Debugf(ctx, "ensuring condition %s", VNetPeeringReadyCondition)
+
+	vnetName := azureCluster.Spec.NetworkSpec.Vnet.Name
+	if vnetName == "" {
+		r.setVNetPeeringNotReady(ctx, azureCluster, VirtualNetworkNotFoundReason, "Virtual network is not set yet, check back in few minutes")
+		return nil
+	}
+
+	virtualNetworksClient, err := r.azureClientsFactory.GetVirtualNetworksClient(ctx, azureCluster.ObjectMeta)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	// The peerings of the virtual network include their state, so there is
+	// no need for a separate peerings client.
+	vnet, err := virtualNetworksClient.Get(ctx, key.VnetResourceGroupNameFromAzureCluster(*azureCluster), vnetName, "")
+	if IsNotFound(err) {
+		r.setVNetPeeringNotReady(ctx, azureCluster, VirtualNetworkNotFoundReason, "Virtual network "+vnetName+" is not found, check back in few minutes")
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	peering, found := findPeering(vnet, key.VNetPeeringName(r.azure.HostCluster.VirtualNetwork))
+	if !found {
+		r.setVNetPeeringNotReady(ctx, azureCluster, VNetPeeringNotFoundReason, "VNet peering to host cluster virtual network "+r.azure.HostCluster.VirtualNetwork+" is not found, check back in few minutes")
+		return nil
+	}
+
+	if peering.PeeringState != network.VirtualNetworkPeeringStateConnected {
+		r.setVNetPeeringNotReady(ctx, azureCluster, VNetPeeringStatePrefix+string(peering.PeeringState), "VNet peering to host cluster virtual network "+r.azure.HostCluster.VirtualNetwork+" is "+strings.ToLower(string(peering.PeeringState))+", check back in few minutes")
+		return nil
+	}
+
+	capiconditions.MarkTrue(azureCluster, VNetPeeringReadyCondition)
+
+	r.logger.Debugf(ctx, "finished ensuring condition %s", VNetPeeringReadyCondition)
+
+	return nil
+}
+
+func findPeering(vnet network.VirtualNetwork, name string) (network.VirtualNetworkPeering, bool) {
+	if vnet.VirtualNetworkPropertiesFormat == nil || vnet.VirtualNetworkPeerings == nil {
+		return network.VirtualNetworkPeering{}, false
+	}
+
+	for _, peering := range *vnet.VirtualNetworkPeerings {
+		if peering.Name != nil && *peering.Name == name && peering.VirtualNetworkPeeringPropertiesFormat != nil {
+			return peering, true
+		}
+	}
+
+	return network.VirtualNetworkPeering{}, false
+}
+
+func (r *Resource) setVNetPeeringNotReady(ctx context.Context, azureCluster *capz.AzureCluster, reason, message string) {
+	capiconditions.MarkFalse(
+		azureCluster,
+		VNetPeeringReadyCondition,
+		reason,
+		capi.ConditionSeverityWarning,
+		"%s",
+		message)
+
+	r.logger.Debugf(ctx, "%s", message)
+}
@@ -5,12 +5,14 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
 
-	azureconditions "github.com/giantswarm/apiextensions/v3/pkg/conditions/azure"
+	apiextensionsconditions "github.com/giantswarm/apiextensions/v3/pkg/conditions/azure"
 	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
 	capz "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
 	capi "sigs.k8s.io/cluster-api/api/v1alpha3"
 	capiconditions "sigs.k8s.io/cluster-api/util/conditions"
 
+	"github.com/giantswarm/azure-operator/v5/pkg/azureconditions"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
 
@@ -25,7 +27,7 @@ const (
 )
 
 func (r *Resource) ensureVPNGatewayReadyCondition(ctx context.Context, azureCluster *capz.AzureCluster) error {
-	r.logger.Debugf(ctx, "ensuring condition %s", azureconditions.VPNGatewayReadyCondition)
+	r.logger.Debugf(ctx, "ensuring condition %s", apiextensionsconditions.VPNGatewayReadyCondition)
 	var err error
 
 	// Get Azure Deployments client
@@ -35,7 +37,7 @@ func (r *Resource) ensureVPNGatewayReadyCondition(ctx context.Context, azureClus
 	}
 
 	// Get VPN Gateway deployment
-	deployment, err := deploymentsClient.Get(ctx, key.ClusterName(azureCluster), vpnDeploymentName)
+	deployment, err := deploymentsClient.Get(ctx, key.ResourceGroupNameFromAzureCluster(*azureCluster), vpnDeploymentName)
 	if IsNotFound(err) {
 		// VPN Gateway deployment has not been found, which means that we still
 		// didn't start deploying it.
@@ -67,16 +69,28 @@ func (r *Resource) ensureVPNGatewayReadyCondition(ctx context.Context, azureClus
 	switch currentProvisioningState {
 	case DeploymentProvisioningStateSucceeded:
 		// All good, VPN gateway deployment has been completed successfully! :)
-		capiconditions.MarkTrue(azureCluster, azureconditions.VPNGatewayReadyCondition)
+		capiconditions.MarkTrue(azureCluster, apiextensionsconditions.VPNGatewayReadyCondition)
 	case DeploymentProvisioningStateFailed:
 		// VPN gateway deployment has failed.
-		r.setProvisioningStateWarningFailed(ctx, azureCluster)
+		deploymentOperationsClient, err := r.azureClientsFactory.GetDeploymentOperationsClient(ctx, azureCluster.ObjectMeta)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		failures, err := azureconditions.GetDeploymentFailures(ctx, deploymentOperationsClient, key.ResourceGroupNameFromAzureCluster(*azureCluster), vpnDeploymentName)
+		if err != nil {
+			// The condition is set without the details of the failed
+			// operations then.
+			r.logger.Debugf(ctx, "failed to get the operations of deployment %s: %s", vpnDeploymentName, err)
+		}
+
+		r.setProvisioningStateWarningFailed(ctx, azureCluster, deployment, failures)
 	default:
 		// VPN gateway deployment is probably still running.
 		r.setProvisioningStateWarning(ctx, azureCluster, currentProvisioningState)
 	}
 
-	r.logger.Debugf(ctx, "finished ensuring condition %s", azureconditions.VPNGatewayReadyCondition)
+	r.logger.Debugf(ctx, "finished ensuring condition %s", apiextensionsconditions.VPNGatewayReadyCondition)
 
 	return nil
 }
@@ -91,20 +105,28 @@ func isProvisioningStateSet(deployment *resources.DeploymentExtended) bool {
 	return false
 }
 
-func (r *Resource) setProvisioningStateWarningFailed(ctx context.Context, azureCluster *capz.AzureCluster) {
-	message := "VPN Gateway deployment %s failed, it might succeed after retrying, see Azure portal for more details"
-	messageArgs := vpnDeploymentName
+func (r *Resource) setProvisioningStateWarningFailed(ctx context.Context, azureCluster *capz.AzureCluster, deployment resources.DeploymentExtended, failures []azureconditions.DeploymentFailure) {
+	message := "VPN Gateway " + azureconditions.FailedDeploymentMessage(deployment, failures)
+	if len(failures) == 0 {
+		message += ", it might succeed after retrying, see Azure portal for more details"
+	}
 	reason := DeploymentProvisioningStatePrefix + DeploymentProvisioningStateFailed
 
+	// The Event is only emitted when the failure changed, not on every
+	// reconciliation.
+	if capiconditions.GetMessage(azureCluster, apiextensionsconditions.VPNGatewayReadyCondition) != message {
+		r.eventRecorder.Event(azureCluster, corev1.EventTypeWarning, azureconditions.DeploymentFailedReason, message)
+	}
+
 	capiconditions.MarkFalse(
 		azureCluster,
-		azureconditions.VPNGatewayReadyCondition,
+		apiextensionsconditions.VPNGatewayReadyCondition,
 		reason,
 		capi.ConditionSeverityError,
-		message,
-		messageArgs)
+		"%s",
+		message)
 
-	r.logger.Debugf(ctx, message, messageArgs)
+	r.logger.Debugf(ctx, "%s", message)
 }
 
 func (r *Resource) setProvisioningStateWarning(ctx context.Context, azureCluster *capz.AzureCluster, currentProvisioningState string) {
@@ -115,7 +137,7 @@ func (r *Resource) setProvisioningStateWarning(ctx context.Context, azureCluster
 
 	capiconditions.MarkFalse(
 		azureCluster,
-		azureconditions.VPNGatewayReadyCondition,
+		apiextensionsconditions.VPNGatewayReadyCondition,
 		reason,
 		capi.ConditionSeverityWarning,
 		message,
@@ -129,7 +151,7 @@ func (r *Resource) setProvisioningStateUnknown(ctx context.Context, azureCluster
 	messageArgs := vpnDeploymentName
 	capiconditions.MarkFalse(
 		azureCluster,
-		azureconditions.VPNGatewayReadyCondition,
+		apiextensionsconditions.VPNGatewayReadyCondition,
 		DeploymentProvisioningStateUnknownReason,
 		capi.ConditionSeverityWarning,
 		message,
@@ -143,7 +165,7 @@ func (r *Resource) setVPNGatewayDeploymentNotFound(ctx context.Context, azureClu
 	messageArgs := vpnDeploymentName
 	capiconditions.MarkFalse(
 		azureCluster,
-		azureconditions.VPNGatewayReadyCondition,
+		apiextensionsconditions.VPNGatewayReadyCondition,
 		DeploymentNotFoundReason,
 		capi.ConditionSeverityWarning,
 		message,
@@ -3,10 +3,12 @@ package azureclusterconditions
 import (
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
+	"k8s.io/client-go/tools/record"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	azureclient "github.com/giantswarm/azure-operator/v5/client"
+	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
 )
 
 const (
@@ -17,14 +19,20 @@ const (
 type Config struct {
 	AzureClientsFactory *azureclient.OrganizationFactory
 	CtrlClient          client.Client
+	EventRecorder       record.EventRecorder
 	Logger              micrologger.Logger
+
+	Azure setting.Azure
 }
 
 // Resource ensures that AzureCluster Status Conditions are set.
 type Resource struct {
 	azureClientsFactory *azureclient.OrganizationFactory
 	ctrlClient          client.Client
+	eventRecorder       record.EventRecorder
 	logger              micrologger.Logger
+
+	azure setting.Azure
 }
 
 func New(config Config) (*Resource, error) {
@@ -34,14 +42,23 @@ func New(config Config) (*Resource, error) {
 	if config.CtrlClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.CtrlClient must not be empty", config)
 	}
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
+	if err := config.Azure.Validate(); err != nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Azure.%s", config, err)
+	}
 
 	r := &Resource{
 		azureClientsFactory: config.AzureClientsFactory,
 		ctrlClient:          config.CtrlClient,
+		eventRecorder:       config.EventRecorder,
 		logger:              config.Logger,
+
+		azure: config.Azure,
 	}
 
 	return r, nil
@@ -18,8 +18,10 @@ import (
 	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	azureannotation "github.com/giantswarm/azure-operator/v5/pkg/annotation"
 	"github.com/giantswarm/azure-operator/v5/pkg/label"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
 )
 
 const (
@@ -112,6 +114,12 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		err = r.ctrlClient.Get(ctx, nsName, &presentAzureConfig)
 		if apierrors.IsNotFound(err) {
 			r.logger.Debugf(ctx, "did not found existing azureconfig")
+
+			err = r.validateExistingResourcesNotShared(ctx, mappedAzureConfig)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
 			r.logger.Debugf(ctx, "creating azureconfig")
 			err = r.ctrlClient.Create(ctx, &mappedAzureConfig)
 			if err != nil {
@@ -246,11 +254,33 @@ func (r *Resource) buildAzureConfig(ctx context.Context, cluster capiv1alpha3.Cl
 		azureConfig.Annotations[annotation.ClusterDescription] = cluster.Annotations[annotation.ClusterDescription]
 	}
 
+	{
+		// Existing resources are only taken over when the AzureConfig CR is
+		// created. Moving a running cluster into other resources is not
+		// supported.
+		err = r.validateExistingResources(azureCluster)
+		if err != nil {
+			return providerv1alpha1.AzureConfig{}, microerror.Mask(err)
+		}
+
+		for _, a := range []string{azureannotation.ExistingResourceGroup, azureannotation.ExistingVirtualNetwork} {
+			if v, ok := azureCluster.Annotations[a]; ok {
+				azureConfig.Annotations[a] = v
+			}
+		}
+	}
+
 	{
 		azureConfig.Labels[label.Cluster] = key.ClusterName(&cluster)
 		azureConfig.Labels[capiv1alpha3.ClusterLabelName] = key.ClusterName(&cluster)
 		azureConfig.Labels[label.Organization] = key.OrganizationID(&cluster)
-		azureConfig.Labels[label.ReleaseVersion] = key.ReleaseVersion(&cluster)
+		// The release of the AzureCluster CR is only changed once an upgrade
+		// may start, so that masters are not upgraded outside of maintenance
+		// windows.
+		azureConfig.Labels[label.ReleaseVersion] = key.ReleaseVersion(&azureCluster)
+		if azureConfig.Labels[label.ReleaseVersion] == "" {
+			azureConfig.Labels[label.ReleaseVersion] = key.ReleaseVersion(&cluster)
+		}
 		azureConfig.Labels[label.OperatorVersion] = key.OperatorVersion(&azureCluster)
 	}
 
@@ -427,3 +457,64 @@ func newSpecClusterWorkerNodes(numWorkers int) []providerv1alpha1.ClusterNode {
 
 	return workerNodes
 }
+
+func (r *Resource) validateExistingResources(azureCluster capzv1alpha3.AzureCluster) error {
+	if v, ok := azureCluster.Annotations[azureannotation.ExistingResourceGroup]; ok {
+		if _, ok := key.ParseExistingResourceGroup(v); !ok {
+			return microerror.Maskf(invalidConfigError, "annotation %#q must be the name of a resource group, got %#q", azureannotation.ExistingResourceGroup, v)
+		}
+	}
+
+	v, ok := azureCluster.Annotations[azureannotation.ExistingVirtualNetwork]
+	if !ok {
+		return nil
+	}
+
+	if _, _, ok := key.ParseExistingVirtualNetwork(v); !ok {
+		return microerror.Maskf(invalidConfigError, "annotation %#q must be formatted as %#q, got %#q", azureannotation.ExistingVirtualNetwork, "<resource group>/<name>", v)
+	}
+
+	connectivity, err := r.azure.ConnectivityForCluster(azureCluster.Annotations)
+	if err != nil {
+		return microerror.Maskf(invalidConfigError, "%s", err)
+	}
+	if connectivity != setting.ConnectivityVNetPeering {
+		return microerror.Maskf(invalidConfigError, "annotation %#q requires %#q connectivity, got %#q", azureannotation.ExistingVirtualNetwork, setting.ConnectivityVNetPeering, connectivity)
+	}
+
+	return nil
+}
+
+// validateExistingResourcesNotShared ensures that no other cluster uses the
+// resource group or virtual network created beforehand the given cluster is
+// placed into. Deployments in the resource group and the peerings of the
+// virtual network are named independently of the cluster, so clusters can't
+// share them.
+func (r *Resource) validateExistingResourcesNotShared(ctx context.Context, azureConfig providerv1alpha1.AzureConfig) error {
+	_, existingResourceGroup := key.ExistingResourceGroup(azureConfig)
+	_, _, existingVirtualNetwork := key.ExistingVirtualNetwork(azureConfig)
+	if !existingResourceGroup && !existingVirtualNetwork {
+		return nil
+	}
+
+	azureConfigList := &providerv1alpha1.AzureConfigList{}
+	err := r.ctrlClient.List(ctx, azureConfigList, client.InNamespace(azureConfig.Namespace))
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	for _, other := range azureConfigList.Items {
+		if other.Name == azureConfig.Name {
+			continue
+		}
+
+		if existingResourceGroup && strings.EqualFold(key.ResourceGroupName(other), key.ResourceGroupName(azureConfig)) {
+			return microerror.Maskf(invalidConfigError, "resource group %#q is used by cluster %#q already", key.ResourceGroupName(azureConfig), key.ClusterID(&other))
+		}
+		if existingVirtualNetwork && strings.EqualFold(key.VnetResourceGroupName(other), key.VnetResourceGroupName(azureConfig)) && strings.EqualFold(key.VnetName(other), key.VnetName(azureConfig)) {
+			return microerror.Maskf(invalidConfigError, "virtual network %#q is used by cluster %#q already", key.VnetResourceGroupName(azureConfig)+"/"+key.VnetName(azureConfig), key.ClusterID(&other))
+		}
+	}
+
+	return nil
+}
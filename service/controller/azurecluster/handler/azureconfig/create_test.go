@@ -0,0 +1,121 @@
+package azureconfig
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/micrologger/microloggertest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	azureannotation "github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/pkg/label"
+)
+
+func Test_validateExistingResourcesNotShared(t *testing.T) {
+	testCases := []struct {
+		name         string
+		annotations  map[string]string
+		errorMatcher func(error) bool
+	}{
+		{
+			name:         "case 0: cluster with resources of its own",
+			annotations:  map[string]string{},
+			errorMatcher: nil,
+		},
+		{
+			name: "case 1: existing resource group not used by other clusters",
+			annotations: map[string]string{
+				azureannotation.ExistingResourceGroup: "customer-rg",
+			},
+			errorMatcher: nil,
+		},
+		{
+			name: "case 2: existing resource group used by another cluster",
+			annotations: map[string]string{
+				azureannotation.ExistingResourceGroup: "Shared-RG",
+			},
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name: "case 3: existing resource group of another cluster",
+			annotations: map[string]string{
+				azureannotation.ExistingResourceGroup: "a1b2c",
+			},
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name: "case 4: existing virtual network not used by other clusters",
+			annotations: map[string]string{
+				azureannotation.ExistingVirtualNetwork: "network-rg/other-vnet",
+			},
+			errorMatcher: nil,
+		},
+		{
+			name: "case 5: existing virtual network used by another cluster",
+			annotations: map[string]string{
+				azureannotation.ExistingVirtualNetwork: "network-rg/spoke-vnet",
+			},
+			errorMatcher: IsInvalidConfig,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			scheme := runtime.NewScheme()
+			err := providerv1alpha1.AddToScheme(scheme)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctrlClient := fake.NewFakeClientWithScheme(scheme,
+				newTestAzureConfig("a1b2c", nil),
+				newTestAzureConfig("d3e4f", map[string]string{
+					azureannotation.ExistingResourceGroup:  "shared-rg",
+					azureannotation.ExistingVirtualNetwork: "network-rg/spoke-vnet",
+				}),
+			)
+
+			r := &Resource{
+				ctrlClient: ctrlClient,
+				logger:     microloggertest.New(),
+			}
+
+			err = r.validateExistingResourcesNotShared(context.Background(), *newTestAzureConfig("g5h6i", tc.annotations))
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+		})
+	}
+}
+
+func newTestAzureConfig(clusterID string, annotations map[string]string) *providerv1alpha1.AzureConfig {
+	return &providerv1alpha1.AzureConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        clusterID,
+			Namespace:   metav1.NamespaceDefault,
+			Annotations: annotations,
+			Labels: map[string]string{
+				label.Cluster: clusterID,
+			},
+		},
+		Spec: providerv1alpha1.AzureConfigSpec{
+			Cluster: providerv1alpha1.Cluster{
+				ID: clusterID,
+			},
+		},
+	}
+}
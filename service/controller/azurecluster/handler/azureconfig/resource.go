@@ -4,6 +4,8 @@ import (
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
 )
 
 const (
@@ -21,6 +23,7 @@ type Config struct {
 	Logger     micrologger.Logger
 
 	APIServerSecurePort            int
+	Azure                          setting.Azure
 	Calico                         CalicoConfig
 	ClusterIPRange                 string
 	EtcdPrefix                     string
@@ -32,6 +35,7 @@ type Resource struct {
 	logger     micrologger.Logger
 
 	apiServerSecurePort            int
+	azure                          setting.Azure
 	calico                         CalicoConfig
 	clusterIPRange                 string
 	etcdPrefix                     string
@@ -53,6 +57,7 @@ func New(config Config) (*Resource, error) {
 		logger:     config.Logger,
 
 		apiServerSecurePort:            config.APIServerSecurePort,
+		azure:                          config.Azure,
 		calico:                         config.Calico,
 		clusterIPRange:                 config.ClusterIPRange,
 		etcdPrefix:                     config.EtcdPrefix,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"reflect"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
@@ -20,6 +21,7 @@ import (
 	"github.com/giantswarm/azure-operator/v5/client"
 	subnet "github.com/giantswarm/azure-operator/v5/service/controller/azurecluster/handler/subnet/template"
 	"github.com/giantswarm/azure-operator/v5/service/controller/debugger"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/tags"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
 
@@ -123,7 +125,7 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 }
 
 func (r *Resource) ensureSubnets(ctx context.Context, deploymentsClient *azureresource.DeploymentsClient, storageAccountsClient *storage.AccountsClient, natGatewaysClient *network.NatGatewaysClient, azureCluster *capzv1alpha3.AzureCluster) error {
-	natGw, err := natGatewaysClient.Get(ctx, key.ClusterID(azureCluster), "workers-nat-gw", "")
+	natGw, err := natGatewaysClient.Get(ctx, key.ResourceGroupNameFromAzureCluster(*azureCluster), "workers-nat-gw", "")
 	if IsNotFound(err) {
 		return microerror.Mask(natGatewayNotReadyError)
 	} else if err != nil {
@@ -139,16 +141,21 @@ func (r *Resource) ensureSubnets(ctx context.Context, deploymentsClient *azurere
 		return microerror.Mask(err)
 	}
 
+	customTags, err := tags.ForCluster(ctx, r.ctrlClient, azureCluster)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	for i := 0; i < len(azureCluster.Spec.NetworkSpec.Subnets); i++ {
 		deploymentName := key.SubnetDeploymentName(azureCluster.Spec.NetworkSpec.Subnets[i].Name)
-		currentDeployment, err := deploymentsClient.Get(ctx, key.ClusterID(azureCluster), deploymentName)
+		currentDeployment, err := deploymentsClient.Get(ctx, key.ResourceGroupNameFromAzureCluster(*azureCluster), deploymentName)
 		if IsNotFound(err) {
 			// fallthrough
 		} else if err != nil {
 			return microerror.Mask(err)
 		}
 
-		parameters, err := r.getDeploymentParameters(azureCluster, *natGw.ID, azureCluster.Spec.NetworkSpec.Subnets[i])
+		parameters, err := r.getDeploymentParameters(azureCluster, *natGw.ID, azureCluster.Spec.NetworkSpec.Subnets[i], customTags)
 		if err != nil {
 			return microerror.Mask(err)
 		}
@@ -164,7 +171,7 @@ func (r *Resource) ensureSubnets(ctx context.Context, deploymentsClient *azurere
 		// We only submit the deployment if it doesn't exist or it exists but it's out of date.
 		shouldSubmitDeployment := currentDeployment.IsHTTPStatus(http.StatusNotFound)
 		if !shouldSubmitDeployment {
-			shouldSubmitDeployment, err = r.isDeploymentOutOfDate(ctx, azureCluster.Spec.NetworkSpec.Subnets[i], currentDeployment)
+			shouldSubmitDeployment, err = r.isDeploymentOutOfDate(ctx, azureCluster.Spec.NetworkSpec.Subnets[i], customTags, currentDeployment)
 			if err != nil {
 				return microerror.Mask(err)
 			}
@@ -172,7 +179,7 @@ func (r *Resource) ensureSubnets(ctx context.Context, deploymentsClient *azurere
 
 		if shouldSubmitDeployment {
 			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("template or parameters changed for deployment %#q", deploymentName), "subnet", azureCluster.Spec.NetworkSpec.Subnets[i].Name)
-			err = r.createDeployment(ctx, deploymentsClient, key.ClusterID(azureCluster), deploymentName, desiredDeployment)
+			err = r.createDeployment(ctx, deploymentsClient, key.ResourceGroupNameFromAzureCluster(*azureCluster), deploymentName, desiredDeployment)
 			if err != nil {
 				return microerror.Mask(err)
 			}
@@ -192,7 +199,7 @@ func (r *Resource) ensureSubnets(ctx context.Context, deploymentsClient *azurere
 		if key.IsFailedProvisioningState(*currentDeployment.Properties.ProvisioningState) {
 			r.debugger.LogFailedDeployment(ctx, currentDeployment, err)
 			r.logger.Debugf(ctx, "removing failed deployment %#q", deploymentName)
-			_, err = deploymentsClient.Delete(ctx, key.ClusterID(azureCluster), deploymentName)
+			_, err = deploymentsClient.Delete(ctx, key.ResourceGroupNameFromAzureCluster(*azureCluster), deploymentName)
 			if err != nil {
 				return microerror.Mask(err)
 			}
@@ -226,8 +233,13 @@ func (r *Resource) ensureSubnets(ctx context.Context, deploymentsClient *azurere
 
 // garbageCollectSubnets removes subnets that have an ARM deployment in Azure but are not defined in `AzureCluster`.
 // This is required because when removing a node pool, we remove the subnet from `AzureCluster`, so we can remove it here from Azure.
+// In existing virtual networks only the subnets with an ARM deployment are
+// removed, since the other subnets belong to the owner of the virtual network.
 func (r *Resource) garbageCollectSubnets(ctx context.Context, deploymentsClient *azureresource.DeploymentsClient, subnetsClient *network.SubnetsClient, azureCluster capzv1alpha3.AzureCluster) error {
-	subnetsIterator, err := subnetsClient.ListComplete(ctx, key.ClusterID(&azureCluster), azureCluster.Spec.NetworkSpec.Vnet.Name)
+	vnetResourceGroup := key.VnetResourceGroupNameFromAzureCluster(azureCluster)
+	existingVirtualNetwork := vnetResourceGroup != key.ResourceGroupNameFromAzureCluster(azureCluster)
+
+	subnetsIterator, err := subnetsClient.ListComplete(ctx, vnetResourceGroup, azureCluster.Spec.NetworkSpec.Vnet.Name)
 	if IsNotFound(err) {
 		r.logger.Debugf(ctx, "Vnet %#q not found, cancelling resource", azureCluster.Spec.NetworkSpec.Vnet.Name)
 		return nil
@@ -238,8 +250,18 @@ func (r *Resource) garbageCollectSubnets(ctx context.Context, deploymentsClient
 	for subnetsIterator.NotDone() {
 		subnetInAzure := subnetsIterator.Value()
 
-		if !isSubnetInAzureClusterSpec(azureCluster, *subnetInAzure.Name) && !isProtectedSubnet(*subnetInAzure.Name) {
-			err = r.deleteSubnet(ctx, subnetsClient, key.ClusterID(&azureCluster), azureCluster.Spec.NetworkSpec.Vnet.Name, *subnetInAzure.Name)
+		garbage := !isSubnetInAzureClusterSpec(azureCluster, *subnetInAzure.Name) && !isProtectedSubnet(*subnetInAzure.Name)
+		if garbage && existingVirtualNetwork {
+			_, err = deploymentsClient.Get(ctx, key.ResourceGroupNameFromAzureCluster(azureCluster), key.SubnetDeploymentName(*subnetInAzure.Name))
+			if IsNotFound(err) {
+				garbage = false
+			} else if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+
+		if garbage {
+			err = r.deleteSubnet(ctx, subnetsClient, vnetResourceGroup, azureCluster.Spec.NetworkSpec.Vnet.Name, *subnetInAzure.Name)
 			if IsSubnetInUse(err) {
 				r.logger.Debugf(ctx, "subnet %q in Azure still in use by VMSS", *subnetInAzure.Name)
 
@@ -253,7 +275,7 @@ func (r *Resource) garbageCollectSubnets(ctx context.Context, deploymentsClient
 				return microerror.Mask(err)
 			}
 
-			err = r.deleteARMDeployment(ctx, deploymentsClient, key.ClusterID(&azureCluster), key.SubnetDeploymentName(*subnetInAzure.Name))
+			err = r.deleteARMDeployment(ctx, deploymentsClient, key.ResourceGroupNameFromAzureCluster(azureCluster), key.SubnetDeploymentName(*subnetInAzure.Name))
 			if err != nil {
 				return microerror.Mask(err)
 			}
@@ -329,7 +351,7 @@ func getSubnetIDFromDeploymentOutput(currentDeployment azureresource.DeploymentE
 }
 
 func (r *Resource) ensureSubnetIsAllowedToStorageAccount(ctx context.Context, storageAccountsClient *storage.AccountsClient, azureCluster *capzv1alpha3.AzureCluster, allocatedSubnet *capzv1alpha3.SubnetSpec) error {
-	storageAccount, err := storageAccountsClient.GetProperties(ctx, key.ClusterID(azureCluster), key.StorageAccountName(azureCluster), "")
+	storageAccount, err := storageAccountsClient.GetProperties(ctx, key.ResourceGroupNameFromAzureCluster(*azureCluster), key.StorageAccountName(azureCluster), "")
 	if err != nil {
 		return microerror.Mask(err)
 	}
@@ -337,7 +359,7 @@ func (r *Resource) ensureSubnetIsAllowedToStorageAccount(ctx context.Context, st
 	if !isSubnetAllowedToStorageAccount(storageAccount, allocatedSubnet.ID) {
 		r.logger.Debugf(ctx, "Ensuring subnet %#q is allowed into storage account", allocatedSubnet.Name)
 
-		err = addSubnetToStoreAccountAllowedSubnets(ctx, storageAccountsClient, storageAccount, key.ClusterID(azureCluster), key.StorageAccountName(azureCluster), allocatedSubnet.ID)
+		err = addSubnetToStoreAccountAllowedSubnets(ctx, storageAccountsClient, storageAccount, key.ResourceGroupNameFromAzureCluster(*azureCluster), key.StorageAccountName(azureCluster), allocatedSubnet.ID)
 		if err != nil {
 			return microerror.Mask(err)
 		}
@@ -380,7 +402,7 @@ func isSubnetAllowedToStorageAccount(storageAccount storage.Account, subnetID st
 
 // This functions decides whether or not the ARM deployment is out of date.
 // We only take into consideration the subnet's name and CIDR.
-func (r *Resource) isDeploymentOutOfDate(ctx context.Context, allocatedSubnet *capzv1alpha3.SubnetSpec, currentDeployment azureresource.DeploymentExtended) (bool, error) {
+func (r *Resource) isDeploymentOutOfDate(ctx context.Context, allocatedSubnet *capzv1alpha3.SubnetSpec, customTags map[string]string, currentDeployment azureresource.DeploymentExtended) (bool, error) {
 	currentParams, ok := currentDeployment.Properties.Parameters.(map[string]interface{})
 	if !ok {
 		return false, microerror.Maskf(wrongTypeError, "expected '%T', got '%T'", map[string]interface{}{}, currentDeployment.Properties.Parameters)
@@ -396,20 +418,31 @@ func (r *Resource) isDeploymentOutOfDate(ctx context.Context, allocatedSubnet *c
 		return false, microerror.Maskf(wrongTypeError, "expected 'string', got '%T'", currentParams["nodepoolName"].(map[string]interface{})["value"])
 	}
 
+	// Deployments created before user-defined tags were introduced don't
+	// have the parameter.
+	deployedTags := map[string]interface{}{}
+	if param, ok := currentParams["customTags"].(map[string]interface{}); ok {
+		if value, ok := param["value"].(map[string]interface{}); ok {
+			deployedTags = value
+		}
+	}
+
 	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("Checking if deployment is out of date for %#q", nodepoolName), "desiredSubnetName", allocatedSubnet.Name, "deploymentSubnetName", nodepoolName, "desiredSubnetCidr", allocatedSubnet.CIDRBlocks[0], "deploymentSubnetCidr", subnetCidr)
 
-	return allocatedSubnet.Name != nodepoolName || allocatedSubnet.CIDRBlocks[0] != subnetCidr, nil
+	return allocatedSubnet.Name != nodepoolName || allocatedSubnet.CIDRBlocks[0] != subnetCidr || !reflect.DeepEqual(deployedTags, tags.TemplateParameter(customTags)), nil
 }
 
-func (r *Resource) getDeploymentParameters(azureCluster *capzv1alpha3.AzureCluster, natGatewayId string, allocatedSubnet *capzv1alpha3.SubnetSpec) (map[string]interface{}, error) {
+func (r *Resource) getDeploymentParameters(azureCluster *capzv1alpha3.AzureCluster, natGatewayId string, allocatedSubnet *capzv1alpha3.SubnetSpec, customTags map[string]string) (map[string]interface{}, error) {
 	// @TODO: nat gateway, route table and security group names should come from CR state instead of convention.
 	return map[string]interface{}{
-		"natGatewayId":       natGatewayId,
-		"nodepoolName":       allocatedSubnet.Name,
-		"routeTableName":     fmt.Sprintf("%s-%s", key.ClusterID(azureCluster), "RouteTable"),
-		"securityGroupName":  fmt.Sprintf("%s-%s", key.ClusterID(azureCluster), "WorkerSecurityGroup"),
-		"subnetCidr":         allocatedSubnet.CIDRBlocks[0],
-		"virtualNetworkName": azureCluster.Spec.NetworkSpec.Vnet.Name,
+		"customTags":                  tags.TemplateParameter(customTags),
+		"natGatewayId":                natGatewayId,
+		"nodepoolName":                allocatedSubnet.Name,
+		"routeTableName":              fmt.Sprintf("%s-%s", key.ClusterID(azureCluster), "RouteTable"),
+		"securityGroupName":           fmt.Sprintf("%s-%s", key.ClusterID(azureCluster), "WorkerSecurityGroup"),
+		"subnetCidr":                  allocatedSubnet.CIDRBlocks[0],
+		"virtualNetworkName":          azureCluster.Spec.NetworkSpec.Vnet.Name,
+		"virtualNetworkResourceGroup": key.VnetResourceGroupNameFromAzureCluster(*azureCluster),
 	}, nil
 }
 
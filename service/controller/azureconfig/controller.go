@@ -18,6 +18,7 @@ import (
 	"github.com/giantswarm/tenantcluster/v3/pkg/tenantcluster"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/giantswarm/azure-operator/v5/client"
 	"github.com/giantswarm/azure-operator/v5/pkg/credential"
@@ -28,20 +29,26 @@ import (
 	"github.com/giantswarm/azure-operator/v5/pkg/label"
 	"github.com/giantswarm/azure-operator/v5/pkg/locker"
 	"github.com/giantswarm/azure-operator/v5/pkg/project"
+	"github.com/giantswarm/azure-operator/v5/pkg/ratelimit"
+	"github.com/giantswarm/azure-operator/v5/pkg/recorder"
 	"github.com/giantswarm/azure-operator/v5/service/collector"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/azureconfigfinalizer"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/blobobject"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/capzcrs"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/clusterid"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/containerurl"
+	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/deletionprotection"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/deployment"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/dnsrecord"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/encryptionkey"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/endpoints"
+	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/etcdsnapshot"
+	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/keyvault"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/masters"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/namespace"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/resourcegroup"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/service"
+	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/vnetpeering"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/volumebindingmigration"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/vpn"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/vpnconnection"
@@ -49,6 +56,7 @@ import (
 	"github.com/giantswarm/azure-operator/v5/service/controller/cloudconfig"
 	"github.com/giantswarm/azure-operator/v5/service/controller/controllercontext"
 	"github.com/giantswarm/azure-operator/v5/service/controller/debugger"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/workerpool"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
 )
@@ -62,11 +70,17 @@ type ControllerConfig struct {
 
 	Azure                 setting.Azure
 	AzureMetricsCollector collector.AzureAPIMetrics
+	AzureRateLimiter      *ratelimit.Limiter
 	// Azure client set used when managing control plane resources
 	CPAzureClientSet *client.AzureClientSet
 	ProjectName      string
 
+	CloudProvider       setting.CloudProvider
 	ClusterVNetMaskBits int
+	EtcdSnapshots       setting.EtcdSnapshots
+	// InstanceConcurrency is the number of VMSS instances operated on in
+	// parallel.
+	InstanceConcurrency int
 
 	Ignition          setting.Ignition
 	IPAMNetworkRange  net.IPNet
@@ -95,6 +109,9 @@ func NewController(config ControllerConfig) (*controller.Controller, error) {
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
+	if config.InstanceConcurrency <= 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.InstanceConcurrency must be greater than zero", config)
+	}
 
 	var err error
 
@@ -135,7 +152,7 @@ func NewController(config ControllerConfig) (*controller.Controller, error) {
 					return nil, microerror.Mask(err)
 				}
 
-				tenantClusterAzureClientSet, err := client.NewAzureClientSet(organizationAzureClientCredentialsConfig, config.AzureMetricsCollector, subscriptionID, partnerID)
+				tenantClusterAzureClientSet, err := client.NewAzureClientSet(organizationAzureClientCredentialsConfig, config.AzureMetricsCollector, config.AzureRateLimiter, subscriptionID, partnerID)
 				if err != nil {
 					return nil, microerror.Mask(err)
 				}
@@ -145,6 +162,7 @@ func NewController(config ControllerConfig) (*controller.Controller, error) {
 					c := cloudconfig.Config{
 						Azure:                  config.Azure,
 						AzureClientCredentials: organizationAzureClientCredentialsConfig,
+						CloudProvider:          config.CloudProvider,
 						CtrlClient:             config.K8sClient.CtrlClient(),
 						DockerhubToken:         config.DockerhubToken,
 						Ignition:               config.Ignition,
@@ -212,6 +230,7 @@ func newAzureConfigResources(config ControllerConfig, certsSearcher certs.Interf
 	{
 		c := client.FactoryConfig{
 			AzureAPIMetrics:    config.AzureMetricsCollector,
+			RateLimiter:        config.AzureRateLimiter,
 			CacheDuration:      30 * time.Minute,
 			CredentialProvider: config.CredentialProvider,
 			Logger:             config.Logger,
@@ -341,6 +360,23 @@ func newAzureConfigResources(config ControllerConfig, certsSearcher certs.Interf
 		}
 	}
 
+	var keyVaultResource resource.Interface
+	{
+		c := keyvault.Config{
+			CtrlClient: config.K8sClient.CtrlClient(),
+			Debugger:   newDebugger,
+			K8sClient:  config.K8sClient.K8sClient(),
+			Logger:     config.Logger,
+
+			Azure: config.Azure,
+		}
+
+		keyVaultResource, err = keyvault.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var blobObjectResource resource.Interface
 	{
 		c := blobobject.Config{
@@ -367,6 +403,7 @@ func newAzureConfigResources(config ControllerConfig, certsSearcher certs.Interf
 	var deploymentResource resource.Interface
 	{
 		c := deployment.Config{
+			CtrlClient:       config.K8sClient.CtrlClient(),
 			Debugger:         newDebugger,
 			G8sClient:        config.K8sClient.G8sClient(),
 			InstallationName: config.InstallationName,
@@ -390,6 +427,8 @@ func newAzureConfigResources(config ControllerConfig, certsSearcher certs.Interf
 		c := dnsrecord.Config{
 			CPRecordSetsClient: *config.CPAzureClientSet.DNSRecordSetsClient,
 			Logger:             config.Logger,
+
+			InstallationName: config.InstallationName,
 		}
 
 		ops, err := dnsrecord.New(c)
@@ -421,21 +460,54 @@ func newAzureConfigResources(config ControllerConfig, certsSearcher certs.Interf
 		}
 	}
 
+	var eventRecorder record.EventRecorder
+	{
+		c := recorder.Config{
+			K8sClient: config.K8sClient,
+
+			Component: project.Name(),
+		}
+
+		eventRecorder, err = recorder.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var deletionProtectionResource resource.Interface
+	{
+		c := deletionprotection.Config{
+			CtrlClient:    config.K8sClient.CtrlClient(),
+			EventRecorder: eventRecorder,
+			Logger:        config.Logger,
+		}
+
+		deletionProtectionResource, err = deletionprotection.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	nodesConfig := nodes.Config{
-		CtrlClient: config.K8sClient.CtrlClient(),
-		Debugger:   newDebugger,
-		Logger:     config.Logger,
+		CtrlClient:    config.K8sClient.CtrlClient(),
+		Debugger:      newDebugger,
+		EventRecorder: eventRecorder,
+		Logger:        config.Logger,
 
 		Azure:         config.Azure,
 		ClientFactory: organizationClientFactory,
+		CloudProvider: config.CloudProvider,
+		Ignition:      config.Ignition,
 	}
 
 	var mastersResource resource.Interface
 	{
 		c := masters.Config{
 			Config:                   nodesConfig,
+			CertsSearcher:            certsSearcher,
 			CtrlClient:               config.K8sClient.CtrlClient(),
 			TenantRestConfigProvider: tenantRestConfigProvider,
+			WorkerPool:               workerpool.New(config.InstanceConcurrency, config.Logger),
 		}
 
 		mastersResource, err = masters.New(c)
@@ -444,6 +516,22 @@ func newAzureConfigResources(config ControllerConfig, certsSearcher certs.Interf
 		}
 	}
 
+	var etcdSnapshotResource resource.Interface
+	{
+		c := etcdsnapshot.Config{
+			CtrlClient:    config.K8sClient.CtrlClient(),
+			EventRecorder: eventRecorder,
+			Logger:        config.Logger,
+
+			EtcdSnapshots: config.EtcdSnapshots,
+		}
+
+		etcdSnapshotResource, err = etcdsnapshot.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var workerMigrationResource resource.Interface
 	{
 		c := workermigration.Config{
@@ -492,14 +580,18 @@ func newAzureConfigResources(config ControllerConfig, certsSearcher certs.Interf
 	var virtualNetworkCollector *ipam.VirtualNetworkCollector
 	{
 		c := ipam.VirtualNetworkCollectorConfig{
-			AzureMetricsCollector: config.AzureMetricsCollector,
-			CredentialProvider:    config.CredentialProvider,
-			K8sClient:             config.K8sClient,
-			InstallationName:      config.InstallationName,
-			Logger:                config.Logger,
+			AzureMetricsCollector:     config.AzureMetricsCollector,
+			AzureRateLimiter:          config.AzureRateLimiter,
+			CredentialProvider:        config.CredentialProvider,
+			HostVirtualNetworksClient: config.CPAzureClientSet.VirtualNetworkClient,
+			K8sClient:                 config.K8sClient,
+			InstallationName:          config.InstallationName,
+			Logger:                    config.Logger,
 
-			NetworkRange:  config.IPAMNetworkRange,
-			ReservedCIDRs: config.IPAMReservedCIDRs,
+			HostResourceGroup:  config.Azure.HostCluster.ResourceGroup,
+			HostVirtualNetwork: config.Azure.HostCluster.VirtualNetwork,
+			NetworkRange:       config.IPAMNetworkRange,
+			ReservedCIDRs:      config.IPAMReservedCIDRs,
 		}
 
 		virtualNetworkCollector, err = ipam.NewVirtualNetworkCollector(c)
@@ -609,6 +701,7 @@ func newAzureConfigResources(config ControllerConfig, certsSearcher certs.Interf
 	{
 		c := vpnconnection.Config{
 			Azure:                                    config.Azure,
+			CtrlClient:                               config.K8sClient.CtrlClient(),
 			Logger:                                   config.Logger,
 			CPVirtualNetworkGatewaysClient:           *config.CPAzureClientSet.VirtualNetworkGatewaysClient,
 			CPVirtualNetworkGatewayConnectionsClient: *config.CPAzureClientSet.VirtualNetworkGatewayConnectionsClient,
@@ -625,8 +718,28 @@ func newAzureConfigResources(config ControllerConfig, certsSearcher certs.Interf
 		}
 	}
 
+	var vnetPeeringResource resource.Interface
+	{
+		c := vnetpeering.Config{
+			CPVirtualNetworkPeeringsClient: config.CPAzureClientSet.VnetPeeringClient,
+			CPVirtualNetworksClient:        config.CPAzureClientSet.VirtualNetworkClient,
+			CtrlClient:                     config.K8sClient.CtrlClient(),
+			Logger:                         config.Logger,
+
+			Azure: config.Azure,
+		}
+
+		vnetPeeringResource, err = vnetpeering.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	resources := []resource.Interface{
 		azureconfigFinalizerResource,
+		// Deletion protection must come before all resources deleting
+		// anything of the cluster.
+		deletionProtectionResource,
 		clusteridResource,
 		capzcrsResource,
 		namespaceResource,
@@ -635,16 +748,19 @@ func newAzureConfigResources(config ControllerConfig, certsSearcher certs.Interf
 		serviceResource,
 		resourceGroupResource,
 		encryptionkeyResource,
+		keyVaultResource,
 		deploymentResource,
 		containerURLResource,
 		blobObjectResource,
 		dnsrecordResource,
 		mastersResource,
+		etcdSnapshotResource,
 		workerMigrationResource,
 		endpointsResource,
 		volumeBindingMigrationResource,
 		vpnResource,
 		vpnconnectionResource,
+		vnetPeeringResource,
 	}
 
 	{
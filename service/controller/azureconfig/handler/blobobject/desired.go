@@ -10,6 +10,7 @@ import (
 	"github.com/giantswarm/operatorkit/v4/pkg/controller/context/resourcecanceledcontext"
 	"golang.org/x/sync/errgroup"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
 	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -127,6 +128,17 @@ func (r *Resource) GetDesiredState(ctx context.Context, obj interface{}) (interf
 		}
 	}
 
+	// The AzureCluster CR carries the overrides of the cloud provider
+	// settings of the cluster.
+	var azureCluster *capzv1alpha3.AzureCluster
+	if cluster.Spec.InfrastructureRef != nil {
+		azureCluster = &capzv1alpha3.AzureCluster{}
+		err := r.ctrlClient.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Spec.InfrastructureRef.Name}, azureCluster)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	// Inject in the azureConfig the SREs' public keys.
 	{
 		cr.Spec.Cluster.Kubernetes.SSH.UserList = key.ToClusterKubernetesSSHUser(r.sshUserList)
@@ -145,6 +157,7 @@ func (r *Resource) GetDesiredState(ctx context.Context, obj interface{}) (interf
 		images := k8scloudconfig.BuildImages(r.registryDomain, versions)
 
 		ignitionTemplateData = cloudconfig.IgnitionTemplateData{
+			AzureCluster:    azureCluster,
 			Cluster:         &cluster,
 			CustomObject:    cr,
 			Images:          images,
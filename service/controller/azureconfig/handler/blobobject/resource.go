@@ -98,8 +98,9 @@ func (r *Resource) toEncrypterObject(ctx context.Context, secretName string) (en
 			return nil, microerror.Maskf(invalidConfigError, "encryption iv not found in secret %q", secret.Name)
 		}
 		c := encrypter.Config{
-			Key: secret.Data[key.CertificateEncryptionKeyName],
-			IV:  secret.Data[key.CertificateEncryptionIVName],
+			Key:         secret.Data[key.CertificateEncryptionKeyName],
+			IV:          secret.Data[key.CertificateEncryptionIVName],
+			PreviousKey: secret.Data[key.CertificateEncryptionPreviousKeyName],
 		}
 
 		enc, err = encrypter.New(c)
@@ -83,15 +83,17 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 			mergeFunc:      nil,
 		})
 
-		o, err = r.mapAzureConfigToAzureMachine(ctx, cr)
+		objs, err := r.mapAzureConfigToAzureMachines(ctx, cr)
 		if err != nil {
 			return microerror.Mask(err)
 		}
-		mappedCRs = append(mappedCRs, crmapping{
-			obj:            o,
-			needUpdateFunc: nil,
-			mergeFunc:      nil,
-		})
+		for _, o := range objs {
+			mappedCRs = append(mappedCRs, crmapping{
+				obj:            o,
+				needUpdateFunc: nil,
+				mergeFunc:      nil,
+			})
+		}
 	}
 
 	err = r.updateCRs(ctx, mappedCRs)
@@ -173,12 +175,12 @@ func (r *Resource) mapAzureConfigToAzureCluster(ctx context.Context, cr provider
 				Host: key.ClusterAPIEndpoint(cr),
 				Port: 443,
 			},
-			ResourceGroup: key.ClusterID(&cr),
+			ResourceGroup: key.ResourceGroupName(cr),
 			NetworkSpec: capzv1alpha3.NetworkSpec{
 				Vnet: capzv1alpha3.VnetSpec{
 					CIDRBlocks:    []string{key.VnetCIDR(cr)},
 					Name:          key.VnetName(cr),
-					ResourceGroup: key.ResourceGroupName(cr),
+					ResourceGroup: key.VnetResourceGroupName(cr),
 				},
 			},
 		},
@@ -187,18 +189,29 @@ func (r *Resource) mapAzureConfigToAzureCluster(ctx context.Context, cr provider
 	return azureCluster, nil
 }
 
-func (r *Resource) mapAzureConfigToAzureMachine(ctx context.Context, cr providerv1alpha1.AzureConfig) (runtime.Object, error) {
+// mapAzureConfigToAzureMachines returns one AzureMachine per master of the
+// cluster, spread over the availability zones of the cluster.
+func (r *Resource) mapAzureConfigToAzureMachines(ctx context.Context, cr providerv1alpha1.AzureConfig) ([]runtime.Object, error) {
 	if len(cr.Spec.Azure.Masters) == 0 {
 		return nil, microerror.Maskf(invalidConfigError, "no master nodes defined")
 	}
-	vmSize := cr.Spec.Azure.Masters[0].VMSize
+
+	var objs []runtime.Object
+	for i, m := range cr.Spec.Azure.Masters {
+		objs = append(objs, r.mapAzureConfigToAzureMachine(ctx, cr, i, m.VMSize))
+	}
+
+	return objs, nil
+}
+
+func (r *Resource) mapAzureConfigToAzureMachine(ctx context.Context, cr providerv1alpha1.AzureConfig, index int, vmSize string) runtime.Object {
 	azureMachine := &capzv1alpha3.AzureMachine{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: capzv1alpha3.GroupVersion.String(),
 			Kind:       "AzureMachine",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      key.AzureMachineName(&cr),
+			Name:      key.MasterAzureMachineName(&cr, index),
 			Namespace: key.OrganizationNamespace(&cr),
 			Labels: map[string]string{
 				label.AzureOperatorVersion:                key.OperatorVersion(&cr),
@@ -232,11 +245,12 @@ func (r *Resource) mapAzureConfigToAzureMachine(ctx context.Context, cr provider
 		},
 	}
 
-	if len(key.AvailabilityZones(cr, r.location)) > 0 {
-		azureMachine.Spec.FailureDomain = to.StringP(strconv.Itoa(key.AvailabilityZones(cr, r.location)[0]))
+	zone, ok := key.MasterFailureDomain(cr, r.location, index)
+	if ok {
+		azureMachine.Spec.FailureDomain = to.StringP(strconv.Itoa(zone))
 	}
 
-	return azureMachine, nil
+	return azureMachine
 }
 
 func (r *Resource) updateCRs(ctx context.Context, crmappings []crmapping) error {
@@ -18,7 +18,7 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 	r.logger.Debugf(ctx, "finding storage account")
 
 	containerName := key.BlobContainerName()
-	groupName := key.ClusterID(&cr)
+	groupName := key.ResourceGroupName(cr)
 	storageAccountName := key.StorageAccountName(&cr)
 
 	storageAccountsClient, err := r.getStorageAccountsClient(ctx)
@@ -0,0 +1,43 @@
+package deletionprotection
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+// EnsureCreated locks the resource group of clusters protected against
+// deletion and unlocks it once the protection is removed.
+func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	azureCluster, err := r.getAzureCluster(ctx, cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if azureCluster != nil && key.DeletionProtection(*azureCluster) {
+		err = r.ensureLocked(ctx, cr, cc.AzureClientSet.ManagementLocksClient)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	} else {
+		err = r.ensureUnlocked(ctx, cr, cc.AzureClientSet.ManagementLocksClient)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
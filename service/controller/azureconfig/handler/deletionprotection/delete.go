@@ -0,0 +1,59 @@
+package deletionprotection
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/operatorkit/v4/pkg/controller/context/finalizerskeptcontext"
+	"github.com/giantswarm/operatorkit/v4/pkg/controller/context/reconciliationcanceledcontext"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+// EnsureDeleted keeps the finalizer of clusters protected against deletion
+// and cancels the reconciliation, so that no other handler deletes anything
+// of them. The resource group of other clusters is unlocked, so that it can
+// be deleted.
+func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	azureCluster, err := r.getAzureCluster(ctx, cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if azureCluster != nil && key.DeletionProtection(*azureCluster) {
+		// The lock is ensured in case it was removed by hand.
+		err = r.ensureLocked(ctx, cr, cc.AzureClientSet.ManagementLocksClient)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.LogCtx(ctx, "level", "warning", "message", "refusing to delete cluster protected against deletion", "annotation", annotation.DeletionProtection)
+		r.eventRecorder.Eventf(azureCluster, corev1.EventTypeWarning, DeletionProtectedReason, "Cluster is protected against deletion, remove annotation %s to delete it", annotation.DeletionProtection)
+
+		finalizerskeptcontext.SetKept(ctx)
+		reconciliationcanceledcontext.SetCanceled(ctx)
+		r.logger.Debugf(ctx, "canceling reconciliation")
+
+		return nil
+	}
+
+	err = r.ensureUnlocked(ctx, cr, cc.AzureClientSet.ManagementLocksClient)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package deletionprotection
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+// getAzureCluster returns the AzureCluster CR of the given AzureConfig CR,
+// which carries the deletion protection annotation. It returns nil when the
+// AzureCluster CR does not exist.
+func (r *Resource) getAzureCluster(ctx context.Context, cr v1alpha1.AzureConfig) (*capzv1alpha3.AzureCluster, error) {
+	azureCluster := &capzv1alpha3.AzureCluster{}
+	err := r.ctrlClient.Get(ctx, ctrlclient.ObjectKey{Namespace: key.OrganizationNamespace(&cr), Name: cr.Name}, azureCluster)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return azureCluster, nil
+}
+
+// ensureLocked puts a CanNotDelete management lock on the resource group of
+// the given cluster.
+func (r *Resource) ensureLocked(ctx context.Context, cr v1alpha1.AzureConfig, locksClient *locks.ManagementLocksClient) error {
+	r.logger.Debugf(ctx, "ensuring resource group is locked against deletion")
+
+	lock, err := locksClient.GetAtResourceGroupLevel(ctx, key.ResourceGroupName(cr), key.DeletionProtectionLockName)
+	if IsNotFound(err) {
+		// fall through
+	} else if err != nil {
+		return microerror.Mask(err)
+	} else if lock.ManagementLockProperties != nil && lock.Level == locks.CanNotDelete {
+		r.logger.Debugf(ctx, "ensured resource group is locked against deletion")
+		return nil
+	}
+
+	lock = locks.ManagementLockObject{
+		ManagementLockProperties: &locks.ManagementLockProperties{
+			Level: locks.CanNotDelete,
+			Notes: to.StringPtr("Protects the cluster against deletion as long as the AzureCluster CR has the " + annotation.DeletionProtection + " annotation."),
+		},
+	}
+
+	_, err = locksClient.CreateOrUpdateAtResourceGroupLevel(ctx, key.ResourceGroupName(cr), key.DeletionProtectionLockName, lock)
+	if IsNotFound(err) {
+		r.logger.Debugf(ctx, "resource group %#q not found yet", key.ResourceGroupName(cr))
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "ensured resource group is locked against deletion")
+
+	return nil
+}
+
+// ensureUnlocked removes the management lock put on the resource group of the
+// given cluster by ensureLocked.
+func (r *Resource) ensureUnlocked(ctx context.Context, cr v1alpha1.AzureConfig, locksClient *locks.ManagementLocksClient) error {
+	_, err := locksClient.GetAtResourceGroupLevel(ctx, key.ResourceGroupName(cr), key.DeletionProtectionLockName)
+	if IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "ensuring resource group is unlocked")
+
+	_, err = locksClient.DeleteAtResourceGroupLevel(ctx, key.ResourceGroupName(cr), key.DeletionProtectionLockName)
+	if IsNotFound(err) {
+		// fall through
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "ensured resource group is unlocked")
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package deletionprotection
+
+import (
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// Name is the identifier of the resource.
+	Name = "deletionprotection"
+
+	DeletionProtectedReason = "DeletionProtected"
+)
+
+type Config struct {
+	CtrlClient    client.Client
+	EventRecorder record.EventRecorder
+	Logger        micrologger.Logger
+}
+
+// Resource protects clusters with the deletion protection annotation against
+// deletion. It locks their resource group and refuses to delete them until
+// the annotation is removed. It must run before all other handlers deleting
+// anything of the cluster.
+type Resource struct {
+	ctrlClient    client.Client
+	eventRecorder record.EventRecorder
+	logger        micrologger.Logger
+}
+
+func New(config Config) (*Resource, error) {
+	if config.CtrlClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CtrlClient must not be empty", config)
+	}
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	r := &Resource{
+		ctrlClient:    config.CtrlClient,
+		eventRecorder: config.EventRecorder,
+		logger:        config.Logger,
+	}
+
+	return r, nil
+}
+
+// Name returns the resource name.
+func (r *Resource) Name() string {
+	return Name
+}
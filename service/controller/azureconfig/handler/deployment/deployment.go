@@ -10,6 +10,7 @@ import (
 	"github.com/giantswarm/microerror"
 
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/deployment/template"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/tags"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 	"github.com/giantswarm/azure-operator/v5/service/network"
 )
@@ -36,23 +37,36 @@ func (r Resource) newDeployment(ctx context.Context, customObject providerv1alph
 		return azureresource.Deployment{}, microerror.Mask(err)
 	}
 
+	_, _, existingVirtualNetwork := key.ExistingVirtualNetwork(customObject)
+
+	customTags, err := tags.ForCluster(ctx, r.ctrlClient, &customObject)
+	if err != nil {
+		return azureresource.Deployment{}, microerror.Mask(err)
+	}
+
 	defaultParams := map[string]interface{}{
-		"blobContainerName":          key.BlobContainerName(),
-		"calicoSubnetCidr":           key.CalicoCIDR(customObject),
-		"controlPlaneWorkerSubnetID": controlPlaneWorkerSubnetID,
-		"clusterID":                  key.ClusterID(&customObject),
-		"dnsZones":                   key.DNSZones(customObject),
-		"hostClusterCidr":            r.azure.HostCluster.CIDR,
-		"hostPublicIPs":              controlPlanePublicIPs,
-		"insecureStorageAccount":     r.debug.InsecureStorageAccount,
-		"kubernetesAPISecurePort":    key.APISecurePort(customObject),
-		"masterSubnetCidr":           key.MastersSubnetCIDR(customObject),
-		"storageAccountName":         key.StorageAccountName(&customObject),
-		"virtualNetworkCidr":         key.VnetCIDR(customObject),
-		"virtualNetworkName":         key.VnetName(customObject),
-		"vnetGatewaySubnetName":      key.VNetGatewaySubnetName(),
-		"vpnSubnetCidr":              vpnSubnet.String(),
-		"workerSubnetCidr":           key.WorkersSubnetCIDR(customObject),
+		"blobContainerName":           key.BlobContainerName(),
+		"calicoSubnetCidr":            key.CalicoCIDR(customObject),
+		"controlPlaneWorkerSubnetID":  controlPlaneWorkerSubnetID,
+		"clusterID":                   key.ClusterID(&customObject),
+		"customTags":                  tags.TemplateParameter(customTags),
+		"dnsZones":                    key.DNSZones(customObject),
+		"existingVirtualNetwork":      existingVirtualNetwork,
+		"GiantSwarmTags":              key.TemplateTags(customObject, r.installationName),
+		"hostClusterCidr":             r.azure.HostCluster.CIDR,
+		"hostPublicIPs":               controlPlanePublicIPs,
+		"insecureStorageAccount":      r.debug.InsecureStorageAccount,
+		"kubernetesAPISecurePort":     key.APISecurePort(customObject),
+		"masterSubnetCidr":            key.MastersSubnetCIDR(customObject),
+		"masterSubnetName":            key.MasterSubnetName(customObject),
+		"storageAccountName":          key.StorageAccountName(&customObject),
+		"virtualNetworkCidr":          key.VnetCIDR(customObject),
+		"virtualNetworkName":          key.VnetName(customObject),
+		"virtualNetworkResourceGroup": key.VnetResourceGroupName(customObject),
+		"vnetGatewaySubnetName":       key.VNetGatewaySubnetName(),
+		"vpnSubnetCidr":               vpnSubnet.String(),
+		"workerSubnetCidr":            key.WorkersSubnetCIDR(customObject),
+		"workerSubnetName":            key.WorkerSubnetName(customObject),
 	}
 
 	armTemplate, err := template.GetARMTemplate()
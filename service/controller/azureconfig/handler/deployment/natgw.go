@@ -24,7 +24,7 @@ func (r *Resource) ensureNatGatewayForMasterSubnet(ctx context.Context, cr provi
 
 	r.logger.LogCtx(ctx, "level", "info", "message", fmt.Sprintf("Checking if subnet %s has the nat gateway set as expected", key.MasterSubnetName(cr)))
 
-	subnet, err := subnetsClient.Get(ctx, key.ResourceGroupName(cr), key.VnetName(cr), key.MasterSubnetName(cr), "")
+	subnet, err := subnetsClient.Get(ctx, key.VnetResourceGroupName(cr), key.VnetName(cr), key.MasterSubnetName(cr), "")
 	if err != nil {
 		return microerror.Mask(err)
 	}
@@ -41,7 +41,7 @@ func (r *Resource) ensureNatGatewayForMasterSubnet(ctx context.Context, cr provi
 			ID: to.StringPtr(key.MasterNatGatewayID(cr, subnetsClient.SubscriptionID)),
 		}
 
-		_, err := subnetsClient.CreateOrUpdate(ctx, key.ResourceGroupName(cr), key.VnetName(cr), key.MasterSubnetName(cr), subnet)
+		_, err := subnetsClient.CreateOrUpdate(ctx, key.VnetResourceGroupName(cr), key.VnetName(cr), key.MasterSubnetName(cr), subnet)
 		if err != nil {
 			return microerror.Mask(err)
 		}
@@ -12,6 +12,7 @@ import (
 	"github.com/giantswarm/micrologger"
 	"github.com/giantswarm/operatorkit/v4/pkg/controller/context/reconciliationcanceledcontext"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/giantswarm/azure-operator/v5/client"
 	"github.com/giantswarm/azure-operator/v5/service/controller/controllercontext"
@@ -32,6 +33,7 @@ const (
 )
 
 type Config struct {
+	CtrlClient       ctrlclient.Client
 	Debugger         *debugger.Debugger
 	G8sClient        versioned.Interface
 	InstallationName string
@@ -45,6 +47,7 @@ type Config struct {
 }
 
 type Resource struct {
+	ctrlClient       ctrlclient.Client
 	debugger         *debugger.Debugger
 	g8sClient        versioned.Interface
 	installationName string
@@ -66,6 +69,9 @@ func New(config Config) (*Resource, error) {
 	if config.AzureClientSet == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.AzureClientSet must not be empty", config)
 	}
+	if config.CtrlClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CtrlClient must not be empty", config)
+	}
 	if config.Debugger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Debugger must not be empty", config)
 	}
@@ -86,6 +92,7 @@ func New(config Config) (*Resource, error) {
 	}
 
 	r := &Resource{
+		ctrlClient:       config.CtrlClient,
 		debugger:         config.Debugger,
 		g8sClient:        config.G8sClient,
 		installationName: config.InstallationName,
@@ -125,7 +132,7 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 
 	failed := false
 
-	d, err := deploymentsClient.Get(ctx, key.ClusterID(&cr), mainDeploymentName)
+	d, err := deploymentsClient.Get(ctx, key.ResourceGroupName(cr), mainDeploymentName)
 	if IsNotFound(err) {
 		params := map[string]interface{}{
 			"initialProvisioning": "Yes",
@@ -213,7 +220,7 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		r.logger.Debugf(ctx, "template or parameters changed")
 	}
 
-	res, err := deploymentsClient.CreateOrUpdate(ctx, key.ClusterID(&cr), mainDeploymentName, deployment)
+	res, err := deploymentsClient.CreateOrUpdate(ctx, key.ResourceGroupName(cr), mainDeploymentName, deployment)
 	if err != nil {
 		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("deployment failed; deployment: %#v", deployment), "stack", microerror.JSON(microerror.Mask(err)))
 
@@ -274,7 +281,7 @@ func (r *Resource) enrichControllerContext(ctx context.Context, customObject pro
 		return microerror.Mask(err)
 	}
 
-	resourceGroupName := key.ClusterID(&customObject)
+	resourceGroupName := key.ResourceGroupName(customObject)
 	{
 		v, err := r.getDeploymentOutputValue(ctx, deploymentsClient, resourceGroupName, "master_load_balancer_setup", "backendPoolId")
 		if IsNotFound(err) {
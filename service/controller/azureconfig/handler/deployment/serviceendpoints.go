@@ -23,7 +23,7 @@ func (r *Resource) ensureServiceEndpoints(ctx context.Context, cr providerv1alph
 		return microerror.Mask(err)
 	}
 
-	subnet, err := subnetsClient.Get(ctx, key.ResourceGroupName(cr), key.VnetName(cr), key.MasterSubnetName(cr), "")
+	subnet, err := subnetsClient.Get(ctx, key.VnetResourceGroupName(cr), key.VnetName(cr), key.MasterSubnetName(cr), "")
 	if err != nil {
 		return microerror.Mask(err)
 	}
@@ -44,7 +44,7 @@ func (r *Resource) ensureServiceEndpoints(ctx context.Context, cr providerv1alph
 		Service: to.StringPtr(storageServiceEndpoint),
 	})
 
-	_, err = subnetsClient.CreateOrUpdate(ctx, key.ResourceGroupName(cr), key.VnetName(cr), key.MasterSubnetName(cr), subnet)
+	_, err = subnetsClient.CreateOrUpdate(ctx, key.VnetResourceGroupName(cr), key.VnetName(cr), key.MasterSubnetName(cr), subnet)
 	if err != nil {
 		return microerror.Mask(err)
 	}
@@ -42,6 +42,7 @@ func (r *Resource) getCurrentState(ctx context.Context, obj providerv1alpha1.Azu
 		}
 
 		current[i].NameServers = nameServers
+		current[i].Metadata = resp.Metadata
 	}
 
 	return current, nil
@@ -42,6 +42,7 @@ func (r *Resource) getDesiredState(ctx context.Context, obj providerv1alpha1.Azu
 		nameServers = append(nameServers, *resp.NameServers...)
 
 		desired[i].NameServers = nameServers
+		desired[i].Metadata = key.ClusterTags(obj, r.installationName)
 	}
 
 	return desired, nil
@@ -18,22 +18,31 @@ const (
 type Config struct {
 	CPRecordSetsClient dns.RecordSetsClient
 	Logger             micrologger.Logger
+
+	InstallationName string
 }
 
 // Resource manages Azure resource groups.
 type Resource struct {
 	cpRecordSetsClient dns.RecordSetsClient
 	logger             micrologger.Logger
+
+	installationName string
 }
 
 func New(config Config) (*Resource, error) {
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "config.Logger must not be empty")
 	}
+	if config.InstallationName == "" {
+		return nil, microerror.Maskf(invalidConfigError, "config.InstallationName must not be empty")
+	}
 
 	r := &Resource{
 		cpRecordSetsClient: config.CPRecordSetsClient,
 		logger:             config.Logger,
+
+		installationName: config.InstallationName,
 	}
 
 	return r, nil
@@ -19,6 +19,9 @@ type nsRecord struct {
 	// NameServers are entires for the NS record to be created in the
 	// parent DNS zone.
 	NameServers []string
+	// Metadata holds the tags of the cluster, which tell the record apart
+	// from the ones of other installations.
+	Metadata map[string]*string
 }
 
 type dnsRecords []nsRecord
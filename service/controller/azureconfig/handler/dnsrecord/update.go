@@ -36,6 +36,7 @@ func (r *Resource) applyUpdateChange(ctx context.Context, change dnsRecords) err
 				nameServers = append(nameServers, dns.NsRecord{Nsdname: to.StringPtr(ns)})
 			}
 			params.RecordSetProperties = &dns.RecordSetProperties{
+				Metadata:  record.Metadata,
 				TTL:       to.Int64Ptr(300),
 				NsRecords: &nameServers,
 			}
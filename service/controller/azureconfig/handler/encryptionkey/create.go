@@ -23,13 +23,13 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		return microerror.Mask(err)
 	}
 
-	encKey = make([]byte, keySize)
-	if _, err := io.ReadFull(rand.Reader, encKey); err != nil {
+	encKey, err = newRandomBytes(keySize)
+	if err != nil {
 		return microerror.Mask(err)
 	}
 
-	encIV = make([]byte, aes.BlockSize)
-	if _, err := io.ReadFull(rand.Reader, encIV); err != nil {
+	encIV, err = newRandomBytes(aes.BlockSize)
+	if err != nil {
 		return microerror.Mask(err)
 	}
 
@@ -59,5 +59,24 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		return microerror.Mask(err)
 	}
 
+	err = r.retirePreviousKey(ctx, cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.rotateKey(ctx, cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	return nil
 }
+
+func newRandomBytes(size int) ([]byte, error) {
+	b := make([]byte, size)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,176 @@
+package encryptionkey
+
+import (
+	"context"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v5/service/controller/encrypter"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+// rotateKey generates a new certificate encryption key when a rotation is
+// requested using the annotation.EncryptionKeyRotation annotation. The key in
+// use becomes the previous key, which keeps encrypting the certificates so
+// that nodes which were not rolled yet can still decrypt them when they
+// reboot. The changed key ID makes the masters and nodepool handlers roll all
+// nodes, which get both keys. Only one rotation can be in progress at a time.
+func (r *Resource) rotateKey(ctx context.Context, cr providerv1alpha1.AzureConfig) error {
+	requested, ok := cr.GetAnnotations()[annotation.EncryptionKeyRotation]
+	if !ok {
+		return nil
+	}
+
+	secret, err := r.k8sClient.CoreV1().Secrets(key.CertificateEncryptionNamespace).Get(ctx, key.CertificateEncryptionSecretName(&cr), metav1.GetOptions{})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if secret.GetAnnotations()[annotation.EncryptionKeyRotation] == requested {
+		return nil
+	}
+
+	if _, ok := secret.Data[key.CertificateEncryptionPreviousKeyName]; ok {
+		r.logger.Debugf(ctx, "encryption key rotation %#q requested but previous rotation is still in progress", requested)
+		return nil
+	}
+
+	r.logger.Debugf(ctx, "rotating encryption key as requested by %#q", requested)
+
+	newKey, err := newRandomBytes(keySize)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	secret.Data[key.CertificateEncryptionPreviousKeyName] = secret.Data[key.CertificateEncryptionKeyName]
+	secret.Data[key.CertificateEncryptionKeyName] = newKey
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[annotation.EncryptionKeyRotation] = requested
+
+	_, err = r.k8sClient.CoreV1().Secrets(key.CertificateEncryptionNamespace).Update(ctx, secret, metav1.UpdateOptions{})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "rotated encryption key to key ID %#q", encrypter.KeyID(newKey))
+
+	return nil
+}
+
+// retirePreviousKey removes the previous certificate encryption key once every
+// VMSS of the cluster uses the current key in its model and all of their
+// instances run the latest model, which means all nodes have been rolled. Only
+// scale sets carrying the cluster tag are considered, since a bring-your-own
+// resource group may hold scale sets the operator does not manage. The
+// blobobject and cloudconfigblob handlers then re-encrypt the certificates
+// with the current key.
+func (r *Resource) retirePreviousKey(ctx context.Context, cr providerv1alpha1.AzureConfig) error {
+	secret, err := r.k8sClient.CoreV1().Secrets(key.CertificateEncryptionNamespace).Get(ctx, key.CertificateEncryptionSecretName(&cr), metav1.GetOptions{})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	previousKey, ok := secret.Data[key.CertificateEncryptionPreviousKeyName]
+	if !ok {
+		return nil
+	}
+
+	keyID := encrypter.KeyID(secret.Data[key.CertificateEncryptionKeyName])
+
+	rolled, err := r.allNodesRolled(ctx, cr, keyID)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if !rolled {
+		r.logger.Debugf(ctx, "waiting for all nodes to roll to encryption key ID %#q before retiring key ID %#q", keyID, encrypter.KeyID(previousKey))
+		return nil
+	}
+
+	r.logger.Debugf(ctx, "retiring encryption key ID %#q", encrypter.KeyID(previousKey))
+
+	delete(secret.Data, key.CertificateEncryptionPreviousKeyName)
+
+	_, err = r.k8sClient.CoreV1().Secrets(key.CertificateEncryptionNamespace).Update(ctx, secret, metav1.UpdateOptions{})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "retired encryption key ID %#q", encrypter.KeyID(previousKey))
+
+	return nil
+}
+
+func (r *Resource) allNodesRolled(ctx context.Context, cr providerv1alpha1.AzureConfig, keyID string) (bool, error) {
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	resourceGroupName := key.ResourceGroupName(cr)
+
+	scaleSets, err := cc.AzureClientSet.VirtualMachineScaleSetsClient.ListComplete(ctx, resourceGroupName)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	var found bool
+	for scaleSets.NotDone() {
+		vmss := scaleSets.Value()
+
+		if !key.IsClusterResource(vmss.Tags, key.ClusterID(&cr)) {
+			err = scaleSets.NextWithContext(ctx)
+			if err != nil {
+				return false, microerror.Mask(err)
+			}
+			continue
+		}
+		found = true
+
+		tag, ok := vmss.Tags[key.EncryptionKeyIDTag]
+		if !ok || tag == nil || *tag != keyID {
+			r.logger.Debugf(ctx, "VMSS %#q does not use encryption key ID %#q yet", *vmss.Name, keyID)
+			return false, nil
+		}
+
+		instances, err := cc.AzureClientSet.VirtualMachineScaleSetVMsClient.ListComplete(ctx, resourceGroupName, *vmss.Name, "", "", "")
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+
+		for instances.NotDone() {
+			instance := instances.Value()
+			if instance.LatestModelApplied == nil || !*instance.LatestModelApplied {
+				r.logger.Debugf(ctx, "instance %#q of VMSS %#q does not run the latest model yet", *instance.InstanceID, *vmss.Name)
+				return false, nil
+			}
+
+			err = instances.NextWithContext(ctx)
+			if err != nil {
+				return false, microerror.Mask(err)
+			}
+		}
+
+		err = scaleSets.NextWithContext(ctx)
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+	}
+
+	// Scale sets created before they got the cluster tag only get it with the
+	// deployment of the current key, so nothing has rolled yet when none of
+	// them carries it.
+	if !found {
+		r.logger.Debugf(ctx, "no VMSS of the cluster uses encryption key ID %#q yet", keyID)
+		return false, nil
+	}
+
+	return true, nil
+}
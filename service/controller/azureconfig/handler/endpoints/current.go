@@ -43,7 +43,7 @@ func (r *Resource) GetCurrentState(ctx context.Context, obj interface{}) (interf
 			return nil, microerror.Mask(err)
 		}
 
-		g := key.ClusterID(&cr)
+		g := key.ResourceGroupName(cr)
 		s := key.MasterVMSSName(cr)
 		_, err = interfacesClient.ListVirtualMachineScaleSetNetworkInterfaces(ctx, g, s)
 		if IsNetworkInterfacesNotFound(err) {
@@ -2,6 +2,7 @@ package endpoints
 
 import (
 	"context"
+	"sort"
 
 	"github.com/giantswarm/microerror"
 	v1 "k8s.io/api/core/v1"
@@ -17,7 +18,7 @@ func (r *Resource) GetDesiredState(ctx context.Context, obj interface{}) (interf
 		return nil, microerror.Mask(err)
 	}
 
-	masterNICPrivateIPs, err := r.getMasterNICPrivateIPs(ctx, key.ClusterID(&cr), key.MasterVMSSName(cr))
+	masterNICPrivateIPs, err := r.getMasterNICPrivateIPs(ctx, key.ResourceGroupName(cr), key.MasterVMSSName(cr))
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
@@ -37,19 +38,28 @@ func (r *Resource) GetDesiredState(ctx context.Context, obj interface{}) (interf
 		},
 	}
 
-	for _, ip := range masterNICPrivateIPs {
-		endpoints.Subsets = append(endpoints.Subsets, v1.EndpointSubset{
-			Addresses: []v1.EndpointAddress{
-				{
-					IP: ip,
-				},
-			},
+	// All masters serve the Kubernetes API on the same port, so they are
+	// registered as addresses of a single subset. The addresses are sorted
+	// like the Kubernetes API stores them, so that the current and desired
+	// state can be compared.
+	if len(masterNICPrivateIPs) > 0 {
+		sort.Strings(masterNICPrivateIPs)
+
+		subset := v1.EndpointSubset{
 			Ports: []v1.EndpointPort{
 				{
-					Port: httpsPort,
+					Port:     httpsPort,
+					Protocol: v1.ProtocolTCP,
 				},
 			},
-		})
+		}
+		for _, ip := range masterNICPrivateIPs {
+			subset.Addresses = append(subset.Addresses, v1.EndpointAddress{
+				IP: ip,
+			})
+		}
+
+		endpoints.Subsets = []v1.EndpointSubset{subset}
 	}
 
 	return endpoints, nil
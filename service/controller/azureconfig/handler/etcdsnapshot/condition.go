@@ -0,0 +1,69 @@
+package etcdsnapshot
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capiconditions "sigs.k8s.io/cluster-api/util/conditions"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// EtcdSnapshotReadyCondition is True on the AzureCluster CR while a
+	// snapshot of the etcd data disks of all masters exists which is not older
+	// than the snapshot interval. Its message tells when the last successful
+	// snapshot was taken. It is False while no snapshot is available yet, the
+	// last snapshot failed, the snapshots are disabled or the etcd data disks
+	// are restored.
+	EtcdSnapshotReadyCondition capiv1alpha3.ConditionType = "EtcdSnapshotReady"
+
+	EtcdSnapshotTakenReason     = "EtcdSnapshotTaken"
+	EtcdSnapshotFailedReason    = "EtcdSnapshotFailed"
+	EtcdSnapshotPendingReason   = "EtcdSnapshotPending"
+	EtcdSnapshotRestoringReason = "EtcdSnapshotRestoring"
+	EtcdSnapshotRestoredReason  = "EtcdSnapshotRestored"
+	InvalidConfigReason         = "InvalidConfig"
+	SnapshotsDisabledReason     = "SnapshotsDisabled"
+)
+
+// setEtcdSnapshotCondition sets the EtcdSnapshotReady condition on the given
+// AzureCluster CR, unless it is set like that already.
+func (r *Resource) setEtcdSnapshotCondition(ctx context.Context, cr *capzv1alpha3.AzureCluster, status corev1.ConditionStatus, reason string, severity capiv1alpha3.ConditionSeverity, message string) error {
+	current := capiconditions.Get(cr, EtcdSnapshotReadyCondition)
+	if current != nil && current.Status == status && current.Reason == reason && current.Message == message {
+		return nil
+	}
+
+	// Get the newest CR version so that conditions set by other handlers in
+	// the meantime are not overwritten.
+	azureCluster := &capzv1alpha3.AzureCluster{}
+	err := r.ctrlClient.Get(ctx, ctrlclient.ObjectKey{Namespace: cr.Namespace, Name: cr.Name}, azureCluster)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	condition := &capiv1alpha3.Condition{
+		Type:    EtcdSnapshotReadyCondition,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	}
+	if status == corev1.ConditionFalse {
+		condition.Severity = severity
+	}
+	capiconditions.Set(azureCluster, condition)
+
+	err = r.ctrlClient.Status().Update(ctx, azureCluster)
+	if apierrors.IsConflict(err) {
+		r.logger.Debugf(ctx, "conflict trying to save object in k8s API concurrently")
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
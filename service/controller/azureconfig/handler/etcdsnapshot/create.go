@@ -0,0 +1,88 @@
+package etcdsnapshot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	azureCluster := &capzv1alpha3.AzureCluster{}
+	err = r.ctrlClient.Get(ctx, ctrlclient.ObjectKey{Namespace: key.OrganizationNamespace(&cr), Name: cr.Name}, azureCluster)
+	if apierrors.IsNotFound(err) {
+		r.logger.Debugf(ctx, "AzureCluster not found")
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	etcdSnapshots, err := r.etcdSnapshots.ForCluster(azureCluster.Annotations)
+	if err != nil {
+		message := fmt.Sprintf("AzureCluster %s: %s", azureCluster.Name, err)
+		err = r.setEtcdSnapshotCondition(ctx, azureCluster, corev1.ConditionFalse, InvalidConfigReason, capiv1alpha3.ConditionSeverityError, message)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return microerror.Maskf(invalidConfigError, "%s", message)
+	}
+
+	masters, err := r.getMasterInstances(ctx, cc.AzureClientSet, cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	if len(masters) == 0 {
+		r.logger.Debugf(ctx, "VMSS %#q has no instances yet", key.MasterVMSSName(cr))
+		return nil
+	}
+
+	set := azureCluster.Annotations[annotation.EtcdSnapshotRestore]
+	if set != "" && set != azureCluster.Annotations[annotation.EtcdSnapshotRestored] {
+		// No snapshots are taken while the etcd data disks are restored,
+		// so that the snapshots to restore from are not deleted.
+		err = r.ensureRestored(ctx, cc.AzureClientSet, cr, azureCluster, masters, set)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	}
+
+	if !etcdSnapshots.Enabled() {
+		r.logger.Debugf(ctx, "etcd snapshots are disabled")
+
+		err = r.setEtcdSnapshotCondition(ctx, azureCluster, corev1.ConditionFalse, SnapshotsDisabledReason, capiv1alpha3.ConditionSeverityInfo, "etcd snapshots are disabled")
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	}
+
+	err = r.ensureSnapshots(ctx, cc.AzureClientSet, cr, azureCluster, masters, etcdSnapshots)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,11 @@
+package etcdsnapshot
+
+import (
+	"context"
+)
+
+// EnsureDeleted does nothing. The snapshots are part of the resource group of
+// the cluster and deleted together with it.
+func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	return nil
+}
@@ -0,0 +1,124 @@
+package etcdsnapshot
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v5/client"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+const (
+	// etcdDiskLun is the LUN of the data disk holding the etcd data on the
+	// masters, see the ARM template of the masters handler.
+	etcdDiskLun = 0
+
+	powerStateDeallocated = "PowerState/deallocated"
+	powerStateStopped     = "PowerState/stopped"
+	provisioningSucceeded = "Succeeded"
+	provisioningFailed    = "Failed"
+)
+
+// master is a master instance together with the name of the etcd member it
+// runs.
+type master struct {
+	member   string
+	instance compute.VirtualMachineScaleSetVM
+}
+
+// getMasterInstances returns the instances of the master VMSS with their etcd
+// member names.
+func (r *Resource) getMasterInstances(ctx context.Context, azureClientSet *client.AzureClientSet, cr providerv1alpha1.AzureConfig) ([]master, error) {
+	iterator, err := azureClientSet.VirtualMachineScaleSetVMsClient.ListComplete(ctx, key.ResourceGroupName(cr), key.MasterVMSSName(cr), "", "", "instanceView")
+	if IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var instances []compute.VirtualMachineScaleSetVM
+	for iterator.NotDone() {
+		instances = append(instances, iterator.Value())
+
+		err = iterator.NextWithContext(ctx)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	return masters(instances, key.MasterCount(cr)), nil
+}
+
+// masters maps the etcd member names to the given master instances in the
+// order of their instance IDs, the same way the masters handler maps them
+// for the etcd member DNS records. Instances beyond the number of masters,
+// e.g. while the VMSS is being scaled, are left out.
+func masters(instances []compute.VirtualMachineScaleSetVM, masterCount int) []master {
+	sorted := make([]compute.VirtualMachineScaleSetVM, len(instances))
+	copy(sorted, instances)
+	sort.Slice(sorted, func(i, j int) bool {
+		idI, idJ := instanceID(sorted[i]), instanceID(sorted[j])
+		a, errA := strconv.Atoi(idI)
+		b, errB := strconv.Atoi(idJ)
+		if errA != nil || errB != nil {
+			return idI < idJ
+		}
+		return a < b
+	})
+
+	var result []master
+	for i, instance := range sorted {
+		if i >= masterCount {
+			break
+		}
+		result = append(result, master{
+			member:   key.EtcdMemberName(i+1, masterCount),
+			instance: instance,
+		})
+	}
+
+	return result
+}
+
+// etcdDisk returns the etcd data disk of the given instance, nil when it has
+// none.
+func etcdDisk(instance compute.VirtualMachineScaleSetVM) *compute.DataDisk {
+	if instance.VirtualMachineScaleSetVMProperties == nil || instance.StorageProfile == nil || instance.StorageProfile.DataDisks == nil {
+		return nil
+	}
+
+	for i, disk := range *instance.StorageProfile.DataDisks {
+		if disk.Lun != nil && *disk.Lun == etcdDiskLun && disk.ManagedDisk != nil && disk.ManagedDisk.ID != nil {
+			return &(*instance.StorageProfile.DataDisks)[i]
+		}
+	}
+
+	return nil
+}
+
+func instanceID(instance compute.VirtualMachineScaleSetVM) string {
+	if instance.InstanceID == nil {
+		return ""
+	}
+
+	return *instance.InstanceID
+}
+
+func hasStatus(instance compute.VirtualMachineScaleSetVM, code string) bool {
+	if instance.VirtualMachineScaleSetVMProperties == nil || instance.InstanceView == nil || instance.InstanceView.Statuses == nil {
+		return false
+	}
+
+	for _, status := range *instance.InstanceView.Statuses {
+		if status.Code != nil && *status.Code == code {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,64 @@
+package etcdsnapshot
+
+import (
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
+)
+
+const (
+	Name = "etcdsnapshot"
+)
+
+type Config struct {
+	CtrlClient    client.Client
+	EventRecorder record.EventRecorder
+	Logger        micrologger.Logger
+
+	EtcdSnapshots setting.EtcdSnapshots
+}
+
+// Resource takes snapshots of the etcd data disks of the masters of tenant
+// clusters on a schedule, deletes the snapshots beyond the retention and
+// restores the etcd data disks from snapshots on request.
+type Resource struct {
+	ctrlClient    client.Client
+	eventRecorder record.EventRecorder
+	logger        micrologger.Logger
+
+	etcdSnapshots setting.EtcdSnapshots
+}
+
+func New(config Config) (*Resource, error) {
+	if config.CtrlClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CtrlClient must not be empty", config)
+	}
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	err := config.EtcdSnapshots.Validate()
+	if err != nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EtcdSnapshots: %s", config, err)
+	}
+
+	r := &Resource{
+		ctrlClient:    config.CtrlClient,
+		eventRecorder: config.EventRecorder,
+		logger:        config.Logger,
+
+		etcdSnapshots: config.EtcdSnapshots,
+	}
+
+	return r, nil
+}
+
+func (r *Resource) Name() string {
+	return Name
+}
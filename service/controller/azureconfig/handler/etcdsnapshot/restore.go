@@ -0,0 +1,251 @@
+package etcdsnapshot
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/client"
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/tags"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+// ensureRestored restores the etcd data disks of all masters from the
+// snapshot set with the given name, as requested by the
+// annotation.EtcdSnapshotRestore annotation. Each master instance is
+// recreated with a disk created from the snapshot of its etcd member attached
+// as etcd data disk. The masters ignition keeps the filesystem of the disk,
+// so etcd starts from the restored data. Once all masters are restored the
+// restore is recorded in the annotation.EtcdSnapshotRestored annotation.
+func (r *Resource) ensureRestored(ctx context.Context, azureClientSet *client.AzureClientSet, cr providerv1alpha1.AzureConfig, azureCluster *capzv1alpha3.AzureCluster, masters []master, name string) error {
+	sets, err := r.listSnapshotSets(ctx, azureClientSet, cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	var set *snapshotSet
+	for i := range sets {
+		if sets[i].name == name {
+			set = &sets[i]
+		}
+	}
+
+	var invalid string
+	if set == nil {
+		invalid = fmt.Sprintf("etcd snapshot %s to restore not found", name)
+	} else if set.provisioningState() != provisioningSucceeded {
+		invalid = fmt.Sprintf("etcd snapshot %s to restore did not succeed", name)
+	} else {
+		for _, m := range masters {
+			if set.snapshotFor(m.member) == nil {
+				invalid = fmt.Sprintf("etcd snapshot %s to restore has no snapshot of member %s", name, m.member)
+				break
+			}
+		}
+	}
+	if invalid != "" {
+		err = r.setEtcdSnapshotCondition(ctx, azureCluster, corev1.ConditionFalse, InvalidConfigReason, capiv1alpha3.ConditionSeverityError, invalid)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return microerror.Maskf(invalidConfigError, "%s", invalid)
+	}
+
+	if len(masters) < key.MasterCount(cr) {
+		r.logger.Debugf(ctx, "waiting for VMSS %#q to have %d masters before restoring etcd snapshot %#q", key.MasterVMSSName(cr), key.MasterCount(cr), name)
+		return nil
+	}
+
+	var restored int
+	for _, m := range masters {
+		done, err := r.restoreMaster(ctx, azureClientSet, cr, m, set.snapshotFor(m.member), name)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		if done {
+			restored++
+		}
+	}
+
+	if restored < len(masters) {
+		message := fmt.Sprintf("restored etcd data disks of %d of %d masters from etcd snapshot %s", restored, len(masters), name)
+		err = r.setEtcdSnapshotCondition(ctx, azureCluster, corev1.ConditionFalse, EtcdSnapshotRestoringReason, capiv1alpha3.ConditionSeverityWarning, message)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	}
+
+	{
+		original := azureCluster.DeepCopy()
+
+		if azureCluster.Annotations == nil {
+			azureCluster.Annotations = map[string]string{}
+		}
+		azureCluster.Annotations[annotation.EtcdSnapshotRestored] = name
+
+		if !reflect.DeepEqual(original.Annotations, azureCluster.Annotations) {
+			err = r.ctrlClient.Patch(ctx, azureCluster, ctrlclient.MergeFrom(original))
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	}
+
+	r.eventRecorder.Eventf(azureCluster, corev1.EventTypeNormal, EtcdSnapshotRestoredReason, "Restored etcd data disks of %d masters from etcd snapshot %s", len(masters), name)
+
+	return nil
+}
+
+// restoreMaster takes the next step to recreate the given master instance
+// with a disk created from the given snapshot as etcd data disk. It returns
+// true once the instance runs with the restored disk. The steps are
+//
+//     1. create the disk from the snapshot
+//     2. deallocate the instance
+//     3. attach the disk in place of the current etcd data disk
+//     4. start the instance
+//
+// Each step is only started, the next reconciliation checks whether it is
+// done and takes the following one.
+func (r *Resource) restoreMaster(ctx context.Context, azureClientSet *client.AzureClientSet, cr providerv1alpha1.AzureConfig, m master, snapshot *compute.Snapshot, set string) (bool, error) {
+	resourceGroupName := key.ResourceGroupName(cr)
+	vmssName := key.MasterVMSSName(cr)
+	id := instanceID(m.instance)
+	diskName := restoredDiskName(cr, m.member, set)
+
+	current := etcdDisk(m.instance)
+	if current == nil {
+		r.logger.Debugf(ctx, "instance %#q has no etcd data disk yet", id)
+		return false, nil
+	}
+
+	if strings.EqualFold(to.String(current.Name), diskName) {
+		if !hasStatus(m.instance, powerStateDeallocated) && !hasStatus(m.instance, powerStateStopped) {
+			return true, nil
+		}
+
+		r.logger.Debugf(ctx, "starting instance %#q with restored etcd data disk %#q", id, diskName)
+
+		res, err := azureClientSet.VirtualMachineScaleSetVMsClient.Start(ctx, resourceGroupName, vmssName, id)
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+		_, err = azureClientSet.VirtualMachineScaleSetVMsClient.StartResponder(res.Response())
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+
+		return false, nil
+	}
+
+	disk, err := azureClientSet.DisksClient.Get(ctx, resourceGroupName, diskName)
+	if IsNotFound(err) {
+		r.logger.Debugf(ctx, "creating etcd data disk %#q from snapshot %#q", diskName, to.String(snapshot.Name))
+
+		customTags, err := tags.ForCluster(ctx, r.ctrlClient, &cr)
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+
+		disk = compute.Disk{
+			Location: m.instance.Location,
+			Zones:    m.instance.Zones,
+			Sku: &compute.DiskSku{
+				Name: compute.DiskStorageAccountTypes(current.ManagedDisk.StorageAccountType),
+			},
+			Tags: tags.Merge(customTags, map[string]*string{
+				etcdSnapshotSetTag:  to.StringPtr(set),
+				etcdMemberTag:       to.StringPtr(m.member),
+				key.ProviderTagName: to.StringPtr(key.ProviderTagValue),
+			}),
+			DiskProperties: &compute.DiskProperties{
+				CreationData: &compute.CreationData{
+					CreateOption:     compute.Copy,
+					SourceResourceID: snapshot.ID,
+				},
+			},
+		}
+
+		res, err := azureClientSet.DisksClient.CreateOrUpdate(ctx, resourceGroupName, diskName, disk)
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+		_, err = azureClientSet.DisksClient.CreateOrUpdateResponder(res.Response())
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+
+		return false, nil
+	} else if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	if disk.DiskProperties == nil || to.String(disk.ProvisioningState) != provisioningSucceeded {
+		r.logger.Debugf(ctx, "waiting for etcd data disk %#q to be created", diskName)
+		return false, nil
+	}
+
+	if !hasStatus(m.instance, powerStateDeallocated) {
+		r.logger.Debugf(ctx, "deallocating instance %#q to restore its etcd data disk", id)
+
+		res, err := azureClientSet.VirtualMachineScaleSetVMsClient.Deallocate(ctx, resourceGroupName, vmssName, id)
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+		_, err = azureClientSet.VirtualMachineScaleSetVMsClient.DeallocateResponder(res.Response())
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+
+		return false, nil
+	}
+
+	r.logger.Debugf(ctx, "attaching etcd data disk %#q to instance %#q", diskName, id)
+
+	// The instance view is read only and must not be sent with the update.
+	instance := m.instance
+	properties := *instance.VirtualMachineScaleSetVMProperties
+	properties.InstanceView = nil
+	instance.VirtualMachineScaleSetVMProperties = &properties
+	*etcdDisk(instance) = compute.DataDisk{
+		Lun:          to.Int32Ptr(etcdDiskLun),
+		Name:         disk.Name,
+		Caching:      current.Caching,
+		CreateOption: compute.DiskCreateOptionTypesAttach,
+		ManagedDisk: &compute.ManagedDiskParameters{
+			ID:                 disk.ID,
+			StorageAccountType: current.ManagedDisk.StorageAccountType,
+		},
+	}
+
+	res, err := azureClientSet.VirtualMachineScaleSetVMsClient.Update(ctx, resourceGroupName, vmssName, id, instance)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+	_, err = azureClientSet.VirtualMachineScaleSetVMsClient.UpdateResponder(res.Response())
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	return false, nil
+}
+
+// restoredDiskName returns the name of the etcd data disk of the given member
+// restored from the given snapshot set.
+func restoredDiskName(cr providerv1alpha1.AzureConfig, member string, set string) string {
+	return fmt.Sprintf("%s-restored", snapshotName(cr, member, set))
+}
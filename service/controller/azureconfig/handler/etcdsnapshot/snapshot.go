@@ -0,0 +1,361 @@
+package etcdsnapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/azure-operator/v5/client"
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/tags"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
+)
+
+const (
+	// etcdSnapshotSetTag holds the name of the set a snapshot belongs to. The
+	// snapshots of the etcd data disks of all masters taken at the same time
+	// form a set, named after the time they were taken at.
+	etcdSnapshotSetTag = "gs-etcd-snapshot-set"
+	// etcdMemberTag holds the name of the etcd member whose data disk a
+	// snapshot was taken of.
+	etcdMemberTag = "gs-etcd-member"
+	// etcdMembersTag holds the number of etcd members the set a snapshot
+	// belongs to was taken of, so that incomplete sets can be told apart.
+	etcdMembersTag = "gs-etcd-members"
+
+	// setNameFormat is the time format of the names of snapshot sets, which
+	// sort in the order the sets were taken in.
+	setNameFormat = "20060102150405"
+)
+
+// snapshotSet holds the snapshots of the etcd data disks of all masters taken
+// at the same time.
+type snapshotSet struct {
+	name      string
+	snapshots []compute.Snapshot
+}
+
+// takenAt returns the time the snapshots of the set were taken at.
+func (s snapshotSet) takenAt() time.Time {
+	t, err := time.Parse(setNameFormat, s.name)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
+
+// members returns the number of etcd members the set was taken of.
+func (s snapshotSet) members() int {
+	for _, snapshot := range s.snapshots {
+		n, err := strconv.Atoi(to.String(snapshot.Tags[etcdMembersTag]))
+		if err == nil {
+			return n
+		}
+	}
+
+	return 0
+}
+
+// provisioningState returns "Failed" when any snapshot of the set failed or
+// the set misses the snapshot of a member, "Succeeded" when all of them
+// succeeded and an empty string while they are still being taken.
+func (s snapshotSet) provisioningState() string {
+	succeeded := 0
+	for _, snapshot := range s.snapshots {
+		if snapshot.SnapshotProperties == nil || snapshot.ProvisioningState == nil {
+			continue
+		}
+
+		switch *snapshot.ProvisioningState {
+		case provisioningFailed:
+			return provisioningFailed
+		case provisioningSucceeded:
+			succeeded++
+		}
+	}
+
+	if succeeded == len(s.snapshots) {
+		// Taking a set was interrupted when all its snapshots succeeded but
+		// there are fewer than members. It can't restore the cluster.
+		if succeeded < s.members() {
+			return provisioningFailed
+		}
+
+		return provisioningSucceeded
+	}
+
+	return ""
+}
+
+// snapshotFor returns the snapshot of the set taken of the data disk of the
+// given etcd member, nil when there is none.
+func (s snapshotSet) snapshotFor(member string) *compute.Snapshot {
+	for i, snapshot := range s.snapshots {
+		if to.String(snapshot.Tags[etcdMemberTag]) == member {
+			return &s.snapshots[i]
+		}
+	}
+
+	return nil
+}
+
+// ensureSnapshots takes a new snapshot set once the interval passed since the
+// last one was taken, deletes failed sets and the succeeded sets beyond the
+// retention, and reports the last succeeded set in the EtcdSnapshotReady
+// condition of the AzureCluster CR.
+func (r *Resource) ensureSnapshots(ctx context.Context, azureClientSet *client.AzureClientSet, cr providerv1alpha1.AzureConfig, azureCluster *capzv1alpha3.AzureCluster, masters []master, etcdSnapshots setting.EtcdSnapshots) error {
+	sets, err := r.listSnapshotSets(ctx, azureClientSet, cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	for _, set := range sets {
+		if set.provisioningState() != provisioningFailed {
+			continue
+		}
+
+		// The failed set is deleted so that the next reconciliation takes a
+		// new one.
+		message := fmt.Sprintf("etcd snapshot %s failed", set.name)
+		r.eventRecorder.Event(azureCluster, corev1.EventTypeWarning, EtcdSnapshotFailedReason, message)
+
+		err = r.deleteSnapshotSet(ctx, azureClientSet, cr, set)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = r.setEtcdSnapshotCondition(ctx, azureCluster, corev1.ConditionFalse, EtcdSnapshotFailedReason, capiv1alpha3.ConditionSeverityWarning, message)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	}
+
+	now := time.Now().UTC()
+	if snapshotDue(now, sets, etcdSnapshots.Interval) {
+		err = r.takeSnapshotSet(ctx, azureClientSet, cr, azureCluster, masters, now.Format(setNameFormat))
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	latest := latestSucceeded(sets)
+	if latest == nil {
+		err = r.setEtcdSnapshotCondition(ctx, azureCluster, corev1.ConditionFalse, EtcdSnapshotPendingReason, capiv1alpha3.ConditionSeverityInfo, "waiting for the first etcd snapshot")
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	} else {
+		message := fmt.Sprintf("last etcd snapshot %s taken at %s", latest.name, latest.takenAt().Format(time.RFC3339))
+		err = r.setEtcdSnapshotCondition(ctx, azureCluster, corev1.ConditionTrue, EtcdSnapshotTakenReason, capiv1alpha3.ConditionSeverityNone, message)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	// The management lock of clusters protected against deletion prevents
+	// deleting snapshots, so they are kept beyond the retention meanwhile.
+	if key.DeletionProtection(*azureCluster) {
+		r.logger.Debugf(ctx, "keeping etcd snapshots beyond retention of %d while cluster is protected against deletion", etcdSnapshots.Retention)
+		return nil
+	}
+
+	for _, set := range expiredSnapshotSets(sets, etcdSnapshots.Retention, azureCluster.Annotations[annotation.EtcdSnapshotRestore]) {
+		r.logger.Debugf(ctx, "deleting etcd snapshot %#q beyond retention of %d", set.name, etcdSnapshots.Retention)
+
+		err = r.deleteSnapshotSet(ctx, azureClientSet, cr, set)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.Debugf(ctx, "deleted etcd snapshot %#q beyond retention of %d", set.name, etcdSnapshots.Retention)
+	}
+
+	return nil
+}
+
+// takeSnapshotSet starts taking snapshots of the etcd data disks of all
+// masters as set with the given name. Nothing is taken unless all masters
+// have an etcd data disk, so that every set can restore the whole cluster.
+func (r *Resource) takeSnapshotSet(ctx context.Context, azureClientSet *client.AzureClientSet, cr providerv1alpha1.AzureConfig, azureCluster *capzv1alpha3.AzureCluster, masters []master, name string) error {
+	if len(masters) < key.MasterCount(cr) {
+		r.logger.Debugf(ctx, "not taking etcd snapshot, VMSS %#q has %d of %d masters", key.MasterVMSSName(cr), len(masters), key.MasterCount(cr))
+		return nil
+	}
+	for _, m := range masters {
+		if etcdDisk(m.instance) == nil {
+			r.logger.Debugf(ctx, "not taking etcd snapshot, instance %#q has no etcd data disk yet", instanceID(m.instance))
+			return nil
+		}
+	}
+
+	customTags, err := tags.ForCluster(ctx, r.ctrlClient, &cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "taking etcd snapshot %#q", name)
+
+	for _, m := range masters {
+		snapshot := compute.Snapshot{
+			Location: m.instance.Location,
+			Tags: tags.Merge(customTags, map[string]*string{
+				etcdSnapshotSetTag:  to.StringPtr(name),
+				etcdMemberTag:       to.StringPtr(m.member),
+				etcdMembersTag:      to.StringPtr(strconv.Itoa(len(masters))),
+				key.ProviderTagName: to.StringPtr(key.ProviderTagValue),
+			}),
+			SnapshotProperties: &compute.SnapshotProperties{
+				CreationData: &compute.CreationData{
+					CreateOption:     compute.Copy,
+					SourceResourceID: etcdDisk(m.instance).ManagedDisk.ID,
+				},
+				Incremental: to.BoolPtr(true),
+			},
+		}
+
+		res, err := azureClientSet.SnapshotsClient.CreateOrUpdate(ctx, key.ResourceGroupName(cr), snapshotName(cr, m.member, name), snapshot)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		_, err = azureClientSet.SnapshotsClient.CreateOrUpdateResponder(res.Response())
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	r.eventRecorder.Eventf(azureCluster, corev1.EventTypeNormal, EtcdSnapshotTakenReason, "Taking etcd snapshot %s of %d masters", name, len(masters))
+
+	r.logger.Debugf(ctx, "took etcd snapshot %#q", name)
+
+	return nil
+}
+
+func (r *Resource) deleteSnapshotSet(ctx context.Context, azureClientSet *client.AzureClientSet, cr providerv1alpha1.AzureConfig, set snapshotSet) error {
+	for _, snapshot := range set.snapshots {
+		res, err := azureClientSet.SnapshotsClient.Delete(ctx, key.ResourceGroupName(cr), to.String(snapshot.Name))
+		if IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+		_, err = azureClientSet.SnapshotsClient.DeleteResponder(res.Response())
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
+
+// listSnapshotSets returns the etcd snapshot sets in the resource group of
+// the cluster, the newest first.
+func (r *Resource) listSnapshotSets(ctx context.Context, azureClientSet *client.AzureClientSet, cr providerv1alpha1.AzureConfig) ([]snapshotSet, error) {
+	iterator, err := azureClientSet.SnapshotsClient.ListByResourceGroupComplete(ctx, key.ResourceGroupName(cr))
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var snapshots []compute.Snapshot
+	for iterator.NotDone() {
+		snapshots = append(snapshots, iterator.Value())
+
+		err = iterator.NextWithContext(ctx)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	return snapshotSets(snapshots), nil
+}
+
+// snapshotSets groups the given etcd snapshots by their sets, the newest set
+// first. Snapshots without set tag are ignored.
+func snapshotSets(snapshots []compute.Snapshot) []snapshotSet {
+	byName := map[string][]compute.Snapshot{}
+	for _, snapshot := range snapshots {
+		name := to.String(snapshot.Tags[etcdSnapshotSetTag])
+		if name == "" {
+			continue
+		}
+		byName[name] = append(byName[name], snapshot)
+	}
+
+	var sets []snapshotSet
+	for name, snapshots := range byName {
+		sets = append(sets, snapshotSet{name: name, snapshots: snapshots})
+	}
+	sort.Slice(sets, func(i, j int) bool {
+		return sets[i].name > sets[j].name
+	})
+
+	return sets
+}
+
+// snapshotDue returns true when no snapshot set is being taken and the given
+// interval passed since the newest set was taken.
+func snapshotDue(now time.Time, sets []snapshotSet, interval time.Duration) bool {
+	if len(sets) == 0 {
+		return true
+	}
+	if sets[0].provisioningState() == "" {
+		return false
+	}
+
+	return now.Sub(sets[0].takenAt()) >= interval
+}
+
+// latestSucceeded returns the newest of the given snapshot sets which
+// succeeded, nil when none did.
+func latestSucceeded(sets []snapshotSet) *snapshotSet {
+	for i, set := range sets {
+		if set.provisioningState() == provisioningSucceeded {
+			return &sets[i]
+		}
+	}
+
+	return nil
+}
+
+// expiredSnapshotSets returns the succeeded snapshot sets beyond the newest
+// retention ones. The set with the given name is kept, so that a requested
+// restore does not lose its snapshots.
+func expiredSnapshotSets(sets []snapshotSet, retention int, keep string) []snapshotSet {
+	var expired []snapshotSet
+	var kept int
+	for _, set := range sets {
+		if set.provisioningState() != provisioningSucceeded {
+			continue
+		}
+		if kept < retention {
+			kept++
+			continue
+		}
+		if set.name == keep {
+			continue
+		}
+
+		expired = append(expired, set)
+	}
+
+	return expired
+}
+
+// snapshotName returns the name of the snapshot of the etcd data disk of the
+// given member in the given set.
+func snapshotName(cr providerv1alpha1.AzureConfig, member string, set string) string {
+	return fmt.Sprintf("%s-%s-%s", key.ClusterID(&cr), member, set)
+}
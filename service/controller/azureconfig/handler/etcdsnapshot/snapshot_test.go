@@ -0,0 +1,238 @@
+package etcdsnapshot
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func Test_snapshotDue(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		sets        []snapshotSet
+		expectedDue bool
+	}{
+		{
+			name:        "case 0: no snapshot taken yet",
+			expectedDue: true,
+		},
+		{
+			name: "case 1: newest snapshot within interval",
+			sets: []snapshotSet{
+				newSnapshotSet("20261018060000", provisioningSucceeded, provisioningSucceeded),
+			},
+			expectedDue: false,
+		},
+		{
+			name: "case 2: newest snapshot older than interval",
+			sets: []snapshotSet{
+				newSnapshotSet("20261017120000", provisioningSucceeded, provisioningSucceeded),
+			},
+			expectedDue: true,
+		},
+		{
+			name: "case 3: snapshot still being taken",
+			sets: []snapshotSet{
+				newSnapshotSet("20261017060000", provisioningSucceeded, "Creating"),
+			},
+			expectedDue: false,
+		},
+		{
+			name: "case 4: newest snapshot incomplete",
+			sets: []snapshotSet{
+				newIncompleteSnapshotSet("20261017060000", 3, provisioningSucceeded, provisioningSucceeded),
+			},
+			expectedDue: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			due := snapshotDue(now, tc.sets, 24*time.Hour)
+			if due != tc.expectedDue {
+				t.Fatalf("expected %t, got %t", tc.expectedDue, due)
+			}
+		})
+	}
+}
+
+func Test_expiredSnapshotSets(t *testing.T) {
+	testCases := []struct {
+		name            string
+		sets            []snapshotSet
+		retention       int
+		keep            string
+		expectedExpired []string
+	}{
+		{
+			name: "case 0: sets within retention",
+			sets: []snapshotSet{
+				newSnapshotSet("20261018000000", provisioningSucceeded),
+				newSnapshotSet("20261017000000", provisioningSucceeded),
+			},
+			retention: 2,
+		},
+		{
+			name: "case 1: oldest sets beyond retention",
+			sets: []snapshotSet{
+				newSnapshotSet("20261018000000", provisioningSucceeded),
+				newSnapshotSet("20261017000000", provisioningSucceeded),
+				newSnapshotSet("20261016000000", provisioningSucceeded),
+				newSnapshotSet("20261015000000", provisioningSucceeded),
+			},
+			retention:       2,
+			expectedExpired: []string{"20261016000000", "20261015000000"},
+		},
+		{
+			name: "case 2: sets being taken don't count",
+			sets: []snapshotSet{
+				newSnapshotSet("20261018000000", "Creating"),
+				newSnapshotSet("20261017000000", provisioningSucceeded),
+				newSnapshotSet("20261016000000", provisioningSucceeded),
+			},
+			retention: 2,
+		},
+		{
+			name: "case 3: set to restore is kept",
+			sets: []snapshotSet{
+				newSnapshotSet("20261018000000", provisioningSucceeded),
+				newSnapshotSet("20261017000000", provisioningSucceeded),
+				newSnapshotSet("20261016000000", provisioningSucceeded),
+			},
+			retention:       1,
+			keep:            "20261016000000",
+			expectedExpired: []string{"20261017000000"},
+		},
+		{
+			name: "case 4: incomplete sets don't count",
+			sets: []snapshotSet{
+				newIncompleteSnapshotSet("20261018000000", 3, provisioningSucceeded, provisioningSucceeded),
+				newSnapshotSet("20261017000000", provisioningSucceeded, provisioningSucceeded, provisioningSucceeded),
+				newSnapshotSet("20261016000000", provisioningSucceeded, provisioningSucceeded, provisioningSucceeded),
+			},
+			retention: 2,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			var expired []string
+			for _, set := range expiredSnapshotSets(tc.sets, tc.retention, tc.keep) {
+				expired = append(expired, set.name)
+			}
+
+			if !reflect.DeepEqual(expired, tc.expectedExpired) {
+				t.Fatalf("expected %v, got %v", tc.expectedExpired, expired)
+			}
+		})
+	}
+}
+
+func Test_snapshotSet_provisioningState(t *testing.T) {
+	testCases := []struct {
+		name          string
+		set           snapshotSet
+		expectedState string
+	}{
+		{
+			name:          "case 0: all snapshots succeeded",
+			set:           newSnapshotSet("20261018000000", provisioningSucceeded, provisioningSucceeded, provisioningSucceeded),
+			expectedState: provisioningSucceeded,
+		},
+		{
+			name:          "case 1: snapshot still being taken",
+			set:           newSnapshotSet("20261018000000", provisioningSucceeded, "Creating", provisioningSucceeded),
+			expectedState: "",
+		},
+		{
+			name:          "case 2: snapshot failed",
+			set:           newSnapshotSet("20261018000000", provisioningSucceeded, provisioningFailed, "Creating"),
+			expectedState: provisioningFailed,
+		},
+		{
+			name:          "case 3: snapshot of a member missing",
+			set:           newIncompleteSnapshotSet("20261018000000", 3, provisioningSucceeded, provisioningSucceeded),
+			expectedState: provisioningFailed,
+		},
+		{
+			name:          "case 4: snapshot of a member missing while another is being taken",
+			set:           newIncompleteSnapshotSet("20261018000000", 3, provisioningSucceeded, "Creating"),
+			expectedState: "",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			state := tc.set.provisioningState()
+			if state != tc.expectedState {
+				t.Fatalf("expected %#q, got %#q", tc.expectedState, state)
+			}
+		})
+	}
+}
+
+func Test_snapshotSets(t *testing.T) {
+	snapshots := []compute.Snapshot{
+		newSnapshot("20261017000000", "etcd1", provisioningSucceeded),
+		newSnapshot("20261018000000", "etcd1", provisioningSucceeded),
+		newSnapshot("20261017000000", "etcd2", provisioningSucceeded),
+		{Name: to.StringPtr("unrelated")},
+	}
+
+	sets := snapshotSets(snapshots)
+
+	if len(sets) != 2 {
+		t.Fatalf("expected 2 sets, got %d", len(sets))
+	}
+	if sets[0].name != "20261018000000" || len(sets[0].snapshots) != 1 {
+		t.Fatalf("expected newest set 20261018000000 with 1 snapshot, got %s with %d", sets[0].name, len(sets[0].snapshots))
+	}
+	if sets[1].name != "20261017000000" || len(sets[1].snapshots) != 2 {
+		t.Fatalf("expected oldest set 20261017000000 with 2 snapshots, got %s with %d", sets[1].name, len(sets[1].snapshots))
+	}
+	if sets[1].snapshotFor("etcd2") == nil {
+		t.Fatalf("expected snapshot of member etcd2 in set 20261017000000")
+	}
+}
+
+func newSnapshotSet(name string, provisioningStates ...string) snapshotSet {
+	return newIncompleteSnapshotSet(name, len(provisioningStates), provisioningStates...)
+}
+
+// newIncompleteSnapshotSet returns a set taken of the given number of members
+// which only has snapshots in the given provisioning states.
+func newIncompleteSnapshotSet(name string, members int, provisioningStates ...string) snapshotSet {
+	set := snapshotSet{name: name}
+	for i, state := range provisioningStates {
+		snapshot := newSnapshot(name, "etcd"+strconv.Itoa(i+1), state)
+		snapshot.Tags[etcdMembersTag] = to.StringPtr(strconv.Itoa(members))
+		set.snapshots = append(set.snapshots, snapshot)
+	}
+
+	return set
+}
+
+func newSnapshot(set string, member string, provisioningState string) compute.Snapshot {
+	return compute.Snapshot{
+		Name: to.StringPtr(member + "-" + set),
+		Tags: map[string]*string{
+			etcdSnapshotSetTag: to.StringPtr(set),
+			etcdMemberTag:      to.StringPtr(member),
+		},
+		SnapshotProperties: &compute.SnapshotProperties{
+			ProvisioningState: to.StringPtr(provisioningState),
+		},
+	}
+}
@@ -0,0 +1,111 @@
+package keyvault
+
+import (
+	"context"
+
+	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/giantswarm/microerror"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/tags"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+const (
+	keyVaultDeploymentName = "keyvault-template"
+)
+
+// EnsureCreated ensures the Key Vault of the cluster holds the current and the
+// previous certificate encryption key and grants the managed identities of all
+// VMSSes of the cluster read access to them. Nodes fetch the key at boot, so
+// it doesn't have to be put into the VMSS custom data.
+func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if !r.azure.KeyVaultEnabled() {
+		r.logger.Debugf(ctx, "certificate encryption key is delivered in the VMSS custom data")
+		r.logger.Debugf(ctx, "canceling resource")
+		return nil
+	}
+
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "ensuring key vault")
+
+	secret, err := r.k8sClient.CoreV1().Secrets(key.CertificateEncryptionNamespace).Get(ctx, key.CertificateEncryptionSecretName(&cr), metav1.GetOptions{})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	secretNames, secretValues := keyVaultSecrets(secret)
+
+	principalIDs, err := getPrincipalIDs(ctx, cc.AzureClientSet.VirtualMachineScaleSetsClient, key.ResourceGroupName(cr))
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	customTags, err := tags.ForCluster(ctx, r.ctrlClient, &cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	deploymentsClient := cc.AzureClientSet.DeploymentsClient
+
+	{
+		d, err := deploymentsClient.Get(ctx, key.ResourceGroupName(cr), keyVaultDeploymentName)
+		if IsNotFound(err) {
+			// fallthrough
+		} else if err != nil {
+			return microerror.Mask(err)
+		} else {
+			s := *d.Properties.ProvisioningState
+
+			r.logger.Debugf(ctx, "key vault deployment is in state '%s'", s)
+
+			if !key.IsSucceededProvisioningState(s) {
+				r.debugger.LogFailedDeployment(ctx, d, err)
+			}
+			if !key.IsFinalProvisioningState(s) {
+				r.logger.Debugf(ctx, "canceling resource")
+				return nil
+			}
+
+			// Every deployment writes new versions of the secrets, so the
+			// deployment is only re-applied when secrets, identities or tags
+			// change.
+			if key.IsSucceededProvisioningState(s) && isUpToDate(d.Properties.Parameters, secretNames, principalIDs, customTags) {
+				r.logger.Debugf(ctx, "key vault is up to date")
+				return nil
+			}
+		}
+	}
+
+	var deployment azureresource.Deployment
+	{
+		deployment, err = r.newDeployment(cr, secretNames, secretValues, principalIDs, customTags)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	res, err := deploymentsClient.CreateOrUpdate(ctx, key.ResourceGroupName(cr), keyVaultDeploymentName, deployment)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	_, err = deploymentsClient.CreateOrUpdateResponder(res.Response())
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "ensured key vault")
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package keyvault
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/keyvault/template"
+	"github.com/giantswarm/azure-operator/v5/service/controller/encrypter"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/tags"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+func (r Resource) newDeployment(customObject providerv1alpha1.AzureConfig, secretNames []string, secretValues map[string]interface{}, principalIDs []string, customTags map[string]string) (azureresource.Deployment, error) {
+	params := map[string]interface{}{
+		"customTags":   tags.TemplateParameter(customTags),
+		"keyVaultName": key.KeyVaultName(&customObject),
+		"principalIDs": principalIDs,
+		"secretNames":  secretNames,
+		"secretValues": secretValues,
+	}
+
+	armTemplate, err := template.GetARMTemplate()
+	if err != nil {
+		return azureresource.Deployment{}, microerror.Mask(err)
+	}
+
+	d := azureresource.Deployment{
+		Properties: &azureresource.DeploymentProperties{
+			Mode:       azureresource.Incremental,
+			Parameters: key.ToParameters(params, nil),
+			Template:   armTemplate,
+		},
+	}
+
+	return d, nil
+}
+
+// keyVaultSecrets returns the names of the Key Vault secrets of the current and
+// the previous certificate encryption key in the given secret, and their values
+// keyed by name. The values are environment files read by the certificate
+// decrypter on the nodes.
+func keyVaultSecrets(secret *corev1.Secret) ([]string, map[string]interface{}) {
+	iv := secret.Data[key.CertificateEncryptionIVName]
+
+	var names []string
+	values := map[string]interface{}{}
+	for _, k := range [][]byte{secret.Data[key.CertificateEncryptionKeyName], secret.Data[key.CertificateEncryptionPreviousKeyName]} {
+		if len(k) == 0 {
+			continue
+		}
+
+		keyID := encrypter.KeyID(k)
+		name := key.KeyVaultSecretName(keyID)
+
+		names = append(names, name)
+		values[name] = fmt.Sprintf("ENCRYPTION_KEY=%s\nENCRYPTION_KEY_ID=%s\nINITIAL_VECTOR=%s\n", hex.EncodeToString(k), keyID, hex.EncodeToString(iv))
+	}
+
+	sort.Strings(names)
+
+	return names, values
+}
+
+// getPrincipalIDs returns the sorted object IDs of the managed identities of
+// all VMSSes in the given resource group.
+func getPrincipalIDs(ctx context.Context, vmssClient *compute.VirtualMachineScaleSetsClient, resourceGroupName string) ([]string, error) {
+	iterator, err := vmssClient.ListComplete(ctx, resourceGroupName)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	principalIDs := []string{}
+	for iterator.NotDone() {
+		vmss := iterator.Value()
+		if vmss.Identity != nil && vmss.Identity.PrincipalID != nil {
+			principalIDs = append(principalIDs, *vmss.Identity.PrincipalID)
+		}
+
+		err = iterator.NextWithContext(ctx)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	sort.Strings(principalIDs)
+
+	return principalIDs, nil
+}
+
+// isUpToDate returns true when the given parameters of a deployment contain the
+// given secret names, principal IDs and user-defined tags.
+func isUpToDate(parameters interface{}, secretNames []string, principalIDs []string, customTags map[string]string) bool {
+	return reflect.DeepEqual(deployedStrings(parameters, "secretNames"), secretNames) &&
+		reflect.DeepEqual(deployedStrings(parameters, "principalIDs"), principalIDs) &&
+		reflect.DeepEqual(deployedTags(parameters), tags.TemplateParameter(customTags))
+}
+
+// deployedTags returns the user-defined tags of a deployment. Deployments
+// created before the tags were introduced have none.
+func deployedTags(parameters interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	params, ok := parameters.(map[string]interface{})
+	if !ok {
+		return result
+	}
+
+	param, ok := params["customTags"].(map[string]interface{})
+	if !ok {
+		return result
+	}
+
+	values, ok := param["value"].(map[string]interface{})
+	if !ok {
+		return result
+	}
+
+	for name, value := range values {
+		result[name] = value
+	}
+
+	return result
+}
+
+// deployedStrings returns the value of the string array parameter with the
+// given name of a deployment.
+func deployedStrings(parameters interface{}, name string) []string {
+	params, ok := parameters.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	param, ok := params[name].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	values, ok := param["value"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	result := []string{}
+	for _, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			return nil
+		}
+		result = append(result, s)
+	}
+
+	return result
+}
@@ -0,0 +1,150 @@
+package keyvault
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/encrypter"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+func Test_keyVaultSecrets(t *testing.T) {
+	currentKey := []byte("0123456789abcdef0123456789abcdef")
+	previousKey := []byte("fedcba9876543210fedcba9876543210")
+
+	testCases := []struct {
+		name          string
+		data          map[string][]byte
+		expectedNames []string
+	}{
+		{
+			name: "case 0: current key only",
+			data: map[string][]byte{
+				key.CertificateEncryptionKeyName: currentKey,
+				key.CertificateEncryptionIVName:  []byte("0123456789abcdef"),
+			},
+			expectedNames: []string{
+				key.KeyVaultSecretName(encrypter.KeyID(currentKey)),
+			},
+		},
+		{
+			name: "case 1: current and previous key during a rotation",
+			data: map[string][]byte{
+				key.CertificateEncryptionKeyName:         currentKey,
+				key.CertificateEncryptionPreviousKeyName: previousKey,
+				key.CertificateEncryptionIVName:          []byte("0123456789abcdef"),
+			},
+			expectedNames: sortedStrings(
+				key.KeyVaultSecretName(encrypter.KeyID(currentKey)),
+				key.KeyVaultSecretName(encrypter.KeyID(previousKey)),
+			),
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			names, values := keyVaultSecrets(&corev1.Secret{Data: tc.data})
+
+			if !reflect.DeepEqual(names, tc.expectedNames) {
+				t.Fatalf("expected %v, got %v", tc.expectedNames, names)
+			}
+			if len(values) != len(names) {
+				t.Fatalf("expected %d values, got %d", len(names), len(values))
+			}
+		})
+	}
+}
+
+func Test_isUpToDate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		parameters       string
+		secretNames      []string
+		principalIDs     []string
+		customTags       map[string]string
+		expectedUpToDate bool
+	}{
+		{
+			name:             "case 0: unchanged secrets and identities",
+			parameters:       `{"principalIDs": {"value": ["a", "b"]}, "secretNames": {"value": ["s"]}}`,
+			secretNames:      []string{"s"},
+			principalIDs:     []string{"a", "b"},
+			expectedUpToDate: true,
+		},
+		{
+			name:             "case 1: new node pool identity",
+			parameters:       `{"principalIDs": {"value": ["a"]}, "secretNames": {"value": ["s"]}}`,
+			secretNames:      []string{"s"},
+			principalIDs:     []string{"a", "b"},
+			expectedUpToDate: false,
+		},
+		{
+			name:             "case 2: rotated key",
+			parameters:       `{"principalIDs": {"value": ["a"]}, "secretNames": {"value": ["s"]}}`,
+			secretNames:      []string{"s", "t"},
+			principalIDs:     []string{"a"},
+			expectedUpToDate: false,
+		},
+		{
+			name:             "case 3: no identities yet",
+			parameters:       `{"principalIDs": {"value": []}, "secretNames": {"value": ["s"]}}`,
+			secretNames:      []string{"s"},
+			principalIDs:     []string{},
+			expectedUpToDate: true,
+		},
+		{
+			name:             "case 4: unchanged tags",
+			parameters:       `{"customTags": {"value": {"cost-centre": "1234"}}, "principalIDs": {"value": ["a"]}, "secretNames": {"value": ["s"]}}`,
+			secretNames:      []string{"s"},
+			principalIDs:     []string{"a"},
+			customTags:       map[string]string{"cost-centre": "1234"},
+			expectedUpToDate: true,
+		},
+		{
+			name:             "case 5: tags added to deployment created before tags",
+			parameters:       `{"principalIDs": {"value": ["a"]}, "secretNames": {"value": ["s"]}}`,
+			secretNames:      []string{"s"},
+			principalIDs:     []string{"a"},
+			customTags:       map[string]string{"cost-centre": "1234"},
+			expectedUpToDate: false,
+		},
+		{
+			name:             "case 6: changed tag",
+			parameters:       `{"customTags": {"value": {"cost-centre": "1234"}}, "principalIDs": {"value": ["a"]}, "secretNames": {"value": ["s"]}}`,
+			secretNames:      []string{"s"},
+			principalIDs:     []string{"a"},
+			customTags:       map[string]string{"cost-centre": "5678"},
+			expectedUpToDate: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			var parameters interface{}
+			err := json.Unmarshal([]byte(tc.parameters), &parameters)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			upToDate := isUpToDate(parameters, tc.secretNames, tc.principalIDs, tc.customTags)
+			if upToDate != tc.expectedUpToDate {
+				t.Fatalf("expected %t, got %t", tc.expectedUpToDate, upToDate)
+			}
+		})
+	}
+}
+
+func sortedStrings(a, b string) []string {
+	if a > b {
+		return []string{b, a}
+	}
+	return []string{a, b}
+}
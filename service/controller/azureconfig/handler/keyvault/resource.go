@@ -0,0 +1,72 @@
+package keyvault
+
+import (
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"k8s.io/client-go/kubernetes"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/debugger"
+	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
+)
+
+const (
+	// Name is the identifier of the resource.
+	Name = "keyvault"
+)
+
+// Config contains information required by Resource.
+type Config struct {
+	CtrlClient ctrlclient.Client
+	Debugger   *debugger.Debugger
+	K8sClient  kubernetes.Interface
+	Logger     micrologger.Logger
+
+	Azure setting.Azure
+}
+
+// Resource ensures the Key Vault delivering the certificate encryption keys to
+// the nodes of a cluster.
+type Resource struct {
+	ctrlClient ctrlclient.Client
+	debugger   *debugger.Debugger
+	k8sClient  kubernetes.Interface
+	logger     micrologger.Logger
+
+	azure setting.Azure
+}
+
+// New validates Config and creates a new Resource with it.
+func New(config Config) (*Resource, error) {
+	if config.CtrlClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CtrlClient must not be empty", config)
+	}
+	if config.Debugger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Debugger must not be empty", config)
+	}
+	if config.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if err := config.Azure.Validate(); err != nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Azure.%s", config, err)
+	}
+
+	r := &Resource{
+		ctrlClient: config.CtrlClient,
+		debugger:   config.Debugger,
+		k8sClient:  config.K8sClient,
+		logger:     config.Logger,
+
+		azure: config.Azure,
+	}
+
+	return r, nil
+}
+
+// Name returns the resource name.
+func (r *Resource) Name() string {
+	return Name
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/giantswarm/microerror"
 
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
 	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
@@ -23,6 +24,12 @@ func (r *Resource) createStateMachine() state.Machine {
 			ClusterUpgradeRequirementCheck: r.clusterUpgradeRequirementCheckTransition,
 			MasterInstancesUpgrading:       r.masterInstancesUpgradingTransition,
 			DeploymentCompleted:            r.deploymentCompletedTransition,
+			Paused:                         r.pausedTransition,
+			StateTimedOut:                  r.stateTimedOutTransition,
+		},
+		BeforeHooks: []state.BeforeHook{
+			r.newPauseHook(),
+			r.newTimeoutHook(),
 		},
 	}
 
@@ -38,6 +45,7 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 
 	var newState state.State
 	var currentState state.State
+	var history state.History
 	{
 		s, err := r.GetResourceStatus(ctx, cr, Stage)
 		if err != nil {
@@ -45,9 +53,20 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		}
 		currentState = state.State(s)
 
+		history, err = r.GetStateHistory(ctx, cr, annotation.MastersStateMachineHistory)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
 		r.Logger.Debugf(ctx, "current state: %s", currentState)
-		newState, err = r.StateMachine.Execute(ctx, obj, currentState)
+		newState, history, err = r.StateMachine.ExecuteWithHistory(ctx, obj, currentState, history)
 		if err != nil {
+			// Persist the failed transition so that it shows up in the history.
+			historyErr := r.SetStateHistory(ctx, cr, annotation.MastersStateMachineHistory, history)
+			if historyErr != nil {
+				r.Logger.Errorf(ctx, historyErr, "failed to save state machine history")
+			}
+
 			return microerror.Mask(err)
 		}
 	}
@@ -60,6 +79,12 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 			return microerror.Mask(err)
 		}
 		r.Logger.Debugf(ctx, "set resource status to '%s/%s'", Stage, newState)
+
+		err = r.SetStateHistory(ctx, cr, annotation.MastersStateMachineHistory, history)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
 		r.Logger.Debugf(ctx, "canceling reconciliation")
 	} else {
 		r.Logger.Debugf(ctx, "no state change")
@@ -52,9 +52,15 @@ func (r *Resource) clusterUpgradeRequirementCheckTransition(ctx context.Context,
 		return "", microerror.Mask(err)
 	}
 
-	if conditions.IsCreatingFalse(cluster) && anyOldNodes {
+	anyOutdatedInstances, err := r.anyMasterInstanceOutdated(ctx, cr)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	if conditions.IsCreatingFalse(cluster) && (anyOldNodes || anyOutdatedInstances) {
 		// Only continue rolling nodes when cluster is not creating and there
-		// are old nodes in tenant cluster.
+		// are old nodes in tenant cluster or instances which do not run the
+		// latest VMSS model, e.g. after the OS image changed.
 		return MasterInstancesUpgrading, nil
 	}
 
@@ -62,6 +68,25 @@ func (r *Resource) clusterUpgradeRequirementCheckTransition(ctx context.Context,
 	return DeploymentCompleted, nil
 }
 
+// anyMasterInstanceOutdated returns true when any master instance does not run
+// the latest model of the master VMSS.
+func (r *Resource) anyMasterInstanceOutdated(ctx context.Context, cr providerv1alpha1.AzureConfig) (bool, error) {
+	instances, err := r.AllInstances(ctx, cr, key.MasterVMSSName)
+	if nodes.IsScaleSetNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	for _, vm := range instances {
+		if vm.LatestModelApplied != nil && !*vm.LatestModelApplied {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (r *Resource) getCluster(ctx context.Context, cr *providerv1alpha1.AzureConfig) (*capiv1alpha3.Cluster, error) {
 	orgNs := key.OrganizationNamespace(cr)
 
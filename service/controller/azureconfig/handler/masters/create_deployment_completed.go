@@ -28,7 +28,7 @@ func (r *Resource) deploymentCompletedTransition(ctx context.Context, obj interf
 		return currentState, microerror.Mask(err)
 	}
 
-	d, err := deploymentsClient.Get(ctx, key.ClusterID(&cr), key.MastersVmssDeploymentName)
+	d, err := deploymentsClient.Get(ctx, key.ResourceGroupName(cr), key.MastersVmssDeploymentName)
 	if IsDeploymentNotFound(err) {
 		r.Logger.Debugf(ctx, "deployment should be completed but is not found")
 		r.Logger.Debugf(ctx, "going back to DeploymentUninitialized")
@@ -40,12 +40,18 @@ func (r *Resource) deploymentCompletedTransition(ctx context.Context, obj interf
 	s := *d.Properties.ProvisioningState
 	r.Logger.Debugf(ctx, "deployment is in state '%s'", s)
 
-	group, err := groupsClient.Get(ctx, key.ClusterID(&cr))
+	group, err := groupsClient.Get(ctx, key.ResourceGroupName(cr))
 	if err != nil {
 		return currentState, microerror.Mask(err)
 	}
 
 	if key.IsSucceededProvisioningState(s) {
+		// Masters joining etcd wait for their DNS record to show up.
+		err = r.ensureEtcdMemberRecords(ctx, cr)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+
 		// Check if any of the nodes is out of date.
 		var releases []releasev1alpha1.Release
 		{
@@ -78,6 +84,16 @@ func (r *Resource) deploymentCompletedTransition(ctx context.Context, obj interf
 			return Empty, nil
 		}
 
+		anyOutdatedInstances, err := r.anyMasterInstanceOutdated(ctx, cr)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+
+		if anyOutdatedInstances {
+			r.Logger.Debugf(ctx, "master instance[s] not running the latest VMSS model")
+			return Empty, nil
+		}
+
 		computedDeployment, err := r.newDeployment(ctx, cr, nil, *group.Location)
 		if blobclient.IsBlobNotFound(err) {
 			r.Logger.Debugf(ctx, "ignition blob not found")
@@ -19,7 +19,7 @@ func (r *Resource) deploymentInitializedTransition(ctx context.Context, obj inte
 		return Empty, microerror.Mask(err)
 	}
 
-	d, err := deploymentsClient.Get(ctx, key.ClusterID(&cr), key.MastersVmssDeploymentName)
+	d, err := deploymentsClient.Get(ctx, key.ResourceGroupName(cr), key.MastersVmssDeploymentName)
 	if IsDeploymentNotFound(err) {
 		r.Logger.Debugf(ctx, "deployment not found")
 		r.Logger.Debugf(ctx, "waiting for creation")
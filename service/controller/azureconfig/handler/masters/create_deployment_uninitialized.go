@@ -30,7 +30,7 @@ func (r *Resource) deploymentUninitializedTransition(ctx context.Context, obj in
 
 	r.Logger.Debugf(ctx, "ensuring deployment")
 
-	group, err := groupsClient.Get(ctx, key.ClusterID(&cr))
+	group, err := groupsClient.Get(ctx, key.ResourceGroupName(cr))
 	if err != nil {
 		return currentState, microerror.Mask(err)
 	}
@@ -50,7 +50,7 @@ func (r *Resource) deploymentUninitializedTransition(ctx context.Context, obj in
 	} else if err != nil {
 		return currentState, microerror.Mask(err)
 	} else {
-		res, err := deploymentsClient.CreateOrUpdate(ctx, key.ClusterID(&cr), key.MastersVmssDeploymentName, computedDeployment)
+		res, err := deploymentsClient.CreateOrUpdate(ctx, key.ResourceGroupName(cr), key.MastersVmssDeploymentName, computedDeployment)
 		if err != nil {
 			return currentState, microerror.Mask(err)
 		}
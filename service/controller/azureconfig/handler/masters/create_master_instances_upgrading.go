@@ -12,6 +12,7 @@ import (
 
 	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes"
 	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/workerpool"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
 
@@ -61,6 +62,11 @@ func (r *Resource) masterInstancesUpgradingTransition(ctx context.Context, obj i
 		} else {
 			r.Logger.Debugf(ctx, "processing master VMSSs")
 
+			err = r.ensureEtcdMemberRecords(ctx, cr)
+			if err != nil {
+				return "", microerror.Mask(err)
+			}
+
 			// Ensure that all VM instances are in Successful state before proceeding with reimaging.
 			for _, vm := range allMasterInstances {
 				if vm.ProvisioningState != nil && !key.IsSucceededProvisioningState(*vm.ProvisioningState) {
@@ -71,6 +77,8 @@ func (r *Resource) masterInstancesUpgradingTransition(ctx context.Context, obj i
 			}
 
 			desiredVersion := key.ReleaseVersion(&cr)
+
+			var outdatedInstances, instancesToReplace, instancesToReimage []compute.VirtualMachineScaleSetVM
 			for _, vm := range allMasterInstances {
 				instanceName := key.MasterInstanceName(cr, *vm.InstanceID)
 				instanceVersion, ok := versionValue[instanceName]
@@ -78,30 +86,62 @@ func (r *Resource) masterInstancesUpgradingTransition(ctx context.Context, obj i
 					continue
 				}
 				if desiredVersion == instanceVersion {
+					// The release did not change but the VMSS model did, e.g.
+					// the OS image or the cloud provider configuration. Such
+					// instances get the latest model and are reimaged in one go,
+					// because their version alone does not tell they were rolled.
+					if !*vm.VirtualMachineScaleSetVMProperties.LatestModelApplied {
+						masterUpgradeInProgress = true
+						instancesToReplace = append(instancesToReplace, vm)
+					}
 					continue
 				}
 
 				masterUpgradeInProgress = true
 
-				// Ensure that VM has latest VMSS configuration (includes ignition template etc.).
 				if !*vm.VirtualMachineScaleSetVMProperties.LatestModelApplied {
-					err = r.updateInstance(ctx, cr, &vm, key.MasterVMSSName, key.MasterInstanceName)
+					outdatedInstances = append(outdatedInstances, vm)
+				} else {
+					instancesToReimage = append(instancesToReimage, vm)
+				}
+			}
+
+			// Outdated instances get the latest VMSS configuration (includes
+			// ignition template etc.) first. Once the VM instance configuration
+			// has been updated, the instances can be reimaged.
+			instancesToRoll, rollInstances, action := instancesToReimage, r.reimageInstances, "reimaging"
+			if len(instancesToReplace) > 0 {
+				instancesToRoll, rollInstances, action = instancesToReplace, r.replaceInstances, "replacing"
+			}
+			if len(outdatedInstances) > 0 {
+				instancesToRoll, rollInstances, action = outdatedInstances, r.updateInstances, "updating"
+			}
+
+			if len(instancesToRoll) > 0 {
+				// Updating or reimaging a master of a cluster with multiple masters
+				// takes one etcd member down, so all the others must be up.
+				if len(allMasterInstances) > 1 {
+					ready, err := r.etcdMembersReady(ctx, cr, len(allMasterInstances))
 					if err != nil {
 						return "", microerror.Mask(err)
 					}
+					if !ready {
+						r.Logger.Debugf(ctx, "waiting for etcd members to be ready before %s masters", action)
+						return currentState, nil
+					}
+				}
 
-					// Update only one instance at at time.
-					break
+				// Only as many masters are rolled at once as etcd can lose without
+				// losing quorum.
+				n := maxUnavailableMasters(len(allMasterInstances))
+				if len(instancesToRoll) > n {
+					instancesToRoll = instancesToRoll[:n]
 				}
 
-				// Once the VM instance configuration has been updated, it can be reimaged.
-				err = r.reimageInstance(ctx, cr, &vm, key.MasterVMSSName, key.MasterInstanceName)
+				err = rollInstances(ctx, cr, instancesToRoll, key.MasterVMSSName, key.MasterInstanceName)
 				if err != nil {
 					return "", microerror.Mask(err)
 				}
-
-				// Reimage only one instance at a time.
-				break
 			}
 
 			r.Logger.Debugf(ctx, "processed master VMSSs")
@@ -144,6 +184,35 @@ func areNodesReadyForUpgrading(nodes []corev1.Node) bool {
 	return numNodes > 0
 }
 
+// maxUnavailableMasters returns the number of masters which can be down at the
+// same time without losing the etcd quorum, but at least one.
+func maxUnavailableMasters(masters int) int {
+	n := (masters - 1) / 2
+	if n < 1 {
+		return 1
+	}
+
+	return n
+}
+
+// reimageInstances reimages the given instances in parallel.
+func (r *Resource) reimageInstances(ctx context.Context, customObject providerv1alpha1.AzureConfig, instances []compute.VirtualMachineScaleSetVM, deploymentNameFunc func(customObject providerv1alpha1.AzureConfig) string, instanceNameFunc func(customObject providerv1alpha1.AzureConfig, instanceID string) string) error {
+	var jobs []workerpool.Job
+	for i := range instances {
+		instance := instances[i]
+		jobs = append(jobs, workerpool.NewJob(instanceNameFunc(customObject, *instance.InstanceID), func() error {
+			return r.reimageInstance(ctx, customObject, &instance, deploymentNameFunc, instanceNameFunc)
+		}))
+	}
+
+	err := r.workerPool.Run(ctx, jobs...)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
 func (r *Resource) reimageInstance(ctx context.Context, customObject providerv1alpha1.AzureConfig, instance *compute.VirtualMachineScaleSetVM, deploymentNameFunc func(customObject providerv1alpha1.AzureConfig) string, instanceNameFunc func(customObject providerv1alpha1.AzureConfig, instanceID string) string) error {
 	if instance == nil {
 		return nil
@@ -179,6 +248,49 @@ func (r *Resource) reimageInstance(ctx context.Context, customObject providerv1a
 	return nil
 }
 
+// updateInstances applies the latest VMSS model to the given instances in
+// parallel.
+func (r *Resource) updateInstances(ctx context.Context, customObject providerv1alpha1.AzureConfig, instances []compute.VirtualMachineScaleSetVM, deploymentNameFunc func(customObject providerv1alpha1.AzureConfig) string, instanceNameFunc func(customObject providerv1alpha1.AzureConfig, instanceID string) string) error {
+	var jobs []workerpool.Job
+	for i := range instances {
+		instance := instances[i]
+		jobs = append(jobs, workerpool.NewJob(instanceNameFunc(customObject, *instance.InstanceID), func() error {
+			return r.updateInstance(ctx, customObject, &instance, deploymentNameFunc, instanceNameFunc)
+		}))
+	}
+
+	err := r.workerPool.Run(ctx, jobs...)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// replaceInstances applies the latest VMSS model to the given instances and
+// reimages them afterwards, in parallel.
+func (r *Resource) replaceInstances(ctx context.Context, customObject providerv1alpha1.AzureConfig, instances []compute.VirtualMachineScaleSetVM, deploymentNameFunc func(customObject providerv1alpha1.AzureConfig) string, instanceNameFunc func(customObject providerv1alpha1.AzureConfig, instanceID string) string) error {
+	var jobs []workerpool.Job
+	for i := range instances {
+		instance := instances[i]
+		jobs = append(jobs, workerpool.NewJob(instanceNameFunc(customObject, *instance.InstanceID), func() error {
+			err := r.updateInstance(ctx, customObject, &instance, deploymentNameFunc, instanceNameFunc)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			return r.reimageInstance(ctx, customObject, &instance, deploymentNameFunc, instanceNameFunc)
+		}))
+	}
+
+	err := r.workerPool.Run(ctx, jobs...)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
 func (r *Resource) updateInstance(ctx context.Context, customObject providerv1alpha1.AzureConfig, instance *compute.VirtualMachineScaleSetVM, deploymentNameFunc func(customObject providerv1alpha1.AzureConfig) string, instanceNameFunc func(customObject providerv1alpha1.AzureConfig, instanceID string) string) error {
 	if instance == nil {
 		return nil
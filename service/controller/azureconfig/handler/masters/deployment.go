@@ -9,13 +9,17 @@ import (
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/operatorkit/v2/pkg/controller/context/resourcecanceledcontext"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/giantswarm/azure-operator/v5/pkg/helpers/vmss"
 	"github.com/giantswarm/azure-operator/v5/pkg/project"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/masters/template"
 	"github.com/giantswarm/azure-operator/v5/service/controller/blobclient"
 	"github.com/giantswarm/azure-operator/v5/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/tags"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
 )
 
 func (r Resource) newDeployment(ctx context.Context, obj providerv1alpha1.AzureConfig, overwrites map[string]interface{}, location string) (azureresource.Deployment, error) {
@@ -40,6 +44,21 @@ func (r Resource) newDeployment(ctx context.Context, obj providerv1alpha1.AzureC
 		return azureresource.Deployment{}, microerror.Mask(err)
 	}
 
+	osImage, err := r.getMasterOSImage(ctx, obj, distroVersion)
+	if err != nil {
+		return azureresource.Deployment{}, microerror.Mask(err)
+	}
+
+	cloudProvider, err := r.getCloudProvider(ctx, obj)
+	if err != nil {
+		return azureresource.Deployment{}, microerror.Mask(err)
+	}
+
+	masterCount, err := r.masterCount(ctx, obj)
+	if err != nil {
+		return azureresource.Deployment{}, microerror.Mask(err)
+	}
+
 	for _, k := range cloudConfigURLs {
 		blobURL := cc.ContainerURL.NewBlockBlobURL(k)
 		_, err := blobURL.GetProperties(ctx, azblob.BlobAccessConditions{})
@@ -61,6 +80,7 @@ func (r Resource) newDeployment(ctx context.Context, obj providerv1alpha1.AzureC
 	}
 
 	encryptionKey := encrypter.GetEncryptionKey()
+	encryptionKeyID := encrypter.GetEncryptionKeyID()
 	initialVector := encrypter.GetInitialVector()
 
 	storageAccountsClient, err := r.ClientFactory.GetStorageAccountsClient(ctx, obj.ObjectMeta)
@@ -86,7 +106,25 @@ func (r Resource) newDeployment(ctx context.Context, obj providerv1alpha1.AzureC
 	if err != nil {
 		return azureresource.Deployment{}, microerror.Mask(err)
 	}
-	masterCloudConfig, err := vmss.RenderCloudConfig(masterBlobURL, encryptionKey, initialVector, prefixMaster)
+	masterCloudConfig, err := vmss.RenderCloudConfig(vmss.SmallCloudconfigConfig{
+		BlobURL:         masterBlobURL,
+		EncryptionKey:   encryptionKey,
+		EncryptionKeyID: encryptionKeyID,
+		InitialVector:   initialVector,
+		InstanceRole:    prefixMaster,
+		KeyVault:        r.Azure.KeyVaultEnabled(),
+		SpecVersion:     r.Ignition.SpecVersion,
+
+		PreviousEncryptionKey:   encrypter.GetPreviousEncryptionKey(),
+		PreviousEncryptionKeyID: encrypter.GetPreviousEncryptionKeyID(),
+	})
+	if err != nil {
+		return azureresource.Deployment{}, microerror.Mask(err)
+	}
+
+	// Changing the tags only updates the VMSS, the masters are not rolled
+	// since their release does not change.
+	customTags, err := tags.ForCluster(ctx, r.ctrlClient, &obj)
 	if err != nil {
 		return azureresource.Deployment{}, microerror.Mask(err)
 	}
@@ -95,8 +133,12 @@ func (r Resource) newDeployment(ctx context.Context, obj providerv1alpha1.AzureC
 		"masterLBBackendPoolID": cc.MasterLBBackendPoolID,
 		"azureOperatorVersion":  project.Version(),
 		"clusterID":             key.ClusterID(&obj),
+		"cloudProviderConfig":   cloudProvider.String(),
+		"customTags":            tags.TemplateParameter(customTags),
+		"encryptionKeyID":       encryptionKeyID,
 		"masterCloudConfigData": masterCloudConfig,
-		"masterNodes":           vmss.GetMasterNodesConfiguration(obj, distroVersion),
+		"masterCount":           masterCount,
+		"masterNodes":           vmss.GetMasterNodesConfiguration(obj, osImage),
 		"masterSubnetID":        cc.MasterSubnetID,
 		"vmssMSIEnabled":        r.Azure.MSI.Enabled,
 		"zones":                 key.AvailabilityZones(obj, location),
@@ -117,3 +159,43 @@ func (r Resource) newDeployment(ctx context.Context, obj providerv1alpha1.AzureC
 
 	return d, nil
 }
+
+// getCloudProvider returns the settings of the Azure cloud provider of the
+// cluster. The masters get them from their Ignition blob, so changing them does
+// not roll the masters, they are applied with the next master roll.
+func (r Resource) getCloudProvider(ctx context.Context, obj providerv1alpha1.AzureConfig) (setting.CloudProvider, error) {
+	cluster, err := r.getCluster(ctx, &obj)
+	if err != nil {
+		return setting.CloudProvider{}, microerror.Mask(err)
+	}
+	if cluster.Spec.InfrastructureRef == nil {
+		return r.CloudProvider, nil
+	}
+
+	azureCluster := &capzv1alpha3.AzureCluster{}
+	err = r.CtrlClient.Get(ctx, ctrlclient.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Spec.InfrastructureRef.Name}, azureCluster)
+	if err != nil {
+		return setting.CloudProvider{}, microerror.Mask(err)
+	}
+
+	cloudProvider, err := r.CloudProvider.ForCluster(azureCluster.Annotations)
+	if err != nil {
+		return setting.CloudProvider{}, microerror.Maskf(invalidConfigError, "AzureCluster %#q: %s", azureCluster.Name, err)
+	}
+
+	return cloudProvider, nil
+}
+
+// getMasterOSImage returns the OS image set in the master AzureMachine CR,
+// Flatcar Container Linux in the version of the release otherwise.
+func (r Resource) getMasterOSImage(ctx context.Context, obj providerv1alpha1.AzureConfig, distroVersion string) (vmss.NodeOSImage, error) {
+	azureMachine := &capzv1alpha3.AzureMachine{}
+	err := r.CtrlClient.Get(ctx, ctrlclient.ObjectKey{Namespace: key.OrganizationNamespace(&obj), Name: key.AzureMachineName(&obj)}, azureMachine)
+	if apierrors.IsNotFound(err) {
+		return vmss.NewNodeOSImage(nil, distroVersion), nil
+	} else if err != nil {
+		return vmss.NodeOSImage{}, microerror.Mask(err)
+	}
+
+	return vmss.NewNodeOSImage(azureMachine.Spec.Image, distroVersion), nil
+}
@@ -0,0 +1,86 @@
+package masters
+
+import (
+	"context"
+	"fmt"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/certs/v3/pkg/certs"
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/etcd"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+// etcdMembersReady returns true when the etcd cluster of the tenant cluster is
+// healthy and consists of the given number of started members. Masters must
+// only be reimaged or added while this is the case.
+func (r *Resource) etcdMembersReady(ctx context.Context, cr providerv1alpha1.AzureConfig, masters int) (bool, error) {
+	r.Logger.Debugf(ctx, "checking etcd membership")
+
+	etcdClient, err := r.getEtcdClient(ctx, cr)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	healthy, err := etcdClient.Healthy(ctx)
+	if err != nil {
+		r.Logger.Debugf(ctx, "etcd is not reachable: %s", err)
+		return false, nil
+	}
+	if !healthy {
+		r.Logger.Debugf(ctx, "etcd is not healthy")
+		return false, nil
+	}
+
+	members, err := etcdClient.Members(ctx)
+	if err != nil {
+		r.Logger.Debugf(ctx, "etcd members could not be listed: %s", err)
+		return false, nil
+	}
+
+	if !membersReady(members, masters) {
+		r.Logger.Debugf(ctx, "etcd has %d members (%d started), expected %d started members", len(members), startedMembers(members), masters)
+		return false, nil
+	}
+
+	r.Logger.Debugf(ctx, "checked etcd membership")
+
+	return true, nil
+}
+
+func (r *Resource) getEtcdClient(ctx context.Context, cr providerv1alpha1.AzureConfig) (*etcd.Client, error) {
+	tls, err := r.certsSearcher.SearchTLS(ctx, key.ClusterID(&cr), certs.EtcdCert)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	c := etcd.Config{
+		Endpoint: fmt.Sprintf("https://%s", key.ClusterEtcdDomain(cr)),
+		TLS:      tls,
+	}
+
+	etcdClient, err := etcd.New(c)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return etcdClient, nil
+}
+
+// membersReady returns true when the given etcd members are as many as
+// masters and all of them are started.
+func membersReady(members []etcd.Member, masters int) bool {
+	return len(members) == masters && startedMembers(members) == masters
+}
+
+func startedMembers(members []etcd.Member) int {
+	var n int
+	for _, m := range members {
+		if m.Started() {
+			n++
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,143 @@
+package masters
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strconv"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/Azure/go-autorest/autorest/to"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+const (
+	etcdMemberRecordTTL = 60
+)
+
+// ensureEtcdMemberRecords ensures the DNS records etcd members of clusters
+// with multiple masters use to reach each other. The record of each member
+// points to the master instance it runs on. Masters find their own member
+// name by looking for the record pointing to their IP, so instances are
+// mapped to member names in the order of their instance IDs, which keeps the
+// mapping stable when masters are added or reimaged.
+func (r *Resource) ensureEtcdMemberRecords(ctx context.Context, cr providerv1alpha1.AzureConfig) error {
+	if key.MasterCount(cr) < 2 {
+		return nil
+	}
+
+	r.Logger.Debugf(ctx, "ensuring etcd member DNS records")
+
+	instanceIPs, err := r.getMasterInstanceIPs(ctx, cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	recordSetsClient, err := r.ClientFactory.GetDNSRecordSetsClient(ctx, cr.ObjectMeta)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	zone := key.ClusterDNSDomain(cr)
+	for name, ip := range etcdMemberRecords(instanceIPs, key.MasterCount(cr)) {
+		current, err := recordSetsClient.Get(ctx, key.ResourceGroupName(cr), zone, name, dns.A)
+		if IsNotFound(err) {
+			// The record is created below.
+		} else if err != nil {
+			return microerror.Mask(err)
+		} else if current.ARecords != nil && len(*current.ARecords) == 1 && to.String((*current.ARecords)[0].Ipv4Address) == ip {
+			continue
+		}
+
+		params := dns.RecordSet{
+			RecordSetProperties: &dns.RecordSetProperties{
+				TTL: to.Int64Ptr(etcdMemberRecordTTL),
+				ARecords: &[]dns.ARecord{
+					{
+						Ipv4Address: to.StringPtr(ip),
+					},
+				},
+			},
+		}
+		_, err = recordSetsClient.CreateOrUpdate(ctx, key.ResourceGroupName(cr), zone, name, dns.A, params, "", "")
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.Logger.Debugf(ctx, "ensured DNS record %#q pointing to %#q", name, ip)
+	}
+
+	r.Logger.Debugf(ctx, "ensured etcd member DNS records")
+
+	return nil
+}
+
+// getMasterInstanceIPs returns the private IPs of the master instances by
+// their instance IDs.
+func (r *Resource) getMasterInstanceIPs(ctx context.Context, cr providerv1alpha1.AzureConfig) (map[string]string, error) {
+	interfacesClient, err := r.ClientFactory.GetInterfacesClient(ctx, cr.ObjectMeta)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	result, err := interfacesClient.ListVirtualMachineScaleSetNetworkInterfaces(ctx, key.ResourceGroupName(cr), key.MasterVMSSName(cr))
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	ips := map[string]string{}
+	for result.NotDone() {
+		for _, nic := range result.Values() {
+			if nic.VirtualMachine == nil || nic.IPConfigurations == nil || len(*nic.IPConfigurations) == 0 {
+				continue
+			}
+
+			ip := to.String((*nic.IPConfigurations)[0].PrivateIPAddress)
+			if ip == "" {
+				continue
+			}
+
+			// The ID of the instance is the last segment of the resource ID
+			// of the VMSS virtual machine.
+			ips[path.Base(to.String(nic.VirtualMachine.ID))] = ip
+		}
+
+		err := result.NextWithContext(ctx)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	return ips, nil
+}
+
+// etcdMemberRecords maps the etcd member names to the IPs of the given master
+// instances, ordered by their instance IDs. Instances beyond the number of
+// masters, e.g. while the VMSS is being scaled, don't get a record.
+func etcdMemberRecords(instanceIPs map[string]string, masters int) map[string]string {
+	var ids []string
+	for id := range instanceIPs {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, errA := strconv.Atoi(ids[i])
+		b, errB := strconv.Atoi(ids[j])
+		if errA != nil || errB != nil {
+			return ids[i] < ids[j]
+		}
+		return a < b
+	})
+
+	records := map[string]string{}
+	for i, id := range ids {
+		if i >= masters {
+			break
+		}
+		records[key.EtcdMemberName(i+1, masters)] = instanceIPs[id]
+	}
+
+	return records
+}
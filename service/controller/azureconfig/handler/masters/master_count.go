@@ -0,0 +1,68 @@
+package masters
+
+import (
+	"context"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+// validateMasterCount ensures the cluster has a number of masters etcd can
+// run with. An even number of members doesn't tolerate more failures than the
+// odd number below it, more than five members slow down writes.
+func validateMasterCount(count int) error {
+	switch count {
+	case 1, 3, 5:
+		return nil
+	default:
+		return microerror.Maskf(invalidConfigError, "cluster has %d masters, must be 1, 3 or 5", count)
+	}
+}
+
+// masterCount returns the number of instances of the master VMSS. New
+// clusters get all their masters at once, all etcd members bootstrap the
+// cluster together. Existing clusters grow one master at a time, a master is
+// only added once all existing etcd members are started and healthy, so that
+// the joining member never costs the cluster its quorum.
+func (r *Resource) masterCount(ctx context.Context, cr providerv1alpha1.AzureConfig) (int, error) {
+	desired := key.MasterCount(cr)
+	err := validateMasterCount(desired)
+	if err != nil {
+		return 0, microerror.Mask(err)
+	}
+
+	instances, err := r.AllInstances(ctx, cr, key.MasterVMSSName)
+	if nodes.IsScaleSetNotFound(err) {
+		return desired, nil
+	} else if err != nil {
+		return 0, microerror.Mask(err)
+	}
+
+	current := len(instances)
+	if current > desired {
+		return 0, microerror.Maskf(invalidConfigError, "cluster has %d masters, removing masters is not supported", current)
+	}
+	if current == desired || current == 0 {
+		return desired, nil
+	}
+
+	ready, err := r.etcdMembersReady(ctx, cr, current)
+	if err != nil {
+		return 0, microerror.Mask(err)
+	}
+
+	return nextMasterCount(current, desired, ready), nil
+}
+
+// nextMasterCount returns the number of masters to grow the cluster with the
+// given number of masters to.
+func nextMasterCount(current, desired int, etcdReady bool) int {
+	if current >= desired || !etcdReady {
+		return current
+	}
+
+	return current + 1
+}
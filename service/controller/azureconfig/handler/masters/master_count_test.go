@@ -0,0 +1,193 @@
+package masters
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/etcd"
+)
+
+func Test_nextMasterCount(t *testing.T) {
+	testCases := []struct {
+		name          string
+		current       int
+		desired       int
+		etcdReady     bool
+		expectedCount int
+	}{
+		{
+			name:          "case 0: single master cluster grows by one master",
+			current:       1,
+			desired:       3,
+			etcdReady:     true,
+			expectedCount: 2,
+		},
+		{
+			name:          "case 1: cluster does not grow while etcd is not ready",
+			current:       2,
+			desired:       3,
+			etcdReady:     false,
+			expectedCount: 2,
+		},
+		{
+			name:          "case 2: cluster with desired masters stays as it is",
+			current:       3,
+			desired:       3,
+			etcdReady:     true,
+			expectedCount: 3,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			count := nextMasterCount(tc.current, tc.desired, tc.etcdReady)
+			if count != tc.expectedCount {
+				t.Fatalf("expected %d, got %d", tc.expectedCount, count)
+			}
+		})
+	}
+}
+
+func Test_validateMasterCount(t *testing.T) {
+	testCases := []struct {
+		name         string
+		count        int
+		errorMatcher func(error) bool
+	}{
+		{
+			name:  "case 0: single master",
+			count: 1,
+		},
+		{
+			name:  "case 1: five masters",
+			count: 5,
+		},
+		{
+			name:         "case 2: even number of masters",
+			count:        2,
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name:         "case 3: no masters",
+			count:        0,
+			errorMatcher: IsInvalidConfig,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			err := validateMasterCount(tc.count)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+		})
+	}
+}
+
+func Test_membersReady(t *testing.T) {
+	testCases := []struct {
+		name          string
+		members       []etcd.Member
+		masters       int
+		expectedReady bool
+	}{
+		{
+			name: "case 0: all members started",
+			members: []etcd.Member{
+				{ID: "1", Name: "etcd1"},
+				{ID: "2", Name: "etcd2"},
+				{ID: "3", Name: "etcd3"},
+			},
+			masters:       3,
+			expectedReady: true,
+		},
+		{
+			name: "case 1: joining member did not start yet",
+			members: []etcd.Member{
+				{ID: "1", Name: "etcd"},
+				{ID: "2"},
+			},
+			masters:       2,
+			expectedReady: false,
+		},
+		{
+			name: "case 2: member of a new master was not added yet",
+			members: []etcd.Member{
+				{ID: "1", Name: "etcd"},
+			},
+			masters:       2,
+			expectedReady: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			ready := membersReady(tc.members, tc.masters)
+			if ready != tc.expectedReady {
+				t.Fatalf("expected %t, got %t", tc.expectedReady, ready)
+			}
+		})
+	}
+}
+
+func Test_etcdMemberRecords(t *testing.T) {
+	testCases := []struct {
+		name            string
+		instanceIPs     map[string]string
+		masters         int
+		expectedRecords map[string]string
+	}{
+		{
+			name: "case 0: instances are mapped in the order of their IDs",
+			instanceIPs: map[string]string{
+				"10": "10.0.0.7",
+				"2":  "10.0.0.6",
+				"0":  "10.0.0.5",
+			},
+			masters: 3,
+			expectedRecords: map[string]string{
+				"etcd1": "10.0.0.5",
+				"etcd2": "10.0.0.6",
+				"etcd3": "10.0.0.7",
+			},
+		},
+		{
+			name: "case 1: cluster still growing",
+			instanceIPs: map[string]string{
+				"0": "10.0.0.5",
+				"1": "10.0.0.6",
+			},
+			masters: 3,
+			expectedRecords: map[string]string{
+				"etcd1": "10.0.0.5",
+				"etcd2": "10.0.0.6",
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			records := etcdMemberRecords(tc.instanceIPs, tc.masters)
+			if !reflect.DeepEqual(records, tc.expectedRecords) {
+				t.Fatalf("expected %#v, got %#v", tc.expectedRecords, records)
+			}
+		})
+	}
+}
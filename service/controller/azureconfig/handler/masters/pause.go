@@ -0,0 +1,47 @@
+package masters
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
+	"github.com/giantswarm/azure-operator/v5/pkg/upgradepolicy"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+func (r *Resource) newPauseHook() state.PauseHook {
+	return state.PauseHook{
+		Paused:      r.isUpgradePaused,
+		PausedState: Paused,
+	}
+}
+
+// pausedTransition keeps the masters in Paused until the pause hook unpauses
+// the state machine.
+func (r *Resource) pausedTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
+	r.Logger.Debugf(ctx, "cluster upgrade is paused, remove annotation %#q from the cluster to resume", annotation.UpgradePaused)
+	return currentState, nil
+}
+
+// isUpgradePaused returns true when the upgrade of the cluster is paused
+// using the annotation.UpgradePaused annotation of the Cluster CR.
+func (r *Resource) isUpgradePaused(ctx context.Context, obj interface{}) (bool, error) {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	cluster, err := r.getCluster(ctx, &cr)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	paused, err := upgradepolicy.IsPaused(cluster.GetAnnotations())
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	return paused, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/certs/v3/pkg/certs"
 	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/tenantcluster/v3/pkg/tenantcluster"
@@ -11,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/workerpool"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
 
@@ -20,23 +22,33 @@ const (
 
 type Config struct {
 	nodes.Config
+	CertsSearcher            certs.Interface
 	CtrlClient               client.Client
 	TenantRestConfigProvider *tenantcluster.TenantCluster
+	WorkerPool               *workerpool.Pool
 }
 
 type Resource struct {
 	nodes.Resource
+	certsSearcher            certs.Interface
 	ctrlClient               client.Client
 	tenantRestConfigProvider *tenantcluster.TenantCluster
+	workerPool               *workerpool.Pool
 }
 
 func New(config Config) (*Resource, error) {
+	if config.CertsSearcher == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CertsSearcher must not be empty", config)
+	}
 	if config.CtrlClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.CtrlClient must not be empty", config)
 	}
 	if config.TenantRestConfigProvider == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.TenantRestConfigProvider must not be empty", config)
 	}
+	if config.WorkerPool == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.WorkerPool must not be empty", config)
+	}
 
 	config.Name = Name
 	nodes, err := nodes.New(config.Config)
@@ -46,8 +58,10 @@ func New(config Config) (*Resource, error) {
 
 	r := &Resource{
 		Resource:                 *nodes,
+		certsSearcher:            config.CertsSearcher,
 		ctrlClient:               config.CtrlClient,
 		tenantRestConfigProvider: config.TenantRestConfigProvider,
+		workerPool:               config.WorkerPool,
 	}
 	stateMachine := r.createStateMachine()
 	r.SetStateMachine(stateMachine)
@@ -13,5 +13,7 @@ const (
 	DeploymentCompleted            = "DeploymentCompleted"
 	Empty                          = ""
 	MasterInstancesUpgrading       = "MasterInstancesUpgrading"
+	Paused                         = "Paused"
 	ProvisioningSuccessful         = "ProvisioningSuccessful"
+	StateTimedOut                  = "StateTimedOut"
 )
@@ -0,0 +1,153 @@
+package masters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capiconditions "sigs.k8s.io/cluster-api/util/conditions"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes"
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+const (
+	// ControlPlaneUpgradeProgressingCondition is False on the Cluster CR while
+	// the masters state machine is stuck because a state timed out.
+	ControlPlaneUpgradeProgressingCondition capiv1alpha3.ConditionType = "ControlPlaneUpgradeProgressing"
+
+	StateTimedOutReason = "StateTimedOut"
+	ResumedReason       = "Resumed"
+)
+
+func (r *Resource) newTimeoutHook() state.TimeoutHook {
+	return state.TimeoutHook{
+		Deadlines:        r.stateDeadlines,
+		FailureState:     StateTimedOut,
+		ResumeAnnotation: annotation.MastersStateMachineResume,
+		OnTimeout:        r.onStateTimeout,
+		OnResume:         r.onStateResume,
+	}
+}
+
+// stateTimedOutTransition keeps the masters in StateTimedOut until the timeout
+// hook resumes the state machine.
+func (r *Resource) stateTimedOutTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
+	r.Logger.Debugf(ctx, "state machine timed out, set annotation %#q to the current time to resume", annotation.MastersStateMachineResume)
+	return currentState, nil
+}
+
+// stateDeadlines returns the deadlines configured on the AzureConfig CR using
+// the annotation.MastersStateMachineDeadlines annotation. Deadlines are opt-in,
+// since how long rolling nodes takes depends on the size of the cluster, so
+// no state times out without the annotation.
+func (r *Resource) stateDeadlines(obj interface{}) (map[state.State]time.Duration, error) {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	deadlines, err := state.ParseDeadlines(cr.GetAnnotations()[annotation.MastersStateMachineDeadlines])
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return deadlines, nil
+}
+
+func (r *Resource) onStateTimeout(ctx context.Context, obj interface{}, timedOutState state.State, deadline time.Duration) error {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	instanceIDs, err := r.blockingInstanceIDs(ctx, cr)
+	if err != nil {
+		// The timeout is reported anyway, only without the blocking instances.
+		r.Logger.Errorf(ctx, err, "failed to find instances blocking state %#q", timedOutState)
+	}
+
+	message := fmt.Sprintf(
+		"Masters did not leave state %s within %s, blocking instances: %s. Set annotation %s to the current time to resume.",
+		timedOutState,
+		deadline,
+		nodes.FormatInstanceIDs(instanceIDs),
+		annotation.MastersStateMachineResume,
+	)
+
+	r.Logger.Debugf(ctx, message)
+	r.EventRecorder.Event(&cr, corev1.EventTypeWarning, StateTimedOutReason, message)
+
+	err = r.setControlPlaneUpgradeProgressingCondition(ctx, cr, func(cluster *capiv1alpha3.Cluster) {
+		capiconditions.MarkFalse(cluster, ControlPlaneUpgradeProgressingCondition, StateTimedOutReason, capiv1alpha3.ConditionSeverityError, "%s", message)
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *Resource) onStateResume(ctx context.Context, obj interface{}, resumedState state.State) error {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	message := fmt.Sprintf("Resuming masters state machine in state %s.", resumedState)
+
+	r.Logger.Debugf(ctx, message)
+	r.EventRecorder.Event(&cr, corev1.EventTypeNormal, ResumedReason, message)
+
+	err = r.setControlPlaneUpgradeProgressingCondition(ctx, cr, func(cluster *capiv1alpha3.Cluster) {
+		capiconditions.MarkTrue(cluster, ControlPlaneUpgradeProgressingCondition)
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *Resource) setControlPlaneUpgradeProgressingCondition(ctx context.Context, cr providerv1alpha1.AzureConfig, mark func(*capiv1alpha3.Cluster)) error {
+	cluster, err := r.getCluster(ctx, &cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	mark(cluster)
+
+	err = r.ctrlClient.Status().Update(ctx, cluster)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// blockingInstanceIDs returns the IDs of the master instances which are not
+// provisioned successfully or do not run the latest VMSS model yet.
+func (r *Resource) blockingInstanceIDs(ctx context.Context, cr providerv1alpha1.AzureConfig) ([]string, error) {
+	instances, err := r.AllInstances(ctx, cr, key.MasterVMSSName)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var ids []string
+	for _, vm := range instances {
+		succeeded := vm.ProvisioningState != nil && key.IsSucceededProvisioningState(*vm.ProvisioningState)
+		latest := vm.LatestModelApplied != nil && *vm.LatestModelApplied
+
+		if !succeeded || !latest {
+			ids = append(ids, *vm.InstanceID)
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,278 @@
+package resourcegroup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
+	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v5/client"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+// subnetDeletionTier is the tier of deletionTiers before which the subnets of
+// the cluster in an existing virtual network are deleted. The subnets must be
+// gone before the network security groups and route table they use.
+const subnetDeletionTier = 2
+
+// deletionTiers orders the types of the resources deleted from resource
+// groups the operator does not own. Resources of a tier are only deleted once
+// the resources of all tiers before are gone, since Azure refuses to delete
+// resources still in use. Resource types not listed here are deleted last.
+var deletionTiers = [][]string{
+	{
+		"Microsoft.Compute/virtualMachineScaleSets",
+		"Microsoft.Compute/virtualMachines",
+		"Microsoft.Network/virtualNetworkGateways",
+	},
+	{
+		"Microsoft.Network/connections",
+		"Microsoft.Network/loadBalancers",
+		"Microsoft.Network/networkInterfaces",
+	},
+	{
+		"Microsoft.Network/virtualNetworks",
+	},
+	{
+		"Microsoft.Network/natGateways",
+		"Microsoft.Network/networkSecurityGroups",
+		"Microsoft.Network/routeTables",
+	},
+}
+
+// ensureExistingGroup checks the resource group created beforehand the cluster
+// is placed into. It returns false when the resource group does not exist
+// yet.
+func (r *Resource) ensureExistingGroup(ctx context.Context, cr v1alpha1.AzureConfig, groupsClient *azureresource.GroupsClient) (bool, error) {
+	r.logger.Debugf(ctx, "ensuring existing resource group is usable")
+
+	group, err := groupsClient.Get(ctx, key.ResourceGroupName(cr))
+	if IsNotFound(err) {
+		r.logger.Debugf(ctx, "existing resource group %#q not found", key.ResourceGroupName(cr))
+		return false, nil
+	} else if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	if !strings.EqualFold(to.String(group.Location), r.azure.Location) {
+		return false, microerror.Maskf(invalidConfigError, "existing resource group %#q must be in location %#q, got %#q", key.ResourceGroupName(cr), r.azure.Location, to.String(group.Location))
+	}
+
+	r.logger.Debugf(ctx, "ensured existing resource group is usable")
+
+	return true, nil
+}
+
+// ensureOwnedResourcesDeleted deletes the resources created by the operator,
+// identified by their provider tag, one tier of deletionTiers per
+// reconciliation. It returns true once all of them are gone.
+func (r *Resource) ensureOwnedResourcesDeleted(ctx context.Context, cr v1alpha1.AzureConfig, azureClientSet *client.AzureClientSet) (bool, error) {
+	r.logger.Debugf(ctx, "ensuring resources created by the operator are deleted")
+
+	var owned []azureresource.GenericResourceExpanded
+	{
+		filter := fmt.Sprintf("tagName eq '%s' and tagValue eq '%s'", key.ProviderTagName, key.ProviderTagValue)
+		iterator, err := azureClientSet.ResourcesClient.ListByResourceGroupComplete(ctx, key.ResourceGroupName(cr), filter, "", nil)
+		if IsNotFound(err) {
+			r.logger.Debugf(ctx, "resource group %#q not found", key.ResourceGroupName(cr))
+		} else if err != nil {
+			return false, microerror.Mask(err)
+		} else {
+			for iterator.NotDone() {
+				owned = append(owned, iterator.Value())
+
+				err = iterator.NextWithContext(ctx)
+				if err != nil {
+					return false, microerror.Mask(err)
+				}
+			}
+		}
+	}
+
+	tier, resources := nextDeletionTier(owned)
+
+	if _, _, ok := key.ExistingVirtualNetwork(cr); ok && tier >= subnetDeletionTier {
+		deleted, err := r.ensureSubnetsDeleted(ctx, cr, azureClientSet.SubnetsClient)
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+		if !deleted {
+			return false, nil
+		}
+	}
+
+	if len(resources) == 0 {
+		r.logger.Debugf(ctx, "ensured resources created by the operator are deleted")
+		return true, nil
+	}
+
+	apiVersions := map[string]string{}
+	for _, resource := range resources {
+		resourceType := to.String(resource.Type)
+
+		apiVersion, ok := apiVersions[resourceType]
+		if !ok {
+			var err error
+			apiVersion, err = getAPIVersion(ctx, azureClientSet.ProvidersClient, resourceType)
+			if err != nil {
+				return false, microerror.Mask(err)
+			}
+			apiVersions[resourceType] = apiVersion
+		}
+
+		r.logger.Debugf(ctx, "deleting %s %#q", resourceType, to.String(resource.Name))
+
+		res, err := azureClientSet.ResourcesClient.DeleteByID(ctx, to.String(resource.ID), apiVersion)
+		if IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return false, microerror.Mask(err)
+		}
+		_, err = azureClientSet.ResourcesClient.DeleteByIDResponder(res.Response())
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+	}
+
+	r.logger.Debugf(ctx, "deletion of %d resources created by the operator in progress", len(resources))
+
+	return false, nil
+}
+
+// ensureSubnetsDeleted deletes the subnets of the cluster from the existing
+// virtual network it is placed into. They are told apart from the other
+// subnets of the virtual network by the route table of the cluster. It
+// returns true once all of them are gone.
+func (r *Resource) ensureSubnetsDeleted(ctx context.Context, cr v1alpha1.AzureConfig, subnetsClient *network.SubnetsClient) (bool, error) {
+	r.logger.Debugf(ctx, "ensuring subnets are deleted from existing virtual network %#q", key.VnetName(cr))
+
+	var subnets []network.Subnet
+	{
+		iterator, err := subnetsClient.ListComplete(ctx, key.VnetResourceGroupName(cr), key.VnetName(cr))
+		if IsNotFound(err) {
+			r.logger.Debugf(ctx, "existing virtual network %#q not found", key.VnetName(cr))
+			return true, nil
+		} else if err != nil {
+			return false, microerror.Mask(err)
+		}
+
+		for iterator.NotDone() {
+			subnets = append(subnets, iterator.Value())
+
+			err = iterator.NextWithContext(ctx)
+			if err != nil {
+				return false, microerror.Mask(err)
+			}
+		}
+	}
+
+	subnets = clusterSubnets(subnets, key.ResourceGroupName(cr), key.RouteTableName(cr))
+	if len(subnets) == 0 {
+		r.logger.Debugf(ctx, "ensured subnets are deleted from existing virtual network %#q", key.VnetName(cr))
+		return true, nil
+	}
+
+	for _, subnet := range subnets {
+		r.logger.Debugf(ctx, "deleting subnet %#q", to.String(subnet.Name))
+
+		res, err := subnetsClient.Delete(ctx, key.VnetResourceGroupName(cr), key.VnetName(cr), to.String(subnet.Name))
+		if IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return false, microerror.Mask(err)
+		}
+		_, err = subnetsClient.DeleteResponder(res.Response())
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+	}
+
+	r.logger.Debugf(ctx, "deletion of %d subnets in progress", len(subnets))
+
+	return false, nil
+}
+
+// clusterSubnets returns the subnets using the route table with the given name
+// in the given resource group.
+func clusterSubnets(subnets []network.Subnet, resourceGroup string, routeTable string) []network.Subnet {
+	suffix := strings.ToLower(fmt.Sprintf("/resourceGroups/%s/providers/Microsoft.Network/routeTables/%s", resourceGroup, routeTable))
+
+	var matching []network.Subnet
+	for _, subnet := range subnets {
+		if subnet.SubnetPropertiesFormat == nil || subnet.RouteTable == nil {
+			continue
+		}
+		if strings.HasSuffix(strings.ToLower(to.String(subnet.RouteTable.ID)), suffix) {
+			matching = append(matching, subnet)
+		}
+	}
+
+	return matching
+}
+
+// nextDeletionTier returns the index of the first tier of deletionTiers with
+// resources left and those resources. Resources of types not listed in
+// deletionTiers make up the tier after the last one.
+func nextDeletionTier(resources []azureresource.GenericResourceExpanded) (int, []azureresource.GenericResourceExpanded) {
+	tiers := make([][]azureresource.GenericResourceExpanded, len(deletionTiers)+1)
+	for _, resource := range resources {
+		tier := deletionTier(to.String(resource.Type))
+		tiers[tier] = append(tiers[tier], resource)
+	}
+
+	for i, resources := range tiers {
+		if len(resources) > 0 {
+			return i, resources
+		}
+	}
+
+	return len(tiers), nil
+}
+
+func deletionTier(resourceType string) int {
+	for i, types := range deletionTiers {
+		for _, t := range types {
+			if strings.EqualFold(t, resourceType) {
+				return i
+			}
+		}
+	}
+
+	return len(deletionTiers)
+}
+
+// getAPIVersion returns the newest stable API version of the given resource
+// type, e.g. "Microsoft.Network/loadBalancers", which DeleteByID requires.
+func getAPIVersion(ctx context.Context, providersClient *azureresource.ProvidersClient, resourceType string) (string, error) {
+	parts := strings.SplitN(resourceType, "/", 2)
+	if len(parts) != 2 {
+		return "", microerror.Maskf(executionFailedError, "unexpected resource type %#q", resourceType)
+	}
+
+	provider, err := providersClient.Get(ctx, parts[0], "")
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	if provider.ResourceTypes != nil {
+		for _, t := range *provider.ResourceTypes {
+			if !strings.EqualFold(to.String(t.ResourceType), parts[1]) || t.APIVersions == nil {
+				continue
+			}
+
+			// API versions are listed newest first.
+			for _, v := range *t.APIVersions {
+				if !strings.Contains(v, "preview") {
+					return v, nil
+				}
+			}
+		}
+	}
+
+	return "", microerror.Maskf(executionFailedError, "no API version found for resource type %#q", resourceType)
+}
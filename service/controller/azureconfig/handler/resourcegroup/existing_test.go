@@ -0,0 +1,112 @@
+package resourcegroup
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
+	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func Test_nextDeletionTier(t *testing.T) {
+	testCases := []struct {
+		name              string
+		resources         []azureresource.GenericResourceExpanded
+		expectedTier      int
+		expectedResources []string
+	}{
+		{
+			name:         "case 0: no resources left",
+			expectedTier: 5,
+		},
+		{
+			name: "case 1: scale sets are deleted first",
+			resources: []azureresource.GenericResourceExpanded{
+				newResource("ip", "Microsoft.Network/publicIPAddresses"),
+				newResource("lb", "Microsoft.Network/loadBalancers"),
+				newResource("masters", "Microsoft.Compute/virtualMachineScaleSets"),
+				newResource("nodepool", "microsoft.compute/virtualmachinescalesets"),
+			},
+			expectedTier:      0,
+			expectedResources: []string{"masters", "nodepool"},
+		},
+		{
+			name: "case 2: virtual network is deleted before route table",
+			resources: []azureresource.GenericResourceExpanded{
+				newResource("rt", "Microsoft.Network/routeTables"),
+				newResource("vnet", "Microsoft.Network/virtualNetworks"),
+			},
+			expectedTier:      2,
+			expectedResources: []string{"vnet"},
+		},
+		{
+			name: "case 3: unknown types are deleted last",
+			resources: []azureresource.GenericResourceExpanded{
+				newResource("ip", "Microsoft.Network/publicIPAddresses"),
+				newResource("zone", "Microsoft.Network/dnszones"),
+			},
+			expectedTier:      4,
+			expectedResources: []string{"ip", "zone"},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			tier, resources := nextDeletionTier(tc.resources)
+			if tier != tc.expectedTier {
+				t.Fatalf("expected tier %d, got %d", tc.expectedTier, tier)
+			}
+
+			var names []string
+			for _, r := range resources {
+				names = append(names, to.String(r.Name))
+			}
+			if !reflect.DeepEqual(names, tc.expectedResources) {
+				t.Fatalf("expected %v, got %v", tc.expectedResources, names)
+			}
+		})
+	}
+}
+
+func Test_clusterSubnets(t *testing.T) {
+	subnets := []network.Subnet{
+		newSubnet("foreign", ""),
+		newSubnet("other-cluster", "/subscriptions/sub/resourceGroups/other/providers/Microsoft.Network/routeTables/other-RouteTable"),
+		newSubnet("master", "/subscriptions/sub/resourceGroups/c1a2b/providers/Microsoft.Network/routeTables/c1a2b-RouteTable"),
+		newSubnet("nodepool", "/subscriptions/sub/resourcegroups/C1A2B/providers/Microsoft.Network/routeTables/c1a2b-RouteTable"),
+		{Name: to.StringPtr("no-properties")},
+	}
+
+	var names []string
+	for _, s := range clusterSubnets(subnets, "c1a2b", "c1a2b-RouteTable") {
+		names = append(names, to.String(s.Name))
+	}
+
+	expected := []string{"master", "nodepool"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected %v, got %v", expected, names)
+	}
+}
+
+func newResource(name string, resourceType string) azureresource.GenericResourceExpanded {
+	return azureresource.GenericResourceExpanded{
+		Name: to.StringPtr(name),
+		Type: to.StringPtr(resourceType),
+	}
+}
+
+func newSubnet(name string, routeTableID string) network.Subnet {
+	subnet := network.Subnet{
+		Name:                   to.StringPtr(name),
+		SubnetPropertiesFormat: &network.SubnetPropertiesFormat{},
+	}
+	if routeTableID != "" {
+		subnet.RouteTable = &network.RouteTable{ID: to.StringPtr(routeTableID)}
+	}
+
+	return subnet
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/giantswarm/azure-operator/v5/pkg/helpers"
 	"github.com/giantswarm/azure-operator/v5/pkg/project"
 	"github.com/giantswarm/azure-operator/v5/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/tags"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
 )
@@ -89,13 +90,31 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		r.logger.LogCtx(ctx, "level", "warning", "message", "error while updating AzureCluster ResourceGroupReady condition", "error", conditionsUpdateError.Error())
 	}
 
+	if _, ok := key.ExistingResourceGroup(cr); ok {
+		found, err := r.ensureExistingGroup(ctx, cr, groupsClient)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		if !found {
+			r.logger.Debugf(ctx, "canceling reconciliation")
+			reconciliationcanceledcontext.SetCanceled(ctx)
+		}
+
+		return nil
+	}
+
+	customTags, err := tags.ForCluster(ctx, r.ctrlClient, &cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	r.logger.Debugf(ctx, "ensuring resource group is created")
 
 	resourceGroup := azureresource.Group{
-		Name:      to.StringPtr(key.ClusterID(&cr)),
+		Name:      to.StringPtr(key.ResourceGroupName(cr)),
 		Location:  to.StringPtr(r.azure.Location),
 		ManagedBy: to.StringPtr(project.Name()),
-		Tags:      key.ClusterTags(cr, r.installationName),
+		Tags:      tags.Merge(customTags, key.ClusterTags(cr, r.installationName)),
 	}
 	_, err = groupsClient.CreateOrUpdate(ctx, *resourceGroup.Name, resourceGroup)
 	if err != nil {
@@ -114,20 +133,44 @@ func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
 		return microerror.Mask(err)
 	}
 
-	groupsClient, err := r.getGroupsClient(ctx)
+	cc, err := controllercontext.FromContext(ctx)
 	if err != nil {
 		return microerror.Mask(err)
 	}
+	groupsClient := cc.AzureClientSet.GroupsClient
+
+	// Resource groups and virtual networks created beforehand are shared with
+	// foreign resources, so only the resources created by the operator are
+	// deleted from them. The resource group created by the operator is
+	// deleted afterwards as a whole.
+	_, existingResourceGroup := key.ExistingResourceGroup(cr)
+	_, _, existingVirtualNetwork := key.ExistingVirtualNetwork(cr)
+	if existingResourceGroup || existingVirtualNetwork {
+		deleted, err := r.ensureOwnedResourcesDeleted(ctx, cr, cc.AzureClientSet)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		if !deleted {
+			finalizerskeptcontext.SetKept(ctx)
+			reconciliationcanceledcontext.SetCanceled(ctx)
+			r.logger.Debugf(ctx, "canceling reconciliation")
+
+			return nil
+		}
+		if existingResourceGroup {
+			return nil
+		}
+	}
 
 	r.logger.Debugf(ctx, "ensuring resource group deletion")
 
-	_, err = groupsClient.Get(ctx, key.ClusterID(&cr))
+	_, err = groupsClient.Get(ctx, key.ResourceGroupName(cr))
 	if IsNotFound(err) {
 		// fall through
 	} else if err != nil {
 		return microerror.Mask(err)
 	} else {
-		res, err := groupsClient.Delete(ctx, key.ClusterID(&cr))
+		res, err := groupsClient.Delete(ctx, key.ResourceGroupName(cr))
 		if IsNotFound(err) {
 			// fall through
 		} else if err != nil {
@@ -182,7 +225,7 @@ func (r *Resource) checkAndUpdateResourceGroupReadyCondition(ctx context.Context
 		return nil
 	}
 
-	group, err := groupsClient.Get(ctx, azureConfig.Name)
+	group, err := groupsClient.Get(ctx, key.ResourceGroupName(azureConfig))
 	const genericErrorMessage = "Failed to get resource group from Azure API"
 	var conditionReason string
 	var conditionSeverity capi.ConditionSeverity
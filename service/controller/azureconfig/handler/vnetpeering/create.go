@@ -0,0 +1,126 @@
+package vnetpeering
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/connectivity"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
+)
+
+// EnsureCreated ensures the peerings between the host cluster and the tenant
+// cluster virtual networks in both directions for clusters using vnet peering
+// connectivity. Peerings of clusters which use vpn connectivity are deleted,
+// so that clusters can be switched back.
+func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	mode, err := connectivity.ForAzureConfig(ctx, r.ctrlClient, r.azure, cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	if mode != setting.ConnectivityVNetPeering {
+		err = r.ensurePeeringsDeleted(ctx, cr)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	}
+
+	r.logger.Debugf(ctx, "ensuring vnet peerings")
+
+	vnetClient, err := r.getVirtualNetworksClient(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	peeringsClient, err := r.getVirtualNetworkPeeringsClient(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	tenantVNet, err := vnetClient.Get(ctx, key.VnetResourceGroupName(cr), key.VnetName(cr), "")
+	if IsNotFound(err) {
+		r.logger.Debugf(ctx, "virtual network %#q not ready", key.VnetName(cr))
+		r.logger.Debugf(ctx, "canceling resource")
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	hostVNet, err := r.cpVirtualNetworksClient.Get(ctx, r.azure.HostCluster.ResourceGroup, r.azure.HostCluster.VirtualNetwork, "")
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	// Azure refuses to peer virtual networks with overlapping address spaces.
+	// IPAM does not allocate address spaces of the host cluster virtual
+	// network, so an overlap means the tenant cluster predates it or the host
+	// cluster virtual network was extended.
+	if tenantPrefix, hostPrefix, overlap := overlappingPrefixes(addressPrefixes(tenantVNet), addressPrefixes(hostVNet)); overlap {
+		return microerror.Maskf(overlappingAddressSpaceError, "address space %#q of virtual network %#q overlaps with address space %#q of host cluster virtual network %#q", tenantPrefix, key.VnetName(cr), hostPrefix, r.azure.HostCluster.VirtualNetwork)
+	}
+
+	err = r.ensurePeering(ctx, r.cpVirtualNetworkPeeringsClient, r.azure.HostCluster.ResourceGroup, r.azure.HostCluster.VirtualNetwork, hostPeeringName(cr), tenantVNet)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.ensurePeering(ctx, peeringsClient, key.VnetResourceGroupName(cr), key.VnetName(cr), r.tenantPeeringName(), hostVNet)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "ensured vnet peerings")
+
+	return nil
+}
+
+// ensurePeering ensures the peering with the given name from the given
+// virtual network to the remote virtual network. Disconnected peerings, whose
+// remote peering was deleted, are deleted to be created again on the next
+// reconciliation, since Azure does not reconnect them.
+func (r *Resource) ensurePeering(ctx context.Context, client *network.VirtualNetworkPeeringsClient, resourceGroup, vnetName, peeringName string, remote network.VirtualNetwork) error {
+	desired := newPeering(peeringName, remote)
+
+	current, err := client.Get(ctx, resourceGroup, vnetName, peeringName)
+	if IsNotFound(err) {
+		// The peering is created below.
+	} else if err != nil {
+		return microerror.Mask(err)
+	} else if isDisconnected(current) {
+		r.logger.Debugf(ctx, "vnet peering %#q of virtual network %#q is disconnected", peeringName, vnetName)
+
+		err = r.deletePeering(ctx, client, resourceGroup, vnetName, peeringName)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	} else if !needsUpdate(current, desired) {
+		return nil
+	}
+
+	r.logger.Debugf(ctx, "ensuring vnet peering %#q of virtual network %#q", peeringName, vnetName)
+
+	res, err := client.CreateOrUpdate(ctx, resourceGroup, vnetName, peeringName, desired)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	_, err = client.CreateOrUpdateResponder(res.Response())
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "ensured vnet peering %#q of virtual network %#q", peeringName, vnetName)
+
+	return nil
+}
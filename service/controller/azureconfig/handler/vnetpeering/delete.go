@@ -0,0 +1,75 @@
+package vnetpeering
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+// EnsureDeleted ensures the peerings between the host cluster and the tenant
+// cluster virtual networks are deleted. The peering of the host cluster
+// virtual network is not deleted together with the resource group of the
+// tenant cluster and would be left disconnected otherwise.
+func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.ensurePeeringsDeleted(ctx, cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *Resource) ensurePeeringsDeleted(ctx context.Context, cr providerv1alpha1.AzureConfig) error {
+	peeringsClient, err := r.getVirtualNetworkPeeringsClient(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.deletePeering(ctx, r.cpVirtualNetworkPeeringsClient, r.azure.HostCluster.ResourceGroup, r.azure.HostCluster.VirtualNetwork, hostPeeringName(cr))
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.deletePeering(ctx, peeringsClient, key.VnetResourceGroupName(cr), key.VnetName(cr), r.tenantPeeringName())
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// deletePeering deletes the peering with the given name of the given virtual
+// network, unless it does not exist.
+func (r *Resource) deletePeering(ctx context.Context, client *network.VirtualNetworkPeeringsClient, resourceGroup, vnetName, peeringName string) error {
+	_, err := client.Get(ctx, resourceGroup, vnetName, peeringName)
+	if IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "deleting vnet peering %#q of virtual network %#q", peeringName, vnetName)
+
+	res, err := client.Delete(ctx, resourceGroup, vnetName, peeringName)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	_, err = client.DeleteResponder(res.Response())
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "deleted vnet peering %#q of virtual network %#q", peeringName, vnetName)
+
+	return nil
+}
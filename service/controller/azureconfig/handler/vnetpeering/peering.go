@@ -0,0 +1,89 @@
+package vnetpeering
+
+import (
+	"net"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+// hostPeeringName returns the name of the peering of the host cluster virtual
+// network to the virtual network of the given tenant cluster.
+func hostPeeringName(cr providerv1alpha1.AzureConfig) string {
+	return key.ClusterID(&cr)
+}
+
+// tenantPeeringName returns the name of the peering of the tenant cluster
+// virtual network to the host cluster virtual network.
+func (r *Resource) tenantPeeringName() string {
+	return key.VNetPeeringName(r.azure.HostCluster.VirtualNetwork)
+}
+
+func newPeering(name string, remote network.VirtualNetwork) network.VirtualNetworkPeering {
+	return network.VirtualNetworkPeering{
+		Name: to.StringPtr(name),
+		VirtualNetworkPeeringPropertiesFormat: &network.VirtualNetworkPeeringPropertiesFormat{
+			AllowVirtualNetworkAccess: to.BoolPtr(true),
+			AllowForwardedTraffic:     to.BoolPtr(false),
+			AllowGatewayTransit:       to.BoolPtr(false),
+			UseRemoteGateways:         to.BoolPtr(false),
+			RemoteVirtualNetwork: &network.SubResource{
+				ID: remote.ID,
+			},
+		},
+	}
+}
+
+// needsUpdate returns true when the current peering does not point to the
+// remote virtual network of the desired one or does not allow access to it.
+func needsUpdate(current, desired network.VirtualNetworkPeering) bool {
+	if current.VirtualNetworkPeeringPropertiesFormat == nil ||
+		current.RemoteVirtualNetwork == nil ||
+		!strings.EqualFold(to.String(current.RemoteVirtualNetwork.ID), to.String(desired.RemoteVirtualNetwork.ID)) {
+		return true
+	}
+
+	return to.Bool(current.AllowVirtualNetworkAccess) != to.Bool(desired.AllowVirtualNetworkAccess)
+}
+
+// isDisconnected returns true when the remote peering of the given peering was
+// deleted.
+func isDisconnected(peering network.VirtualNetworkPeering) bool {
+	return peering.VirtualNetworkPeeringPropertiesFormat != nil && peering.PeeringState == network.VirtualNetworkPeeringStateDisconnected
+}
+
+func addressPrefixes(vnet network.VirtualNetwork) []string {
+	if vnet.VirtualNetworkPropertiesFormat == nil || vnet.AddressSpace == nil || vnet.AddressSpace.AddressPrefixes == nil {
+		return nil
+	}
+
+	return *vnet.AddressSpace.AddressPrefixes
+}
+
+// overlappingPrefixes returns the first pair of the given address prefixes
+// which overlap. Prefixes which can't be parsed are ignored.
+func overlappingPrefixes(a, b []string) (string, string, bool) {
+	for _, prefixA := range a {
+		_, netA, err := net.ParseCIDR(prefixA)
+		if err != nil {
+			continue
+		}
+
+		for _, prefixB := range b {
+			_, netB, err := net.ParseCIDR(prefixB)
+			if err != nil {
+				continue
+			}
+
+			if netA.Contains(netB.IP) || netB.Contains(netA.IP) {
+				return prefixA, prefixB, true
+			}
+		}
+	}
+
+	return "", "", false
+}
@@ -0,0 +1,118 @@
+package vnetpeering
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func Test_overlappingPrefixes(t *testing.T) {
+	testCases := []struct {
+		name            string
+		a               []string
+		b               []string
+		expectedOverlap bool
+	}{
+		{
+			name:            "case 0: disjoint address spaces",
+			a:               []string{"10.1.0.0/16"},
+			b:               []string{"10.0.0.0/16"},
+			expectedOverlap: false,
+		},
+		{
+			name:            "case 1: equal address spaces",
+			a:               []string{"10.0.0.0/16"},
+			b:               []string{"10.0.0.0/16"},
+			expectedOverlap: true,
+		},
+		{
+			name:            "case 2: address space contained in the other",
+			a:               []string{"10.0.128.0/24"},
+			b:               []string{"172.16.0.0/12", "10.0.0.0/16"},
+			expectedOverlap: true,
+		},
+		{
+			name:            "case 3: address space containing the other",
+			a:               []string{"10.0.0.0/8"},
+			b:               []string{"10.2.0.0/16"},
+			expectedOverlap: true,
+		},
+		{
+			name:            "case 4: invalid prefixes are ignored",
+			a:               []string{"invalid"},
+			b:               []string{"10.0.0.0/16"},
+			expectedOverlap: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			_, _, overlap := overlappingPrefixes(tc.a, tc.b)
+			if overlap != tc.expectedOverlap {
+				t.Fatalf("expected %t, got %t", tc.expectedOverlap, overlap)
+			}
+		})
+	}
+}
+
+func Test_needsUpdate(t *testing.T) {
+	remote := network.VirtualNetwork{
+		ID: to.StringPtr("/subscriptions/s/resourceGroups/c1/providers/Microsoft.Network/virtualNetworks/c1-VirtualNetwork"),
+	}
+	desired := newPeering("host", remote)
+
+	testCases := []struct {
+		name           string
+		current        network.VirtualNetworkPeering
+		expectedUpdate bool
+	}{
+		{
+			name:           "case 0: peering without properties",
+			current:        network.VirtualNetworkPeering{},
+			expectedUpdate: true,
+		},
+		{
+			name:           "case 1: peering equal to desired one",
+			current:        newPeering("host", remote),
+			expectedUpdate: false,
+		},
+		{
+			name: "case 2: remote id differs in case only",
+			current: newPeering("host", network.VirtualNetwork{
+				ID: to.StringPtr("/subscriptions/s/resourcegroups/c1/providers/Microsoft.Network/virtualNetworks/c1-virtualnetwork"),
+			}),
+			expectedUpdate: false,
+		},
+		{
+			name: "case 3: peering to another virtual network",
+			current: newPeering("host", network.VirtualNetwork{
+				ID: to.StringPtr("/subscriptions/s/resourceGroups/c2/providers/Microsoft.Network/virtualNetworks/c2-VirtualNetwork"),
+			}),
+			expectedUpdate: true,
+		},
+		{
+			name: "case 4: virtual network access not allowed",
+			current: func() network.VirtualNetworkPeering {
+				p := newPeering("host", remote)
+				p.AllowVirtualNetworkAccess = to.BoolPtr(false)
+				return p
+			}(),
+			expectedUpdate: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			update := needsUpdate(tc.current, desired)
+			if update != tc.expectedUpdate {
+				t.Fatalf("expected %t, got %t", tc.expectedUpdate, update)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package vnetpeering
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
+)
+
+const (
+	// Name is the identifier of the resource.
+	Name = "vnetpeering"
+)
+
+// Config contains information required by Resource.
+type Config struct {
+	CPVirtualNetworkPeeringsClient *network.VirtualNetworkPeeringsClient
+	CPVirtualNetworksClient        *network.VirtualNetworksClient
+	CtrlClient                     ctrlclient.Client
+	Logger                         micrologger.Logger
+
+	Azure setting.Azure
+}
+
+// Resource ensures the peerings between the host cluster virtual network and
+// the virtual networks of tenant clusters using vnet peering connectivity.
+type Resource struct {
+	cpVirtualNetworkPeeringsClient *network.VirtualNetworkPeeringsClient
+	cpVirtualNetworksClient        *network.VirtualNetworksClient
+	ctrlClient                     ctrlclient.Client
+	logger                         micrologger.Logger
+
+	azure setting.Azure
+}
+
+// New validates Config and creates a new Resource with it.
+func New(config Config) (*Resource, error) {
+	if config.CPVirtualNetworkPeeringsClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CPVirtualNetworkPeeringsClient must not be empty", config)
+	}
+	if config.CPVirtualNetworksClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CPVirtualNetworksClient must not be empty", config)
+	}
+	if config.CtrlClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CtrlClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if err := config.Azure.Validate(); err != nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Azure.%s", config, err)
+	}
+
+	r := &Resource{
+		cpVirtualNetworkPeeringsClient: config.CPVirtualNetworkPeeringsClient,
+		cpVirtualNetworksClient:        config.CPVirtualNetworksClient,
+		ctrlClient:                     config.CtrlClient,
+		logger:                         config.Logger,
+
+		azure: config.Azure,
+	}
+
+	return r, nil
+}
+
+// Name returns the resource name.
+func (r *Resource) Name() string {
+	return Name
+}
+
+func (r *Resource) getVirtualNetworkPeeringsClient(ctx context.Context) (*network.VirtualNetworkPeeringsClient, error) {
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return cc.AzureClientSet.VnetPeeringClient, nil
+}
+
+func (r *Resource) getVirtualNetworksClient(ctx context.Context) (*network.VirtualNetworksClient, error) {
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return cc.AzureClientSet.VirtualNetworkClient, nil
+}
@@ -6,7 +6,9 @@ import (
 	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
 	"github.com/giantswarm/microerror"
 
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/connectivity"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
 )
 
 const (
@@ -20,6 +22,26 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		return microerror.Mask(err)
 	}
 
+	if _, _, ok := key.ExistingVirtualNetwork(cr); ok {
+		r.logger.Debugf(ctx, "cluster uses an existing virtual network, not ensuring vpn gateway")
+		return nil
+	}
+
+	mode, err := connectivity.ForAzureConfig(ctx, r.ctrlClient, r.azure, cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	if mode != setting.ConnectivityVPN {
+		r.logger.Debugf(ctx, "cluster uses %#q connectivity, not ensuring vpn gateway", mode)
+
+		err = r.ensureGatewayDeleted(ctx, cr)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	}
+
 	vnetClient, err := r.getVirtualNetworkClient(ctx)
 	if err != nil {
 		return microerror.Mask(err)
@@ -35,7 +57,7 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 	// Wait for virtual network subnet.
 	{
 		vnetName := key.VnetName(cr)
-		vnet, err := vnetClient.Get(ctx, key.ClusterID(&cr), vnetName, "")
+		vnet, err := vnetClient.Get(ctx, key.ResourceGroupName(cr), vnetName, "")
 		if err != nil {
 			r.logger.Debugf(ctx, "virtual network %#q not ready", vnetName)
 			r.logger.Debugf(ctx, "canceling resource")
@@ -60,7 +82,7 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 	// Prepare VPN Gateway deployment
 	var deployment azureresource.Deployment
 	{
-		d, err := deploymentsClient.Get(ctx, key.ClusterID(&cr), vpnDeploymentName)
+		d, err := deploymentsClient.Get(ctx, key.ResourceGroupName(cr), vpnDeploymentName)
 		if IsNotFound(err) {
 			// fallthrough
 		} else if err != nil {
@@ -79,14 +101,14 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 			}
 		}
 
-		deployment, err = r.newDeployment(cr, nil)
+		deployment, err = r.newDeployment(ctx, cr, nil)
 		if err != nil {
 			return microerror.Mask(err)
 		}
 	}
 
 	// Create/Update VPN Gateway deployment
-	res, err := deploymentsClient.CreateOrUpdate(ctx, key.ClusterID(&cr), vpnDeploymentName, deployment)
+	res, err := deploymentsClient.CreateOrUpdate(ctx, key.ResourceGroupName(cr), vpnDeploymentName, deployment)
 	if err != nil {
 		return microerror.Mask(err)
 	}
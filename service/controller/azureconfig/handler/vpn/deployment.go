@@ -1,17 +1,26 @@
 package vpn
 
 import (
+	"context"
+
 	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
 	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
 	"github.com/giantswarm/microerror"
 
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig/handler/vpn/template"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/tags"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
 
-func (r Resource) newDeployment(customObject providerv1alpha1.AzureConfig, overwrites map[string]interface{}) (azureresource.Deployment, error) {
+func (r Resource) newDeployment(ctx context.Context, customObject providerv1alpha1.AzureConfig, overwrites map[string]interface{}) (azureresource.Deployment, error) {
+	customTags, err := tags.ForCluster(ctx, r.ctrlClient, &customObject)
+	if err != nil {
+		return azureresource.Deployment{}, microerror.Mask(err)
+	}
+
 	defaultParams := map[string]interface{}{
 		"clusterID":             key.ClusterID(&customObject),
+		"customTags":            tags.TemplateParameter(customTags),
 		"virtualNetworkName":    key.VnetName(customObject),
 		"vnetGatewaySubnetName": key.VNetGatewaySubnetName(),
 		"vpnGatewayName":        key.VPNGatewayName(customObject),
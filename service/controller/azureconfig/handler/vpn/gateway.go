@@ -0,0 +1,120 @@
+package vpn
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+// ensureGatewayDeleted deletes the vpn gateway of clusters which switched to
+// vnet peering connectivity, along with its public IP and deployment. The
+// gateway is only deleted once the vpnconnection handler deleted its
+// connections, and its public IP once the gateway is gone. Each step is only
+// started, the next reconciliation takes the following one.
+func (r *Resource) ensureGatewayDeleted(ctx context.Context, cr providerv1alpha1.AzureConfig) error {
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	resourceGroup := key.ResourceGroupName(cr)
+
+	{
+		iterator, err := cc.AzureClientSet.VirtualNetworkGatewayConnectionsClient.ListComplete(ctx, resourceGroup)
+		if IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+
+		if iterator.NotDone() {
+			r.logger.Debugf(ctx, "waiting for vpn gateway connection %#q to be deleted", to.String(iterator.Value().Name))
+			return nil
+		}
+	}
+
+	{
+		gatewaysClient := cc.AzureClientSet.VirtualNetworkGatewaysClient
+
+		gateway, err := gatewaysClient.Get(ctx, resourceGroup, key.VPNGatewayName(cr))
+		if IsNotFound(err) {
+			// fall through
+		} else if err != nil {
+			return microerror.Mask(err)
+		} else if gateway.VirtualNetworkGatewayPropertiesFormat != nil && gateway.ProvisioningState == network.Deleting {
+			r.logger.Debugf(ctx, "vpn gateway is being deleted")
+			return nil
+		} else {
+			r.logger.Debugf(ctx, "ensuring vpn gateway is deleted")
+
+			res, err := gatewaysClient.Delete(ctx, resourceGroup, key.VPNGatewayName(cr))
+			if err != nil {
+				return microerror.Mask(err)
+			}
+			_, err = gatewaysClient.DeleteResponder(res.Response())
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			r.logger.Debugf(ctx, "ensured vpn gateway is deleted")
+
+			return nil
+		}
+	}
+
+	{
+		publicIPsClient := cc.AzureClientSet.PublicIpAddressesClient
+
+		_, err := publicIPsClient.Get(ctx, resourceGroup, key.VPNGatewayPublicIPName(cr), "")
+		if IsNotFound(err) {
+			// fall through
+		} else if err != nil {
+			return microerror.Mask(err)
+		} else {
+			r.logger.Debugf(ctx, "ensuring vpn gateway public ip is deleted")
+
+			res, err := publicIPsClient.Delete(ctx, resourceGroup, key.VPNGatewayPublicIPName(cr))
+			if err != nil {
+				return microerror.Mask(err)
+			}
+			_, err = publicIPsClient.DeleteResponder(res.Response())
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			r.logger.Debugf(ctx, "ensured vpn gateway public ip is deleted")
+		}
+	}
+
+	{
+		deploymentsClient := cc.AzureClientSet.DeploymentsClient
+
+		_, err := deploymentsClient.Get(ctx, resourceGroup, vpnDeploymentName)
+		if IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.Debugf(ctx, "ensuring vpn gateway deployment is deleted")
+
+		res, err := deploymentsClient.Delete(ctx, resourceGroup, vpnDeploymentName)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		_, err = deploymentsClient.DeleteResponder(res.Response())
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.Debugf(ctx, "ensured vpn gateway deployment is deleted")
+	}
+
+	return nil
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/operatorkit/v4/pkg/controller/context/resourcecanceledcontext"
 
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/connectivity"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
 )
 
 // GetCurrentState retrieve current vpn gateway connection from host to tenant
@@ -17,6 +19,29 @@ func (r *Resource) GetCurrentState(ctx context.Context, obj interface{}) (interf
 		return connections{}, microerror.Mask(err)
 	}
 
+	// Clusters connected using vnet peerings have no vpn gateway connections
+	// to reconcile, see the vnetpeering handler. Connections left from vpn
+	// connectivity are deleted.
+	{
+		mode, err := connectivity.ForAzureConfig(ctx, r.ctrlClient, r.azure, cr)
+		if err != nil {
+			return connections{}, microerror.Mask(err)
+		}
+		if mode != setting.ConnectivityVPN {
+			r.logger.Debugf(ctx, "cluster uses %#q connectivity", mode)
+
+			err = r.ensureConnectionsDeleted(ctx, cr)
+			if err != nil {
+				return connections{}, microerror.Mask(err)
+			}
+
+			resourcecanceledcontext.SetCanceled(ctx)
+			r.logger.Debugf(ctx, "canceling resource")
+
+			return connections{}, nil
+		}
+	}
+
 	var c connections
 	{
 		r.logger.Debugf(ctx, "finding host vpn gateway connection")
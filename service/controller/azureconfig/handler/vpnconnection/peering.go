@@ -0,0 +1,73 @@
+package vpnconnection
+
+import (
+	"context"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+// ensureConnectionsDeleted deletes the vpn gateway connections of clusters
+// which do not use vpn connectivity, so that clusters switched to vnet peering
+// connectivity do not keep them. The tenant cluster vpn gateway can only be
+// deleted once they are gone.
+func (r *Resource) ensureConnectionsDeleted(ctx context.Context, cr providerv1alpha1.AzureConfig) error {
+	{
+		resourceGroup := r.azure.HostCluster.ResourceGroup
+		connectionName := key.ResourceGroupName(cr)
+
+		_, err := r.getHostVirtualNetworkGatewayConnection(ctx, resourceGroup, connectionName)
+		if IsVPNGatewayConnectionNotFound(err) {
+			// fall through
+		} else if err != nil {
+			return microerror.Mask(err)
+		} else {
+			r.logger.Debugf(ctx, "ensuring host vpn gateway connection is deleted")
+
+			respFuture, err := r.cpVirtualNetworkGatewayConnectionsClient.Delete(ctx, resourceGroup, connectionName)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+			_, err = r.cpVirtualNetworkGatewayConnectionsClient.DeleteResponder(respFuture.Response())
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			r.logger.Debugf(ctx, "ensured host vpn gateway connection is deleted")
+		}
+	}
+
+	{
+		resourceGroup := key.ResourceGroupName(cr)
+		connectionName := r.azure.HostCluster.ResourceGroup
+
+		_, err := r.getGuestVirtualNetworkGatewayConnection(ctx, resourceGroup, connectionName)
+		if IsVPNGatewayConnectionNotFound(err) {
+			// fall through
+		} else if err != nil {
+			return microerror.Mask(err)
+		} else {
+			r.logger.Debugf(ctx, "ensuring tenant vpn gateway connection is deleted")
+
+			gatewayConnectionClient, err := r.getGuestVirtualNetworkGatewayConnectionsClient(ctx)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			respFuture, err := gatewayConnectionClient.Delete(ctx, resourceGroup, connectionName)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+			_, err = gatewayConnectionClient.DeleteResponder(respFuture.Response())
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			r.logger.Debugf(ctx, "ensured tenant vpn gateway connection is deleted")
+		}
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/giantswarm/azure-operator/v5/service/controller/controllercontext"
 	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
@@ -18,6 +19,7 @@ const (
 // Config is the configuration required by Resource.
 type Config struct {
 	Azure                                    setting.Azure
+	CtrlClient                               client.Client
 	Logger                                   micrologger.Logger
 	CPVirtualNetworkGatewaysClient           network.VirtualNetworkGatewaysClient
 	CPVirtualNetworkGatewayConnectionsClient network.VirtualNetworkGatewayConnectionsClient
@@ -26,12 +28,16 @@ type Config struct {
 // Resource manages Azure virtual network peering.
 type Resource struct {
 	azure                                    setting.Azure
+	ctrlClient                               client.Client
 	logger                                   micrologger.Logger
 	cpVirtualNetworkGatewaysClient           network.VirtualNetworkGatewaysClient
 	cpVirtualNetworkGatewayConnectionsClient network.VirtualNetworkGatewayConnectionsClient
 }
 
 func New(config Config) (*Resource, error) {
+	if config.CtrlClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CtrlClient must not be empty", config)
+	}
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
@@ -41,6 +47,7 @@ func New(config Config) (*Resource, error) {
 
 	r := &Resource{
 		azure:                                    config.Azure,
+		ctrlClient:                               config.CtrlClient,
 		cpVirtualNetworkGatewaysClient:           config.CPVirtualNetworkGatewaysClient,
 		cpVirtualNetworkGatewayConnectionsClient: config.CPVirtualNetworkGatewayConnectionsClient,
 		logger:                                   config.Logger,
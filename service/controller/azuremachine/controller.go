@@ -11,18 +11,22 @@ import (
 	"github.com/giantswarm/operatorkit/v4/pkg/resource"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	capz "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
 
 	"github.com/giantswarm/azure-operator/v5/client"
 	"github.com/giantswarm/azure-operator/v5/pkg/credential"
 	"github.com/giantswarm/azure-operator/v5/pkg/label"
 	"github.com/giantswarm/azure-operator/v5/pkg/project"
+	"github.com/giantswarm/azure-operator/v5/pkg/ratelimit"
+	"github.com/giantswarm/azure-operator/v5/pkg/recorder"
 	"github.com/giantswarm/azure-operator/v5/service/collector"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azuremachine/handler/azuremachineconditions"
 )
 
 type ControllerConfig struct {
 	AzureMetricsCollector collector.AzureAPIMetrics
+	AzureRateLimiter      *ratelimit.Limiter
 	CredentialProvider    credential.Provider
 	K8sClient             k8sclient.Interface
 	Logger                micrologger.Logger
@@ -82,6 +86,7 @@ func NewAzureMachineResourceSet(config ControllerConfig) ([]resource.Interface,
 	{
 		c := client.FactoryConfig{
 			AzureAPIMetrics:    config.AzureMetricsCollector,
+			RateLimiter:        config.AzureRateLimiter,
 			CacheDuration:      30 * time.Minute,
 			CredentialProvider: config.CredentialProvider,
 			Logger:             config.Logger,
@@ -103,11 +108,26 @@ func NewAzureMachineResourceSet(config ControllerConfig) ([]resource.Interface,
 		organizationClientFactory = client.NewOrganizationFactory(c)
 	}
 
+	var eventRecorder record.EventRecorder
+	{
+		c := recorder.Config{
+			K8sClient: config.K8sClient,
+
+			Component: project.Name(),
+		}
+
+		eventRecorder, err = recorder.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var azureMachineConditionsResource resource.Interface
 	{
 		c := azuremachineconditions.Config{
 			AzureClientsFactory: &organizationClientFactory,
 			CtrlClient:          config.K8sClient.CtrlClient(),
+			EventRecorder:       eventRecorder,
 			Logger:              config.Logger,
 		}
 
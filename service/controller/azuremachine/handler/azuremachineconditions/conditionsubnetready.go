@@ -22,17 +22,27 @@ const (
 func (r *Resource) ensureSubnetReadyCondition(ctx context.Context, azureMachine *capz.AzureMachine) error {
 	r.logger.Debugf(ctx, "ensuring condition %s", azureconditions.SubnetReadyCondition)
 
+	azureCluster, err := helpers.GetAzureClusterFromMetadata(ctx, r.ctrlClient, azureMachine.ObjectMeta)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	deploymentsClient, err := r.azureClientsFactory.GetDeploymentsClient(ctx, azureMachine.ObjectMeta)
 	if err != nil {
 		return microerror.Mask(err)
 	}
 
+	deploymentOperationsClient, err := r.azureClientsFactory.GetDeploymentOperationsClient(ctx, azureMachine.ObjectMeta)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	// Now let's first check ARM deployment state (master subnet is currently
 	// deployed as a part of VNet deployment, we can remove this once we have
 	// a separate VirtualNetworkReady conditions, or separate master subnet
 	// deployment).
 	vnetDeploymentName := "virtual_network_setup"
-	isSubnetDeploymentSuccessful, err := r.deploymentChecker.CheckIfDeploymentIsSuccessful(ctx, deploymentsClient, azureMachine, vnetDeploymentName, azureconditions.SubnetReadyCondition)
+	isSubnetDeploymentSuccessful, err := r.deploymentChecker.CheckIfDeploymentIsSuccessful(ctx, deploymentsClient, deploymentOperationsClient, azureMachine, key.ResourceGroupNameFromAzureCluster(*azureCluster), vnetDeploymentName, azureconditions.SubnetReadyCondition)
 	if err != nil {
 		return microerror.Mask(err)
 	} else if !isSubnetDeploymentSuccessful {
@@ -49,13 +59,8 @@ func (r *Resource) ensureSubnetReadyCondition(ctx context.Context, azureMachine
 		return microerror.Mask(err)
 	}
 
-	azureCluster, err := helpers.GetAzureClusterFromMetadata(ctx, r.ctrlClient, azureMachine.ObjectMeta)
-	if err != nil {
-		return microerror.Mask(err)
-	}
-
 	subnetName := key.MasterSubnetNameFromClusterAPIObject(azureMachine)
-	subnet, err := subnetsClient.Get(ctx, azureCluster.Name, azureCluster.Spec.NetworkSpec.Vnet.Name, subnetName, "")
+	subnet, err := subnetsClient.Get(ctx, key.VnetResourceGroupNameFromAzureCluster(*azureCluster), azureCluster.Spec.NetworkSpec.Vnet.Name, subnetName, "")
 	if IsNotFound(err) {
 		r.setSubnetNotFound(ctx, azureMachine, subnetName, azureconditions.SubnetReadyCondition)
 		return nil
@@ -6,6 +6,7 @@ import (
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	capz "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
 	capi "sigs.k8s.io/cluster-api/api/v1alpha3"
 	capiconditions "sigs.k8s.io/cluster-api/util/conditions"
@@ -23,6 +24,7 @@ const (
 type Config struct {
 	AzureClientsFactory *azureclient.OrganizationFactory
 	CtrlClient          client.Client
+	EventRecorder       record.EventRecorder
 	Logger              micrologger.Logger
 }
 
@@ -41,13 +43,17 @@ func New(config Config) (*Resource, error) {
 	if config.CtrlClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.CtrlClient must not be empty", config)
 	}
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
 
 	c := azureconditions.DeploymentCheckerConfig{
-		CtrlClient: config.CtrlClient,
-		Logger:     config.Logger,
+		CtrlClient:    config.CtrlClient,
+		EventRecorder: config.EventRecorder,
+		Logger:        config.Logger,
 	}
 	dc, err := azureconditions.NewDeploymentChecker(c)
 	if err != nil {
@@ -14,6 +14,7 @@ import (
 	"github.com/giantswarm/operatorkit/v4/pkg/resource/wrapper/retryresource"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
 
 	"github.com/giantswarm/azure-operator/v5/client"
@@ -24,14 +25,19 @@ import (
 	"github.com/giantswarm/azure-operator/v5/pkg/label"
 	"github.com/giantswarm/azure-operator/v5/pkg/locker"
 	"github.com/giantswarm/azure-operator/v5/pkg/project"
+	"github.com/giantswarm/azure-operator/v5/pkg/ratelimit"
+	"github.com/giantswarm/azure-operator/v5/pkg/recorder"
 	"github.com/giantswarm/azure-operator/v5/pkg/tenantcluster"
 	"github.com/giantswarm/azure-operator/v5/service/collector"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azuremachinepool/handler/azuremachinepoolconditions"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azuremachinepool/handler/cloudconfigblob"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azuremachinepool/handler/nodepool"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azuremachinepool/handler/spark"
+	"github.com/giantswarm/azure-operator/v5/service/controller/azuremachinepool/handler/spotinstances"
 	"github.com/giantswarm/azure-operator/v5/service/controller/debugger"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/preflight"
 	"github.com/giantswarm/azure-operator/v5/service/controller/internal/vmsku"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/workerpool"
 	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
 )
 
@@ -39,16 +45,19 @@ type ControllerConfig struct {
 	APIServerSecurePort   int
 	Azure                 setting.Azure
 	AzureMetricsCollector collector.AzureAPIMetrics
+	AzureRateLimiter      *ratelimit.Limiter
 	CalicoCIDRSize        int
 	CalicoMTU             int
 	CalicoSubnet          string
 	ClusterIPRange        string
+	CloudProvider         setting.CloudProvider
 	CPAzureClientSet      *client.AzureClientSet
 	CredentialProvider    credential.Provider
 	DockerhubToken        string
 	EtcdPrefix            string
 	Ignition              setting.Ignition
 	InstallationName      string
+	InstanceConcurrency   int
 	K8sClient             k8sclient.Interface
 	Locker                locker.Interface
 	Logger                micrologger.Logger
@@ -73,6 +82,10 @@ func NewController(config ControllerConfig) (*controller.Controller, error) {
 		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
 	}
 
+	if config.InstanceConcurrency <= 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.InstanceConcurrency must be greater than zero", config)
+	}
+
 	var err error
 
 	var resources []resource.Interface
@@ -118,6 +131,7 @@ func NewAzureMachinePoolResourceSet(config ControllerConfig) ([]resource.Interfa
 	{
 		c := client.FactoryConfig{
 			AzureAPIMetrics:    config.AzureMetricsCollector,
+			RateLimiter:        config.AzureRateLimiter,
 			CacheDuration:      30 * time.Minute,
 			CredentialProvider: config.CredentialProvider,
 			Logger:             config.Logger,
@@ -139,11 +153,26 @@ func NewAzureMachinePoolResourceSet(config ControllerConfig) ([]resource.Interfa
 		organizationClientFactory = client.NewOrganizationFactory(c)
 	}
 
+	var eventRecorder record.EventRecorder
+	{
+		c := recorder.Config{
+			K8sClient: config.K8sClient,
+
+			Component: project.Name(),
+		}
+
+		eventRecorder, err = recorder.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var azureMachinePoolConditionsResource resource.Interface
 	{
 		c := azuremachinepoolconditions.Config{
 			AzureClientsFactory: &organizationClientFactory,
 			CtrlClient:          config.K8sClient.CtrlClient(),
+			EventRecorder:       eventRecorder,
 			Logger:              config.Logger,
 		}
 
@@ -194,12 +223,15 @@ func NewAzureMachinePoolResourceSet(config ControllerConfig) ([]resource.Interfa
 	}
 
 	nodesConfig := nodes.Config{
-		CtrlClient: config.K8sClient.CtrlClient(),
-		Debugger:   newDebugger,
-		Logger:     config.Logger,
+		CtrlClient:    config.K8sClient.CtrlClient(),
+		Debugger:      newDebugger,
+		EventRecorder: eventRecorder,
+		Logger:        config.Logger,
 
 		Azure:         config.Azure,
 		ClientFactory: organizationClientFactory,
+		CloudProvider: config.CloudProvider,
+		Ignition:      config.Ignition,
 	}
 
 	var vmSKU *vmsku.VMSKUs
@@ -214,13 +246,26 @@ func NewAzureMachinePoolResourceSet(config ControllerConfig) ([]resource.Interfa
 		}
 	}
 
+	var preflightValidator *preflight.Validator
+	{
+		preflightValidator, err = preflight.New(preflight.Config{
+			Logger: config.Logger,
+			VMSKU:  vmSKU,
+		})
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var nodepoolResource resource.Interface
 	{
 		c := nodepool.Config{
 			Config:              nodesConfig,
 			CredentialProvider:  config.CredentialProvider,
+			PreflightValidator:  preflightValidator,
 			TenantClientFactory: cachedTenantClientFactory,
 			VMSKU:               vmSKU,
+			WorkerPool:          workerpool.New(config.InstanceConcurrency, config.Logger),
 		}
 
 		nodepoolResource, err = nodepool.New(c)
@@ -229,6 +274,21 @@ func NewAzureMachinePoolResourceSet(config ControllerConfig) ([]resource.Interfa
 		}
 	}
 
+	var spotInstancesResource resource.Interface
+	{
+		c := spotinstances.Config{
+			ClientFactory: organizationClientFactory,
+			CtrlClient:    config.K8sClient.CtrlClient(),
+			EventRecorder: eventRecorder,
+			Logger:        config.Logger,
+		}
+
+		spotInstancesResource, err = spotinstances.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var subnetChecker *ipam.AzureMachinePoolSubnetChecker
 	{
 		c := ipam.AzureMachinePoolSubnetCheckerConfig{
@@ -324,6 +384,7 @@ func NewAzureMachinePoolResourceSet(config ControllerConfig) ([]resource.Interfa
 			CalicoSubnet:        config.CalicoSubnet,
 			CertsSearcher:       certsSearcher,
 			ClusterIPRange:      config.ClusterIPRange,
+			CloudProvider:       config.CloudProvider,
 			EtcdPrefix:          config.EtcdPrefix,
 			CredentialProvider:  config.CredentialProvider,
 			CtrlClient:          config.K8sClient.CtrlClient(),
@@ -362,6 +423,7 @@ func NewAzureMachinePoolResourceSet(config ControllerConfig) ([]resource.Interfa
 		sparkResource,
 		cloudconfigblobResource,
 		nodepoolResource,
+		spotInstancesResource,
 	}
 
 	{
@@ -22,14 +22,24 @@ const (
 func (r *Resource) ensureSubnetReadyCondition(ctx context.Context, azureMachinePool *capzexp.AzureMachinePool) error {
 	r.logger.Debugf(ctx, "ensuring condition %s", azureconditions.SubnetReadyCondition)
 
+	azureCluster, err := helpers.GetAzureClusterFromMetadata(ctx, r.ctrlClient, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	deploymentsClient, err := r.azureClientsFactory.GetDeploymentsClient(ctx, azureMachinePool.ObjectMeta)
 	if err != nil {
 		return microerror.Mask(err)
 	}
 
+	deploymentOperationsClient, err := r.azureClientsFactory.GetDeploymentOperationsClient(ctx, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	// Now let's first check ARM deployment state
 	subnetDeploymentName := key.SubnetDeploymentName(azureMachinePool.Name)
-	isSubnetDeploymentSuccessful, err := r.deploymentChecker.CheckIfDeploymentIsSuccessful(ctx, deploymentsClient, azureMachinePool, subnetDeploymentName, azureconditions.SubnetReadyCondition)
+	isSubnetDeploymentSuccessful, err := r.deploymentChecker.CheckIfDeploymentIsSuccessful(ctx, deploymentsClient, deploymentOperationsClient, azureMachinePool, key.ResourceGroupNameFromAzureCluster(*azureCluster), subnetDeploymentName, azureconditions.SubnetReadyCondition)
 	if err != nil {
 		return microerror.Mask(err)
 	} else if !isSubnetDeploymentSuccessful {
@@ -46,13 +56,8 @@ func (r *Resource) ensureSubnetReadyCondition(ctx context.Context, azureMachineP
 		return microerror.Mask(err)
 	}
 
-	azureCluster, err := helpers.GetAzureClusterFromMetadata(ctx, r.ctrlClient, azureMachinePool.ObjectMeta)
-	if err != nil {
-		return microerror.Mask(err)
-	}
-
 	subnetName := azureMachinePool.Name
-	subnet, err := subnetsClient.Get(ctx, azureCluster.Name, azureCluster.Spec.NetworkSpec.Vnet.Name, subnetName, "")
+	subnet, err := subnetsClient.Get(ctx, key.VnetResourceGroupNameFromAzureCluster(*azureCluster), azureCluster.Spec.NetworkSpec.Vnet.Name, subnetName, "")
 	if IsNotFound(err) {
 		r.setSubnetNotFound(ctx, azureMachinePool, subnetName, azureconditions.SubnetReadyCondition)
 		return nil
@@ -10,6 +10,7 @@ import (
 	capi "sigs.k8s.io/cluster-api/api/v1alpha3"
 	capiconditions "sigs.k8s.io/cluster-api/util/conditions"
 
+	"github.com/giantswarm/azure-operator/v5/pkg/helpers"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
 
@@ -24,14 +25,24 @@ const (
 func (r *Resource) ensureVMSSReadyCondition(ctx context.Context, azureMachinePool *capzexp.AzureMachinePool) error {
 	r.logger.Debugf(ctx, "ensuring condition %s", azureconditions.VMSSReadyCondition)
 
+	azureCluster, err := helpers.GetAzureClusterFromMetadata(ctx, r.ctrlClient, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	deploymentsClient, err := r.azureClientsFactory.GetDeploymentsClient(ctx, azureMachinePool.ObjectMeta)
 	if err != nil {
 		return microerror.Mask(err)
 	}
 
+	deploymentOperationsClient, err := r.azureClientsFactory.GetDeploymentOperationsClient(ctx, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	// Now let's first check ARM deployment state
 	deploymentName := key.NodePoolDeploymentName(azureMachinePool)
-	isDeploymentSuccessful, err := r.deploymentChecker.CheckIfDeploymentIsSuccessful(ctx, deploymentsClient, azureMachinePool, deploymentName, azureconditions.VMSSReadyCondition)
+	isDeploymentSuccessful, err := r.deploymentChecker.CheckIfDeploymentIsSuccessful(ctx, deploymentsClient, deploymentOperationsClient, azureMachinePool, key.ResourceGroupNameFromAzureCluster(*azureCluster), deploymentName, azureconditions.VMSSReadyCondition)
 	if err != nil {
 		return microerror.Mask(err)
 	} else if !isDeploymentSuccessful {
@@ -48,7 +59,7 @@ func (r *Resource) ensureVMSSReadyCondition(ctx context.Context, azureMachinePoo
 		return microerror.Mask(err)
 	}
 
-	resourceGroupName := key.ClusterName(azureMachinePool)
+	resourceGroupName := key.ResourceGroupNameFromAzureCluster(*azureCluster)
 	vmssName := key.NodePoolVMSSName(azureMachinePool)
 
 	vmss, err := vmssClient.Get(ctx, resourceGroupName, vmssName)
@@ -3,6 +3,7 @@ package azuremachinepoolconditions
 import (
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	azureclient "github.com/giantswarm/azure-operator/v5/client"
@@ -17,6 +18,7 @@ const (
 type Config struct {
 	AzureClientsFactory *azureclient.OrganizationFactory
 	CtrlClient          client.Client
+	EventRecorder       record.EventRecorder
 	Logger              micrologger.Logger
 }
 
@@ -35,13 +37,17 @@ func New(config Config) (*Resource, error) {
 	if config.CtrlClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.CtrlClient must not be empty", config)
 	}
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
 
 	c := azureconditions.DeploymentCheckerConfig{
-		CtrlClient: config.CtrlClient,
-		Logger:     config.Logger,
+		CtrlClient:    config.CtrlClient,
+		EventRecorder: config.EventRecorder,
+		Logger:        config.Logger,
 	}
 	dc, err := azureconditions.NewDeploymentChecker(c)
 	if err != nil {
@@ -16,6 +16,7 @@ import (
 	expcapiv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/giantswarm/azure-operator/v5/pkg/helpers"
 	"github.com/giantswarm/azure-operator/v5/service/controller/blobclient"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
@@ -61,9 +62,14 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		}
 	}
 
+	resourceGroupName, err := helpers.GetResourceGroupNameFromMetadata(ctx, r.ctrlClient, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	var containerURL azblob.ContainerURL
 	{
-		containerURL, err = r.getContainerURL(ctx, &azureMachinePool, key.ClusterID(&azureMachinePool), key.StorageAccountName(&azureMachinePool))
+		containerURL, err = r.getContainerURL(ctx, &azureMachinePool, resourceGroupName, key.StorageAccountName(&azureMachinePool))
 		if IsNotFound(err) {
 			r.logger.Debugf(ctx, "did not find storage account")
 			r.logger.Debugf(ctx, "canceling resource")
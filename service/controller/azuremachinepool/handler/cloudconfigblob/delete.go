@@ -5,7 +5,9 @@ import (
 
 	"github.com/Azure/azure-storage-blob-go/azblob"
 	"github.com/giantswarm/microerror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
+	"github.com/giantswarm/azure-operator/v5/pkg/helpers"
 	"github.com/giantswarm/azure-operator/v5/service/controller/blobclient"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
@@ -33,9 +35,18 @@ func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
 
 	r.logger.Debugf(ctx, "deleting container object %#q", blobName)
 
+	resourceGroupName, err := helpers.GetResourceGroupNameFromMetadata(ctx, r.ctrlClient, azureMachinePool.ObjectMeta)
+	if apierrors.IsNotFound(microerror.Cause(err)) {
+		// AzureCluster deleted already. Storage Account is deleted with the
+		// cluster.
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
 	var containerURL azblob.ContainerURL
 	{
-		containerURL, err = r.getContainerURL(ctx, &azureMachinePool, key.ClusterID(&azureMachinePool), key.StorageAccountName(&azureMachinePool))
+		containerURL, err = r.getContainerURL(ctx, &azureMachinePool, resourceGroupName, key.StorageAccountName(&azureMachinePool))
 		if IsNotFound(err) {
 			// Resource Group deleted or deletion in progress. Storage Account already gone.
 			return nil
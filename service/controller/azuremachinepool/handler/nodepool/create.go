@@ -26,6 +26,14 @@ func (r *Resource) createStateMachine() state.Machine {
 			DrainOldWorkerNodes:         r.drainOldWorkerNodesTransition,
 			TerminateOldWorkerInstances: r.terminateOldWorkersTransition,
 			ScaleDownWorkerVMSS:         r.scaleDownWorkerVMSSTransition,
+			RollbackDeployment:          r.rollbackDeploymentTransition,
+			RollbackNewWorkerInstances:  r.rollbackNewWorkerInstancesTransition,
+			Paused:                      r.pausedTransition,
+			StateTimedOut:               r.stateTimedOutTransition,
+		},
+		BeforeHooks: []state.BeforeHook{
+			r.newPauseHook(),
+			r.newTimeoutHook(),
 		},
 	}
 
@@ -47,6 +55,7 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 
 	var newState state.State
 	var currentState state.State
+	var history state.History
 	{
 		s, err := r.getCurrentState(ctx, azureMachinePool)
 		if err != nil {
@@ -54,9 +63,20 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		}
 		currentState = state.State(s)
 
+		history, err = r.getStateHistory(ctx, azureMachinePool)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
 		r.Logger.Debugf(ctx, "current state: %s", currentState)
-		newState, err = r.StateMachine.Execute(ctx, obj, currentState)
+		newState, history, err = r.StateMachine.ExecuteWithHistory(ctx, obj, currentState, history)
 		if err != nil {
+			// Persist the failed transition so that it shows up in the history.
+			historyErr := r.saveCurrentState(ctx, azureMachinePool, string(currentState), history)
+			if historyErr != nil {
+				r.Logger.Errorf(ctx, historyErr, "failed to save state machine history")
+			}
+
 			return microerror.Mask(err)
 		}
 	}
@@ -64,7 +84,7 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 	if newState != currentState {
 		r.Logger.Debugf(ctx, "new state: %s", newState)
 		r.Logger.Debugf(ctx, "setting resource status to %#q", newState)
-		err = r.saveCurrentState(ctx, azureMachinePool, string(newState))
+		err = r.saveCurrentState(ctx, azureMachinePool, string(newState), history)
 		if apierrors.IsConflict(err) {
 			r.Logger.Debugf(ctx, "conflict trying to save object in k8s API concurrently")
 			r.Logger.Debugf(ctx, "no state change")
@@ -14,7 +14,9 @@ import (
 	"sigs.k8s.io/cluster-api/util"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
 	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
+	"github.com/giantswarm/azure-operator/v5/pkg/helpers"
 	"github.com/giantswarm/azure-operator/v5/pkg/label"
 	"github.com/giantswarm/azure-operator/v5/pkg/project"
 	"github.com/giantswarm/azure-operator/v5/pkg/tenantcluster"
@@ -23,8 +25,9 @@ import (
 
 const (
 	// UnschedulablePatch is the JSON patch structure being applied to nodes using
-	// a strategic merge patch in order to cordon them.
-	UnschedulablePatch = `{"spec":{"unschedulable":true}}`
+	// a strategic merge patch in order to cordon them and add them to the
+	// batch being replaced.
+	UnschedulablePatch = `{"metadata":{"annotations":{"` + annotation.ScaleBatch + `":"true"}},"spec":{"unschedulable":true}}`
 )
 
 func (r *Resource) cordonOldWorkersTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
@@ -59,38 +62,60 @@ func (r *Resource) cordonOldWorkersTransition(ctx context.Context, obj interface
 	if err != nil {
 		return currentState, microerror.Mask(err)
 	}
-	if len(newNodes) < len(oldNodes) {
+
+	machinePool, err := r.getOwnerMachinePool(ctx, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return DeploymentUninitialized, microerror.Mask(err)
+	}
+
+	if machinePool == nil {
+		return currentState, microerror.Mask(ownerReferenceNotSet)
+	}
+
+	poolSize := nodePoolSize(machinePool, len(oldNodes))
+	strategy, err := r.getScaleStrategy(ctx, &azureMachinePool, poolSize)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	batch := nextBatch(oldNodes, strategy.GetBatchSize(int64(len(oldNodes))))
+
+	// The new nodes must be able to take over the workload of the whole node
+	// pool once the batch is gone.
+	requiredNewNodes := len(batch) + int(poolSize) - len(oldNodes)
+	if len(newNodes) < requiredNewNodes {
 		// Wait until there's enough new nodes up.
-		r.Logger.Debugf(ctx, "number of new nodes (%d) is smaller than number of old nodes (%d)", len(newNodes), len(oldNodes))
+		r.Logger.Debugf(ctx, "number of new nodes (%d) is smaller than required number of new nodes (%d)", len(newNodes), requiredNewNodes)
 		r.Logger.Debugf(ctx, "canceling resource")
 		return currentState, nil
 	}
 
 	r.Logger.Debugf(ctx, "found %d old and %d new nodes from tenant cluster", len(oldNodes), len(newNodes))
-	r.Logger.Debugf(ctx, "ensuring old nodes are cordoned")
+	r.Logger.Debugf(ctx, "ensuring batch of %d old nodes is cordoned", len(batch))
 
-	oldNodesCordoned, err := r.ensureNodesCordoned(ctx, tenantClusterK8sClient, oldNodes)
+	oldNodesCordoned, err := r.ensureNodesCordoned(ctx, tenantClusterK8sClient, batch)
 	if err != nil {
 		return DeploymentUninitialized, microerror.Mask(err)
 	}
 
-	if oldNodesCordoned < len(oldNodes) {
-		r.Logger.Debugf(ctx, "not all old nodes are still cordoned; %d pending", len(oldNodes)-oldNodesCordoned)
+	if oldNodesCordoned < len(batch) {
+		r.Logger.Debugf(ctx, "not all old nodes are still cordoned; %d pending", len(batch)-oldNodesCordoned)
 
 		return currentState, nil
 	}
 
-	r.Logger.Debugf(ctx, "ensured all old nodes (%d) are cordoned", oldNodesCordoned)
+	r.Logger.Debugf(ctx, "ensured batch of old nodes (%d) is cordoned", oldNodesCordoned)
 
 	return WaitForWorkersToBecomeReady, nil
 }
 
-// ensureNodesCordoned ensures that given tenant cluster nodes are cordoned.
+// ensureNodesCordoned ensures that given tenant cluster nodes are cordoned
+// and marked as members of the batch being replaced.
 func (r *Resource) ensureNodesCordoned(ctx context.Context, tenantClusterK8sClient ctrlclient.Client, nodes []corev1.Node) (int, error) {
 	var count int
 	for _, n := range nodes {
 		// Node already cordoned?
-		if n.Spec.Unschedulable {
+		if n.Spec.Unschedulable && isNodeInBatch(n) {
 			count++
 			continue
 		}
@@ -122,27 +147,26 @@ func (r *Resource) sortNodesByTenantVMState(ctx context.Context, tenantClusterK8
 		return nil, nil, microerror.Mask(err)
 	}
 
-	vmss, err := virtualMachineScaleSetsClient.Get(ctx, key.ClusterID(azureMachinePool), key.NodePoolVMSSName(azureMachinePool))
+	resourceGroupName, err := helpers.GetResourceGroupNameFromMetadata(ctx, r.CtrlClient, azureMachinePool.ObjectMeta)
 	if err != nil {
 		return nil, nil, microerror.Mask(err)
 	}
 
-	var nodeList *corev1.NodeList
-	{
-		nodeList = &corev1.NodeList{}
+	vmss, err := virtualMachineScaleSetsClient.Get(ctx, resourceGroupName, key.NodePoolVMSSName(azureMachinePool))
+	if err != nil {
+		return nil, nil, microerror.Mask(err)
+	}
 
-		labelSelector := ctrlclient.MatchingLabels{apiextensionslabels.MachinePool: azureMachinePool.Name}
-		err := tenantClusterK8sClient.List(ctx, nodeList, labelSelector)
-		if err != nil {
-			return nil, nil, microerror.Mask(err)
-		}
+	nodeMap, err := listWorkerNodes(ctx, tenantClusterK8sClient, azureMachinePool.Name)
+	if err != nil {
+		return nil, nil, microerror.Mask(err)
 	}
 
 	var allWorkerInstances []compute.VirtualMachineScaleSetVM
 	{
 		r.Logger.Debugf(ctx, "finding all worker VMSS instances")
 
-		allWorkerInstances, err = r.GetVMSSInstances(ctx, virtualMachineScaleSetVMsClient, key.ClusterID(azureMachinePool), key.NodePoolVMSSName(azureMachinePool))
+		allWorkerInstances, err = r.GetVMSSInstances(ctx, virtualMachineScaleSetVMsClient, resourceGroupName, key.NodePoolVMSSName(azureMachinePool))
 		if err != nil {
 			return nil, nil, microerror.Mask(err)
 		}
@@ -150,11 +174,6 @@ func (r *Resource) sortNodesByTenantVMState(ctx context.Context, tenantClusterK8
 		r.Logger.Debugf(ctx, "found %d worker VMSS instances", len(allWorkerInstances))
 	}
 
-	nodeMap := make(map[string]corev1.Node)
-	for _, n := range nodeList.Items {
-		nodeMap[n.GetName()] = n
-	}
-
 	var oldNodes []corev1.Node
 	var newNodes []corev1.Node
 	for _, i := range allWorkerInstances {
@@ -168,10 +187,7 @@ func (r *Resource) sortNodesByTenantVMState(ctx context.Context, tenantClusterK8
 			continue
 		}
 
-		outdated, err := r.isWorkerInstanceFromPreviousRelease(ctx, tenantClusterK8sClient, azureMachinePool.Name, i, vmss)
-		if err != nil {
-			return nil, nil, microerror.Mask(err)
-		}
+		outdated := isWorkerInstanceFromPreviousRelease(nodeMap, azureMachinePool.Name, i, vmss)
 		if *outdated {
 			oldNodes = append(oldNodes, n)
 		} else {
@@ -182,9 +198,10 @@ func (r *Resource) sortNodesByTenantVMState(ctx context.Context, tenantClusterK8
 	return oldNodes, newNodes, nil
 }
 
-func (r *Resource) getK8sWorkerNodeForInstance(ctx context.Context, tenantClusterK8sClient ctrlclient.Client, nodePoolId string, instance compute.VirtualMachineScaleSetVM) (*corev1.Node, error) {
-	name := key.NodePoolInstanceName(nodePoolId, *instance.InstanceID)
-
+// listWorkerNodes returns the nodes of the given node pool by their name.
+// Transitions list the nodes once and look up the node of every instance in
+// the returned map.
+func listWorkerNodes(ctx context.Context, tenantClusterK8sClient ctrlclient.Client, nodePoolId string) (map[string]corev1.Node, error) {
 	nodeList := &corev1.NodeList{}
 	labelSelector := ctrlclient.MatchingLabels{apiextensionslabels.MachinePool: nodePoolId}
 	err := tenantClusterK8sClient.List(ctx, nodeList, labelSelector)
@@ -192,29 +209,32 @@ func (r *Resource) getK8sWorkerNodeForInstance(ctx context.Context, tenantCluste
 		return nil, microerror.Mask(err)
 	}
 
-	nodes := nodeList.Items
-	for _, n := range nodes {
-		if n.GetName() == name {
-			return &n, nil
-		}
+	nodes := make(map[string]corev1.Node, len(nodeList.Items))
+	for _, n := range nodeList.Items {
+		nodes[n.GetName()] = n
 	}
 
-	// Node related to this instance was not found.
-	return nil, nil
+	return nodes, nil
 }
 
-func (r *Resource) isWorkerInstanceFromPreviousRelease(ctx context.Context, tenantClusterK8sClient ctrlclient.Client, nodePoolId string, instance compute.VirtualMachineScaleSetVM, vmss compute.VirtualMachineScaleSet) (*bool, error) {
+func getK8sWorkerNodeForInstance(nodes map[string]corev1.Node, nodePoolId string, instance compute.VirtualMachineScaleSetVM) *corev1.Node {
+	n, found := nodes[key.NodePoolInstanceName(nodePoolId, *instance.InstanceID)]
+	if !found {
+		// Node related to this instance was not found.
+		return nil
+	}
+
+	return &n
+}
+
+func isWorkerInstanceFromPreviousRelease(nodes map[string]corev1.Node, nodePoolId string, instance compute.VirtualMachineScaleSetVM, vmss compute.VirtualMachineScaleSet) *bool {
 	t := true
 	f := false
 
-	n, err := r.getK8sWorkerNodeForInstance(ctx, tenantClusterK8sClient, nodePoolId, instance)
-	if err != nil {
-		return nil, err
-	}
-
+	n := getK8sWorkerNodeForInstance(nodes, nodePoolId, instance)
 	if n == nil {
 		// Kubernetes node related to this instance not found, we consider the node old.
-		return &t, nil
+		return &t
 	}
 
 	myVersion := semver.New(project.Version())
@@ -224,17 +244,17 @@ func (r *Resource) isWorkerInstanceFromPreviousRelease(ctx context.Context, tena
 		// Label does not exist, this normally happens when a new node is coming up but did not finish
 		// its kubernetes bootstrap yet and thus doesn't have all the needed labels.
 		// We'll ignore this node for now and wait for it to bootstrap correctly.
-		return nil, nil
+		return nil
 	}
 
 	nodeVersion := semver.New(v)
 	if nodeVersion.LessThan(*myVersion) {
-		return &t, nil
+		return &t
 	} else {
 		// Check if instance type is up to date.
 		if *instance.Sku.Name != *vmss.Sku.Name {
-			return &t, nil
+			return &t
 		}
-		return &f, nil
+		return &f
 	}
 }
@@ -0,0 +1,108 @@
+package nodepool
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
+)
+
+func Test_cordonOldWorkersTransition(t *testing.T) {
+	testCases := []struct {
+		name          string
+		scaleStrategy string
+		capacity      int64
+		nodes         []corev1.Node
+		expectedBatch []string
+		expectedState state.State
+	}{
+		{
+			name:     "case 0: cordon all old nodes at once by default",
+			capacity: 4,
+			nodes: []corev1.Node{
+				newOldTestNode("0"),
+				newOldTestNode("1"),
+				newNewTestNode("2"),
+				newNewTestNode("3"),
+			},
+			expectedBatch: []string{"0", "1"},
+			expectedState: WaitForWorkersToBecomeReady,
+		},
+		{
+			name:          "case 1: cordon a batch of the configured size",
+			scaleStrategy: "batch:1",
+			capacity:      4,
+			nodes: []corev1.Node{
+				newOldTestNode("0"),
+				newOldTestNode("1"),
+				newNewTestNode("2"),
+				newNewTestNode("3"),
+			},
+			expectedBatch: []string{"0"},
+			expectedState: WaitForWorkersToBecomeReady,
+		},
+		{
+			name:          "case 2: complete the interrupted batch and ignore nodes cordoned outside of it",
+			scaleStrategy: "batch:1",
+			capacity:      4,
+			nodes: []corev1.Node{
+				newCordonedTestNode("0"),
+				newBatchTestNode("1"),
+				newNewTestNode("2"),
+				newNewTestNode("3"),
+			},
+			expectedBatch: []string{"1"},
+			expectedState: WaitForWorkersToBecomeReady,
+		},
+		{
+			name:     "case 3: wait for enough new nodes",
+			capacity: 3,
+			nodes: []corev1.Node{
+				newOldTestNode("0"),
+				newOldTestNode("1"),
+				newNewTestNode("2"),
+			},
+			expectedBatch: nil,
+			expectedState: CordonOldWorkers,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			env := newTestEnv(t, 2, tc.nodes...)
+			env.ensureVMSS(t, tc.capacity, "v1")
+			if tc.scaleStrategy != "" {
+				env.azureMachinePool.Annotations = map[string]string{annotation.ScaleStrategy: tc.scaleStrategy}
+			}
+
+			newState, err := env.resource.cordonOldWorkersTransition(context.Background(), &env.azureMachinePool, CordonOldWorkers)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if newState != tc.expectedState {
+				t.Fatalf("expected state %#q, got %#q", tc.expectedState, newState)
+			}
+
+			var batch []string
+			for _, id := range env.instanceIDs(t) {
+				n := env.node(t, id)
+				if !isNodeInBatch(n) {
+					continue
+				}
+				if !n.Spec.Unschedulable {
+					t.Fatalf("expected node %#q of the batch to be cordoned", n.Name)
+				}
+				batch = append(batch, id)
+			}
+
+			assertNames(t, tc.expectedBatch, batch)
+		})
+	}
+}
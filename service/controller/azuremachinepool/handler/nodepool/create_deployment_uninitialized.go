@@ -15,7 +15,9 @@ import (
 	"sigs.k8s.io/cluster-api/util"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
 	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
+	"github.com/giantswarm/azure-operator/v5/pkg/helpers"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azuremachinepool/handler/nodepool/template"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
@@ -85,7 +87,12 @@ func (r *Resource) deploymentUninitializedTransition(ctx context.Context, obj in
 		return currentState, microerror.Mask(err)
 	}
 
-	vmss, err := virtualMachineScaleSetsClient.Get(ctx, key.ClusterID(&azureMachinePool), key.NodePoolVMSSName(&azureMachinePool))
+	resourceGroupName, err := helpers.GetResourceGroupNameFromMetadata(ctx, r.CtrlClient, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	vmss, err := virtualMachineScaleSetsClient.Get(ctx, resourceGroupName, key.NodePoolVMSSName(&azureMachinePool))
 	if IsNotFound(err) {
 		// We haven't created the VMSS just yet, it's fine.
 	} else if err != nil {
@@ -108,7 +115,7 @@ func (r *Resource) deploymentUninitializedTransition(ctx context.Context, obj in
 	}
 
 	// Fetch current Azure ARM Deployment.
-	currentDeployment, err := deploymentsClient.Get(ctx, key.ClusterID(&azureMachinePool), key.NodePoolDeploymentName(&azureMachinePool))
+	currentDeployment, err := deploymentsClient.Get(ctx, resourceGroupName, key.NodePoolDeploymentName(&azureMachinePool))
 	if IsDeploymentNotFound(err) {
 		// We haven't created the deployment just yet, it's fine.
 	} else if err != nil {
@@ -124,14 +131,39 @@ func (r *Resource) deploymentUninitializedTransition(ctx context.Context, obj in
 			return currentState, microerror.Mask(err)
 		}
 
-		// When customer is only scaling the cluster,
+		// When customer is only scaling the cluster or changing its tags,
 		// we don't need to move to the next state of the state machine which will rollout all the nodes.
-		numberOfChangedParameters := len(changes)
-		deploymentNeedsToBeSubmitted = numberOfChangedParameters > 0
-		nodesNeedToBeRolled = numberOfChangedParameters > 1 || numberOfChangedParameters == 1 && !contains(changes, "scaling")
+		deploymentNeedsToBeSubmitted = len(changes) > 0
+		nodesNeedToBeRolled = needsRolling(changes)
 		r.Logger.Debugf(ctx, "Checking if deployment is out of date and needs to be re-submitted", "deploymentNeedsToBeSubmitted", deploymentNeedsToBeSubmitted, "nodesNeedToBeRolled", nodesNeedToBeRolled, "changedParameters", changes)
 	}
 
+	if deploymentNeedsToBeSubmitted && nodesNeedToBeRolled {
+		rolledBack, err := isRolledBackDeployment(&azureMachinePool, desiredDeployment)
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+
+		if rolledBack {
+			r.Logger.Debugf(ctx, "deployment has been rolled back, remove annotation %#q to retry", annotation.RolledBackDeployment)
+			r.Logger.Debugf(ctx, "canceling resource")
+			return currentState, nil
+		}
+	}
+
+	if deploymentNeedsToBeSubmitted {
+		passed, err := r.runPreflightChecks(ctx, &azureMachinePool, machinePool, vmss, nodesNeedToBeRolled)
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+
+		if !passed {
+			r.Logger.Debugf(ctx, "not submitting deployment")
+			r.Logger.Debugf(ctx, "canceling resource")
+			return currentState, nil
+		}
+	}
+
 	if deploymentNeedsToBeSubmitted {
 		r.Logger.Debugf(ctx, "template or parameters changed")
 
@@ -163,6 +195,19 @@ func (r *Resource) deploymentUninitializedTransition(ctx context.Context, obj in
 			return currentState, microerror.Mask(err)
 		}
 
+		// The deployment may have failed because Azure cannot provide the
+		// VMs, re-applying it does not help then.
+		passed, err := r.runPreflightChecks(ctx, &azureMachinePool, machinePool, vmss, false)
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+
+		if !passed {
+			r.Logger.Debugf(ctx, "not re-applying deployment")
+			r.Logger.Debugf(ctx, "canceling resource")
+			return currentState, nil
+		}
+
 		// Deployment is not running and not succeeded (Failed?)
 		// This indicates some kind of error in the deployment template and/or parameters.
 		// Restart state machine on the next loop to apply the deployment once again.
@@ -187,6 +232,13 @@ func (r *Resource) deploymentUninitializedTransition(ctx context.Context, obj in
 			return currentState, microerror.Mask(err)
 		}
 
+		if *currentDeployment.Properties.ProvisioningState == "Succeeded" {
+			err = r.ensureRollbackSnapshot(ctx, storageAccountsClient, &azureMachinePool, desiredDeployment)
+			if err != nil {
+				return currentState, microerror.Mask(err)
+			}
+		}
+
 		r.Logger.Debugf(ctx, "canceling resource")
 		return currentState, nil
 	}
@@ -199,7 +251,12 @@ func (r *Resource) saveAzureIDsInCR(ctx context.Context, virtualMachineScaleSetV
 
 	r.Logger.Debugf(ctx, "saving provider status info in CR")
 
-	instances, err := r.GetVMSSInstances(ctx, virtualMachineScaleSetVMsClient, key.ClusterID(azureMachinePool), key.NodePoolVMSSName(azureMachinePool))
+	resourceGroupName, err := helpers.GetResourceGroupNameFromMetadata(ctx, r.CtrlClient, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	instances, err := r.GetVMSSInstances(ctx, virtualMachineScaleSetVMsClient, resourceGroupName, key.NodePoolVMSSName(azureMachinePool))
 	if err != nil {
 		return microerror.Mask(err)
 	}
@@ -236,6 +293,17 @@ func (r *Resource) saveAzureIDsInCR(ctx context.Context, virtualMachineScaleSetV
 	return nil
 }
 
+// needsRolling returns true when any of the given changed parameters requires
+// the nodes to be rolled.
+func needsRolling(changes []string) bool {
+	for _, c := range changes {
+		if !contains(template.NonRollingParameters, c) {
+			return true
+		}
+	}
+	return false
+}
+
 func contains(s []string, e string) bool {
 	for _, a := range s {
 		if a == e {
@@ -248,7 +316,12 @@ func contains(s []string, e string) bool {
 func (r *Resource) ensureDeployment(ctx context.Context, deploymentsClient *azureresource.DeploymentsClient, desiredDeployment azureresource.Deployment, azureMachinePool *capzexpv1alpha3.AzureMachinePool) (azureresource.Deployment, error) {
 	r.Logger.Debugf(ctx, "ensuring deployment")
 
-	err := r.CreateARMDeployment(ctx, deploymentsClient, desiredDeployment, key.ClusterID(azureMachinePool), key.NodePoolDeploymentName(azureMachinePool))
+	resourceGroupName, err := helpers.GetResourceGroupNameFromMetadata(ctx, r.CtrlClient, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return desiredDeployment, microerror.Mask(err)
+	}
+
+	err = r.CreateARMDeployment(ctx, deploymentsClient, desiredDeployment, resourceGroupName, key.NodePoolDeploymentName(azureMachinePool))
 	if err != nil {
 		return desiredDeployment, microerror.Mask(err)
 	}
@@ -11,8 +11,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
+	"github.com/giantswarm/azure-operator/v5/pkg/helpers"
 	"github.com/giantswarm/azure-operator/v5/pkg/label"
 	"github.com/giantswarm/azure-operator/v5/pkg/tenantcluster"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/workerpool"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
 
@@ -52,7 +54,12 @@ func (r *Resource) drainOldWorkerNodesTransition(ctx context.Context, obj interf
 		return currentState, microerror.Mask(err)
 	}
 
-	vmss, err := virtualMachineScaleSetsClient.Get(ctx, key.ClusterID(&azureMachinePool), key.NodePoolVMSSName(&azureMachinePool))
+	resourceGroupName, err := helpers.GetResourceGroupNameFromMetadata(ctx, r.CtrlClient, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	vmss, err := virtualMachineScaleSetsClient.Get(ctx, resourceGroupName, key.NodePoolVMSSName(&azureMachinePool))
 	if err != nil {
 		return currentState, microerror.Mask(err)
 	}
@@ -74,56 +81,64 @@ func (r *Resource) drainOldWorkerNodesTransition(ctx context.Context, obj interf
 	r.Logger.Debugf(ctx, "found %d drainerconfigs", len(drainerConfigs))
 	r.Logger.Debugf(ctx, "finding all worker VMSS instances")
 
-	allWorkerInstances, err := r.GetVMSSInstances(ctx, virtualMachineScaleSetVMsClient, key.ClusterID(&azureMachinePool), key.NodePoolVMSSName(&azureMachinePool))
+	allWorkerInstances, err := r.GetVMSSInstances(ctx, virtualMachineScaleSetVMsClient, resourceGroupName, key.NodePoolVMSSName(&azureMachinePool))
 	if err != nil {
 		return DeploymentUninitialized, microerror.Mask(err)
 	}
 
 	r.Logger.Debugf(ctx, "found %d worker VMSS instances", len(allWorkerInstances))
-	r.Logger.Debugf(ctx, "ensuring that drainerconfig exists for all old worker nodes")
+
+	nodes, err := listWorkerNodes(ctx, tenantClusterK8sClient, azureMachinePool.Name)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	r.Logger.Debugf(ctx, "ensuring that drainerconfig exists for all old worker nodes of the current batch")
 
 	var nodesPendingDraining int
+	var jobs []workerpool.Job
 	for _, i := range allWorkerInstances {
-		old, err := r.isWorkerInstanceFromPreviousRelease(ctx, tenantClusterK8sClient, azureMachinePool.Name, i, vmss)
-		if err != nil {
-			return DeploymentUninitialized, nil
-		}
+		old := isWorkerInstanceFromPreviousRelease(nodes, azureMachinePool.Name, i, vmss)
 
 		if old == nil || !*old {
 			// Node is a new one or we weren't able to check it's status, don't drain it.
 			continue
 		}
 
+		if !isInstanceInBatch(nodes, azureMachinePool.Name, i) {
+			// Node is replaced in a later batch, don't drain it yet.
+			continue
+		}
+
 		n := key.NodePoolInstanceName(azureMachinePool.Name, *i.InstanceID)
 
 		dc, drainerConfigExists := drainerConfigs[n]
 		if !drainerConfigExists {
 			nodesPendingDraining++
-			r.Logger.Debugf(ctx, "creating drainerconfig for %s", n)
-			err = r.CreateDrainerConfig(ctx, key.ClusterID(&azureMachinePool), cluster.Spec.ControlPlaneEndpoint.String(), key.NodePoolInstanceName(azureMachinePool.Name, *i.InstanceID))
-			if err != nil {
-				return DeploymentUninitialized, microerror.Mask(err)
-			}
+			jobs = append(jobs, workerpool.NewJob(n, func() error {
+				r.Logger.Debugf(ctx, "creating drainerconfig for %s", n)
+				return r.CreateDrainerConfig(ctx, key.ClusterID(&azureMachinePool), cluster.Spec.ControlPlaneEndpoint.String(), n)
+			}))
 		}
 
 		if drainerConfigExists && dc.Status.HasTimeoutCondition() {
 			nodesPendingDraining++
 			r.Logger.Debugf(ctx, "drainerconfig for %s already exists but has timed out", n)
-			r.Logger.Debugf(ctx, "deleting drainerconfig for %s", n)
-
-			err = r.CtrlClient.Delete(ctx, &dc)
-			if errors.IsNotFound(err) {
-				r.Logger.Debugf(ctx, "did not delete drainer config for tenant cluster node")
-				r.Logger.Debugf(ctx, "drainer config for tenant cluster node does not exist")
-			} else if err != nil {
-				return DeploymentUninitialized, microerror.Mask(err)
-			}
-
-			r.Logger.Debugf(ctx, "creating drainerconfig for %s", n)
-			err = r.CreateDrainerConfig(ctx, key.ClusterID(&azureMachinePool), cluster.Spec.ControlPlaneEndpoint.String(), key.NodePoolInstanceName(azureMachinePool.Name, *i.InstanceID))
-			if err != nil {
-				return DeploymentUninitialized, microerror.Mask(err)
-			}
+
+			jobs = append(jobs, workerpool.NewJob(n, func() error {
+				r.Logger.Debugf(ctx, "deleting drainerconfig for %s", n)
+
+				err := r.CtrlClient.Delete(ctx, &dc)
+				if errors.IsNotFound(err) {
+					r.Logger.Debugf(ctx, "did not delete drainer config for tenant cluster node")
+					r.Logger.Debugf(ctx, "drainer config for tenant cluster node does not exist")
+				} else if err != nil {
+					return microerror.Mask(err)
+				}
+
+				r.Logger.Debugf(ctx, "creating drainerconfig for %s", n)
+				return r.CreateDrainerConfig(ctx, key.ClusterID(&azureMachinePool), cluster.Spec.ControlPlaneEndpoint.String(), n)
+			}))
 		}
 
 		if drainerConfigExists && !dc.Status.HasTimeoutCondition() && !dc.Status.HasDrainedCondition() {
@@ -132,7 +147,12 @@ func (r *Resource) drainOldWorkerNodesTransition(ctx context.Context, obj interf
 		}
 	}
 
-	r.Logger.Debugf(ctx, "ensured that drainerconfig exists for all old worker nodes")
+	err = r.workerPool.Run(ctx, jobs...)
+	if err != nil {
+		return DeploymentUninitialized, microerror.Mask(err)
+	}
+
+	r.Logger.Debugf(ctx, "ensured that drainerconfig exists for all old worker nodes of the current batch")
 	r.Logger.Debugf(ctx, "%d nodes are pending draining", nodesPendingDraining)
 
 	if nodesPendingDraining > 0 {
@@ -0,0 +1,124 @@
+package nodepool
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	corev1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
+	"github.com/giantswarm/azure-operator/v5/pkg/label"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+func Test_drainOldWorkerNodesTransition(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		drainerConfigs         []corev1alpha1.DrainerConfig
+		expectedDrainerConfigs []string
+		expectedState          state.State
+	}{
+		{
+			name:                   "case 0: drain the nodes of the batch only",
+			expectedDrainerConfigs: []string{key.NodePoolInstanceName(testNodePoolID, "0")},
+			expectedState:          DrainOldWorkerNodes,
+		},
+		{
+			name: "case 1: wait for the batch to be drained",
+			drainerConfigs: []corev1alpha1.DrainerConfig{
+				newTestDrainerConfig("0", nil),
+			},
+			expectedDrainerConfigs: []string{key.NodePoolInstanceName(testNodePoolID, "0")},
+			expectedState:          DrainOldWorkerNodes,
+		},
+		{
+			name: "case 2: retry draining after a timeout",
+			drainerConfigs: []corev1alpha1.DrainerConfig{
+				newTestDrainerConfig("0", func(s corev1alpha1.DrainerConfigStatus) corev1alpha1.DrainerConfigStatusCondition {
+					return s.NewTimeoutCondition()
+				}),
+			},
+			expectedDrainerConfigs: []string{key.NodePoolInstanceName(testNodePoolID, "0")},
+			expectedState:          DrainOldWorkerNodes,
+		},
+		{
+			name: "case 3: terminate the batch once it is drained",
+			drainerConfigs: []corev1alpha1.DrainerConfig{
+				newTestDrainerConfig("0", func(s corev1alpha1.DrainerConfigStatus) corev1alpha1.DrainerConfigStatusCondition {
+					return s.NewDrainedCondition()
+				}),
+			},
+			expectedDrainerConfigs: nil,
+			expectedState:          TerminateOldWorkerInstances,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			ctx := context.Background()
+
+			env := newTestEnv(t, 2,
+				newBatchTestNode("0"),
+				newOldTestNode("1"),
+				newNewTestNode("2"),
+				newNewTestNode("3"),
+			)
+			env.ensureVMSS(t, 4, "v1")
+
+			for _, dc := range tc.drainerConfigs {
+				dc := dc
+				err := env.ctrlClient.Create(ctx, &dc)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			newState, err := env.resource.drainOldWorkerNodesTransition(ctx, &env.azureMachinePool, DrainOldWorkerNodes)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if newState != tc.expectedState {
+				t.Fatalf("expected state %#q, got %#q", tc.expectedState, newState)
+			}
+
+			drainerConfigList := &corev1alpha1.DrainerConfigList{}
+			err = env.ctrlClient.List(ctx, drainerConfigList)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var drainerConfigs []string
+			for _, dc := range drainerConfigList.Items {
+				if dc.Status.HasTimeoutCondition() {
+					t.Fatalf("expected drainer config %#q to be recreated after its timeout", dc.Name)
+				}
+				drainerConfigs = append(drainerConfigs, dc.Name)
+			}
+
+			assertNames(t, tc.expectedDrainerConfigs, drainerConfigs)
+		})
+	}
+}
+
+func newTestDrainerConfig(instanceID string, condition func(corev1alpha1.DrainerConfigStatus) corev1alpha1.DrainerConfigStatusCondition) corev1alpha1.DrainerConfig {
+	dc := corev1alpha1.DrainerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.NodePoolInstanceName(testNodePoolID, instanceID),
+			Namespace: testClusterID,
+			Labels: map[string]string{
+				label.Cluster: testClusterID,
+			},
+		},
+	}
+
+	if condition != nil {
+		dc.Status.Conditions = append(dc.Status.Conditions, condition(dc.Status))
+	}
+
+	return dc
+}
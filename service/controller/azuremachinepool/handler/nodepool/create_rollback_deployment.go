@@ -0,0 +1,181 @@
+package nodepool
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
+	capi "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capiconditions "sigs.k8s.io/cluster-api/util/conditions"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes"
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
+	"github.com/giantswarm/azure-operator/v5/pkg/helpers"
+	"github.com/giantswarm/azure-operator/v5/service/controller/azuremachinepool/handler/nodepool/template"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+// rollbackDeploymentTransition applies the last good deployment of the node
+// pool again. The instances created from the failed deployment are recorded
+// before, so that they can be terminated once the rollback is applied.
+func (r *Resource) rollbackDeploymentTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
+	azureMachinePool, err := key.ToAzureMachinePool(obj)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	parameters, err := r.getRollbackParameters(ctx, &azureMachinePool)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	if parameters == nil {
+		message := "Node pool cannot be rolled back, there is no snapshot of a good deployment."
+		r.Logger.Debugf(ctx, message)
+		r.EventRecorder.Event(&azureMachinePool, corev1.EventTypeWarning, RollbackUnavailableReason, message)
+
+		return StateTimedOut, nil
+	}
+
+	deploymentsClient, err := r.ClientFactory.GetDeploymentsClient(ctx, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	virtualMachineScaleSetsClient, err := r.ClientFactory.GetVirtualMachineScaleSetsClient(ctx, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	virtualMachineScaleSetVMsClient, err := r.ClientFactory.GetVirtualMachineScaleSetVMsClient(ctx, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	resourceGroupName, err := helpers.GetResourceGroupNameFromMetadata(ctx, r.CtrlClient, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	currentDeployment, err := deploymentsClient.Get(ctx, resourceGroupName, key.NodePoolDeploymentName(&azureMachinePool))
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	var failedFingerprint string
+	{
+		failedParameters, err := template.NewFromExtendedDeployment(currentDeployment)
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+
+		failedFingerprint, err = template.Fingerprint(failedParameters)
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+	}
+
+	rollbackFingerprint, err := template.Fingerprint(*parameters)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	if failedFingerprint == rollbackFingerprint {
+		// The last good deployment has already been applied again, the rollback
+		// has been interrupted before the state was saved.
+		r.Logger.Debugf(ctx, "rollback deployment already applied")
+		return RollbackNewWorkerInstances, nil
+	}
+
+	vmss, err := virtualMachineScaleSetsClient.Get(ctx, resourceGroupName, key.NodePoolVMSSName(&azureMachinePool))
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	// Instances which run the latest model were created from the failed
+	// deployment. They need to be known before the model is rolled back.
+	var newInstanceIDs []string
+	{
+		instances, err := r.GetVMSSInstances(ctx, virtualMachineScaleSetVMsClient, resourceGroupName, key.NodePoolVMSSName(&azureMachinePool))
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+
+		for _, i := range instances {
+			if isLatestModelApplied(i) {
+				newInstanceIDs = append(newInstanceIDs, *i.InstanceID)
+			}
+		}
+	}
+
+	message := fmt.Sprintf(
+		"Rolling back node pool to the deployment of azure-operator %s, instances to terminate: %s.",
+		parameters.AzureOperatorVersion,
+		nodes.FormatInstanceIDs(newInstanceIDs),
+	)
+	r.Logger.Debugf(ctx, message)
+
+	err = r.saveRollbackAnnotations(ctx, azureMachinePool, failedFingerprint, newInstanceIDs)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	err = r.setCondition(ctx, azureMachinePool, func(cr *capzexpv1alpha3.AzureMachinePool) {
+		capiconditions.MarkFalse(cr, RolledBackCondition, RollbackInProgressReason, capi.ConditionSeverityWarning, "%s", message)
+	})
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	// Keep the current capacity. The instances of the failed deployment are
+	// terminated explicitly, scaling in would remove arbitrary instances.
+	if vmss.Sku != nil && vmss.Sku.Capacity != nil {
+		parameters.Scaling.CurrentReplicas = int32(*vmss.Sku.Capacity)
+	}
+
+	deployment, err := template.NewDeployment(*parameters)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	_, err = r.ensureDeployment(ctx, deploymentsClient, deployment, &azureMachinePool)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	return RollbackNewWorkerInstances, nil
+}
+
+// saveRollbackAnnotations records the fingerprint of the failed deployment and
+// the instances created from it on the AzureMachinePool CR.
+func (r *Resource) saveRollbackAnnotations(ctx context.Context, customObject capzexpv1alpha3.AzureMachinePool, fingerprint string, instanceIDs []string) error {
+	azureMachinePool := &capzexpv1alpha3.AzureMachinePool{}
+	err := r.CtrlClient.Get(ctx, ctrlclient.ObjectKey{Namespace: customObject.Namespace, Name: customObject.Name}, azureMachinePool)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if azureMachinePool.Annotations == nil {
+		azureMachinePool.Annotations = map[string]string{}
+	}
+
+	azureMachinePool.Annotations[annotation.RolledBackDeployment] = fingerprint
+	azureMachinePool.Annotations[annotation.RollbackInstances] = strings.Join(instanceIDs, ",")
+
+	err = r.CtrlClient.Update(ctx, azureMachinePool)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func isLatestModelApplied(instance compute.VirtualMachineScaleSetVM) bool {
+	return instance.VirtualMachineScaleSetVMProperties != nil && instance.LatestModelApplied != nil && *instance.LatestModelApplied
+}
@@ -0,0 +1,184 @@
+package nodepool
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiextensionslabels "github.com/giantswarm/apiextensions/v3/pkg/label"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util"
+	capiconditions "sigs.k8s.io/cluster-api/util/conditions"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes"
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
+	"github.com/giantswarm/azure-operator/v5/pkg/helpers"
+	"github.com/giantswarm/azure-operator/v5/pkg/tenantcluster"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+const (
+	// SchedulablePatch is the JSON patch structure being applied to nodes
+	// using a strategic merge patch in order to uncordon them and remove them
+	// from the batch being replaced.
+	SchedulablePatch = `{"metadata":{"annotations":{"` + annotation.ScaleBatch + `":null}},"spec":{"unschedulable":false}}`
+)
+
+// rollbackNewWorkerInstancesTransition waits for the rollback deployment to
+// be applied, uncordons the old nodes and terminates the instances created
+// from the failed deployment.
+func (r *Resource) rollbackNewWorkerInstancesTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
+	azureMachinePool, err := key.ToAzureMachinePool(obj)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	cluster, err := util.GetClusterFromMetadata(ctx, r.CtrlClient, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	if !cluster.GetDeletionTimestamp().IsZero() {
+		r.Logger.Debugf(ctx, "Cluster is being deleted, skipping reconciling node pool")
+		return currentState, nil
+	}
+
+	deploymentsClient, err := r.ClientFactory.GetDeploymentsClient(ctx, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	virtualMachineScaleSetsClient, err := r.ClientFactory.GetVirtualMachineScaleSetsClient(ctx, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	virtualMachineScaleSetVMsClient, err := r.ClientFactory.GetVirtualMachineScaleSetVMsClient(ctx, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	resourceGroupName, err := helpers.GetResourceGroupNameFromMetadata(ctx, r.CtrlClient, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	deployment, err := deploymentsClient.Get(ctx, resourceGroupName, key.NodePoolDeploymentName(&azureMachinePool))
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	switch *deployment.Properties.ProvisioningState {
+	case "Succeeded":
+		// The last good deployment is applied again.
+	case "Failed", "Canceled":
+		r.Logger.Debugf(ctx, "rollback deployment has failed")
+		r.Debugger.LogFailedDeployment(ctx, deployment, err)
+		return currentState, nil
+	default:
+		r.Logger.Debugf(ctx, "rollback deployment is in state %#q", *deployment.Properties.ProvisioningState)
+		r.Logger.Debugf(ctx, "canceling resource")
+		return currentState, nil
+	}
+
+	tenantClusterK8sClient, err := r.tenantClientFactory.GetClient(ctx, cluster)
+	if tenantcluster.IsAPINotAvailableError(err) {
+		r.Logger.Debugf(ctx, "tenant API not available yet")
+		r.Logger.Debugf(ctx, "canceling resource")
+
+		return currentState, nil
+	} else if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	rollbackInstanceIDs := map[string]bool{}
+	for _, id := range strings.Split(azureMachinePool.GetAnnotations()[annotation.RollbackInstances], ",") {
+		if id != "" {
+			rollbackInstanceIDs[id] = true
+		}
+	}
+
+	var ids []string
+	{
+		instances, err := r.GetVMSSInstances(ctx, virtualMachineScaleSetVMsClient, resourceGroupName, key.NodePoolVMSSName(&azureMachinePool))
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+
+		for _, i := range instances {
+			if rollbackInstanceIDs[*i.InstanceID] {
+				ids = append(ids, *i.InstanceID)
+			}
+		}
+	}
+
+	r.Logger.Debugf(ctx, "ensuring old nodes are uncordoned")
+
+	err = r.ensureOldNodesUncordoned(ctx, tenantClusterK8sClient, &azureMachinePool, rollbackInstanceIDs)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	r.Logger.Debugf(ctx, "ensured old nodes are uncordoned")
+	r.Logger.Debugf(ctx, "terminating %d worker instances of the failed deployment", len(ids))
+
+	err = r.terminateInstances(ctx, virtualMachineScaleSetsClient, &azureMachinePool, ids)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	r.Logger.Debugf(ctx, "terminated %d worker instances of the failed deployment", len(ids))
+
+	message := fmt.Sprintf(
+		"Node pool rolled back, terminated instances: %s. Remove annotation %s to retry the upgrade.",
+		nodes.FormatInstanceIDs(ids),
+		annotation.RolledBackDeployment,
+	)
+	r.Logger.Debugf(ctx, message)
+	r.EventRecorder.Event(&azureMachinePool, corev1.EventTypeWarning, RolledBackReason, message)
+
+	err = r.setCondition(ctx, azureMachinePool, func(cr *capzexpv1alpha3.AzureMachinePool) {
+		capiconditions.MarkTrue(cr, RolledBackCondition)
+	})
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	return DeploymentUninitialized, nil
+}
+
+// ensureOldNodesUncordoned uncordons the nodes of the node pool which don't
+// belong to the given instances of the failed deployment.
+func (r *Resource) ensureOldNodesUncordoned(ctx context.Context, tenantClusterK8sClient ctrlclient.Client, azureMachinePool *capzexpv1alpha3.AzureMachinePool, rollbackInstanceIDs map[string]bool) error {
+	newNodeNames := map[string]bool{}
+	for id := range rollbackInstanceIDs {
+		newNodeNames[key.NodePoolInstanceName(azureMachinePool.Name, id)] = true
+	}
+
+	nodeList := &corev1.NodeList{}
+	err := tenantClusterK8sClient.List(ctx, nodeList, ctrlclient.MatchingLabels{apiextensionslabels.MachinePool: azureMachinePool.Name})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	for _, n := range nodeList.Items {
+		if (!n.Spec.Unschedulable && !isNodeInBatch(n)) || newNodeNames[n.GetName()] {
+			continue
+		}
+
+		err := tenantClusterK8sClient.Patch(ctx, &n, ctrlclient.RawPatch(types.StrategicMergePatchType, []byte(SchedulablePatch)))
+		if apierrors.IsNotFound(err) {
+			// The node is gone already, there is nothing to uncordon.
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
@@ -8,15 +8,18 @@ import (
 
 	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/scalestrategy"
 	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
+	"github.com/giantswarm/azure-operator/v5/pkg/helpers"
 	"github.com/giantswarm/azure-operator/v5/pkg/tenantcluster"
 	"github.com/giantswarm/azure-operator/v5/service/controller/internal/vmsscheck"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
 
-// The goal of scaleUpWorkerVMSSTransition is to double the desired number
-// of nodes in worker VMSS in order to provide 1:1 mapping between new
-// up-to-date nodes when draining and terminating old nodes.
-// This will be done in subsequent reconciliation loops to avoid hitting the
+// The goal of scaleUpWorkerVMSSTransition is to add surge capacity to the
+// worker VMSS for the next batch of old nodes in order to provide 1:1 mapping
+// between new up-to-date nodes when draining and terminating old nodes. The
+// batch size and the scaling steps are defined by the scale strategy of the
+// node pool, which replaces all old nodes in a single batch by default.
+// Scaling will be done in subsequent reconciliation loops to avoid hitting the
 // VMSS api too hard.
 func (r *Resource) scaleUpWorkerVMSSTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
 	azureMachinePool, err := key.ToAzureMachinePool(obj)
@@ -53,7 +56,12 @@ func (r *Resource) scaleUpWorkerVMSSTransition(ctx context.Context, obj interfac
 		return currentState, microerror.Mask(err)
 	}
 
-	allReady, err := vmsscheck.InstancesAreRunning(ctx, r.Logger, virtualMachineScaleSetVMsClient, key.ClusterID(&azureMachinePool), key.NodePoolVMSSName(&azureMachinePool))
+	resourceGroupName, err := helpers.GetResourceGroupNameFromMetadata(ctx, r.CtrlClient, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return DeploymentUninitialized, microerror.Mask(err)
+	}
+
+	allReady, err := vmsscheck.InstancesAreRunning(ctx, r.Logger, virtualMachineScaleSetVMsClient, resourceGroupName, key.NodePoolVMSSName(&azureMachinePool))
 	if err != nil {
 		return DeploymentUninitialized, microerror.Mask(err)
 	}
@@ -62,10 +70,8 @@ func (r *Resource) scaleUpWorkerVMSSTransition(ctx context.Context, obj interfac
 		return currentState, nil
 	}
 
-	strategy := scalestrategy.Quick{}
-
 	// Ensure the deployment is successful before we move on with scaling.
-	currentDeployment, err := deploymentsClient.Get(ctx, key.ClusterID(&azureMachinePool), key.NodePoolDeploymentName(&azureMachinePool))
+	currentDeployment, err := deploymentsClient.Get(ctx, resourceGroupName, key.NodePoolDeploymentName(&azureMachinePool))
 	if IsDeploymentNotFound(err) {
 		// Deployment not found, we need to apply it again.
 		return DeploymentUninitialized, microerror.Mask(err)
@@ -111,10 +117,24 @@ func (r *Resource) scaleUpWorkerVMSSTransition(ctx context.Context, obj interfac
 	if err != nil {
 		return currentState, microerror.Mask(err)
 	}
-	desiredWorkerCount := int64(len(oldNodes) * 2)
+
+	poolSize := nodePoolSize(machinePool, len(oldNodes))
+	strategy, err := r.getScaleStrategy(ctx, &azureMachinePool, poolSize)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	batchSize := strategy.GetBatchSize(int64(len(oldNodes)))
+	r.Logger.Debugf(ctx, "The next batch replaces %d of %d old workers", batchSize, len(oldNodes))
+
+	// Without old nodes there is nothing to add surge capacity for.
+	var desiredWorkerCount int64
+	if batchSize > 0 {
+		desiredWorkerCount = poolSize + batchSize
+	}
 	r.Logger.Debugf(ctx, "The desired number of workers is: %d", desiredWorkerCount)
 
-	currentWorkerCount, err := r.GetInstancesCount(ctx, virtualMachineScaleSetsClient, key.ClusterID(&azureMachinePool), key.NodePoolVMSSName(&azureMachinePool))
+	currentWorkerCount, err := r.GetInstancesCount(ctx, virtualMachineScaleSetsClient, resourceGroupName, key.NodePoolVMSSName(&azureMachinePool))
 	if err != nil {
 		return DeploymentUninitialized, microerror.Mask(err)
 	}
@@ -122,12 +142,12 @@ func (r *Resource) scaleUpWorkerVMSSTransition(ctx context.Context, obj interfac
 
 	if desiredWorkerCount > currentWorkerCount {
 		// Disable cluster autoscaler for this nodepool.
-		err = r.disableClusterAutoscaler(ctx, virtualMachineScaleSetsClient, key.ClusterID(&azureMachinePool), key.NodePoolVMSSName(&azureMachinePool))
+		err = r.disableClusterAutoscaler(ctx, virtualMachineScaleSetsClient, resourceGroupName, key.NodePoolVMSSName(&azureMachinePool))
 		if err != nil {
 			return DeploymentUninitialized, microerror.Mask(err)
 		}
 
-		err = r.ScaleVMSS(ctx, virtualMachineScaleSetsClient, key.ClusterID(&azureMachinePool), key.NodePoolVMSSName(&azureMachinePool), desiredWorkerCount, strategy)
+		err = r.ScaleVMSS(ctx, virtualMachineScaleSetsClient, resourceGroupName, key.NodePoolVMSSName(&azureMachinePool), desiredWorkerCount, strategy)
 		if err != nil {
 			return DeploymentUninitialized, microerror.Mask(err)
 		}
@@ -162,8 +182,13 @@ func (r *Resource) scaleDownWorkerVMSSTransition(ctx context.Context, obj interf
 	desiredWorkerCount := *machinePool.Spec.Replicas
 	r.Logger.Debugf(ctx, "scaling worker VMSS to %d nodes", desiredWorkerCount)
 
+	resourceGroupName, err := helpers.GetResourceGroupNameFromMetadata(ctx, r.CtrlClient, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
 	strategy := scalestrategy.Quick{}
-	err = r.ScaleVMSS(ctx, virtualMachineScaleSetsClient, key.ClusterID(&azureMachinePool), key.NodePoolVMSSName(&azureMachinePool), int64(desiredWorkerCount), strategy)
+	err = r.ScaleVMSS(ctx, virtualMachineScaleSetsClient, resourceGroupName, key.NodePoolVMSSName(&azureMachinePool), int64(desiredWorkerCount), strategy)
 	if err != nil {
 		return DeploymentUninitialized, microerror.Mask(err)
 	}
@@ -171,7 +196,7 @@ func (r *Resource) scaleDownWorkerVMSSTransition(ctx context.Context, obj interf
 	r.Logger.Debugf(ctx, "scaled worker VMSS to %d nodes", desiredWorkerCount)
 
 	// Enable cluster autoscaler for this nodepool.
-	err = r.enableClusterAutoscaler(ctx, virtualMachineScaleSetsClient, key.ClusterID(&azureMachinePool), key.NodePoolVMSSName(&azureMachinePool))
+	err = r.enableClusterAutoscaler(ctx, virtualMachineScaleSetsClient, resourceGroupName, key.NodePoolVMSSName(&azureMachinePool))
 	if err != nil {
 		return DeploymentUninitialized, microerror.Mask(err)
 	}
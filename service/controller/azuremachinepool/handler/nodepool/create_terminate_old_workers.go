@@ -2,13 +2,18 @@ package nodepool
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
 	"sigs.k8s.io/cluster-api/util"
 
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
 	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
+	"github.com/giantswarm/azure-operator/v5/pkg/helpers"
 	"github.com/giantswarm/azure-operator/v5/pkg/tenantcluster"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
@@ -49,11 +54,16 @@ func (r *Resource) terminateOldWorkersTransition(ctx context.Context, obj interf
 		return currentState, microerror.Mask(err)
 	}
 
+	resourceGroupName, err := helpers.GetResourceGroupNameFromMetadata(ctx, r.CtrlClient, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
 	var allWorkerInstances []compute.VirtualMachineScaleSetVM
 	{
 		r.Logger.Debugf(ctx, "finding all worker VMSS instances")
 
-		allWorkerInstances, err = r.GetVMSSInstances(ctx, virtualMachineScaleSetVMsClient, key.ClusterID(&azureMachinePool), key.NodePoolVMSSName(&azureMachinePool))
+		allWorkerInstances, err = r.GetVMSSInstances(ctx, virtualMachineScaleSetVMsClient, resourceGroupName, key.NodePoolVMSSName(&azureMachinePool))
 		if err != nil {
 			return DeploymentUninitialized, microerror.Mask(err)
 		}
@@ -61,47 +71,83 @@ func (r *Resource) terminateOldWorkersTransition(ctx context.Context, obj interf
 		r.Logger.Debugf(ctx, "found %d worker VMSS instances", len(allWorkerInstances))
 	}
 
-	resourceGroupName := key.ClusterID(&azureMachinePool)
-	nodePoolVMSSName := key.NodePoolVMSSName(&azureMachinePool)
-	vmss, err := virtualMachineScaleSetsClient.Get(ctx, resourceGroupName, nodePoolVMSSName)
+	vmss, err := virtualMachineScaleSetsClient.Get(ctx, resourceGroupName, key.NodePoolVMSSName(&azureMachinePool))
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	nodes, err := listWorkerNodes(ctx, tenantClusterK8sClient, azureMachinePool.Name)
 	if err != nil {
 		return currentState, microerror.Mask(err)
 	}
 
-	r.Logger.Debugf(ctx, "filtering instance IDs for old instances")
+	r.Logger.Debugf(ctx, "filtering instance IDs for old instances of the current batch")
 
-	var ids compute.VirtualMachineScaleSetVMInstanceRequiredIDs
+	var ids []string
+	var remainingOldInstances int
 	{
-		var strIds []string
 		for _, i := range allWorkerInstances {
-			old, err := r.isWorkerInstanceFromPreviousRelease(ctx, tenantClusterK8sClient, azureMachinePool.Name, i, vmss)
-			if err != nil {
-				return DeploymentUninitialized, nil
+			old := isWorkerInstanceFromPreviousRelease(nodes, azureMachinePool.Name, i, vmss)
+
+			if old == nil || !*old {
+				continue
 			}
 
-			if old != nil && *old {
-				strIds = append(strIds, *i.InstanceID)
+			if isInstanceInBatch(nodes, azureMachinePool.Name, i) {
+				ids = append(ids, *i.InstanceID)
+			} else {
+				remainingOldInstances++
 			}
 		}
+	}
 
-		ids = compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
-			InstanceIds: to.StringSlicePtr(strIds),
-		}
+	r.Logger.Debugf(ctx, "filtered instance IDs for old instances of the current batch")
+	r.Logger.Debugf(ctx, "terminating %d old worker instances", len(ids))
+
+	err = r.terminateInstances(ctx, virtualMachineScaleSetsClient, &azureMachinePool, ids)
+	if IsScopeLocked(err) {
+		message := fmt.Sprintf("Old worker instances can not be terminated while the resource group of the cluster is locked. Remove annotation %s from the AzureCluster CR or the management lock of the resource group.", annotation.DeletionProtection)
+		r.Logger.LogCtx(ctx, "level", "warning", "message", message)
+		r.EventRecorder.Event(&azureMachinePool, corev1.EventTypeWarning, ScopeLockedReason, message)
+		return currentState, nil
+	} else if err != nil {
+		return DeploymentUninitialized, microerror.Mask(err)
 	}
 
-	r.Logger.Debugf(ctx, "filtered instance IDs for old instances")
-	r.Logger.Debugf(ctx, "terminating %d old worker instances", len(*ids.InstanceIds))
+	r.Logger.Debugf(ctx, "terminated %d old worker instances", len(ids))
 
-	res, err := virtualMachineScaleSetsClient.DeleteInstances(ctx, resourceGroupName, nodePoolVMSSName, ids)
+	if remainingOldInstances > 0 {
+		r.Logger.Debugf(ctx, "%d old worker instances remain to be replaced in further batches", remainingOldInstances)
+		return ScaleUpWorkerVMSS, nil
+	}
+
+	return ScaleDownWorkerVMSS, nil
+}
+
+// terminateInstances deletes the given instances of the node pool VMSS with a
+// single request.
+func (r *Resource) terminateInstances(ctx context.Context, virtualMachineScaleSetsClient *compute.VirtualMachineScaleSetsClient, azureMachinePool *capzexpv1alpha3.AzureMachinePool, instanceIDs []string) error {
+	if len(instanceIDs) == 0 {
+		return nil
+	}
+
+	ids := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+		InstanceIds: to.StringSlicePtr(instanceIDs),
+	}
+
+	resourceGroupName, err := helpers.GetResourceGroupNameFromMetadata(ctx, r.CtrlClient, azureMachinePool.ObjectMeta)
 	if err != nil {
-		return DeploymentUninitialized, microerror.Mask(err)
+		return microerror.Mask(err)
+	}
+
+	res, err := virtualMachineScaleSetsClient.DeleteInstances(ctx, resourceGroupName, key.NodePoolVMSSName(azureMachinePool), ids)
+	if err != nil {
+		return microerror.Mask(err)
 	}
 	_, err = virtualMachineScaleSetsClient.DeleteInstancesResponder(res.Response())
 	if err != nil {
-		return DeploymentUninitialized, microerror.Mask(err)
+		return microerror.Mask(err)
 	}
 
-	r.Logger.Debugf(ctx, "terminated %d old worker instances", len(*ids.InstanceIds))
-
-	return ScaleDownWorkerVMSS, nil
+	return nil
 }
@@ -0,0 +1,95 @@
+package nodepool
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
+)
+
+func Test_terminateOldWorkersTransition(t *testing.T) {
+	testCases := []struct {
+		name              string
+		nodes             []corev1.Node
+		expectedInstances []string
+		expectedState     state.State
+	}{
+		{
+			name: "case 0: terminate the cordoned batch and scale up for the next one",
+			nodes: []corev1.Node{
+				newBatchTestNode("0"),
+				newOldTestNode("1"),
+				newNewTestNode("2"),
+				newNewTestNode("3"),
+			},
+			expectedInstances: []string{"1", "2", "3"},
+			expectedState:     ScaleUpWorkerVMSS,
+		},
+		{
+			name: "case 1: terminate the last batch and scale down",
+			nodes: []corev1.Node{
+				newBatchTestNode("0"),
+				newBatchTestNode("1"),
+				newNewTestNode("2"),
+				newNewTestNode("3"),
+			},
+			expectedInstances: []string{"2", "3"},
+			expectedState:     ScaleDownWorkerVMSS,
+		},
+		{
+			name: "case 2: terminate old instances without nodes",
+			nodes: []corev1.Node{
+				newOldTestNode("1"),
+				newNewTestNode("2"),
+				newNewTestNode("3"),
+			},
+			expectedInstances: []string{"1", "2", "3"},
+			expectedState:     ScaleUpWorkerVMSS,
+		},
+		{
+			name: "case 3: keep old instances cordoned outside of the batch",
+			nodes: []corev1.Node{
+				newBatchTestNode("0"),
+				newCordonedTestNode("1"),
+				newNewTestNode("2"),
+				newNewTestNode("3"),
+			},
+			expectedInstances: []string{"1", "2", "3"},
+			expectedState:     ScaleUpWorkerVMSS,
+		},
+		{
+			name: "case 4: keep new instances",
+			nodes: []corev1.Node{
+				newNewTestNode("0"),
+				newNewTestNode("1"),
+				newNewTestNode("2"),
+				newNewTestNode("3"),
+			},
+			expectedInstances: []string{"0", "1", "2", "3"},
+			expectedState:     ScaleDownWorkerVMSS,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			env := newTestEnv(t, 2, tc.nodes...)
+			env.ensureVMSS(t, 4, "v1")
+
+			newState, err := env.resource.terminateOldWorkersTransition(context.Background(), &env.azureMachinePool, TerminateOldWorkerInstances)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if newState != tc.expectedState {
+				t.Fatalf("expected state %#q, got %#q", tc.expectedState, newState)
+			}
+
+			assertNames(t, tc.expectedInstances, env.instanceIDs(t))
+		})
+	}
+}
@@ -6,16 +6,23 @@ import (
 
 	"github.com/giantswarm/apiextensions/v3/pkg/label"
 	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/operatorkit/v4/pkg/controller/context/finalizerskeptcontext"
+	"github.com/giantswarm/operatorkit/v4/pkg/controller/context/resourcecanceledcontext"
 	corev1 "k8s.io/api/core/v1"
 	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
 	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
 	"github.com/giantswarm/azure-operator/v5/pkg/tenantcluster"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
 
+// ScopeLockedReason is the reason of events emitted while a management lock on
+// the resource group of the cluster prevents deleting node pool resources.
+const ScopeLockedReason = "ScopeLocked"
+
 // EnsureDeleted is a noop since the deletion of deployments is redirected to
 // the deletion of resource groups because they garbage collect them.
 func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
@@ -44,13 +51,23 @@ func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
 		return microerror.Mask(err)
 	}
 
+	// Nothing is deleted while the resource group is locked, so that the
+	// nodes keep running until the node pool can be deleted.
+	if key.DeletionProtection(*azureCluster) {
+		r.reportScopeLocked(ctx, &azureMachinePool)
+		return nil
+	}
+
 	err = r.removeNodesFromK8s(ctx, tenantClusterK8sClient, &azureMachinePool)
 	if err != nil {
 		return microerror.Mask(err)
 	}
 
-	err = r.removeNodePool(ctx, &azureMachinePool)
-	if err != nil {
+	err = r.removeNodePool(ctx, azureCluster, &azureMachinePool)
+	if IsScopeLocked(err) {
+		r.reportScopeLocked(ctx, &azureMachinePool)
+		return nil
+	} else if err != nil {
 		return microerror.Mask(err)
 	}
 
@@ -62,6 +79,20 @@ func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
 	return nil
 }
 
+// reportScopeLocked emits a warning event when the node pool can't be deleted
+// because of a management lock on the resource group of the cluster, and keeps
+// the finalizer until it is removed.
+func (r *Resource) reportScopeLocked(ctx context.Context, azureMachinePool *capzexpv1alpha3.AzureMachinePool) {
+	message := fmt.Sprintf("Node pool can not be deleted while the resource group of the cluster is locked. Remove annotation %s from the AzureCluster CR or the management lock of the resource group.", annotation.DeletionProtection)
+
+	r.Logger.LogCtx(ctx, "level", "warning", "message", message)
+	r.EventRecorder.Event(azureMachinePool, corev1.EventTypeWarning, ScopeLockedReason, message)
+
+	finalizerskeptcontext.SetKept(ctx)
+	resourcecanceledcontext.SetCanceled(ctx)
+	r.Logger.Debugf(ctx, "canceling resource")
+}
+
 func (r *Resource) removeSubnetFromAzureCluster(ctx context.Context, azureCluster *capzv1alpha3.AzureCluster, subnetName string) error {
 	subnetPosition := -1
 	for i, subnet := range azureCluster.Spec.NetworkSpec.Subnets {
@@ -87,15 +118,17 @@ func (r *Resource) removeSubnetFromAzureCluster(ctx context.Context, azureCluste
 	return nil
 }
 
-func (r *Resource) removeNodePool(ctx context.Context, azureMachinePool *capzexpv1alpha3.AzureMachinePool) error {
+func (r *Resource) removeNodePool(ctx context.Context, azureCluster *capzv1alpha3.AzureCluster, azureMachinePool *capzexpv1alpha3.AzureMachinePool) error {
 	var err error
 
-	err = r.deleteARMDeployment(ctx, azureMachinePool, key.ClusterID(azureMachinePool), key.NodePoolDeploymentName(azureMachinePool))
+	resourceGroupName := key.ResourceGroupNameFromAzureCluster(*azureCluster)
+
+	err = r.deleteARMDeployment(ctx, azureMachinePool, resourceGroupName, key.NodePoolDeploymentName(azureMachinePool))
 	if err != nil {
 		return microerror.Mask(err)
 	}
 
-	err = r.deleteVMSS(ctx, azureMachinePool, key.ClusterID(azureMachinePool), key.NodePoolVMSSName(azureMachinePool))
+	err = r.deleteVMSS(ctx, azureMachinePool, resourceGroupName, key.NodePoolVMSSName(azureMachinePool))
 	if err != nil {
 		return microerror.Mask(err)
 	}
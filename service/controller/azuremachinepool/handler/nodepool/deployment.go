@@ -20,12 +20,14 @@ import (
 	capiexpv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
 	"github.com/giantswarm/azure-operator/v5/pkg/helpers/vmss"
 	"github.com/giantswarm/azure-operator/v5/pkg/label"
 	"github.com/giantswarm/azure-operator/v5/pkg/project"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azuremachinepool/handler/nodepool/template"
 	"github.com/giantswarm/azure-operator/v5/service/controller/blobclient"
 	"github.com/giantswarm/azure-operator/v5/service/controller/encrypter"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/tags"
 	"github.com/giantswarm/azure-operator/v5/service/controller/internal/vmsku"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
@@ -36,8 +38,18 @@ func (r Resource) getDesiredDeployment(ctx context.Context, storageAccountsClien
 		return azureresource.Deployment{}, microerror.Mask(err)
 	}
 
+	cloudProvider, err := r.CloudProvider.ForCluster(azureCluster.Annotations)
+	if err != nil {
+		return azureresource.Deployment{}, microerror.Maskf(invalidConfigError, "AzureCluster %#q: %s", azureCluster.Name, err)
+	}
+
+	dataDisks, dataDiskMountPoints, err := newDataDisks(azureMachinePool.Spec.Template.DataDisks, azureMachinePool.Annotations[annotation.DataDiskMountPoints])
+	if err != nil {
+		return azureresource.Deployment{}, microerror.Mask(err)
+	}
+
 	storageAccountName := strings.Replace(fmt.Sprintf("%s%s", "gssa", azureCluster.GetName()), "-", "", -1)
-	workerCloudConfig, err := r.getWorkerCloudConfig(ctx, storageAccountsClient, azureCluster.GetName(), storageAccountName, key.BlobContainerName(), key.BootstrapBlobName(*azureMachinePool), encrypterObject)
+	workerCloudConfig, err := r.getWorkerCloudConfig(ctx, storageAccountsClient, azureCluster.GetName(), storageAccountName, key.BlobContainerName(), key.BootstrapBlobName(*azureMachinePool), encrypterObject, dataDisks)
 	if err != nil {
 		return azureresource.Deployment{}, microerror.Mask(err)
 	}
@@ -56,6 +68,19 @@ func (r Resource) getDesiredDeployment(ctx context.Context, storageAccountsClien
 		return azureresource.Deployment{}, microerror.Mask(err)
 	}
 
+	// The parameter is only set for virtual networks outside of the resource
+	// group of the cluster, so that existing node pools don't roll.
+	var vnetResourceGroup string
+	if key.VnetResourceGroupNameFromAzureCluster(*azureCluster) != key.ResourceGroupNameFromAzureCluster(*azureCluster) {
+		vnetResourceGroup = key.VnetResourceGroupNameFromAzureCluster(*azureCluster)
+	}
+
+	// Changing the tags doesn't roll the nodes, see template.NonRollingParameters.
+	customTags, err := tags.ForCluster(ctx, r.CtrlClient, azureCluster)
+	if err != nil {
+		return azureresource.Deployment{}, microerror.Mask(err)
+	}
+
 	currentReplicas := key.NodePoolMinReplicas(machinePool)
 	if key.NodePoolMinReplicas(machinePool) != key.NodePoolMaxReplicas(machinePool) {
 		// Autoscaler is enabled. Will need to use the current number of replicas from the VMSS if it exists.
@@ -93,16 +118,15 @@ func (r Resource) getDesiredDeployment(ctx context.Context, storageAccountsClien
 	templateParameters := template.Parameters{
 		AzureOperatorVersion:        project.Version(),
 		ClusterID:                   azureCluster.GetName(),
+		CloudProviderConfig:         cloudProvider.String(),
+		CustomTags:                  customTags,
 		DataDisks:                   azureMachinePool.Spec.Template.DataDisks,
+		DataDiskMountPoints:         dataDiskMountPoints,
 		EnableAcceleratedNetworking: enableAcceleratedNetworking,
+		EncryptionKeyID:             encrypterObject.GetEncryptionKeyID(),
 		NodepoolName:                key.NodePoolVMSSName(azureMachinePool),
 		KubernetesVersion:           kubernetesVersion,
-		OSImage: template.OSImage{
-			Publisher: "kinvolk",
-			Offer:     "flatcar-container-linux-free",
-			SKU:       "stable",
-			Version:   distroVersion,
-		},
+		OSImage:                     newOSImage(azureMachinePool, distroVersion),
 		Scaling: template.Scaling{
 			MinReplicas:     key.NodePoolMinReplicas(machinePool),
 			MaxReplicas:     key.NodePoolMaxReplicas(machinePool),
@@ -117,6 +141,7 @@ func (r Resource) getDesiredDeployment(ctx context.Context, storageAccountsClien
 		VMCustomData:       workerCloudConfig,
 		VMSize:             azureMachinePool.Spec.Template.VMSize,
 		VnetName:           vnetName,
+		VnetResourceGroup:  vnetResourceGroup,
 		Zones:              machinePool.Spec.FailureDomains,
 	}
 
@@ -128,6 +153,38 @@ func (r Resource) getDesiredDeployment(ctx context.Context, storageAccountsClien
 	return deployment, nil
 }
 
+// newDataDisks returns the filesystems to create on the given data disks of a
+// node pool and where to mount them, as well as the given mount points in
+// their canonical form, so that reordering them does not roll the nodes.
+func newDataDisks(dataDisks []capzv1alpha3.DataDisk, mountPoints string) ([]vmss.DataDisk, string, error) {
+	disks, err := vmss.WorkerDataDisks(dataDisks, mountPoints)
+	if err != nil {
+		return nil, "", microerror.Mask(err)
+	}
+
+	mountPoints, err = vmss.NormalizeMountPoints(mountPoints)
+	if err != nil {
+		return nil, "", microerror.Mask(err)
+	}
+
+	return disks, mountPoints, nil
+}
+
+// newOSImage returns the image set in the AzureMachinePool CR, Flatcar
+// Container Linux in the version of the release otherwise.
+func newOSImage(azureMachinePool *capzexpv1alpha3.AzureMachinePool, distroVersion string) template.OSImage {
+	osImage := vmss.NewNodeOSImage(azureMachinePool.Spec.Template.Image, distroVersion)
+
+	return template.OSImage{
+		ID:         osImage.ID,
+		Publisher:  osImage.Publisher,
+		Offer:      osImage.Offer,
+		SKU:        osImage.SKU,
+		Version:    osImage.Version,
+		ThirdParty: osImage.ThirdParty,
+	}
+}
+
 func (r Resource) getSubnetName(azureMachinePool *capzexpv1alpha3.AzureMachinePool, azureCluster *capzv1alpha3.AzureCluster) (string, string, error) {
 	for _, subnet := range azureCluster.Spec.NetworkSpec.Subnets {
 		if azureMachinePool.Name == subnet.Name {
@@ -142,10 +199,22 @@ func (r Resource) getSubnetName(azureMachinePool *capzexpv1alpha3.AzureMachinePo
 	return "", "", microerror.Maskf(notFoundError, "there is no allocated subnet for nodepool %#q in virtual network called %#q", azureMachinePool.Name, azureCluster.Spec.NetworkSpec.Vnet.ID)
 }
 
-func (r *Resource) getWorkerCloudConfig(ctx context.Context, storageAccountsClient *storage.AccountsClient, resourceGroupName, storageAccountName, containerName, workerBlobName string, encrypterObject encrypter.Interface) (string, error) {
-	encryptionKey := encrypterObject.GetEncryptionKey()
-	initialVector := encrypterObject.GetInitialVector()
+func (r *Resource) getWorkerCloudConfig(ctx context.Context, storageAccountsClient *storage.AccountsClient, resourceGroupName, storageAccountName, containerName, workerBlobName string, encrypterObject encrypter.Interface, dataDisks []vmss.DataDisk) (string, error) {
+	containerURL, primaryKey, err := r.getContainerURL(ctx, storageAccountsClient, resourceGroupName, storageAccountName, containerName)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	workerBlobURL, err := blobclient.GetBlobURL(workerBlobName, containerName, storageAccountName, primaryKey, containerURL)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+	return vmss.RenderCloudConfig(r.smallCloudconfigConfig(workerBlobURL, encrypterObject, dataDisks))
+}
 
+// getContainerURL returns the URL of the given blob container and the primary
+// key of the storage account it belongs to.
+func (r *Resource) getContainerURL(ctx context.Context, storageAccountsClient *storage.AccountsClient, resourceGroupName, storageAccountName, containerName string) (*azblob.ContainerURL, string, error) {
 	keys, err := storageAccountsClient.ListKeys(ctx, resourceGroupName, storageAccountName, "")
 	if err != nil {
 		var errorMessage string
@@ -156,17 +225,17 @@ func (r *Resource) getWorkerCloudConfig(ctx context.Context, storageAccountsClie
 		}
 
 		r.Logger.LogCtx(ctx, "level", "warning", "message", errorMessage)
-		return "", microerror.Mask(err)
+		return nil, "", microerror.Mask(err)
 	}
 
 	if len(*(keys.Keys)) == 0 {
-		return "", microerror.Maskf(executionFailedError, "storage account key's list is empty")
+		return nil, "", microerror.Maskf(executionFailedError, "storage account key's list is empty")
 	}
 	primaryKey := *(((*keys.Keys)[0]).Value)
 
 	sc, err := azblob.NewSharedKeyCredential(storageAccountName, primaryKey)
 	if err != nil {
-		return "", microerror.Mask(err)
+		return nil, "", microerror.Mask(err)
 	}
 
 	p := azblob.NewPipeline(sc, azblob.PipelineOptions{})
@@ -174,11 +243,7 @@ func (r *Resource) getWorkerCloudConfig(ctx context.Context, storageAccountsClie
 	serviceURL := azblob.NewServiceURL(*u, p)
 	containerURL := serviceURL.NewContainerURL(containerName)
 
-	workerBlobURL, err := blobclient.GetBlobURL(workerBlobName, containerName, storageAccountName, primaryKey, &containerURL)
-	if err != nil {
-		return "", microerror.Mask(err)
-	}
-	return vmss.RenderCloudConfig(workerBlobURL, encryptionKey, initialVector, key.PrefixWorker())
+	return &containerURL, primaryKey, nil
 }
 
 func (r *Resource) getEncrypterObject(ctx context.Context, secretName string) (encrypter.Interface, error) {
@@ -199,8 +264,9 @@ func (r *Resource) getEncrypterObject(ctx context.Context, secretName string) (e
 			return nil, microerror.Maskf(executionFailedError, "encryption iv not found in secret %q", secret.Name)
 		}
 		c := encrypter.Config{
-			Key: secret.Data[key.CertificateEncryptionKeyName],
-			IV:  secret.Data[key.CertificateEncryptionIVName],
+			Key:         secret.Data[key.CertificateEncryptionKeyName],
+			IV:          secret.Data[key.CertificateEncryptionIVName],
+			PreviousKey: secret.Data[key.CertificateEncryptionPreviousKeyName],
 		}
 
 		enc, err = encrypter.New(c)
@@ -272,3 +338,19 @@ func (r *Resource) getReleaseFromMetadata(ctx context.Context, obj metav1.Object
 
 	return release, nil
 }
+
+func (r *Resource) smallCloudconfigConfig(blobURL string, encrypterObject encrypter.Interface, dataDisks []vmss.DataDisk) vmss.SmallCloudconfigConfig {
+	return vmss.SmallCloudconfigConfig{
+		BlobURL:         blobURL,
+		DataDisks:       dataDisks,
+		EncryptionKey:   encrypterObject.GetEncryptionKey(),
+		EncryptionKeyID: encrypterObject.GetEncryptionKeyID(),
+		InitialVector:   encrypterObject.GetInitialVector(),
+		InstanceRole:    key.PrefixWorker(),
+		KeyVault:        r.Azure.KeyVaultEnabled(),
+		SpecVersion:     r.Ignition.SpecVersion,
+
+		PreviousEncryptionKey:   encrypterObject.GetPreviousEncryptionKey(),
+		PreviousEncryptionKeyID: encrypterObject.GetPreviousEncryptionKeyID(),
+	}
+}
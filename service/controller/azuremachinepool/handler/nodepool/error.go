@@ -1,6 +1,8 @@
 package nodepool
 
 import (
+	"strings"
+
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/giantswarm/microerror"
 )
@@ -26,6 +28,15 @@ var executionFailedError = &microerror.Error{
 	Kind: "executionFailedError",
 }
 
+var invalidConfigError = &microerror.Error{
+	Kind: "invalidConfigError",
+}
+
+// IsInvalidConfig asserts invalidConfigError.
+func IsInvalidConfig(err error) bool {
+	return microerror.Cause(err) == invalidConfigError
+}
+
 var deploymentNotFoundError = &microerror.Error{
 	Kind: "deploymentNotFoundError",
 }
@@ -162,3 +173,14 @@ var unexpectedUpstreamResponseError = &microerror.Error{
 func IsUnexpectedUpstreamResponse(err error) bool {
 	return microerror.Cause(err) == unexpectedUpstreamResponseError
 }
+
+// IsScopeLocked asserts the error Azure returns when deleting resources in a
+// resource group with a management lock, e.g. the one of clusters protected
+// against deletion.
+func IsScopeLocked(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return strings.Contains(microerror.Cause(err).Error(), "ScopeLocked")
+}
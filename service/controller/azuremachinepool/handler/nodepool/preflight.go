@@ -0,0 +1,134 @@
+package nodepool
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
+	capi "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capiexpv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+	capiconditions "sigs.k8s.io/cluster-api/util/conditions"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/scalestrategy"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/preflight"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+const (
+	// PreflightChecksPassedCondition is False while the VM size, zones or
+	// quotas of the node pool keep its deployment from being submitted.
+	PreflightChecksPassedCondition capi.ConditionType = "PreflightChecksPassed"
+)
+
+// runPreflightChecks validates that Azure can provide the VMs of the node
+// pool deployment which is about to be submitted. The result is reflected in
+// the PreflightChecksPassed condition, and a warning Event is emitted when the
+// checks start failing for a new reason.
+func (r *Resource) runPreflightChecks(ctx context.Context, azureMachinePool *capzexpv1alpha3.AzureMachinePool, machinePool *capiexpv1alpha3.MachinePool, vmss compute.VirtualMachineScaleSet, nodesNeedToBeRolled bool) (bool, error) {
+	r.Logger.Debugf(ctx, "running preflight checks")
+
+	skusClient, err := r.ClientFactory.GetResourceSkusClient(ctx, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	usageClient, err := r.ClientFactory.GetUsageClient(ctx, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	additional, err := additionalInstances(machinePool, vmss, nodesNeedToBeRolled, azureMachinePool.GetAnnotations()[annotation.ScaleStrategy])
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	spec := preflight.Spec{
+		VMSize:              azureMachinePool.Spec.Template.VMSize,
+		Zones:               machinePool.Spec.FailureDomains,
+		Spot:                azureMachinePool.Spec.Template.SpotVMOptions != nil,
+		AdditionalInstances: additional,
+	}
+
+	problems, err := r.preflightValidator.Validate(ctx, skusClient, usageClient, spec)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	if len(problems) == 0 {
+		if !capiconditions.IsTrue(azureMachinePool, PreflightChecksPassedCondition) {
+			err = r.setCondition(ctx, *azureMachinePool, func(cr *capzexpv1alpha3.AzureMachinePool) {
+				capiconditions.MarkTrue(cr, PreflightChecksPassedCondition)
+			})
+			if err != nil {
+				return false, microerror.Mask(err)
+			}
+		}
+
+		r.Logger.Debugf(ctx, "preflight checks passed")
+
+		return true, nil
+	}
+
+	reason := preflight.Reason(problems)
+	message := preflight.Message(problems)
+
+	r.Logger.Debugf(ctx, "preflight checks failed: %s", message)
+
+	if capiconditions.GetMessage(azureMachinePool, PreflightChecksPassedCondition) != message {
+		r.EventRecorder.Event(azureMachinePool, corev1.EventTypeWarning, reason, message)
+
+		err = r.setCondition(ctx, *azureMachinePool, func(cr *capzexpv1alpha3.AzureMachinePool) {
+			capiconditions.MarkFalse(cr, PreflightChecksPassedCondition, reason, capi.ConditionSeverityError, "%s", message)
+		})
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+	}
+
+	return false, nil
+}
+
+// additionalInstances returns the number of instances the node pool
+// deployment creates next to the running ones. Rolling the nodes adds the
+// first batch of new instances of the given scale strategy on top of the node
+// pool before old ones are terminated.
+func additionalInstances(machinePool *capiexpv1alpha3.MachinePool, vmss compute.VirtualMachineScaleSet, nodesNeedToBeRolled bool, scaleStrategy string) (int64, error) {
+	var running int64
+	if !vmss.IsHTTPStatus(http.StatusNotFound) && vmss.Sku != nil && vmss.Sku.Capacity != nil {
+		running = *vmss.Sku.Capacity
+	}
+
+	desired := int64(key.NodePoolMinReplicas(machinePool))
+	if key.NodePoolMinReplicas(machinePool) != key.NodePoolMaxReplicas(machinePool) && running > 0 {
+		// The autoscaler owns the size of the node pool.
+		desired = running
+	}
+
+	if nodesNeedToBeRolled {
+		// All running instances are old ones, see nodePoolSize.
+		poolSize := desired
+		if running > poolSize {
+			poolSize = running
+		}
+
+		// The ramp of time based strategies starts once the deployment which
+		// rolls the nodes is applied.
+		strategy, err := scalestrategy.Parse(scaleStrategy, poolSize, time.Now())
+		if err != nil {
+			return 0, microerror.Mask(err)
+		}
+
+		return poolSize + strategy.GetBatchSize(running) - running, nil
+	}
+
+	if desired > running {
+		return desired - running, nil
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,77 @@
+package nodepool
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func Test_additionalInstances(t *testing.T) {
+	testCases := []struct {
+		name                string
+		replicas            int32
+		capacity            int64
+		nodesNeedToBeRolled bool
+		scaleStrategy       string
+		expected            int64
+	}{
+		{
+			name:     "case 0: scale up without rolling",
+			replicas: 5,
+			capacity: 3,
+			expected: 2,
+		},
+		{
+			name:     "case 1: scale down without rolling",
+			replicas: 3,
+			capacity: 5,
+			expected: 0,
+		},
+		{
+			name:                "case 2: roll all nodes at once by default",
+			replicas:            10,
+			capacity:            10,
+			nodesNeedToBeRolled: true,
+			expected:            10,
+		},
+		{
+			name:                "case 3: roll nodes in batches",
+			replicas:            10,
+			capacity:            10,
+			nodesNeedToBeRolled: true,
+			scaleStrategy:       "batch:2",
+			expected:            2,
+		},
+		{
+			name:                "case 4: roll nodes in batches while scaling up",
+			replicas:            10,
+			capacity:            8,
+			nodesNeedToBeRolled: true,
+			scaleStrategy:       "batch:2",
+			expected:            4,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			vmss := compute.VirtualMachineScaleSet{
+				Sku: &compute.Sku{
+					Capacity: to.Int64Ptr(tc.capacity),
+				},
+			}
+
+			additional, err := additionalInstances(newTestMachinePool(tc.replicas), vmss, tc.nodesNeedToBeRolled, tc.scaleStrategy)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if additional != tc.expected {
+				t.Fatalf("expected %d additional instances, got %d", tc.expected, additional)
+			}
+		})
+	}
+}
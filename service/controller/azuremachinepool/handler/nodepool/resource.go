@@ -7,7 +7,9 @@ import (
 	"github.com/giantswarm/azure-operator/v5/pkg/credential"
 	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes"
 	"github.com/giantswarm/azure-operator/v5/pkg/tenantcluster"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/preflight"
 	"github.com/giantswarm/azure-operator/v5/service/controller/internal/vmsku"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/workerpool"
 )
 
 const (
@@ -18,19 +20,30 @@ type Config struct {
 	nodes.Config
 	CredentialProvider        credential.Provider
 	GSClientCredentialsConfig auth.ClientCredentialsConfig
+	PreflightValidator        *preflight.Validator
 	TenantClientFactory       tenantcluster.Factory
 	VMSKU                     *vmsku.VMSKUs
+	WorkerPool                *workerpool.Pool
 }
 
 // Resource takes care of node pool life cycle.
 type Resource struct {
 	nodes.Resource
 	CredentialProvider  credential.Provider
+	preflightValidator  *preflight.Validator
 	tenantClientFactory tenantcluster.Factory
 	vmsku               *vmsku.VMSKUs
+	workerPool          *workerpool.Pool
 }
 
 func New(config Config) (*Resource, error) {
+	if config.PreflightValidator == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.PreflightValidator must not be empty", config)
+	}
+	if config.WorkerPool == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.WorkerPool must not be empty", config)
+	}
+
 	config.Name = Name
 	nodesResource, err := nodes.New(config.Config)
 	if err != nil {
@@ -40,8 +53,10 @@ func New(config Config) (*Resource, error) {
 	r := &Resource{
 		Resource:            *nodesResource,
 		CredentialProvider:  config.CredentialProvider,
+		preflightValidator:  config.PreflightValidator,
 		tenantClientFactory: config.TenantClientFactory,
 		vmsku:               config.VMSKU,
+		workerPool:          config.WorkerPool,
 	}
 	stateMachine := r.createStateMachine()
 	r.SetStateMachine(stateMachine)
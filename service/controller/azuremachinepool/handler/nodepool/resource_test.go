@@ -0,0 +1,438 @@
+package nodepool
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/Azure/go-autorest/autorest/to"
+	corev1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/core/v1alpha1"
+	apiextensionslabels "github.com/giantswarm/apiextensions/v3/pkg/label"
+	"github.com/giantswarm/micrologger/microloggertest"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capiexpv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/giantswarm/azure-operator/v5/client"
+	azurefake "github.com/giantswarm/azure-operator/v5/client/fake"
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes"
+	"github.com/giantswarm/azure-operator/v5/pkg/label"
+	"github.com/giantswarm/azure-operator/v5/pkg/mock/mock_tenantcluster"
+	"github.com/giantswarm/azure-operator/v5/pkg/project"
+	"github.com/giantswarm/azure-operator/v5/pkg/ratelimit"
+	"github.com/giantswarm/azure-operator/v5/service/collector"
+	"github.com/giantswarm/azure-operator/v5/service/controller/azuremachinepool/handler/nodepool/template"
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/workerpool"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+const (
+	testClusterID      = "c1a2b"
+	testNamespace      = "org-giantswarm"
+	testNodePoolID     = "np1a2"
+	testOldVersion     = "5.0.0"
+	testSubscriptionID = "00000000-0000-0000-0000-000000000000"
+	testVMSKU          = "Standard_D4s_v3"
+)
+
+// testEnv is a node pool resource whose Azure API is served by the fake
+// Azure Resource Manager API in client/fake and whose management and tenant
+// cluster APIs are served by controller-runtime fake clients.
+type testEnv struct {
+	resource     *Resource
+	server       *azurefake.Server
+	ctrlClient   ctrlclient.Client
+	tenantClient ctrlclient.Client
+
+	azureMachinePool capzexpv1alpha3.AzureMachinePool
+	vmssClient       *compute.VirtualMachineScaleSetsClient
+}
+
+func newTestEnv(t *testing.T, replicas int32, tenantNodes ...corev1.Node) *testEnv {
+	t.Helper()
+
+	logger := microloggertest.New()
+	server := azurefake.NewServer()
+
+	var factory *client.Factory
+	{
+		metricsCollector, err := collector.NewAzureAPIMetricsCollector(collector.Config{Logger: logger})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rateLimiter, err := ratelimit.New(ratelimit.Config{
+			MaxDelay:   ratelimit.DefaultMaxDelay,
+			Thresholds: ratelimit.DefaultThresholds,
+			TTL:        ratelimit.DefaultTTL,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		factory, err = client.NewFactory(client.FactoryConfig{
+			AzureAPIMetrics:    metricsCollector,
+			CacheDuration:      5 * time.Minute,
+			CredentialProvider: testCredentialProvider{},
+			Logger:             logger,
+			RateLimiter:        rateLimiter,
+
+			Authorizer:     autorest.NullAuthorizer{},
+			SendDecorators: []autorest.SendDecorator{server.Decorator()},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	azureMachinePool := newTestAzureMachinePool()
+	ctrlClient := newTestCtrlClient(t, newTestCluster(), newTestAzureCluster(), newTestMachinePool(replicas), &azureMachinePool)
+
+	var tenantObjects []runtime.Object
+	for i := range tenantNodes {
+		tenantObjects = append(tenantObjects, &tenantNodes[i])
+	}
+	tenantClient := newTestCtrlClient(t, tenantObjects...)
+
+	tenantClientFactory := mock_tenantcluster.NewMockFactory(gomock.NewController(t))
+	tenantClientFactory.EXPECT().GetClient(gomock.Any(), gomock.Any()).Return(tenantClient, nil).AnyTimes()
+
+	r := &Resource{
+		Resource: nodes.Resource{
+			CtrlClient:    ctrlClient,
+			EventRecorder: record.NewFakeRecorder(100),
+			Logger:        logger,
+			ClientFactory: client.NewOrganizationFactory(client.OrganizationFactoryConfig{
+				CtrlClient: ctrlClient,
+				Factory:    factory,
+				Logger:     logger,
+			}),
+		},
+		tenantClientFactory: tenantClientFactory,
+		workerPool:          workerpool.New(2, logger),
+	}
+
+	vmssClient, err := r.ClientFactory.GetVirtualMachineScaleSetsClient(context.Background(), azureMachinePool.ObjectMeta)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testEnv{
+		resource:     r,
+		server:       server,
+		ctrlClient:   ctrlClient,
+		tenantClient: tenantClient,
+
+		azureMachinePool: azureMachinePool,
+		vmssClient:       vmssClient,
+	}
+}
+
+// ensureVMSS creates or updates the node pool VMSS with the given capacity
+// and custom data. The fake API creates and removes instances accordingly and
+// marks existing instances as outdated when the custom data changes.
+func (e *testEnv) ensureVMSS(t *testing.T, capacity int64, customData string) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	vmss := compute.VirtualMachineScaleSet{
+		Location: to.StringPtr("westeurope"),
+		Sku: &compute.Sku{
+			Name:     to.StringPtr(testVMSKU),
+			Capacity: to.Int64Ptr(capacity),
+		},
+		VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
+			VirtualMachineProfile: &compute.VirtualMachineScaleSetVMProfile{
+				OsProfile: &compute.VirtualMachineScaleSetOSProfile{
+					ComputerNamePrefix: to.StringPtr(key.NodePoolVMSSName(&e.azureMachinePool) + "-"),
+					CustomData:         to.StringPtr(customData),
+				},
+			},
+		},
+	}
+
+	res, err := e.vmssClient.CreateOrUpdate(ctx, testClusterID, key.NodePoolVMSSName(&e.azureMachinePool), vmss)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = e.vmssClient.CreateOrUpdateResponder(res.Response())
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// ensureDeployment applies the node pool deployment with the given
+// parameters.
+func (e *testEnv) ensureDeployment(t *testing.T, parameters template.Parameters) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	deploymentsClient, err := e.resource.ClientFactory.GetDeploymentsClient(ctx, e.azureMachinePool.ObjectMeta)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deployment, err := template.NewDeployment(parameters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = e.resource.CreateARMDeployment(ctx, deploymentsClient, deployment, testClusterID, key.NodePoolDeploymentName(&e.azureMachinePool))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// deploymentParameters returns the parameters of the node pool deployment.
+func (e *testEnv) deploymentParameters(t *testing.T) template.Parameters {
+	t.Helper()
+
+	ctx := context.Background()
+
+	deploymentsClient, err := e.resource.ClientFactory.GetDeploymentsClient(ctx, e.azureMachinePool.ObjectMeta)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deployment, err := deploymentsClient.Get(ctx, testClusterID, key.NodePoolDeploymentName(&e.azureMachinePool))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parameters, err := template.NewFromExtendedDeployment(deployment)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return parameters
+}
+
+// setAnnotations sets the given annotations on the AzureMachinePool CR.
+func (e *testEnv) setAnnotations(t *testing.T, annotations map[string]string) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	err := e.ctrlClient.Get(ctx, ctrlclient.ObjectKey{Namespace: testNamespace, Name: testNodePoolID}, &e.azureMachinePool)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e.azureMachinePool.Annotations = annotations
+
+	err = e.ctrlClient.Update(ctx, &e.azureMachinePool)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// getAzureMachinePool returns the AzureMachinePool CR as stored.
+func (e *testEnv) getAzureMachinePool(t *testing.T) capzexpv1alpha3.AzureMachinePool {
+	t.Helper()
+
+	var azureMachinePool capzexpv1alpha3.AzureMachinePool
+	err := e.ctrlClient.Get(context.Background(), ctrlclient.ObjectKey{Namespace: testNamespace, Name: testNodePoolID}, &azureMachinePool)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return azureMachinePool
+}
+
+// instanceIDs returns the IDs of the instances of the node pool VMSS.
+func (e *testEnv) instanceIDs(t *testing.T) []string {
+	t.Helper()
+
+	ctx := context.Background()
+
+	vmsClient, err := e.resource.ClientFactory.GetVirtualMachineScaleSetVMsClient(ctx, e.azureMachinePool.ObjectMeta)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	instances, err := e.resource.GetVMSSInstances(ctx, vmsClient, testClusterID, key.NodePoolVMSSName(&e.azureMachinePool))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for _, i := range instances {
+		ids = append(ids, *i.InstanceID)
+	}
+
+	return ids
+}
+
+// node returns the tenant cluster node of the given VMSS instance.
+func (e *testEnv) node(t *testing.T, instanceID string) corev1.Node {
+	t.Helper()
+
+	var n corev1.Node
+	err := e.tenantClient.Get(context.Background(), ctrlclient.ObjectKey{Name: key.NodePoolInstanceName(testNodePoolID, instanceID)}, &n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return n
+}
+
+// newTestNode returns the tenant cluster node of the given VMSS instance,
+// created by the operator of the given version.
+func newTestNode(instanceID string, operatorVersion string) corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: key.NodePoolInstanceName(testNodePoolID, instanceID),
+			Labels: map[string]string{
+				apiextensionslabels.MachinePool: testNodePoolID,
+				label.OperatorVersion:           operatorVersion,
+			},
+		},
+	}
+}
+
+func newOldTestNode(instanceID string) corev1.Node {
+	return newTestNode(instanceID, testOldVersion)
+}
+
+// newCordonedTestNode returns an old node which was cordoned outside of a
+// node pool roll.
+func newCordonedTestNode(instanceID string) corev1.Node {
+	n := newOldTestNode(instanceID)
+	n.Spec.Unschedulable = true
+	return n
+}
+
+// newBatchTestNode returns an old node which was cordoned as part of the
+// batch being replaced.
+func newBatchTestNode(instanceID string) corev1.Node {
+	n := newCordonedTestNode(instanceID)
+	n.Annotations = map[string]string{annotation.ScaleBatch: "true"}
+	return n
+}
+
+func newNewTestNode(instanceID string) corev1.Node {
+	return newTestNode(instanceID, project.Version())
+}
+
+func newTestAzureMachinePool() capzexpv1alpha3.AzureMachinePool {
+	return capzexpv1alpha3.AzureMachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testNodePoolID,
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				capiv1alpha3.ClusterLabelName: testClusterID,
+				label.Cluster:                 testClusterID,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: capiexpv1alpha3.GroupVersion.String(),
+					Kind:       "MachinePool",
+					Name:       testNodePoolID,
+				},
+			},
+		},
+		Spec: capzexpv1alpha3.AzureMachinePoolSpec{
+			Location: "westeurope",
+			Template: capzexpv1alpha3.AzureMachineTemplate{
+				VMSize: testVMSKU,
+			},
+		},
+	}
+}
+
+func newTestCluster() *capiv1alpha3.Cluster {
+	return &capiv1alpha3.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testClusterID,
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				capiv1alpha3.ClusterLabelName: testClusterID,
+				label.Cluster:                 testClusterID,
+			},
+		},
+	}
+}
+
+func newTestAzureCluster() *capzv1alpha3.AzureCluster {
+	return &capzv1alpha3.AzureCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testClusterID,
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				capiv1alpha3.ClusterLabelName: testClusterID,
+				label.Cluster:                 testClusterID,
+			},
+		},
+		Spec: capzv1alpha3.AzureClusterSpec{
+			ResourceGroup: testClusterID,
+		},
+	}
+}
+
+func newTestMachinePool(replicas int32) *capiexpv1alpha3.MachinePool {
+	return &capiexpv1alpha3.MachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testNodePoolID,
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				capiv1alpha3.ClusterLabelName: testClusterID,
+				label.Cluster:                 testClusterID,
+			},
+		},
+		Spec: capiexpv1alpha3.MachinePoolSpec{
+			ClusterName: testClusterID,
+			Replicas:    &replicas,
+		},
+	}
+}
+
+func newTestCtrlClient(t *testing.T, objects ...runtime.Object) ctrlclient.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+
+	schemeBuilder := runtime.SchemeBuilder{
+		capiv1alpha3.AddToScheme,
+		capiexpv1alpha3.AddToScheme,
+		capzv1alpha3.AddToScheme,
+		capzexpv1alpha3.AddToScheme,
+		corev1.AddToScheme,
+		corev1alpha1.AddToScheme,
+	}
+
+	err := schemeBuilder.AddToScheme(scheme)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return fake.NewFakeClientWithScheme(scheme, objects...)
+}
+
+type testCredentialProvider struct{}
+
+func (p testCredentialProvider) GetOrganizationAzureCredentials(ctx context.Context, credentialNamespace, credentialName string) (auth.ClientCredentialsConfig, string, string, error) {
+	return auth.ClientCredentialsConfig{}, testSubscriptionID, "", nil
+}
+
+func assertNames(t *testing.T, expected, actual []string) {
+	t.Helper()
+
+	if fmt.Sprint(expected) != fmt.Sprint(actual) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+}
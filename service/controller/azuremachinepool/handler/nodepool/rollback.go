@@ -0,0 +1,237 @@
+package nodepool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
+	capi "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capiconditions "sigs.k8s.io/cluster-api/util/conditions"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
+	"github.com/giantswarm/azure-operator/v5/pkg/helpers"
+	"github.com/giantswarm/azure-operator/v5/pkg/helpers/vmss"
+	"github.com/giantswarm/azure-operator/v5/service/controller/azuremachinepool/handler/nodepool/template"
+	"github.com/giantswarm/azure-operator/v5/service/controller/blobclient"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+const (
+	// RolledBackCondition is False while a failed upgrade of the node pool is
+	// being rolled back and True once the node pool runs the last good
+	// deployment again.
+	RolledBackCondition capi.ConditionType = "RolledBack"
+
+	RollbackInProgressReason  = "RollbackInProgress"
+	RollbackUnavailableReason = "RollbackUnavailable"
+	RolledBackReason          = "RolledBack"
+
+	rollbackSecretFingerprintKey = "fingerprint"
+	rollbackSecretParametersKey  = "parameters"
+)
+
+// rollbackStates are the states in which the node pool waits for the nodes
+// of a new deployment to become Ready. A timeout in one of them rolls the node
+// pool back to the last good deployment.
+var rollbackStates = map[state.State]bool{
+	ScaleUpWorkerVMSS:           true,
+	CordonOldWorkers:            true,
+	WaitForWorkersToBecomeReady: true,
+}
+
+// escalateStateTimeout rolls the node pool back when the nodes of a new
+// deployment did not become Ready in time and a snapshot of the last good
+// deployment exists. Any other timeout stops the node pool in StateTimedOut.
+func (r *Resource) escalateStateTimeout(ctx context.Context, obj interface{}, timedOutState state.State) (state.State, error) {
+	if !rollbackStates[timedOutState] {
+		return StateTimedOut, nil
+	}
+
+	azureMachinePool, err := key.ToAzureMachinePool(obj)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	parameters, err := r.getRollbackParameters(ctx, &azureMachinePool)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	if parameters == nil {
+		message := fmt.Sprintf("Node pool did not leave state %s in time and cannot be rolled back, there is no snapshot of a good deployment.", timedOutState)
+		r.Logger.Debugf(ctx, message)
+		r.EventRecorder.Event(&azureMachinePool, corev1.EventTypeWarning, RollbackUnavailableReason, message)
+
+		return StateTimedOut, nil
+	}
+
+	message := fmt.Sprintf("Node pool did not leave state %s in time, rolling back to the deployment of azure-operator %s.", timedOutState, parameters.AzureOperatorVersion)
+	r.Logger.Debugf(ctx, message)
+	r.EventRecorder.Event(&azureMachinePool, corev1.EventTypeWarning, RollbackInProgressReason, message)
+
+	return RollbackDeployment, nil
+}
+
+// ensureRollbackSnapshot saves the parameters of the given deployment, which
+// has been applied successfully, as the deployment to roll back to. The cloud
+// config blob is copied so that it survives updates of the bootstrap blob.
+func (r *Resource) ensureRollbackSnapshot(ctx context.Context, storageAccountsClient *storage.AccountsClient, azureMachinePool *capzexpv1alpha3.AzureMachinePool, deployment azureresource.Deployment) error {
+	parameters, err := template.NewFromDeployment(deployment)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	fingerprint, err := template.Fingerprint(parameters)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	secret := &corev1.Secret{}
+	err = r.CtrlClient.Get(ctx, ctrlclient.ObjectKey{Namespace: azureMachinePool.Namespace, Name: key.NodePoolRollbackSecretName(azureMachinePool)}, secret)
+	if apierrors.IsNotFound(err) {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.NodePoolRollbackSecretName(azureMachinePool),
+				Namespace: azureMachinePool.Namespace,
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(azureMachinePool, capzexpv1alpha3.GroupVersion.WithKind("AzureMachinePool")),
+				},
+			},
+		}
+	} else if err != nil {
+		return microerror.Mask(err)
+	} else if string(secret.Data[rollbackSecretFingerprintKey]) == fingerprint {
+		return nil
+	}
+
+	r.Logger.Debugf(ctx, "ensuring rollback snapshot of the node pool deployment")
+
+	encrypterObject, err := r.getEncrypterObject(ctx, key.CertificateEncryptionSecretName(azureMachinePool))
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	storageAccountName := key.StorageAccountName(azureMachinePool)
+	resourceGroupName, err := helpers.GetResourceGroupNameFromMetadata(ctx, r.CtrlClient, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	containerURL, primaryKey, err := r.getContainerURL(ctx, storageAccountsClient, resourceGroupName, storageAccountName, key.BlobContainerName())
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	cloudConfig, err := blobclient.GetBlockBlob(ctx, key.BootstrapBlobName(*azureMachinePool), containerURL)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	_, err = blobclient.PutBlockBlob(ctx, key.NodePoolRollbackBlobName(azureMachinePool), string(cloudConfig), containerURL)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	blobURL, err := blobclient.GetBlobURL(key.NodePoolRollbackBlobName(azureMachinePool), key.BlobContainerName(), storageAccountName, primaryKey, containerURL)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	dataDisks, _, err := newDataDisks(parameters.DataDisks, parameters.DataDiskMountPoints)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	parameters.VMCustomData, err = vmss.RenderCloudConfig(r.smallCloudconfigConfig(blobURL, encrypterObject, dataDisks))
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	data, err := json.Marshal(parameters)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	secret.Data = map[string][]byte{
+		rollbackSecretFingerprintKey: []byte(fingerprint),
+		rollbackSecretParametersKey:  data,
+	}
+
+	if secret.ResourceVersion == "" {
+		err = r.CtrlClient.Create(ctx, secret)
+	} else {
+		err = r.CtrlClient.Update(ctx, secret)
+	}
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	// A new good deployment replaces the one the node pool was rolled back to.
+	if capiconditions.Has(azureMachinePool, RolledBackCondition) {
+		err = r.setCondition(ctx, *azureMachinePool, func(cr *capzexpv1alpha3.AzureMachinePool) {
+			capiconditions.Delete(cr, RolledBackCondition)
+		})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	r.Logger.Debugf(ctx, "ensured rollback snapshot of the node pool deployment")
+
+	return nil
+}
+
+// getRollbackParameters returns the parameters of the last good deployment
+// of the node pool, or nil if there is no snapshot.
+func (r *Resource) getRollbackParameters(ctx context.Context, azureMachinePool *capzexpv1alpha3.AzureMachinePool) (*template.Parameters, error) {
+	secret := &corev1.Secret{}
+	err := r.CtrlClient.Get(ctx, ctrlclient.ObjectKey{Namespace: azureMachinePool.Namespace, Name: key.NodePoolRollbackSecretName(azureMachinePool)}, secret)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	data, ok := secret.Data[rollbackSecretParametersKey]
+	if !ok {
+		return nil, nil
+	}
+
+	var parameters template.Parameters
+	err = json.Unmarshal(data, &parameters)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return &parameters, nil
+}
+
+// isRolledBackDeployment tells whether the given deployment is the one the
+// node pool was rolled back from.
+func isRolledBackDeployment(azureMachinePool *capzexpv1alpha3.AzureMachinePool, deployment azureresource.Deployment) (bool, error) {
+	rolledBack, ok := azureMachinePool.GetAnnotations()[annotation.RolledBackDeployment]
+	if !ok {
+		return false, nil
+	}
+
+	parameters, err := template.NewFromDeployment(deployment)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	fingerprint, err := template.Fingerprint(parameters)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	return fingerprint == rolledBack, nil
+}
@@ -0,0 +1,294 @@
+package nodepool
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
+	capiconditions "sigs.k8s.io/cluster-api/util/conditions"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
+	"github.com/giantswarm/azure-operator/v5/pkg/project"
+	"github.com/giantswarm/azure-operator/v5/service/controller/azuremachinepool/handler/nodepool/template"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+func Test_escalateStateTimeout(t *testing.T) {
+	testCases := []struct {
+		name          string
+		timedOutState state.State
+		snapshot      bool
+		expectedState state.State
+	}{
+		{
+			name:          "case 0: roll back when new nodes don't become ready",
+			timedOutState: WaitForWorkersToBecomeReady,
+			snapshot:      true,
+			expectedState: RollbackDeployment,
+		},
+		{
+			name:          "case 1: time out without a snapshot of a good deployment",
+			timedOutState: WaitForWorkersToBecomeReady,
+			snapshot:      false,
+			expectedState: StateTimedOut,
+		},
+		{
+			name:          "case 2: time out when old nodes are not drained",
+			timedOutState: DrainOldWorkerNodes,
+			snapshot:      true,
+			expectedState: StateTimedOut,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			env := newTestEnv(t, 2)
+			if tc.snapshot {
+				env.ensureRollbackSnapshot(t, newTestParameters(testOldVersion))
+			}
+
+			newState, err := env.resource.escalateStateTimeout(context.Background(), &env.azureMachinePool, tc.timedOutState)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if newState != tc.expectedState {
+				t.Fatalf("expected state %#q, got %#q", tc.expectedState, newState)
+			}
+		})
+	}
+}
+
+func Test_rollbackDeploymentTransition(t *testing.T) {
+	testCases := []struct {
+		name                     string
+		deployedVersion          string
+		snapshot                 bool
+		expectedState            state.State
+		expectedVersion          string
+		expectedRollbackInstance string
+	}{
+		{
+			name:                     "case 0: apply the good deployment and record the new instances",
+			deployedVersion:          project.Version(),
+			snapshot:                 true,
+			expectedState:            RollbackNewWorkerInstances,
+			expectedVersion:          testOldVersion,
+			expectedRollbackInstance: "2,3",
+		},
+		{
+			name:                     "case 1: continue with an already applied rollback",
+			deployedVersion:          testOldVersion,
+			snapshot:                 true,
+			expectedState:            RollbackNewWorkerInstances,
+			expectedVersion:          testOldVersion,
+			expectedRollbackInstance: "",
+		},
+		{
+			name:                     "case 2: time out without a snapshot of a good deployment",
+			deployedVersion:          project.Version(),
+			snapshot:                 false,
+			expectedState:            StateTimedOut,
+			expectedVersion:          project.Version(),
+			expectedRollbackInstance: "",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			env := newTestEnv(t, 2)
+			env.ensureVMSS(t, 2, "v1")
+			env.ensureVMSS(t, 4, "v2")
+			env.ensureDeployment(t, newTestParameters(tc.deployedVersion))
+			if tc.snapshot {
+				env.ensureRollbackSnapshot(t, newTestParameters(testOldVersion))
+			}
+
+			newState, err := env.resource.rollbackDeploymentTransition(context.Background(), &env.azureMachinePool, RollbackDeployment)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if newState != tc.expectedState {
+				t.Fatalf("expected state %#q, got %#q", tc.expectedState, newState)
+			}
+
+			version := env.deploymentParameters(t).AzureOperatorVersion
+			if version != tc.expectedVersion {
+				t.Fatalf("expected deployment of version %#q, got %#q", tc.expectedVersion, version)
+			}
+
+			azureMachinePool := env.getAzureMachinePool(t)
+
+			rollbackInstances := azureMachinePool.GetAnnotations()[annotation.RollbackInstances]
+			if rollbackInstances != tc.expectedRollbackInstance {
+				t.Fatalf("expected instances %#q to be rolled back, got %#q", tc.expectedRollbackInstance, rollbackInstances)
+			}
+
+			if tc.expectedRollbackInstance != "" {
+				fingerprint, err := template.Fingerprint(newTestParameters(tc.deployedVersion))
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				rolledBack, err := isRolledBackDeployment(&azureMachinePool, newTestDeployment(t, newTestParameters(tc.deployedVersion)))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !rolledBack {
+					t.Fatalf("expected the failed deployment with fingerprint %#q to be recorded, got %#q", fingerprint, azureMachinePool.GetAnnotations()[annotation.RolledBackDeployment])
+				}
+
+				if !capiconditions.IsFalse(&azureMachinePool, RolledBackCondition) {
+					t.Fatalf("expected condition %#q to be False", RolledBackCondition)
+				}
+			}
+		})
+	}
+}
+
+func Test_rollbackNewWorkerInstancesTransition(t *testing.T) {
+	testCases := []struct {
+		name              string
+		provisioningState string
+		expectedState     state.State
+		expectedInstances []string
+		expectedUncordon  bool
+	}{
+		{
+			name:              "case 0: terminate the new instances and uncordon the old nodes",
+			provisioningState: "Succeeded",
+			expectedState:     DeploymentUninitialized,
+			expectedInstances: []string{"0", "1"},
+			expectedUncordon:  true,
+		},
+		{
+			name:              "case 1: wait for the rollback deployment",
+			provisioningState: "Running",
+			expectedState:     RollbackNewWorkerInstances,
+			expectedInstances: []string{"0", "1", "2", "3"},
+			expectedUncordon:  false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			env := newTestEnv(t, 2,
+				newBatchTestNode("0"),
+				newCordonedTestNode("1"),
+				newNewTestNode("2"),
+				newNewTestNode("3"),
+			)
+			env.ensureVMSS(t, 4, "v1")
+			env.ensureDeployment(t, newTestParameters(testOldVersion))
+			env.setAnnotations(t, map[string]string{annotation.RollbackInstances: "2,3"})
+
+			err := env.server.SetProvisioningState(testDeploymentID(&env.azureMachinePool), tc.provisioningState)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			newState, err := env.resource.rollbackNewWorkerInstancesTransition(context.Background(), &env.azureMachinePool, RollbackNewWorkerInstances)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if newState != tc.expectedState {
+				t.Fatalf("expected state %#q, got %#q", tc.expectedState, newState)
+			}
+
+			assertNames(t, tc.expectedInstances, env.instanceIDs(t))
+
+			for _, id := range []string{"0", "1"} {
+				n := env.node(t, id)
+				if n.Spec.Unschedulable == tc.expectedUncordon {
+					t.Fatalf("expected node %#q to be unschedulable %t, got %t", n.Name, !tc.expectedUncordon, n.Spec.Unschedulable)
+				}
+				if tc.expectedUncordon && isNodeInBatch(n) {
+					t.Fatalf("expected node %#q to be removed from the batch", n.Name)
+				}
+			}
+
+			if tc.expectedUncordon {
+				azureMachinePool := env.getAzureMachinePool(t)
+				if !capiconditions.IsTrue(&azureMachinePool, RolledBackCondition) {
+					t.Fatalf("expected condition %#q to be True", RolledBackCondition)
+				}
+			}
+		})
+	}
+}
+
+// ensureRollbackSnapshot saves the given parameters as the last good
+// deployment of the node pool.
+func (e *testEnv) ensureRollbackSnapshot(t *testing.T, parameters template.Parameters) {
+	t.Helper()
+
+	fingerprint, err := template.Fingerprint(parameters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(parameters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.NodePoolRollbackSecretName(&e.azureMachinePool),
+			Namespace: testNamespace,
+		},
+		Data: map[string][]byte{
+			rollbackSecretFingerprintKey: []byte(fingerprint),
+			rollbackSecretParametersKey:  data,
+		},
+	}
+
+	err = e.ctrlClient.Create(context.Background(), secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newTestParameters(version string) template.Parameters {
+	return template.Parameters{
+		AzureOperatorVersion: version,
+		ClusterID:            testClusterID,
+		DataDisks: []capzv1alpha3.DataDisk{
+			{NameSuffix: "docker", DiskSizeGB: 100, Lun: to.Int32Ptr(21)},
+		},
+		NodepoolName: testNodePoolID,
+		VMSize:       testVMSKU,
+		Zones:        []string{"1"},
+	}
+}
+
+func newTestDeployment(t *testing.T, parameters template.Parameters) azureresource.Deployment {
+	t.Helper()
+
+	deployment, err := template.NewDeployment(parameters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return deployment
+}
+
+func testDeploymentID(azureMachinePool *capzexpv1alpha3.AzureMachinePool) string {
+	return "/subscriptions/" + testSubscriptionID + "/resourceGroups/" + testClusterID + "/providers/Microsoft.Resources/deployments/" + key.NodePoolDeploymentName(azureMachinePool)
+}
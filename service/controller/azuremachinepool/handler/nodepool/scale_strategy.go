@@ -0,0 +1,105 @@
+package nodepool
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
+	capiexpv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/scalestrategy"
+	"github.com/giantswarm/azure-operator/v5/pkg/helpers"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+// getScaleStrategy returns the scale strategy selected for the node pool using
+// the annotation.ScaleStrategy annotation. Time based ramps start when the
+// node pool deployment which triggered the roll was applied.
+func (r *Resource) getScaleStrategy(ctx context.Context, azureMachinePool *capzexpv1alpha3.AzureMachinePool, poolSize int64) (scalestrategy.Interface, error) {
+	spec := azureMachinePool.GetAnnotations()[annotation.ScaleStrategy]
+
+	var rampStart time.Time
+	if strings.TrimSpace(strings.SplitN(spec, ":", 2)[0]) == scalestrategy.NameTimeRamp {
+		deploymentsClient, err := r.ClientFactory.GetDeploymentsClient(ctx, azureMachinePool.ObjectMeta)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		resourceGroupName, err := helpers.GetResourceGroupNameFromMetadata(ctx, r.CtrlClient, azureMachinePool.ObjectMeta)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		deployment, err := deploymentsClient.Get(ctx, resourceGroupName, key.NodePoolDeploymentName(azureMachinePool))
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		if deployment.Properties != nil && deployment.Properties.Timestamp != nil {
+			rampStart = deployment.Properties.Timestamp.Time
+		}
+	}
+
+	strategy, err := scalestrategy.Parse(spec, poolSize, rampStart)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return strategy, nil
+}
+
+// nodePoolSize returns the number of nodes the node pool runs outside of
+// upgrades. Old nodes are only replaced once surge capacity has been added on
+// top of it.
+func nodePoolSize(machinePool *capiexpv1alpha3.MachinePool, oldNodes int) int64 {
+	size := int64(*machinePool.Spec.Replicas)
+	if int64(oldNodes) > size {
+		size = int64(oldNodes)
+	}
+
+	return size
+}
+
+// nextBatch returns the old nodes to be replaced next. Nodes of the batch
+// being replaced are picked first so that an interrupted batch is completed
+// before a new one is started. The remaining nodes are picked ordered by name,
+// which is ordered by VMSS instance ID.
+func nextBatch(oldNodes []corev1.Node, size int64) []corev1.Node {
+	nodes := make([]corev1.Node, len(oldNodes))
+	copy(nodes, oldNodes)
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if isNodeInBatch(nodes[i]) != isNodeInBatch(nodes[j]) {
+			return isNodeInBatch(nodes[i])
+		}
+		return nodes[i].GetName() < nodes[j].GetName()
+	})
+
+	if int64(len(nodes)) > size {
+		nodes = nodes[:size]
+	}
+
+	return nodes
+}
+
+// isNodeInBatch tells whether the given node was cordoned as part of the
+// batch being replaced.
+func isNodeInBatch(n corev1.Node) bool {
+	return n.GetAnnotations()[annotation.ScaleBatch] == "true"
+}
+
+// isInstanceInBatch tells whether the given old instance belongs to the batch
+// currently being replaced. Batches are selected when cordoning old nodes, so
+// an old instance belongs to the batch when its node carries the
+// annotation.ScaleBatch annotation or when it has no node at all.
+func isInstanceInBatch(nodes map[string]corev1.Node, nodePoolId string, instance compute.VirtualMachineScaleSetVM) bool {
+	n := getK8sWorkerNodeForInstance(nodes, nodePoolId, instance)
+
+	return n == nil || isNodeInBatch(*n)
+}
@@ -8,6 +8,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
 )
 
 const (
@@ -18,13 +19,17 @@ const (
 	CordonOldWorkers            = "CordonOldWorkers"
 	DeploymentUninitialized     = ""
 	DrainOldWorkerNodes         = "DrainOldWorkerNodes"
+	Paused                      = "Paused"
 	ScaleUpWorkerVMSS           = "ScaleUpWorkerVMSS"
+	RollbackDeployment          = "RollbackDeployment"
+	RollbackNewWorkerInstances  = "RollbackNewWorkerInstances"
 	ScaleDownWorkerVMSS         = "ScaleDownWorkerVMSS"
+	StateTimedOut               = "StateTimedOut"
 	TerminateOldWorkerInstances = "TerminateOldWorkerInstances"
 	WaitForWorkersToBecomeReady = "WaitForWorkersToBecomeReady"
 )
 
-func (r *Resource) saveCurrentState(ctx context.Context, customObject v1alpha3.AzureMachinePool, state string) error {
+func (r *Resource) saveCurrentState(ctx context.Context, customObject v1alpha3.AzureMachinePool, currentState string, history state.History) error {
 	// Get the newest CR version. Otherwise status update may fail because of:
 	//
 	//	 the object has been modified; please apply your changes to the
@@ -40,7 +45,8 @@ func (r *Resource) saveCurrentState(ctx context.Context, customObject v1alpha3.A
 		azureMachinePool.Annotations = map[string]string{}
 	}
 
-	azureMachinePool.Annotations[annotation.StateMachineCurrentState] = state
+	azureMachinePool.Annotations[annotation.StateMachineCurrentState] = currentState
+	azureMachinePool.Annotations[annotation.StateMachineHistory] = history.String()
 
 	err = r.CtrlClient.Update(ctx, azureMachinePool)
 	if err != nil {
@@ -68,3 +74,21 @@ func (r *Resource) getCurrentState(ctx context.Context, customObject v1alpha3.Az
 
 	return status, nil
 }
+
+func (r *Resource) getStateHistory(ctx context.Context, customObject v1alpha3.AzureMachinePool) (state.History, error) {
+	azureMachinePool := &v1alpha3.AzureMachinePool{}
+	err := r.CtrlClient.Get(ctx, client.ObjectKey{Namespace: customObject.Namespace, Name: customObject.Name}, azureMachinePool)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	history, err := state.ParseHistory(azureMachinePool.GetAnnotations()[annotation.StateMachineHistory])
+	if state.IsInvalidHistory(err) {
+		r.Logger.Debugf(ctx, "discarding invalid state machine history")
+		return nil, nil
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return history, nil
+}
@@ -10,8 +10,12 @@ import (
 type Parameters struct {
 	AzureOperatorVersion        string
 	ClusterID                   string
+	CloudProviderConfig         string
+	CustomTags                  map[string]string
 	DataDisks                   []v1alpha3.DataDisk
+	DataDiskMountPoints         string
 	EnableAcceleratedNetworking bool
+	EncryptionKeyID             string
 	KubernetesVersion           string
 	NodepoolName                string
 	OSImage                     OSImage
@@ -22,6 +26,7 @@ type Parameters struct {
 	VMCustomData                string
 	VMSize                      string
 	VnetName                    string
+	VnetResourceGroup           string
 	Zones                       []string
 }
 
@@ -36,11 +41,15 @@ type SpotInstanceConfig struct {
 	MaxPrice string
 }
 
+// OSImage is either a Marketplace image or, when ID is set, a managed image or
+// Shared Image Gallery image version.
 type OSImage struct {
-	Publisher string
-	Offer     string
-	SKU       string
-	Version   string
+	ID         string
+	Publisher  string
+	Offer      string
+	SKU        string
+	Version    string
+	ThirdParty bool
 }
 
 func NewFromDeployment(deployment azureresource.Deployment) (Parameters, error) {
@@ -69,6 +78,11 @@ func (p Parameters) ToDeployParams() map[string]interface{} {
 		dataDisks = append(dataDisks, map[string]interface{}{"nameSuffix": disk.NameSuffix, "lun": float64(*disk.Lun), "diskSizeGB": float64(disk.DiskSizeGB)})
 	}
 
+	customTags := map[string]interface{}{}
+	for name, value := range p.CustomTags {
+		customTags[name] = value
+	}
+
 	zones := []interface{}{}
 	for _, zone := range p.Zones {
 		zones = append(zones, zone)
@@ -77,14 +91,20 @@ func (p Parameters) ToDeployParams() map[string]interface{} {
 	armDeploymentParameters := map[string]interface{}{}
 	armDeploymentParameters["azureOperatorVersion"] = toARMParam(p.AzureOperatorVersion)
 	armDeploymentParameters["clusterID"] = toARMParam(p.ClusterID)
+	armDeploymentParameters["cloudProviderConfig"] = toARMParam(p.CloudProviderConfig)
+	armDeploymentParameters["customTags"] = toARMParam(customTags)
 	armDeploymentParameters["dataDisks"] = toARMParam(dataDisks)
+	armDeploymentParameters["dataDiskMountPoints"] = toARMParam(p.DataDiskMountPoints)
 	armDeploymentParameters["enableAcceleratedNetworking"] = toARMParam(p.EnableAcceleratedNetworking)
+	armDeploymentParameters["encryptionKeyID"] = toARMParam(p.EncryptionKeyID)
 	armDeploymentParameters["kubernetesVersion"] = toARMParam(p.KubernetesVersion)
 	armDeploymentParameters["nodepoolName"] = toARMParam(p.NodepoolName)
+	armDeploymentParameters["osImageID"] = toARMParam(p.OSImage.ID)
 	armDeploymentParameters["osImagePublisher"] = toARMParam(p.OSImage.Publisher)
 	armDeploymentParameters["osImageOffer"] = toARMParam(p.OSImage.Offer)
 	armDeploymentParameters["osImageSKU"] = toARMParam(p.OSImage.SKU)
 	armDeploymentParameters["osImageVersion"] = toARMParam(p.OSImage.Version)
+	armDeploymentParameters["osImageThirdParty"] = toARMParam(p.OSImage.ThirdParty)
 	armDeploymentParameters["minReplicas"] = toARMParam(float64(p.Scaling.MinReplicas))
 	armDeploymentParameters["maxReplicas"] = toARMParam(float64(p.Scaling.MaxReplicas))
 	armDeploymentParameters["currentReplicas"] = toARMParam(float64(p.Scaling.CurrentReplicas))
@@ -95,6 +115,7 @@ func (p Parameters) ToDeployParams() map[string]interface{} {
 	armDeploymentParameters["vmCustomData"] = toARMParam(p.VMCustomData)
 	armDeploymentParameters["vmSize"] = toARMParam(p.VMSize)
 	armDeploymentParameters["vnetName"] = toARMParam(p.VnetName)
+	armDeploymentParameters["vnetResourceGroup"] = toARMParam(p.VnetResourceGroup)
 	armDeploymentParameters["zones"] = toARMParam(zones)
 
 	return armDeploymentParameters
@@ -144,6 +165,65 @@ func newParameters(parameters map[string]interface{}, cast func(param interface{
 		zones = append(zones, zone)
 	}
 
+	// Deployments created before the encryption key ID was introduced don't
+	// have the parameter.
+	var encryptionKeyID string
+	if parameters["encryptionKeyID"] != nil {
+		encryptionKeyID = cast(parameters["encryptionKeyID"]).(string)
+	}
+
+	// Deployments created before the cloud provider settings were
+	// configurable don't have the parameter.
+	var cloudProviderConfig string
+	if parameters["cloudProviderConfig"] != nil {
+		cloudProviderConfig = cast(parameters["cloudProviderConfig"]).(string)
+	}
+
+	// Deployments created before user-defined tags were introduced don't
+	// have the parameter.
+	customTags := map[string]string{}
+	if parameters["customTags"] != nil {
+		rawTags, ok := cast(parameters["customTags"]).(map[string]interface{})
+		if !ok {
+			return Parameters{}, microerror.Maskf(wrongTypeError, "customTags should be map[string]interface{}, got '%T'", cast(parameters["customTags"]))
+		}
+
+		for name, rawValue := range rawTags {
+			value, ok := rawValue.(string)
+			if !ok {
+				return Parameters{}, microerror.Maskf(wrongTypeError, "tag should be string, got '%T'", rawValue)
+			}
+
+			customTags[name] = value
+		}
+	}
+
+	// Deployments created before the mount points of data disks were
+	// configurable don't have the parameter.
+	var dataDiskMountPoints string
+	if parameters["dataDiskMountPoints"] != nil {
+		dataDiskMountPoints = cast(parameters["dataDiskMountPoints"]).(string)
+	}
+
+	// Deployments created before custom images were introduced don't have
+	// the parameters, they always use the Flatcar Marketplace image.
+	var osImageID string
+	if parameters["osImageID"] != nil {
+		osImageID = cast(parameters["osImageID"]).(string)
+	}
+	osImageThirdParty := true
+	if parameters["osImageThirdParty"] != nil {
+		osImageThirdParty = cast(parameters["osImageThirdParty"]).(bool)
+	}
+
+	// Deployments created before existing virtual networks were supported
+	// don't have the parameter, their virtual network is in the resource
+	// group of the deployment.
+	var vnetResourceGroup string
+	if parameters["vnetResourceGroup"] != nil {
+		vnetResourceGroup = cast(parameters["vnetResourceGroup"]).(string)
+	}
+
 	bidPrice := "-1"
 	if parameters["spotInstancesMaxPrice"] != nil {
 		bidPrice = cast(parameters["spotInstancesMaxPrice"]).(string)
@@ -153,14 +233,20 @@ func newParameters(parameters map[string]interface{}, cast func(param interface{
 	return Parameters{
 		AzureOperatorVersion:        cast(parameters["azureOperatorVersion"]).(string),
 		ClusterID:                   cast(parameters["clusterID"]).(string),
+		CloudProviderConfig:         cloudProviderConfig,
+		CustomTags:                  customTags,
 		DataDisks:                   dataDisks,
+		DataDiskMountPoints:         dataDiskMountPoints,
 		EnableAcceleratedNetworking: cast(parameters["enableAcceleratedNetworking"]).(bool),
+		EncryptionKeyID:             encryptionKeyID,
 		NodepoolName:                cast(parameters["nodepoolName"]).(string),
 		OSImage: OSImage{
-			Publisher: cast(parameters["osImagePublisher"]).(string),
-			Offer:     cast(parameters["osImageOffer"]).(string),
-			SKU:       cast(parameters["osImageSKU"]).(string),
-			Version:   cast(parameters["osImageVersion"]).(string),
+			ID:         osImageID,
+			Publisher:  cast(parameters["osImagePublisher"]).(string),
+			Offer:      cast(parameters["osImageOffer"]).(string),
+			SKU:        cast(parameters["osImageSKU"]).(string),
+			Version:    cast(parameters["osImageVersion"]).(string),
+			ThirdParty: osImageThirdParty,
 		},
 		Scaling: Scaling{
 			MinReplicas:     int32(cast(parameters["minReplicas"]).(float64)),
@@ -174,10 +260,11 @@ func newParameters(parameters map[string]interface{}, cast func(param interface{
 		StorageAccountType: cast(parameters["storageAccountType"]).(string),
 		SubnetName:         cast(parameters["subnetName"]).(string),
 		// It comes empty from Azure API.
-		VMCustomData: "",
-		VMSize:       cast(parameters["vmSize"]).(string),
-		VnetName:     cast(parameters["vnetName"]).(string),
-		Zones:        zones,
+		VMCustomData:      "",
+		VMSize:            cast(parameters["vmSize"]).(string),
+		VnetName:          cast(parameters["vnetName"]).(string),
+		VnetResourceGroup: vnetResourceGroup,
+		Zones:             zones,
 	}, nil
 }
 
@@ -1,7 +1,9 @@
 package template
 
 import (
+	"crypto/sha256"
 	"encoding/json"
+	"fmt"
 	"reflect"
 
 	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
@@ -41,6 +43,44 @@ func NewDeployment(templateParams Parameters) (azureresource.Deployment, error)
 	}, nil
 }
 
+// NonRollingParameters are the deployment parameters which are applied to
+// the VMSS without rolling its nodes.
+var NonRollingParameters = []string{
+	"customTags",
+	"scaling",
+}
+
+// parameter is a deployment parameter named like in the ARM template.
+type parameter struct {
+	Name  string
+	Value interface{}
+}
+
+// comparedParameters returns the parameters which tell whether deployments
+// are in sync. The field `VMCustomData` is not taken in consideration because
+// it comes empty from Azure. That's ok because changing `VMCustomData` would
+// mean changing the `AzureOperatorVersion` or the `EncryptionKeyID` field.
+func comparedParameters(parameters Parameters) []parameter {
+	return []parameter{
+		{Name: "azureOperatorVersion", Value: parameters.AzureOperatorVersion},
+		{Name: "clusterID", Value: parameters.ClusterID},
+		{Name: "cloudProviderConfig", Value: parameters.CloudProviderConfig},
+		{Name: "dataDiskMountPoints", Value: parameters.DataDiskMountPoints},
+		{Name: "encryptionKeyID", Value: parameters.EncryptionKeyID},
+		{Name: "kubernetesVersion", Value: parameters.KubernetesVersion},
+		{Name: "nodepoolName", Value: parameters.NodepoolName},
+		{Name: "subnetName", Value: parameters.SubnetName},
+		{Name: "vmSize", Value: parameters.VMSize},
+		{Name: "vnetName", Value: parameters.VnetName},
+		{Name: "vnetResourceGroup", Value: parameters.VnetResourceGroup},
+		{Name: "customTags", Value: parameters.CustomTags},
+		{Name: "dataDisks", Value: parameters.DataDisks},
+		{Name: "scaling", Value: parameters.Scaling},
+		{Name: "osImage", Value: parameters.OSImage},
+		{Name: "zones", Value: parameters.Zones},
+	}
+}
+
 func Diff(currentDeployment azureresource.DeploymentExtended, desiredDeployment azureresource.Deployment) ([]string, error) {
 	var changes []string
 
@@ -54,42 +94,43 @@ func Diff(currentDeployment azureresource.DeploymentExtended, desiredDeployment
 		return changes, microerror.Mask(err)
 	}
 
-	// If any of the following fields change, it means the deployments are not in sync.
-	// We are not taking the field `VMCustomData` in consideration because it comes empty from Azure.
-	// That's ok because changing `VMCustomData` would mean changing the `AzureOperatorVersion` field.
-	if currentParameters.AzureOperatorVersion != desiredParameters.AzureOperatorVersion {
-		changes = append(changes, "azureOperatorVersion")
-	}
-	if currentParameters.ClusterID != desiredParameters.ClusterID {
-		changes = append(changes, "clusterID")
-	}
-	if currentParameters.KubernetesVersion != desiredParameters.KubernetesVersion {
-		changes = append(changes, "kubernetesVersion")
-	}
-	if currentParameters.NodepoolName != desiredParameters.NodepoolName {
-		changes = append(changes, "nodepoolName")
-	}
-	if currentParameters.SubnetName != desiredParameters.SubnetName {
-		changes = append(changes, "subnetName")
-	}
-	if currentParameters.VMSize != desiredParameters.VMSize {
-		changes = append(changes, "vmSize")
-	}
-	if currentParameters.VnetName != desiredParameters.VnetName {
-		changes = append(changes, "vnetName")
+	// If any of the compared fields change, it means the deployments are not
+	// in sync.
+	current := comparedParameters(currentParameters)
+	desired := comparedParameters(desiredParameters)
+	for i := range current {
+		if !reflect.DeepEqual(current[i].Value, desired[i].Value) {
+			changes = append(changes, current[i].Name)
+		}
 	}
-	if !reflect.DeepEqual(currentParameters.DataDisks, desiredParameters.DataDisks) {
-		changes = append(changes, "dataDisks")
-	}
-	if !reflect.DeepEqual(currentParameters.Scaling, desiredParameters.Scaling) {
-		changes = append(changes, "scaling")
-	}
-	if !reflect.DeepEqual(currentParameters.OSImage, desiredParameters.OSImage) {
-		changes = append(changes, "osImage")
+
+	return changes, nil
+}
+
+// Fingerprint returns a hash of the parameters which roll the nodes when they
+// change. These are the parameters compared by Diff, except
+// NonRollingParameters.
+func Fingerprint(parameters Parameters) (string, error) {
+	var rolling []parameter
+	for _, p := range comparedParameters(parameters) {
+		if !contains(NonRollingParameters, p.Name) {
+			rolling = append(rolling, p)
+		}
 	}
-	if !reflect.DeepEqual(currentParameters.Zones, desiredParameters.Zones) {
-		changes = append(changes, "zones")
+
+	b, err := json.Marshal(rolling)
+	if err != nil {
+		return "", microerror.Mask(err)
 	}
 
-	return changes, nil
+	return fmt.Sprintf("%x", sha256.Sum256(b)), nil
+}
+
+func contains(s []string, e string) bool {
+	for _, a := range s {
+		if a == e {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,213 @@
+package nodepool
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
+	capi "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util"
+	capiconditions "sigs.k8s.io/cluster-api/util/conditions"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes"
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes/state"
+	"github.com/giantswarm/azure-operator/v5/pkg/helpers"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+const (
+	// UpgradeProgressingCondition is False while the node pool state machine
+	// is stuck because a state timed out.
+	UpgradeProgressingCondition capi.ConditionType = "UpgradeProgressing"
+
+	StateTimedOutReason = "StateTimedOut"
+	ResumedReason       = "Resumed"
+)
+
+func (r *Resource) newTimeoutHook() state.TimeoutHook {
+	return state.TimeoutHook{
+		Deadlines:        r.stateDeadlines,
+		FailureState:     StateTimedOut,
+		ResumeAnnotation: annotation.StateMachineResume,
+		Escalate:         r.escalateStateTimeout,
+		OnTimeout:        r.onStateTimeout,
+		OnResume:         r.onStateResume,
+	}
+}
+
+// stateTimedOutTransition keeps the node pool in StateTimedOut until the
+// timeout hook resumes the state machine.
+func (r *Resource) stateTimedOutTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
+	r.Logger.Debugf(ctx, "state machine timed out, set annotation %#q to the current time to resume", annotation.StateMachineResume)
+	return currentState, nil
+}
+
+// stateDeadlines returns the deadlines configured on the AzureMachinePool CR using
+// the annotation.StateMachineDeadlines annotation. Deadlines are opt-in,
+// since how long rolling nodes takes depends on the size of the cluster, so
+// no state times out without the annotation.
+func (r *Resource) stateDeadlines(obj interface{}) (map[state.State]time.Duration, error) {
+	azureMachinePool, err := key.ToAzureMachinePool(obj)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	deadlines, err := state.ParseDeadlines(azureMachinePool.GetAnnotations()[annotation.StateMachineDeadlines])
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return deadlines, nil
+}
+
+func (r *Resource) onStateTimeout(ctx context.Context, obj interface{}, timedOutState state.State, deadline time.Duration) error {
+	azureMachinePool, err := key.ToAzureMachinePool(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	instanceIDs, err := r.blockingInstanceIDs(ctx, &azureMachinePool, timedOutState)
+	if err != nil {
+		// The timeout is reported anyway, only without the blocking instances.
+		r.Logger.Errorf(ctx, err, "failed to find instances blocking state %#q", timedOutState)
+	}
+
+	message := fmt.Sprintf(
+		"Node pool did not leave state %s within %s, blocking instances: %s. Set annotation %s to the current time to resume.",
+		timedOutState,
+		deadline,
+		nodes.FormatInstanceIDs(instanceIDs),
+		annotation.StateMachineResume,
+	)
+
+	r.Logger.Debugf(ctx, message)
+	r.EventRecorder.Event(&azureMachinePool, corev1.EventTypeWarning, StateTimedOutReason, message)
+
+	err = r.setCondition(ctx, azureMachinePool, func(cr *capzexpv1alpha3.AzureMachinePool) {
+		capiconditions.MarkFalse(cr, UpgradeProgressingCondition, StateTimedOutReason, capi.ConditionSeverityError, "%s", message)
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *Resource) onStateResume(ctx context.Context, obj interface{}, resumedState state.State) error {
+	azureMachinePool, err := key.ToAzureMachinePool(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	message := fmt.Sprintf("Resuming node pool state machine in state %s.", resumedState)
+
+	r.Logger.Debugf(ctx, message)
+	r.EventRecorder.Event(&azureMachinePool, corev1.EventTypeNormal, ResumedReason, message)
+
+	err = r.setCondition(ctx, azureMachinePool, func(cr *capzexpv1alpha3.AzureMachinePool) {
+		capiconditions.MarkTrue(cr, UpgradeProgressingCondition)
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// setCondition applies mark to the latest version of the AzureMachinePool CR
+// and updates its status.
+func (r *Resource) setCondition(ctx context.Context, customObject capzexpv1alpha3.AzureMachinePool, mark func(*capzexpv1alpha3.AzureMachinePool)) error {
+	// Get the newest CR version, the reconciled object may be outdated.
+	azureMachinePool := &capzexpv1alpha3.AzureMachinePool{}
+	err := r.CtrlClient.Get(ctx, ctrlclient.ObjectKey{Namespace: customObject.Namespace, Name: customObject.Name}, azureMachinePool)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	mark(azureMachinePool)
+
+	err = r.CtrlClient.Status().Update(ctx, azureMachinePool)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// blockingInstanceIDs returns the IDs of the VMSS instances which keep the
+// node pool in the given state. These are the instances of the current batch
+// when draining and terminating old nodes, and the instances which are not
+// provisioned or whose nodes are not Ready otherwise.
+func (r *Resource) blockingInstanceIDs(ctx context.Context, azureMachinePool *capzexpv1alpha3.AzureMachinePool, s state.State) ([]string, error) {
+	cluster, err := util.GetClusterFromMetadata(ctx, r.CtrlClient, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	tenantClusterK8sClient, err := r.tenantClientFactory.GetClient(ctx, cluster)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	virtualMachineScaleSetsClient, err := r.ClientFactory.GetVirtualMachineScaleSetsClient(ctx, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	virtualMachineScaleSetVMsClient, err := r.ClientFactory.GetVirtualMachineScaleSetVMsClient(ctx, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	resourceGroupName, err := helpers.GetResourceGroupNameFromMetadata(ctx, r.CtrlClient, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	vmss, err := virtualMachineScaleSetsClient.Get(ctx, resourceGroupName, key.NodePoolVMSSName(azureMachinePool))
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	instances, err := r.GetVMSSInstances(ctx, virtualMachineScaleSetVMsClient, resourceGroupName, key.NodePoolVMSSName(azureMachinePool))
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	nodes, err := listWorkerNodes(ctx, tenantClusterK8sClient, azureMachinePool.Name)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var ids []string
+	for _, i := range instances {
+		var blocking bool
+
+		switch s {
+		case DrainOldWorkerNodes, TerminateOldWorkerInstances:
+			old := isWorkerInstanceFromPreviousRelease(nodes, azureMachinePool.Name, i, vmss)
+			if old != nil && *old {
+				blocking = isInstanceInBatch(nodes, azureMachinePool.Name, i)
+			}
+		default:
+			if i.ProvisioningState == nil || !key.IsSucceededProvisioningState(*i.ProvisioningState) {
+				blocking = true
+				break
+			}
+
+			n := getK8sWorkerNodeForInstance(nodes, azureMachinePool.Name, i)
+			blocking = n == nil || !isReady(*n)
+		}
+
+		if blocking {
+			ids = append(ids, *i.InstanceID)
+		}
+	}
+
+	return ids, nil
+}
@@ -331,6 +331,7 @@ func (r *Resource) createIgnitionBlob(ctx context.Context, cluster *capiv1alpha3
 		c := cloudconfig.Config{
 			Azure:                  r.azure,
 			AzureClientCredentials: organizationAzureClientCredentialsConfig,
+			CloudProvider:          r.cloudProvider,
 			CtrlClient:             r.ctrlClient,
 			DockerhubToken:         r.dockerhubToken,
 			Logger:                 r.logger,
@@ -379,6 +380,7 @@ func (r *Resource) createIgnitionBlob(ctx context.Context, cluster *capiv1alpha3
 			return nil, microerror.Mask(err)
 		}
 		ignitionTemplateData = cloudconfig.IgnitionTemplateData{
+			AzureCluster:     azureCluster,
 			AzureMachinePool: azureMachinePool,
 			CustomObject:     mappedAzureConfig,
 			Images:           images,
@@ -36,6 +36,7 @@ type Config struct {
 	CalicoSubnet        string
 	CertsSearcher       certs.Interface
 	ClusterIPRange      string
+	CloudProvider       setting.CloudProvider
 	CredentialProvider  credential.Provider
 	CtrlClient          client.Client
 	DockerhubToken      string
@@ -58,6 +59,7 @@ type Resource struct {
 	calicoSubnet        string
 	certsSearcher       certs.Interface
 	clusterIPRange      string
+	cloudProvider       setting.CloudProvider
 	credentialProvider  credential.Provider
 	ctrlClient          client.Client
 	dockerhubToken      string
@@ -133,6 +135,7 @@ func New(config Config) (*Resource, error) {
 		calicoSubnet:        config.CalicoSubnet,
 		certsSearcher:       config.CertsSearcher,
 		clusterIPRange:      config.ClusterIPRange,
+		cloudProvider:       config.CloudProvider,
 		credentialProvider:  config.CredentialProvider,
 		ctrlClient:          config.CtrlClient,
 		dockerhubToken:      config.DockerhubToken,
@@ -171,8 +174,9 @@ func (r *Resource) toEncrypterObject(ctx context.Context, secretName string) (en
 			return nil, microerror.Maskf(invalidConfigError, "encryption iv not found in secret %q", secret.Name)
 		}
 		c := encrypter.Config{
-			Key: secret.Data[key.CertificateEncryptionKeyName],
-			IV:  secret.Data[key.CertificateEncryptionIVName],
+			Key:         secret.Data[key.CertificateEncryptionKeyName],
+			IV:          secret.Data[key.CertificateEncryptionIVName],
+			PreviousKey: secret.Data[key.CertificateEncryptionPreviousKeyName],
 		}
 
 		enc, err = encrypter.New(c)
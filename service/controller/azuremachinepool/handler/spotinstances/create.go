@@ -0,0 +1,38 @@
+package spotinstances
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	azureMachinePool, err := key.ToAzureMachinePool(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	var missing int32
+	if isSpot(&azureMachinePool) {
+		missing, err = r.ensureEvictedInstancesReplaced(ctx, &azureMachinePool)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	// The fallback is also reconciled for node pools which do not use spot
+	// instances anymore, so that replicas added on their behalf are removed.
+	err = r.ensureFallback(ctx, &azureMachinePool, missing)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func isSpot(azureMachinePool *capzexpv1alpha3.AzureMachinePool) bool {
+	return azureMachinePool.Spec.Template.SpotVMOptions != nil
+}
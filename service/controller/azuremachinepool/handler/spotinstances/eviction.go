@@ -0,0 +1,164 @@
+package spotinstances
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/helpers"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+const (
+	// SpotInstancesEvictedReason is the reason of the events emitted when
+	// evicted spot instances are requested again.
+	SpotInstancesEvictedReason = "SpotInstancesEvicted"
+
+	powerStateDeallocated = "PowerState/deallocated"
+	provisioningSucceeded = "Succeeded"
+)
+
+// ensureEvictedInstancesReplaced scales the VMSS of the given spot node pool
+// back up to its minimum number of replicas. The VMSS evicts instances with
+// the Delete policy, so Azure deletes evicted instances and lowers the
+// capacity of the VMSS. Setting the capacity again makes Azure request their
+// capacity again. It returns the number of instances the node pool runs less
+// than its minimum number of replicas.
+func (r *Resource) ensureEvictedInstancesReplaced(ctx context.Context, azureMachinePool *capzexpv1alpha3.AzureMachinePool) (int32, error) {
+	machinePool, err := r.getOwnerMachinePool(ctx, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return 0, microerror.Mask(err)
+	}
+	if machinePool == nil {
+		r.logger.Debugf(ctx, "node pool has no MachinePool yet")
+		return 0, nil
+	}
+
+	vmssClient, err := r.clientFactory.GetVirtualMachineScaleSetsClient(ctx, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return 0, microerror.Mask(err)
+	}
+	vmssVMsClient, err := r.clientFactory.GetVirtualMachineScaleSetVMsClient(ctx, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return 0, microerror.Mask(err)
+	}
+
+	resourceGroupName, err := helpers.GetResourceGroupNameFromMetadata(ctx, r.ctrlClient, azureMachinePool.ObjectMeta)
+	if err != nil {
+		return 0, microerror.Mask(err)
+	}
+	vmssName := key.NodePoolVMSSName(azureMachinePool)
+
+	vmss, err := vmssClient.Get(ctx, resourceGroupName, vmssName)
+	if IsNotFound(err) {
+		r.logger.Debugf(ctx, "VMSS %#q not found", vmssName)
+		return 0, nil
+	} else if err != nil {
+		return 0, microerror.Mask(err)
+	}
+
+	var instances []compute.VirtualMachineScaleSetVM
+	{
+		iterator, err := vmssVMsClient.ListComplete(ctx, resourceGroupName, vmssName, "", "", "instanceView")
+		if err != nil {
+			return 0, microerror.Mask(err)
+		}
+
+		for iterator.NotDone() {
+			instances = append(instances, iterator.Value())
+
+			err = iterator.NextWithContext(ctx)
+			if err != nil {
+				return 0, microerror.Mask(err)
+			}
+		}
+	}
+
+	// Instances are only ever missing from a spot VMSS below its minimum
+	// number of replicas because they were evicted. Setting the capacity
+	// again is idempotent, unlike adding to it.
+	minReplicas := key.NodePoolMinReplicas(machinePool)
+	evicted := evictedInstances(minReplicas, instances)
+	if evicted > 0 {
+		r.logger.Debugf(ctx, "requesting %d evicted spot instances of VMSS %#q again", evicted, vmssName)
+
+		capacity := int64(minReplicas)
+		if vmss.Sku != nil && vmss.Sku.Capacity != nil && *vmss.Sku.Capacity > capacity {
+			capacity = *vmss.Sku.Capacity
+		}
+		update := compute.VirtualMachineScaleSetUpdate{
+			Sku: &compute.Sku{
+				Capacity: &capacity,
+			},
+		}
+
+		res, err := vmssClient.Update(ctx, resourceGroupName, vmssName, update)
+		if err != nil {
+			return 0, microerror.Mask(err)
+		}
+		_, err = vmssClient.UpdateResponder(res.Response())
+		if err != nil {
+			return 0, microerror.Mask(err)
+		}
+
+		r.eventRecorder.Eventf(azureMachinePool, corev1.EventTypeWarning, SpotInstancesEvictedReason, "Requested %d evicted spot instances of VMSS %s again", evicted, vmssName)
+		reportEvictions(key.ClusterID(azureMachinePool), azureMachinePool.Name, int(evicted))
+
+		r.logger.Debugf(ctx, "requested %d evicted spot instances of VMSS %#q again", evicted, vmssName)
+	}
+
+	return missingReplicas(minReplicas, runningInstances(instances)), nil
+}
+
+// evictedInstances returns the number of instances the given spot instances
+// of a VMSS are less than the given minimum number of replicas.
+func evictedInstances(minReplicas int32, instances []compute.VirtualMachineScaleSetVM) int32 {
+	if int32(len(instances)) >= minReplicas {
+		return 0
+	}
+
+	return minReplicas - int32(len(instances))
+}
+
+// runningInstances returns the number of the given instances which are
+// provisioned and not evicted.
+func runningInstances(instances []compute.VirtualMachineScaleSetVM) int32 {
+	var running int32
+	for _, instance := range instances {
+		if instance.ProvisioningState == nil || *instance.ProvisioningState != provisioningSucceeded {
+			continue
+		}
+		if hasStatus(instance, powerStateDeallocated) {
+			continue
+		}
+
+		running++
+	}
+
+	return running
+}
+
+func missingReplicas(minReplicas int32, running int32) int32 {
+	if running >= minReplicas {
+		return 0
+	}
+
+	return minReplicas - running
+}
+
+func hasStatus(instance compute.VirtualMachineScaleSetVM, code string) bool {
+	if instance.VirtualMachineScaleSetVMProperties == nil || instance.InstanceView == nil || instance.InstanceView.Statuses == nil {
+		return false
+	}
+
+	for _, status := range *instance.InstanceView.Statuses {
+		if status.Code != nil && *status.Code == code {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,263 @@
+package spotinstances
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"time"
+
+	apiextensionsannotations "github.com/giantswarm/apiextensions/v3/pkg/annotation"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	expcapiv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+const (
+	// SpotFallbackScaledReason is the reason of the events emitted when the
+	// fallback node pool of a spot node pool is scaled.
+	SpotFallbackScaledReason = "SpotFallbackScaled"
+
+	defaultFallbackAfter = 10 * time.Minute
+)
+
+// ensureFallback adds the given number of replicas a spot node pool is missing
+// to its on-demand fallback node pool once spot capacity is unavailable for
+// longer than the configured duration, and removes them again once spot
+// capacity is available.
+func (r *Resource) ensureFallback(ctx context.Context, azureMachinePool *capzexpv1alpha3.AzureMachinePool, missing int32) error {
+	fallbackNodePool := azureMachinePool.Annotations[annotation.SpotFallbackNodePool]
+
+	fallbackAfter := defaultFallbackAfter
+	if v, ok := azureMachinePool.Annotations[annotation.SpotFallbackAfter]; ok {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return microerror.Maskf(invalidConfigError, "annotation %#q must be a positive duration, got %#q", annotation.SpotFallbackAfter, v)
+		}
+		fallbackAfter = d
+	}
+
+	var unavailableSince time.Time
+	if v, ok := azureMachinePool.Annotations[annotation.SpotCapacityUnavailableSince]; ok {
+		t, err := time.Parse(time.RFC3339, v)
+		if err == nil {
+			unavailableSince = t
+		}
+	}
+
+	now := time.Now().UTC()
+	if missing > 0 && unavailableSince.IsZero() {
+		unavailableSince = now
+	} else if missing == 0 {
+		unavailableSince = time.Time{}
+	}
+
+	if fallbackNodePool != "" {
+		machinePool, err := r.getFallbackMachinePool(ctx, azureMachinePool, fallbackNodePool)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		current := addedReplicas(machinePool, azureMachinePool.Name)
+		desired := fallbackReplicas(now, unavailableSince, fallbackAfter, missing, current)
+
+		if desired != current {
+			err = r.scaleFallbackNodePool(ctx, machinePool, azureMachinePool.Name, current, desired)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			if desired > current {
+				r.eventRecorder.Eventf(azureMachinePool, corev1.EventTypeNormal, SpotFallbackScaledReason, "Added %d replicas to on-demand node pool %s, spot capacity is unavailable since %s", desired-current, fallbackNodePool, unavailableSince.Format(time.RFC3339))
+			} else {
+				r.eventRecorder.Eventf(azureMachinePool, corev1.EventTypeNormal, SpotFallbackScaledReason, "Removed %d replicas from on-demand node pool %s, spot capacity is available again", current-desired, fallbackNodePool)
+			}
+		}
+
+		reportFallbackReplicas(key.ClusterID(azureMachinePool), azureMachinePool.Name, fallbackNodePool, desired)
+	}
+
+	// Record since when spot capacity is unavailable on the AzureMachinePool
+	// CR.
+	{
+		original := azureMachinePool.DeepCopy()
+
+		if azureMachinePool.Annotations == nil && !unavailableSince.IsZero() {
+			azureMachinePool.Annotations = map[string]string{}
+		}
+		setAnnotation(azureMachinePool.Annotations, annotation.SpotCapacityUnavailableSince, !unavailableSince.IsZero(), unavailableSince.Format(time.RFC3339))
+
+		if !reflect.DeepEqual(original.Annotations, azureMachinePool.Annotations) {
+			err := r.ctrlClient.Patch(ctx, azureMachinePool, ctrlclient.MergeFrom(original))
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ensureFallbackRemoved removes the replicas added to the fallback node pool
+// on behalf of the given spot node pool.
+func (r *Resource) ensureFallbackRemoved(ctx context.Context, azureMachinePool *capzexpv1alpha3.AzureMachinePool) error {
+	fallbackNodePool := azureMachinePool.Annotations[annotation.SpotFallbackNodePool]
+	if fallbackNodePool == "" {
+		return nil
+	}
+
+	machinePool, err := r.getFallbackMachinePool(ctx, azureMachinePool, fallbackNodePool)
+	if apierrors.IsNotFound(microerror.Cause(err)) {
+		r.logger.Debugf(ctx, "fallback node pool %#q not found", fallbackNodePool)
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	current := addedReplicas(machinePool, azureMachinePool.Name)
+	if current == 0 {
+		return nil
+	}
+
+	err = r.scaleFallbackNodePool(ctx, machinePool, azureMachinePool.Name, current, 0)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.eventRecorder.Eventf(azureMachinePool, corev1.EventTypeNormal, SpotFallbackScaledReason, "Removed %d replicas from on-demand node pool %s, spot node pool is deleted", current, fallbackNodePool)
+
+	return nil
+}
+
+// fallbackReplicas returns the number of replicas the fallback node pool
+// should get on behalf of a spot node pool which misses the given number of
+// replicas since the given time. Replicas are only added once spot capacity
+// is unavailable for longer than fallbackAfter, and removed as soon as spot
+// instances come back.
+func fallbackReplicas(now time.Time, unavailableSince time.Time, fallbackAfter time.Duration, missing int32, current int32) int32 {
+	if missing <= 0 {
+		return 0
+	}
+	if unavailableSince.IsZero() || now.Sub(unavailableSince) < fallbackAfter {
+		if current > missing {
+			return missing
+		}
+		return current
+	}
+
+	return missing
+}
+
+// getFallbackMachinePool returns the MachinePool CR of the given on-demand
+// fallback node pool of the same cluster as the given spot node pool.
+func (r *Resource) getFallbackMachinePool(ctx context.Context, azureMachinePool *capzexpv1alpha3.AzureMachinePool, name string) (*expcapiv1alpha3.MachinePool, error) {
+	fallbackAzureMachinePool := &capzexpv1alpha3.AzureMachinePool{}
+	err := r.ctrlClient.Get(ctx, ctrlclient.ObjectKey{Namespace: azureMachinePool.Namespace, Name: name}, fallbackAzureMachinePool)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	if key.ClusterID(fallbackAzureMachinePool) != key.ClusterID(azureMachinePool) {
+		return nil, microerror.Maskf(invalidConfigError, "fallback node pool %#q must belong to cluster %#q", name, key.ClusterID(azureMachinePool))
+	}
+	if isSpot(fallbackAzureMachinePool) {
+		return nil, microerror.Maskf(invalidConfigError, "fallback node pool %#q must not use spot instances", name)
+	}
+
+	machinePool := &expcapiv1alpha3.MachinePool{}
+	err = r.ctrlClient.Get(ctx, ctrlclient.ObjectKey{Namespace: azureMachinePool.Namespace, Name: name}, machinePool)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	if machinePool.Labels[capiv1alpha3.ClusterLabelName] != azureMachinePool.Labels[capiv1alpha3.ClusterLabelName] {
+		return nil, microerror.Maskf(invalidConfigError, "fallback node pool %#q must belong to cluster %#q", name, key.ClusterID(azureMachinePool))
+	}
+
+	return machinePool, nil
+}
+
+// scaleFallbackNodePool changes the replicas the given MachinePool got on
+// behalf of the given spot node pool from current to desired. The replicas
+// and their record on the MachinePool CR are written in one patch, so that
+// retrying after a failure does not add or remove them twice.
+func (r *Resource) scaleFallbackNodePool(ctx context.Context, machinePool *expcapiv1alpha3.MachinePool, spotNodePool string, current int32, desired int32) error {
+	r.logger.Debugf(ctx, "scaling fallback node pool %#q by %d replicas", machinePool.Name, desired-current)
+
+	original := machinePool.DeepCopy()
+	addReplicas(machinePool, desired-current)
+
+	if machinePool.Annotations == nil {
+		machinePool.Annotations = map[string]string{}
+	}
+	setAnnotation(machinePool.Annotations, addedReplicasAnnotation(spotNodePool), desired > 0, strconv.Itoa(int(desired)))
+
+	err := r.ctrlClient.Patch(ctx, machinePool, ctrlclient.MergeFrom(original))
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "scaled fallback node pool %#q by %d replicas", machinePool.Name, desired-current)
+
+	return nil
+}
+
+// addedReplicas returns the number of replicas the given fallback MachinePool
+// got on behalf of the given spot node pool.
+func addedReplicas(machinePool *expcapiv1alpha3.MachinePool, spotNodePool string) int32 {
+	n, err := strconv.Atoi(machinePool.Annotations[addedReplicasAnnotation(spotNodePool)])
+	if err != nil || n < 0 {
+		return 0
+	}
+
+	return int32(n)
+}
+
+// addedReplicasAnnotation returns the annotation of fallback MachinePool CRs
+// recording the replicas added on behalf of the given spot node pool.
+func addedReplicasAnnotation(spotNodePool string) string {
+	return annotation.SpotFallbackReplicas + "-" + spotNodePool
+}
+
+// addReplicas adds the given number of replicas to the given MachinePool. The
+// bounds of the autoscaler are moved when they are set, the replicas
+// otherwise.
+func addReplicas(machinePool *expcapiv1alpha3.MachinePool, delta int32) {
+	_, hasMin := machinePool.Annotations[apiextensionsannotations.NodePoolMinSize]
+	_, hasMax := machinePool.Annotations[apiextensionsannotations.NodePoolMaxSize]
+	if hasMin && hasMax {
+		minReplicas := nonNegative(key.NodePoolMinReplicas(machinePool) + delta)
+		maxReplicas := nonNegative(key.NodePoolMaxReplicas(machinePool) + delta)
+
+		machinePool.Annotations[apiextensionsannotations.NodePoolMinSize] = strconv.Itoa(int(minReplicas))
+		machinePool.Annotations[apiextensionsannotations.NodePoolMaxSize] = strconv.Itoa(int(maxReplicas))
+		return
+	}
+
+	var replicas int32
+	if machinePool.Spec.Replicas != nil {
+		replicas = *machinePool.Spec.Replicas
+	}
+	replicas = nonNegative(replicas + delta)
+	machinePool.Spec.Replicas = &replicas
+}
+
+func nonNegative(n int32) int32 {
+	if n < 0 {
+		return 0
+	}
+
+	return n
+}
+
+func setAnnotation(annotations map[string]string, name string, set bool, value string) {
+	if set {
+		annotations[name] = value
+	} else {
+		delete(annotations, name)
+	}
+}
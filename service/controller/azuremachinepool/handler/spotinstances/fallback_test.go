@@ -0,0 +1,233 @@
+package spotinstances
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	apiextensionsannotations "github.com/giantswarm/apiextensions/v3/pkg/annotation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	expcapiv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+)
+
+func Test_fallbackReplicas(t *testing.T) {
+	now := time.Date(2021, 2, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name             string
+		unavailableSince time.Time
+		missing          int32
+		current          int32
+		expectedReplicas int32
+	}{
+		{
+			name:             "case 0: spot capacity available",
+			unavailableSince: time.Time{},
+			missing:          0,
+			current:          0,
+			expectedReplicas: 0,
+		},
+		{
+			name:             "case 1: spot capacity unavailable for less than the threshold",
+			unavailableSince: now.Add(-5 * time.Minute),
+			missing:          3,
+			current:          0,
+			expectedReplicas: 0,
+		},
+		{
+			name:             "case 2: spot capacity unavailable for longer than the threshold",
+			unavailableSince: now.Add(-15 * time.Minute),
+			missing:          3,
+			current:          0,
+			expectedReplicas: 3,
+		},
+		{
+			name:             "case 3: some spot instances came back",
+			unavailableSince: now.Add(-30 * time.Minute),
+			missing:          1,
+			current:          3,
+			expectedReplicas: 1,
+		},
+		{
+			name:             "case 4: spot capacity available again",
+			unavailableSince: time.Time{},
+			missing:          0,
+			current:          3,
+			expectedReplicas: 0,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			replicas := fallbackReplicas(now, tc.unavailableSince, defaultFallbackAfter, tc.missing, tc.current)
+			if replicas != tc.expectedReplicas {
+				t.Fatalf("expected %d, got %d", tc.expectedReplicas, replicas)
+			}
+		})
+	}
+}
+
+func Test_addReplicas(t *testing.T) {
+	testCases := []struct {
+		name                string
+		machinePool         expcapiv1alpha3.MachinePool
+		delta               int32
+		expectedReplicas    *int32
+		expectedAnnotations map[string]string
+	}{
+		{
+			name: "case 0: replicas are added",
+			machinePool: expcapiv1alpha3.MachinePool{
+				Spec: expcapiv1alpha3.MachinePoolSpec{
+					Replicas: to.Int32Ptr(3),
+				},
+			},
+			delta:            2,
+			expectedReplicas: to.Int32Ptr(5),
+		},
+		{
+			name: "case 1: replicas are removed but never below zero",
+			machinePool: expcapiv1alpha3.MachinePool{
+				Spec: expcapiv1alpha3.MachinePoolSpec{
+					Replicas: to.Int32Ptr(1),
+				},
+			},
+			delta:            -2,
+			expectedReplicas: to.Int32Ptr(0),
+		},
+		{
+			name: "case 2: autoscaler bounds are moved",
+			machinePool: expcapiv1alpha3.MachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						apiextensionsannotations.NodePoolMinSize: "2",
+						apiextensionsannotations.NodePoolMaxSize: "5",
+					},
+				},
+				Spec: expcapiv1alpha3.MachinePoolSpec{
+					Replicas: to.Int32Ptr(3),
+				},
+			},
+			delta:            2,
+			expectedReplicas: to.Int32Ptr(3),
+			expectedAnnotations: map[string]string{
+				apiextensionsannotations.NodePoolMinSize: "4",
+				apiextensionsannotations.NodePoolMaxSize: "7",
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			addReplicas(&tc.machinePool, tc.delta)
+
+			if !reflect.DeepEqual(tc.machinePool.Spec.Replicas, tc.expectedReplicas) {
+				t.Fatalf("expected %d replicas, got %d", *tc.expectedReplicas, *tc.machinePool.Spec.Replicas)
+			}
+			if !reflect.DeepEqual(tc.machinePool.Annotations, tc.expectedAnnotations) {
+				t.Fatalf("expected %#v, got %#v", tc.expectedAnnotations, tc.machinePool.Annotations)
+			}
+		})
+	}
+}
+
+func Test_evictedInstances(t *testing.T) {
+	testCases := []struct {
+		name            string
+		minReplicas     int32
+		instances       []compute.VirtualMachineScaleSetVM
+		expectedEvicted int32
+		expectedRunning int32
+		expectedMissing int32
+	}{
+		{
+			name:        "case 0: all instances running",
+			minReplicas: 2,
+			instances: []compute.VirtualMachineScaleSetVM{
+				newTestInstance("0", "Succeeded", "PowerState/running"),
+				newTestInstance("1", "Succeeded", "PowerState/running"),
+			},
+			expectedEvicted: 0,
+			expectedRunning: 2,
+			expectedMissing: 0,
+		},
+		{
+			name:        "case 1: evicted instances deleted",
+			minReplicas: 3,
+			instances: []compute.VirtualMachineScaleSetVM{
+				newTestInstance("0", "Succeeded", "PowerState/running"),
+			},
+			expectedEvicted: 2,
+			expectedRunning: 1,
+			expectedMissing: 2,
+		},
+		{
+			name:        "case 2: requested instances not provisioned yet",
+			minReplicas: 3,
+			instances: []compute.VirtualMachineScaleSetVM{
+				newTestInstance("0", "Succeeded", "PowerState/running"),
+				newTestInstance("1", "Creating", ""),
+				newTestInstance("2", "Creating", ""),
+			},
+			expectedEvicted: 0,
+			expectedRunning: 1,
+			expectedMissing: 2,
+		},
+		{
+			name:        "case 3: more instances than the minimum",
+			minReplicas: 1,
+			instances: []compute.VirtualMachineScaleSetVM{
+				newTestInstance("0", "Succeeded", "PowerState/running"),
+				newTestInstance("1", "Succeeded", "PowerState/running"),
+			},
+			expectedEvicted: 0,
+			expectedRunning: 2,
+			expectedMissing: 0,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			evicted := evictedInstances(tc.minReplicas, tc.instances)
+			if evicted != tc.expectedEvicted {
+				t.Fatalf("expected %d evicted instances, got %d", tc.expectedEvicted, evicted)
+			}
+
+			running := runningInstances(tc.instances)
+			if running != tc.expectedRunning {
+				t.Fatalf("expected %d running instances, got %d", tc.expectedRunning, running)
+			}
+
+			missing := missingReplicas(tc.minReplicas, running)
+			if missing != tc.expectedMissing {
+				t.Fatalf("expected %d missing replicas, got %d", tc.expectedMissing, missing)
+			}
+		})
+	}
+}
+
+func newTestInstance(id string, provisioningState string, powerState string) compute.VirtualMachineScaleSetVM {
+	var statuses []compute.InstanceViewStatus
+	if powerState != "" {
+		statuses = append(statuses, compute.InstanceViewStatus{Code: to.StringPtr(powerState)})
+	}
+
+	return compute.VirtualMachineScaleSetVM{
+		InstanceID: to.StringPtr(id),
+		VirtualMachineScaleSetVMProperties: &compute.VirtualMachineScaleSetVMProperties{
+			ProvisioningState: to.StringPtr(provisioningState),
+			InstanceView: &compute.VirtualMachineScaleSetVMInstanceView{
+				Statuses: &statuses,
+			},
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package spotinstances
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	spotInstanceEvictions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "azure_operator_spot_instance_evictions_total",
+			Help: "Number of evicted spot instances deleted from node pools.",
+		},
+		[]string{"cluster_id", "node_pool"},
+	)
+	spotFallbackReplicas = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "azure_operator_spot_fallback_replicas",
+			Help: "Replicas added to the on-demand fallback node pool of a spot node pool.",
+		},
+		[]string{"cluster_id", "node_pool", "fallback_node_pool"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(spotInstanceEvictions)
+	prometheus.MustRegister(spotFallbackReplicas)
+}
+
+// reportEvictions is a utility function for updating metrics related to
+// evicted spot instances.
+func reportEvictions(clusterID string, nodePool string, evicted int) {
+	spotInstanceEvictions.WithLabelValues(
+		clusterID, nodePool,
+	).Add(float64(evicted))
+}
+
+// reportFallbackReplicas is a utility function for updating metrics related
+// to the on-demand fallback node pool.
+func reportFallbackReplicas(clusterID string, nodePool string, fallbackNodePool string, replicas int32) {
+	spotFallbackReplicas.WithLabelValues(
+		clusterID, nodePool, fallbackNodePool,
+	).Set(float64(replicas))
+}
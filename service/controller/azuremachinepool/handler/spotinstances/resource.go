@@ -0,0 +1,79 @@
+package spotinstances
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	expcapiv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/client"
+)
+
+const (
+	// Name is the identifier of the resource.
+	Name = "spotinstances"
+)
+
+type Config struct {
+	ClientFactory client.OrganizationFactory
+	CtrlClient    ctrlclient.Client
+	EventRecorder record.EventRecorder
+	Logger        micrologger.Logger
+}
+
+// Resource deletes evicted spot instances of node pools, so that their
+// capacity is requested again, and scales up an on-demand fallback node pool
+// while spot capacity is unavailable.
+type Resource struct {
+	clientFactory client.OrganizationFactory
+	ctrlClient    ctrlclient.Client
+	eventRecorder record.EventRecorder
+	logger        micrologger.Logger
+}
+
+func New(config Config) (*Resource, error) {
+	if config.CtrlClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CtrlClient must not be empty", config)
+	}
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	r := &Resource{
+		clientFactory: config.ClientFactory,
+		ctrlClient:    config.CtrlClient,
+		eventRecorder: config.EventRecorder,
+		logger:        config.Logger,
+	}
+
+	return r, nil
+}
+
+// Name returns the resource name.
+func (r *Resource) Name() string {
+	return Name
+}
+
+// getOwnerMachinePool returns the MachinePool object owning the current resource.
+func (r *Resource) getOwnerMachinePool(ctx context.Context, obj metav1.ObjectMeta) (*expcapiv1alpha3.MachinePool, error) {
+	for _, ref := range obj.OwnerReferences {
+		if ref.Kind == "MachinePool" && ref.APIVersion == expcapiv1alpha3.GroupVersion.String() {
+			machinePool := &expcapiv1alpha3.MachinePool{}
+			err := r.ctrlClient.Get(ctx, ctrlclient.ObjectKey{Namespace: obj.Namespace, Name: ref.Name}, machinePool)
+			if err != nil {
+				return nil, microerror.Mask(err)
+			}
+
+			return machinePool, nil
+		}
+	}
+
+	return nil, nil
+}
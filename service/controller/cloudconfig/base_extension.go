@@ -1,21 +1,31 @@
 package cloudconfig
 
 import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
 	"github.com/giantswarm/certs/v3/pkg/certs"
+	k8scloudconfig "github.com/giantswarm/k8scloudconfig/v10/pkg/template"
+	"github.com/giantswarm/microerror"
 	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
 
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/pkg/helpers/vmss"
 	"github.com/giantswarm/azure-operator/v5/service/controller/encrypter"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
+	"github.com/giantswarm/azure-operator/v5/service/controller/templates/ignition"
 )
 
 type baseExtension struct {
 	azure                        setting.Azure
+	azureEnvironment             azure.Environment
 	azureClientCredentialsConfig auth.ClientCredentialsConfig
 	azureMachinePool             *capzexpv1alpha3.AzureMachinePool
 	calicoCIDR                   string
+	cloudProvider                setting.CloudProvider
 	certFiles                    []certs.File
 	customObject                 providerv1alpha1.AzureConfig
 	encrypter                    encrypter.Interface
@@ -23,6 +33,48 @@ type baseExtension struct {
 	vnetCIDR                     string
 }
 
+// dataDiskMountUnits renders the mount units of the data disks of the
+// instances, the data disks of the node pool for workers of node pools.
+func (e *baseExtension) dataDiskMountUnits(instanceRole string) ([]k8scloudconfig.UnitAsset, error) {
+	var err error
+	var dataDisks []vmss.DataDisk
+	switch {
+	case instanceRole == key.PrefixMaster():
+		dataDisks = vmss.MasterDataDisks()
+	case e.azureMachinePool != nil:
+		dataDisks, err = vmss.WorkerDataDisks(e.azureMachinePool.Spec.Template.DataDisks, e.azureMachinePool.Annotations[annotation.DataDiskMountPoints])
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	default:
+		dataDisks, err = vmss.WorkerDataDisks(nil, "")
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var units []k8scloudconfig.UnitAsset
+	for _, d := range dataDisks {
+		meta := k8scloudconfig.UnitMetadata{
+			AssetContent: ignition.DataDiskMountUnit,
+			Name:         d.MountUnitName(),
+			Enabled:      true,
+		}
+
+		c, err := k8scloudconfig.RenderAssetContent(meta.AssetContent, d)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		units = append(units, k8scloudconfig.UnitAsset{
+			Metadata: meta,
+			Content:  c,
+		})
+	}
+
+	return units, nil
+}
+
 func (e *baseExtension) templateData(certFiles []certs.File) templateData {
 	var certsPaths []string
 
@@ -40,6 +92,14 @@ func (e *baseExtension) templateData(certFiles []certs.File) templateData {
 		subnetName = e.azureMachinePool.Name
 	}
 
+	// The virtual network is only set apart from the resource group of the
+	// cluster when it existed beforehand, which keeps the cloud provider
+	// config of other clusters unchanged.
+	var vnetResourceGroup string
+	if _, _, ok := key.ExistingVirtualNetwork(e.customObject); ok {
+		vnetResourceGroup = key.VnetResourceGroupName(e.customObject)
+	}
+
 	return templateData{
 		azureCNIFileParams{
 			VnetCIDR: e.vnetCIDR,
@@ -61,13 +121,59 @@ func (e *baseExtension) templateData(certFiles []certs.File) templateData {
 			SubscriptionID:              e.subscriptionID,
 			TenantID:                    e.azureClientCredentialsConfig.TenantID,
 			VnetName:                    key.VnetName(e.customObject),
+			VnetResourceGroup:           vnetResourceGroup,
 			UseManagedIdentityExtension: e.azure.MSI.Enabled,
+			CloudProvider:               e.cloudProvider,
 		},
 		certificateDecrypterUnitParams{
-			CertsPaths: certsPaths,
+			CertsPaths:        certsPaths,
+			KeyVault:          e.azure.KeyVaultEnabled(),
+			KeyVaultResource:  e.azureEnvironment.ResourceIdentifiers.KeyVault,
+			KeyVaultSecretURL: fmt.Sprintf("https://%s.%s/secrets/%s", key.KeyVaultName(&e.customObject), e.azureEnvironment.KeyVaultDNSSuffix, key.KeyVaultSecretName(e.encryptingKeyID())),
+		},
+		etcdMemberFileParams{
+			BaseDomain:     key.ClusterBaseDomain(e.customObject),
+			EtcdEndpoint:   fmt.Sprintf("https://%s", key.ClusterEtcdDomain(e.customObject)),
+			InitialCluster: etcdInitialCluster(e.customObject),
+			MasterCount:    key.MasterCount(e.customObject),
 		},
 		ingressLBFileParams{
 			ClusterDNSDomain: key.ClusterDNSDomain(e.customObject),
 		},
 	}
 }
+
+// encryptingKeyID returns the ID of the key the certificates are encrypted
+// with, which is the previous key during a key rotation. See
+// encrypter.Encrypter.Encrypt.
+func (e *baseExtension) encryptingKeyID() string {
+	if e.encrypter.GetPreviousEncryptionKeyID() != "" {
+		return e.encrypter.GetPreviousEncryptionKeyID()
+	}
+
+	return e.encrypter.GetEncryptionKeyID()
+}
+
+// keyVaultFilesMeta returns the files needed by nodes fetching the certificate
+// encryption key from the Key Vault of the cluster.
+func (e *baseExtension) keyVaultFilesMeta() []k8scloudconfig.FileMetadata {
+	if !e.azure.KeyVaultEnabled() {
+		return nil
+	}
+
+	return []k8scloudconfig.FileMetadata{
+		{
+			AssetContent: ignition.EncryptionKeyFetcher,
+			Path:         "/opt/bin/encryption-key-fetcher",
+			Owner: k8scloudconfig.Owner{
+				Group: k8scloudconfig.Group{
+					Name: FileOwnerGroupName,
+				},
+				User: k8scloudconfig.User{
+					Name: FileOwnerUserName,
+				},
+			},
+			Permissions: FilePermission,
+		},
+	}
+}
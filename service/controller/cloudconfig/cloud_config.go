@@ -3,6 +3,7 @@ package cloudconfig
 import (
 	"context"
 
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
 	apiextensionslabels "github.com/giantswarm/apiextensions/v3/pkg/label"
@@ -12,6 +13,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/giantswarm/azure-operator/v5/pkg/ignition"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
 )
@@ -19,6 +21,7 @@ import (
 const (
 	CertFilePermission          = 0400
 	CloudProviderFilePermission = 0640
+	DropinFilePermission        = 0644
 	FileOwnerUserName           = "root"
 	FileOwnerGroupName          = "root"
 	FileOwnerGroupIDNobody      = 65534
@@ -39,6 +42,7 @@ func (k Key) String() string {
 type Config struct {
 	Azure                  setting.Azure
 	AzureClientCredentials auth.ClientCredentialsConfig
+	CloudProvider          setting.CloudProvider
 	CtrlClient             ctrl.Client
 	DockerhubToken         string
 	Ignition               setting.Ignition
@@ -51,7 +55,9 @@ type Config struct {
 
 type CloudConfig struct {
 	azure                  setting.Azure
+	azureEnvironment       azure.Environment
 	azureClientCredentials auth.ClientCredentialsConfig
+	cloudProvider          setting.CloudProvider
 	ctrlClient             ctrl.Client
 	dockerhubToken         string
 	ignition               setting.Ignition
@@ -69,6 +75,9 @@ func New(config Config) (*CloudConfig, error) {
 	if config.Ignition.Path == "" {
 		return nil, microerror.Maskf(invalidConfigError, "%T.IgnitionPath must not be empty", config)
 	}
+	if err := ignition.ValidateSpecVersion(config.Ignition.SpecVersion); err != nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Ignition.%s", config, err)
+	}
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
@@ -78,6 +87,9 @@ func New(config Config) (*CloudConfig, error) {
 	if err := config.Azure.Validate(); err != nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Azure.%s", config, err)
 	}
+	if err := config.CloudProvider.Validate(); err != nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CloudProvider.%s", config, err)
+	}
 	if config.AzureClientCredentials.ClientID == "" {
 		return nil, microerror.Maskf(invalidConfigError, "%T.azureClientCredentials must not be empty", config)
 	}
@@ -85,9 +97,16 @@ func New(config Config) (*CloudConfig, error) {
 		return nil, microerror.Maskf(invalidConfigError, "%T.SubscriptionID must not be empty", config)
 	}
 
+	azureEnvironment, err := azure.EnvironmentFromName(config.Azure.EnvironmentName)
+	if err != nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Azure.EnvironmentName: %s", config, err)
+	}
+
 	c := &CloudConfig{
 		azure:                  config.Azure,
+		azureEnvironment:       azureEnvironment,
 		azureClientCredentials: config.AzureClientCredentials,
+		cloudProvider:          config.CloudProvider,
 		ctrlClient:             config.CtrlClient,
 		dockerhubToken:         config.DockerhubToken,
 		ignition:               config.Ignition,
@@ -149,7 +168,25 @@ func (c CloudConfig) getEncryptionkey(ctx context.Context, customObject provider
 
 }
 
-func newCloudConfig(template string, params k8scloudconfig.Params) (string, error) {
+// cloudProviderFor returns the settings of the Azure cloud provider of the
+// cluster the cloud config is rendered for.
+func (c CloudConfig) cloudProviderFor(data IgnitionTemplateData) (setting.CloudProvider, error) {
+	if data.AzureCluster == nil {
+		return c.cloudProvider, nil
+	}
+
+	cloudProvider, err := c.cloudProvider.ForCluster(data.AzureCluster.Annotations)
+	if err != nil {
+		return setting.CloudProvider{}, microerror.Maskf(invalidConfigError, "AzureCluster %#q: %s", data.AzureCluster.Name, err)
+	}
+
+	return cloudProvider, nil
+}
+
+// newCloudConfig renders the given k8scloudconfig template in the given
+// Ignition spec version. The rendered config is validated, so that no config
+// Ignition refuses is uploaded.
+func newCloudConfig(template string, params k8scloudconfig.Params, specVersion string) (string, error) {
 	c := k8scloudconfig.CloudConfigConfig{
 		Params:   params,
 		Template: template,
@@ -163,5 +200,15 @@ func newCloudConfig(template string, params k8scloudconfig.Params) (string, erro
 		return "", microerror.Mask(err)
 	}
 
-	return cloudConfig.String(), nil
+	config, err := ignition.Parse([]byte(cloudConfig.String()))
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	rendered, err := ignition.Render(config, specVersion)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	return string(rendered), nil
 }
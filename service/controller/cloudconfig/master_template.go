@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"strings"
 
 	"github.com/giantswarm/apiextensions/v3/pkg/annotation"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
 	k8scloudconfig "github.com/giantswarm/k8scloudconfig/v10/pkg/template"
 	"github.com/giantswarm/microerror"
 
@@ -35,11 +37,18 @@ func (c CloudConfig) NewMasterTemplate(ctx context.Context, data IgnitionTemplat
 		k8sAPIExtraArgs = append(k8sAPIExtraArgs, oidcExtraArgs...)
 	}
 
+	cloudProvider, err := c.cloudProviderFor(data)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
 	var params k8scloudconfig.Params
 	{
 		be := baseExtension{
 			azure:                        c.azure,
+			azureEnvironment:             c.azureEnvironment,
 			azureClientCredentialsConfig: c.azureClientCredentials,
+			cloudProvider:                cloudProvider,
 			calicoCIDR:                   data.CustomObject.Spec.Azure.VirtualNetwork.CalicoSubnetCIDR,
 			certFiles:                    data.MasterCertFiles,
 			customObject:                 data.CustomObject,
@@ -59,6 +68,13 @@ func (c CloudConfig) NewMasterTemplate(ctx context.Context, data IgnitionTemplat
 		params.Etcd.ClientPort = defaultEtcdPort
 		params.Etcd.HighAvailability = false
 		params.Etcd.InitialClusterState = EtcdInitialClusterStateNew
+		if key.MasterCount(data.CustomObject) > 1 {
+			// The member name is overwritten on each master by the
+			// etcd-member-init script, see etcdMemberFilesMeta.
+			params.Etcd.HighAvailability = true
+			params.Etcd.InitialCluster = etcdInitialCluster(data.CustomObject)
+			params.Etcd.NodeName = key.EtcdMemberName(1, key.MasterCount(data.CustomObject))
+		}
 		params.Kubernetes = k8scloudconfig.Kubernetes{
 			Apiserver: k8scloudconfig.KubernetesPodOptions{
 				HostExtraMounts: []k8scloudconfig.KubernetesPodOptionsHostMount{
@@ -119,7 +135,7 @@ func (c CloudConfig) NewMasterTemplate(ctx context.Context, data IgnitionTemplat
 		return "", microerror.Mask(err)
 	}
 
-	return newCloudConfig(k8scloudconfig.MasterTemplate, params)
+	return newCloudConfig(k8scloudconfig.MasterTemplate, params, c.ignition.SpecVersion)
 }
 
 type masterExtension struct {
@@ -187,6 +203,19 @@ func (me *masterExtension) Files() ([]k8scloudconfig.FileAsset, error) {
 			},
 			Permissions: CloudProviderFilePermission,
 		},
+		{
+			AssetContent: ignition.CertificateDecrypter,
+			Path:         "/opt/bin/certificate-decrypter",
+			Owner: k8scloudconfig.Owner{
+				Group: k8scloudconfig.Group{
+					Name: FileOwnerGroupName,
+				},
+				User: k8scloudconfig.User{
+					Name: FileOwnerUserName,
+				},
+			},
+			Permissions: FilePermission,
+		},
 		{
 			AssetContent: ignition.DefaultStorageClass,
 			Path:         "/srv/default-storage-class.yaml",
@@ -202,6 +231,9 @@ func (me *masterExtension) Files() ([]k8scloudconfig.FileAsset, error) {
 		},
 	}
 
+	filesMeta = append(filesMeta, me.keyVaultFilesMeta()...)
+	filesMeta = append(filesMeta, me.etcdMemberFilesMeta()...)
+
 	data := me.templateData(me.certFiles)
 
 	var fileAssets []k8scloudconfig.FileAsset
@@ -255,6 +287,55 @@ func (me *masterExtension) Files() ([]k8scloudconfig.FileAsset, error) {
 	return fileAssets, nil
 }
 
+// etcdMemberFilesMeta returns the files letting each master of a cluster with
+// multiple masters pick its own etcd member name and join the etcd cluster.
+func (me *masterExtension) etcdMemberFilesMeta() []k8scloudconfig.FileMetadata {
+	if key.MasterCount(me.customObject) < 2 {
+		return nil
+	}
+
+	return []k8scloudconfig.FileMetadata{
+		{
+			AssetContent: ignition.EtcdMemberInit,
+			Path:         "/opt/bin/etcd-member-init",
+			Owner: k8scloudconfig.Owner{
+				Group: k8scloudconfig.Group{
+					Name: FileOwnerGroupName,
+				},
+				User: k8scloudconfig.User{
+					Name: FileOwnerUserName,
+				},
+			},
+			Permissions: FilePermission,
+		},
+		{
+			AssetContent: ignition.EtcdMemberDropin,
+			Path:         "/etc/systemd/system/etcd3.service.d/20-etcd-member.conf",
+			Owner: k8scloudconfig.Owner{
+				Group: k8scloudconfig.Group{
+					Name: FileOwnerGroupName,
+				},
+				User: k8scloudconfig.User{
+					Name: FileOwnerUserName,
+				},
+			},
+			Permissions: DropinFilePermission,
+		},
+	}
+}
+
+// etcdInitialCluster returns the members etcd clusters of new clusters with
+// multiple masters are bootstrapped with.
+func etcdInitialCluster(cr providerv1alpha1.AzureConfig) string {
+	var members []string
+	for i := 1; i <= key.MasterCount(cr); i++ {
+		name := key.EtcdMemberName(i, key.MasterCount(cr))
+		members = append(members, fmt.Sprintf("%s=%s", name, key.EtcdPeerURL(cr, name)))
+	}
+
+	return strings.Join(members, ",")
+}
+
 // Units allows systemd units to be injected into the master cloudconfig.
 func (me *masterExtension) Units() ([]k8scloudconfig.UnitAsset, error) {
 	unitsMeta := []k8scloudconfig.UnitMetadata{
@@ -268,21 +349,6 @@ func (me *masterExtension) Units() ([]k8scloudconfig.UnitAsset, error) {
 			Name:         "certificate-decrypter.service",
 			Enabled:      true,
 		},
-		{
-			AssetContent: ignition.EtcdMountUnit,
-			Name:         "var-lib-etcd.mount",
-			Enabled:      true,
-		},
-		{
-			AssetContent: ignition.DockerMountUnit,
-			Name:         "var-lib-docker.mount",
-			Enabled:      true,
-		},
-		{
-			AssetContent: ignition.KubeletMountUnit,
-			Name:         "var-lib-kubelet.mount",
-			Enabled:      true,
-		},
 		{
 			AssetContent: ignition.VNICConfigurationUnit,
 			Name:         "vnic-configuration.service",
@@ -308,6 +374,12 @@ func (me *masterExtension) Units() ([]k8scloudconfig.UnitAsset, error) {
 		newUnits = append(newUnits, unitAsset)
 	}
 
+	mountUnits, err := me.dataDiskMountUnits(key.PrefixMaster())
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	newUnits = append(newUnits, mountUnits...)
+
 	return newUnits, nil
 }
 
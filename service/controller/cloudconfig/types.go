@@ -8,6 +8,8 @@ import (
 	expcapzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
 	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	expcapiv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
 )
 
 type templateData struct {
@@ -15,6 +17,7 @@ type templateData struct {
 	calicoAzureFileParams
 	cloudProviderConfFileParams
 	certificateDecrypterUnitParams
+	etcdMemberFileParams
 	ingressLBFileParams
 }
 
@@ -40,11 +43,23 @@ type cloudProviderConfFileParams struct {
 	SubscriptionID              string
 	TenantID                    string
 	VnetName                    string
+	VnetResourceGroup           string
 	UseManagedIdentityExtension bool
+	CloudProvider               setting.CloudProvider
 }
 
 type certificateDecrypterUnitParams struct {
-	CertsPaths []string
+	CertsPaths        []string
+	KeyVault          bool
+	KeyVaultResource  string
+	KeyVaultSecretURL string
+}
+
+type etcdMemberFileParams struct {
+	BaseDomain     string
+	EtcdEndpoint   string
+	InitialCluster string
+	MasterCount    int
 }
 
 type ingressLBFileParams struct {
@@ -8,19 +8,25 @@ import (
 	"github.com/giantswarm/microerror"
 
 	"github.com/giantswarm/azure-operator/v5/service/controller/encrypter"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 	"github.com/giantswarm/azure-operator/v5/service/controller/templates/ignition"
 )
 
 // NewWorkerCloudConfig generates a new worker cloudconfig and returns it as a
 // base64 encoded string.
 func (c CloudConfig) NewWorkerTemplate(ctx context.Context, data IgnitionTemplateData, encrypter encrypter.Interface) (string, error) {
-	var err error
+	cloudProvider, err := c.cloudProviderFor(data)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
 
 	var params k8scloudconfig.Params
 	{
 		be := baseExtension{
 			azure:                        c.azure,
+			azureEnvironment:             c.azureEnvironment,
 			azureClientCredentialsConfig: c.azureClientCredentials,
+			cloudProvider:                cloudProvider,
 			certFiles:                    data.WorkerCertFiles,
 			customObject:                 data.CustomObject,
 			encrypter:                    encrypter,
@@ -66,7 +72,7 @@ func (c CloudConfig) NewWorkerTemplate(ctx context.Context, data IgnitionTemplat
 
 	c.logger.Debugf(ctx, "rendering cloudconfig with kubelet labels %v", params.Cluster.Kubernetes.Kubelet.Labels)
 
-	return newCloudConfig(k8scloudconfig.WorkerTemplate, params)
+	return newCloudConfig(k8scloudconfig.WorkerTemplate, params, c.ignition.SpecVersion)
 }
 
 type workerExtension struct {
@@ -89,8 +95,23 @@ func (we *workerExtension) Files() ([]k8scloudconfig.FileAsset, error) {
 			},
 			Permissions: CloudProviderFilePermission,
 		},
+		{
+			AssetContent: ignition.CertificateDecrypter,
+			Path:         "/opt/bin/certificate-decrypter",
+			Owner: k8scloudconfig.Owner{
+				Group: k8scloudconfig.Group{
+					Name: FileOwnerGroupName,
+				},
+				User: k8scloudconfig.User{
+					Name: FileOwnerUserName,
+				},
+			},
+			Permissions: FilePermission,
+		},
 	}
 
+	filesMeta = append(filesMeta, we.keyVaultFilesMeta()...)
+
 	data := we.templateData(we.certFiles)
 
 	var fileAssets []k8scloudconfig.FileAsset
@@ -157,16 +178,6 @@ func (we *workerExtension) Units() ([]k8scloudconfig.UnitAsset, error) {
 			Name:         "certificate-decrypter.service",
 			Enabled:      true,
 		},
-		{
-			AssetContent: ignition.DockerMountUnit,
-			Name:         "var-lib-docker.mount",
-			Enabled:      true,
-		},
-		{
-			AssetContent: ignition.KubeletMountUnit,
-			Name:         "var-lib-kubelet.mount",
-			Enabled:      true,
-		},
 		{
 			AssetContent: ignition.VNICConfigurationUnit,
 			Name:         "vnic-configuration.service",
@@ -192,6 +203,12 @@ func (we *workerExtension) Units() ([]k8scloudconfig.UnitAsset, error) {
 		newUnits = append(newUnits, unitAsset)
 	}
 
+	mountUnits, err := we.dataDiskMountUnits(key.PrefixWorker())
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	newUnits = append(newUnits, mountUnits...)
+
 	return newUnits, nil
 }
 
@@ -9,21 +9,22 @@ import (
 	capiexp "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
 )
 
-func isAnyMachinePoolUpgrading(cr capi.Cluster, machinePools []capiexp.MachinePool) (bool, error) {
+func countMachinePoolsUpgrading(cr capi.Cluster, machinePools []capiexp.MachinePool) (int, error) {
 	desiredRelease := cr.Labels[label.ReleaseVersion]
 
+	var count int
 	for _, machinePool := range machinePools {
 		isUpgrading, err := isMachinePoolUpgradingInProgress(&machinePool, desiredRelease)
 		if err != nil {
-			return false, microerror.Mask(err)
+			return 0, microerror.Mask(err)
 		}
 
 		if isUpgrading {
-			return true, nil
+			count++
 		}
 	}
 
-	return false, nil
+	return count, nil
 }
 
 func isMachinePoolUpgradingInProgress(cr conditions.Object, desiredRelease string) (bool, error) {
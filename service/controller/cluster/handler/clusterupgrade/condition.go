@@ -0,0 +1,58 @@
+package clusterupgrade
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capiconditions "sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// UpgradeCompletedCondition is True on the Cluster CR once the masters and
+	// all node pools run the release of the cluster. Until then it is False
+	// and its reason and message tell how far the upgrade got and why it does
+	// not make progress.
+	UpgradeCompletedCondition capiv1alpha3.ConditionType = "UpgradeCompleted"
+
+	InvalidUpgradePolicyReason        = "InvalidUpgradePolicy"
+	UpgradePausedReason               = "UpgradePaused"
+	WaitingForMaintenanceWindowReason = "WaitingForMaintenanceWindow"
+	ControlPlaneUpgradingReason       = "ControlPlaneUpgrading"
+	NodePoolsUpgradingReason          = "NodePoolsUpgrading"
+)
+
+func (r *Resource) setUpgradeCompletedCondition(ctx context.Context, cr capiv1alpha3.Cluster, mark func(*capiv1alpha3.Cluster)) error {
+	// Get the newest CR version so that conditions set by other handlers in
+	// the meantime are not overwritten.
+	cluster := &capiv1alpha3.Cluster{}
+	err := r.ctrlClient.Get(ctx, client.ObjectKey{Namespace: cr.Namespace, Name: cr.Name}, cluster)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	current := capiconditions.Get(cluster, UpgradeCompletedCondition)
+	mark(cluster)
+	desired := capiconditions.Get(cluster, UpgradeCompletedCondition)
+
+	if current != nil && desired != nil &&
+		current.Status == desired.Status &&
+		current.Reason == desired.Reason &&
+		current.Severity == desired.Severity &&
+		current.Message == desired.Message {
+		// The condition is set like that already.
+		return nil
+	}
+
+	err = r.ctrlClient.Status().Update(ctx, cluster)
+	if apierrors.IsConflict(err) {
+		r.logger.Debugf(ctx, "conflict trying to save object in k8s API concurrently")
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
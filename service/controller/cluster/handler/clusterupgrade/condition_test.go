@@ -0,0 +1,104 @@
+package clusterupgrade
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/giantswarm/micrologger/microloggertest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capiconditions "sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_setUpgradeCompletedCondition(t *testing.T) {
+	testCases := []struct {
+		name            string
+		conditions      capiv1alpha3.Conditions
+		mark            func(*capiv1alpha3.Cluster)
+		expectedUpdated bool
+	}{
+		{
+			name: "case 0: condition not set yet",
+			mark: func(cluster *capiv1alpha3.Cluster) {
+				capiconditions.MarkTrue(cluster, UpgradeCompletedCondition)
+			},
+			expectedUpdated: true,
+		},
+		{
+			name: "case 1: condition set like that already",
+			conditions: capiv1alpha3.Conditions{
+				{Type: UpgradeCompletedCondition, Status: "False", Reason: NodePoolsUpgradingReason, Severity: capiv1alpha3.ConditionSeverityInfo, Message: "1 of 2 node pools upgraded."},
+			},
+			mark: func(cluster *capiv1alpha3.Cluster) {
+				capiconditions.MarkFalse(cluster, UpgradeCompletedCondition, NodePoolsUpgradingReason, capiv1alpha3.ConditionSeverityInfo, "%s", "1 of 2 node pools upgraded.")
+			},
+			expectedUpdated: false,
+		},
+		{
+			name: "case 2: condition message changed",
+			conditions: capiv1alpha3.Conditions{
+				{Type: UpgradeCompletedCondition, Status: "False", Reason: NodePoolsUpgradingReason, Severity: capiv1alpha3.ConditionSeverityInfo, Message: "1 of 2 node pools upgraded."},
+			},
+			mark: func(cluster *capiv1alpha3.Cluster) {
+				capiconditions.MarkFalse(cluster, UpgradeCompletedCondition, NodePoolsUpgradingReason, capiv1alpha3.ConditionSeverityInfo, "%s", "2 of 2 node pools upgraded.")
+			},
+			expectedUpdated: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			ctx := context.Background()
+
+			scheme := runtime.NewScheme()
+			err := capiv1alpha3.AddToScheme(scheme)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cluster := &capiv1alpha3.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "c1a2b",
+					Namespace: "org-giantswarm",
+				},
+				Status: capiv1alpha3.ClusterStatus{
+					Conditions: tc.conditions,
+				},
+			}
+			ctrlClient := fake.NewFakeClientWithScheme(scheme, cluster)
+
+			r := &Resource{
+				ctrlClient: ctrlClient,
+				logger:     microloggertest.New(),
+			}
+
+			before := &capiv1alpha3.Cluster{}
+			err = ctrlClient.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Name}, before)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = r.setUpgradeCompletedCondition(ctx, *before, tc.mark)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			after := &capiv1alpha3.Cluster{}
+			err = ctrlClient.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Name}, after)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			updated := before.ResourceVersion != after.ResourceVersion
+			if updated != tc.expectedUpdated {
+				t.Fatalf("expected updated %t, got %t", tc.expectedUpdated, updated)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package clusterupgrade
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/giantswarm/apiextensions/v3/pkg/label"
 	"github.com/giantswarm/microerror"
@@ -10,11 +12,13 @@ import (
 	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
 	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	expcapiv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+	capiconditions "sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/giantswarm/azure-operator/v5/pkg/helpers"
 	"github.com/giantswarm/azure-operator/v5/pkg/project"
 	"github.com/giantswarm/azure-operator/v5/pkg/tenantcluster"
+	"github.com/giantswarm/azure-operator/v5/pkg/upgradepolicy"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
 
@@ -24,13 +28,45 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		return microerror.Mask(err)
 	}
 
+	policy, err := upgradepolicy.FromAnnotations(cr.GetAnnotations())
+	if upgradepolicy.IsInvalidConfig(err) {
+		r.logger.Debugf(ctx, "upgrade policy is invalid: %s", err)
+		r.logger.Debugf(ctx, "cancelling resource")
+
+		return r.setUpgradeCompletedCondition(ctx, cr, func(cluster *capiv1alpha3.Cluster) {
+			capiconditions.MarkFalse(cluster, UpgradeCompletedCondition, InvalidUpgradePolicyReason, capiv1alpha3.ConditionSeverityError, "%s", err)
+		})
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	// Upgrade steps are only started while the upgrade is not paused and a
+	// maintenance window is open. Steps which started already are completed.
+	blockedReason, blockedMessage := upgradeBlocked(policy, time.Now())
+
 	r.logger.Debugf(ctx, "ensuring that azurecluster has same release label")
 
-	err = r.ensureAzureClusterHasSameRelease(ctx, cr)
+	azureClusterUpgraded, err := r.ensureAzureClusterHasSameRelease(ctx, cr, blockedReason == "")
 	if err != nil {
 		return microerror.Mask(err)
 	}
 
+	if !azureClusterUpgraded {
+		if blockedReason != "" {
+			r.logger.Debugf(ctx, "not starting upgrade of azurecluster: %s", blockedMessage)
+
+			err = r.setUpgradeCompletedCondition(ctx, cr, func(cluster *capiv1alpha3.Cluster) {
+				capiconditions.MarkFalse(cluster, UpgradeCompletedCondition, blockedReason, blockedSeverity(blockedReason), "%s", blockedMessage)
+			})
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+
+		r.logger.Debugf(ctx, "cancelling resource")
+		return nil
+	}
+
 	r.logger.Debugf(ctx, "ensured that azurecluster has same release label")
 
 	r.logger.Debugf(ctx, "ensuring that all machinepools has the same release label")
@@ -43,6 +79,20 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 
 	if !masterUpgraded {
 		r.logger.Debugf(ctx, "master hasn't upgraded yet")
+
+		reason, message := ControlPlaneUpgradingReason, fmt.Sprintf("Waiting for masters to run azure-operator %s.", project.Version())
+		if policy.Paused {
+			// The masters do not make progress while the upgrade is paused.
+			reason, message = blockedReason, blockedMessage
+		}
+
+		err = r.setUpgradeCompletedCondition(ctx, cr, func(cluster *capiv1alpha3.Cluster) {
+			capiconditions.MarkFalse(cluster, UpgradeCompletedCondition, reason, blockedSeverity(reason), "%s", message)
+		})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
 		r.logger.Debugf(ctx, "cancelling resource")
 		return nil
 	}
@@ -54,67 +104,96 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		return microerror.Mask(err)
 	}
 
-	machinePoolUpgrading, err := isAnyMachinePoolUpgrading(cr, machinePoolLst.Items)
+	upgrading, err := countMachinePoolsUpgrading(cr, machinePoolLst.Items)
 	if err != nil {
 		return microerror.Mask(err)
 	}
 
-	if machinePoolUpgrading {
-		r.logger.Debugf(ctx, "there is machinepool upgrading")
-		r.logger.Debugf(ctx, "cancelling resource")
-		return nil
-	}
-
-	r.logger.Debugf(ctx, "finding machinepool that has not been upgraded yet")
+	r.logger.Debugf(ctx, "finding machinepools that have not been upgraded yet")
 
 	machinePoolsNotUpgradedYet, err := machinePoolsNotUpgradedYet(cr, machinePoolLst.Items)
 	if err != nil {
 		return microerror.Mask(err)
 	}
 
-	if len(machinePoolsNotUpgradedYet) > 0 {
-		machinePool := machinePoolsNotUpgradedYet[0]
+	if len(machinePoolsNotUpgradedYet) == 0 && upgrading == 0 {
+		r.logger.Debugf(ctx, "did not find any machinepool that has not been upgraded yet")
 
-		r.logger.Debugf(ctx, "found machinepool that has not been upgraded yet: %#q", machinePool.Name)
-		r.logger.Debugf(ctx, "updating release & operator version labels")
-
-		machinePool.Labels[label.ReleaseVersion] = cr.Labels[label.ReleaseVersion]
-		machinePool.Labels[label.AzureOperatorVersion] = cr.Labels[label.AzureOperatorVersion]
-		err = r.ctrlClient.Update(ctx, &machinePool)
-		if apierrors.IsConflict(err) {
-			r.logger.Debugf(ctx, "conflict trying to save object in k8s API concurrently")
-			r.logger.Debugf(ctx, "cancelling resource")
-			return nil
-		} else if err != nil {
+		err = r.setUpgradeCompletedCondition(ctx, cr, func(cluster *capiv1alpha3.Cluster) {
+			capiconditions.MarkTrue(cluster, UpgradeCompletedCondition)
+		})
+		if err != nil {
 			return microerror.Mask(err)
 		}
 
-		r.logger.Debugf(ctx, "updated release & operator version labels of machinepool %#q", machinePool.Name)
-		r.logger.Debugf(ctx, "cancelling resource")
+		r.logger.Debugf(ctx, "ensured that all machinepools has the same release label")
 		return nil
 	}
 
-	r.logger.Debugf(ctx, "did not find any machinepool that has not been upgraded yet")
-	r.logger.Debugf(ctx, "ensured that all machinepools has the same release label")
+	upgraded := len(machinePoolLst.Items) - len(machinePoolsNotUpgradedYet) - upgrading
+	pending := len(machinePoolsNotUpgradedYet)
+
+	if pending > 0 && blockedReason != "" {
+		r.logger.Debugf(ctx, "not starting upgrade of machinepools: %s", blockedMessage)
+	} else {
+		for _, machinePool := range machinePoolsToUpgrade(policy, machinePoolsNotUpgradedYet, upgrading) {
+			r.logger.Debugf(ctx, "found machinepool that has not been upgraded yet: %#q", machinePool.Name)
+			r.logger.Debugf(ctx, "updating release & operator version labels")
+
+			machinePool.Labels[label.ReleaseVersion] = cr.Labels[label.ReleaseVersion]
+			machinePool.Labels[label.AzureOperatorVersion] = cr.Labels[label.AzureOperatorVersion]
+			err = r.ctrlClient.Update(ctx, &machinePool)
+			if apierrors.IsConflict(err) {
+				r.logger.Debugf(ctx, "conflict trying to save object in k8s API concurrently")
+				break
+			} else if err != nil {
+				return microerror.Mask(err)
+			}
+
+			upgrading++
+			pending--
+
+			r.logger.Debugf(ctx, "updated release & operator version labels of machinepool %#q", machinePool.Name)
+		}
+	}
+
+	reason := NodePoolsUpgradingReason
+	message := fmt.Sprintf("%d of %d node pools upgraded, %d upgrading, %d pending.", upgraded, len(machinePoolLst.Items), upgrading, pending)
+	if policy.Paused || (upgrading == 0 && blockedReason != "") {
+		reason = blockedReason
+		message = fmt.Sprintf("%s %s", message, blockedMessage)
+	}
+
+	err = r.setUpgradeCompletedCondition(ctx, cr, func(cluster *capiv1alpha3.Cluster) {
+		capiconditions.MarkFalse(cluster, UpgradeCompletedCondition, reason, blockedSeverity(reason), "%s", message)
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
 
+	r.logger.Debugf(ctx, "cancelling resource")
 	return nil
 }
 
-func (r *Resource) ensureAzureClusterHasSameRelease(ctx context.Context, cr capiv1alpha3.Cluster) error {
+func (r *Resource) ensureAzureClusterHasSameRelease(ctx context.Context, cr capiv1alpha3.Cluster, startUpgrade bool) (bool, error) {
 	if cr.Spec.InfrastructureRef == nil {
-		return microerror.Maskf(notFoundError, "infrastructure reference not yet set")
+		return false, microerror.Maskf(notFoundError, "infrastructure reference not yet set")
 	}
 
 	azureCluster := capzv1alpha3.AzureCluster{}
 	err := r.ctrlClient.Get(ctx, client.ObjectKey{Namespace: cr.Namespace, Name: cr.Spec.InfrastructureRef.Name}, &azureCluster)
 	if err != nil {
-		return microerror.Mask(err)
+		return false, microerror.Mask(err)
 	}
 
 	if cr.Labels[label.ReleaseVersion] == azureCluster.Labels[label.ReleaseVersion] &&
 		cr.Labels[label.AzureOperatorVersion] == azureCluster.Labels[label.AzureOperatorVersion] {
 		// AzureCluster release & operator version already matches. Nothing to do here.
-		return nil
+		return true, nil
+	}
+
+	if !startUpgrade {
+		return false, nil
 	}
 
 	azureCluster.Labels[label.AzureOperatorVersion] = cr.Labels[label.AzureOperatorVersion]
@@ -122,13 +201,12 @@ func (r *Resource) ensureAzureClusterHasSameRelease(ctx context.Context, cr capi
 	err = r.ctrlClient.Update(ctx, &azureCluster)
 	if apierrors.IsConflict(err) {
 		r.logger.Debugf(ctx, "conflict trying to save object in k8s API concurrently")
-		r.logger.Debugf(ctx, "cancelling resource")
-		return nil
+		return false, nil
 	} else if err != nil {
-		return microerror.Mask(err)
+		return false, microerror.Mask(err)
 	}
 
-	return nil
+	return true, nil
 }
 
 func (r *Resource) ensureMasterHasUpgraded(ctx context.Context, cluster capiv1alpha3.Cluster) (bool, error) {
@@ -0,0 +1,66 @@
+package clusterupgrade
+
+import (
+	"fmt"
+	"time"
+
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	expcapiv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+
+	azopannotation "github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/pkg/upgradepolicy"
+)
+
+// upgradeBlocked returns the reason and message why no upgrade step may be
+// started at the given time. The reason is empty when steps may be started.
+func upgradeBlocked(policy upgradepolicy.Policy, now time.Time) (string, string) {
+	if policy.Paused {
+		return UpgradePausedReason, fmt.Sprintf("Upgrade is paused, remove annotation %s from the cluster to resume.", azopannotation.UpgradePaused)
+	}
+
+	if !policy.InMaintenanceWindow(now) {
+		return WaitingForMaintenanceWindowReason, fmt.Sprintf("Waiting for maintenance window %s (UTC).", policy.WindowSpecs())
+	}
+
+	return "", ""
+}
+
+// machinePoolsToUpgrade returns the machine pools out of the ones pending the
+// upgrade which are upgraded next, in the order of the policy and bounded by
+// the number of machine pools the policy allows to upgrade at the same time.
+func machinePoolsToUpgrade(policy upgradepolicy.Policy, pending []expcapiv1alpha3.MachinePool, upgrading int) []expcapiv1alpha3.MachinePool {
+	slots := policy.MaxParallelNodePools - upgrading
+	if slots <= 0 {
+		return nil
+	}
+
+	byName := map[string]expcapiv1alpha3.MachinePool{}
+	var names []string
+	for _, machinePool := range pending {
+		byName[machinePool.Name] = machinePool
+		names = append(names, machinePool.Name)
+	}
+
+	var next []expcapiv1alpha3.MachinePool
+	for _, name := range policy.Order(names) {
+		if len(next) == slots {
+			break
+		}
+		next = append(next, byName[name])
+	}
+
+	return next
+}
+
+// blockedSeverity returns the severity of the UpgradeCompleted condition for
+// the given reason.
+func blockedSeverity(reason string) capiv1alpha3.ConditionSeverity {
+	switch reason {
+	case InvalidUpgradePolicyReason:
+		return capiv1alpha3.ConditionSeverityError
+	case UpgradePausedReason:
+		return capiv1alpha3.ConditionSeverityWarning
+	default:
+		return capiv1alpha3.ConditionSeverityInfo
+	}
+}
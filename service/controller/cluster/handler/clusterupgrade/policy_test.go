@@ -0,0 +1,133 @@
+package clusterupgrade
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	expcapiv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/upgradepolicy"
+)
+
+func Test_machinePoolsToUpgrade(t *testing.T) {
+	testCases := []struct {
+		name          string
+		order         []string
+		maxParallel   int
+		pending       []string
+		upgrading     int
+		expectedNames []string
+	}{
+		{
+			name:          "case 0: one machine pool at a time",
+			maxParallel:   1,
+			pending:       []string{"np3", "np1", "np2"},
+			expectedNames: []string{"np1"},
+		},
+		{
+			name:          "case 1: machine pools in the order of the policy",
+			order:         []string{"np3", "np2"},
+			maxParallel:   2,
+			pending:       []string{"np1", "np2", "np3"},
+			expectedNames: []string{"np3", "np2"},
+		},
+		{
+			name:          "case 2: machine pools upgrading already use up slots",
+			maxParallel:   2,
+			pending:       []string{"np1", "np2", "np3"},
+			upgrading:     1,
+			expectedNames: []string{"np1"},
+		},
+		{
+			name:        "case 3: no free slots",
+			maxParallel: 2,
+			pending:     []string{"np1", "np2", "np3"},
+			upgrading:   2,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			var pending []expcapiv1alpha3.MachinePool
+			for _, name := range tc.pending {
+				pending = append(pending, expcapiv1alpha3.MachinePool{ObjectMeta: metav1.ObjectMeta{Name: name}})
+			}
+
+			policy := upgradepolicy.Policy{
+				NodePoolOrder:        tc.order,
+				MaxParallelNodePools: tc.maxParallel,
+			}
+
+			var names []string
+			for _, machinePool := range machinePoolsToUpgrade(policy, pending, tc.upgrading) {
+				names = append(names, machinePool.Name)
+			}
+
+			if !cmp.Equal(names, tc.expectedNames) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedNames, names))
+			}
+		})
+	}
+}
+
+func Test_upgradeBlocked(t *testing.T) {
+	// 2020-10-01 is a Thursday.
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		paused         bool
+		windows        string
+		expectedReason string
+	}{
+		{
+			name: "case 0: no policy",
+		},
+		{
+			name:           "case 1: paused",
+			paused:         true,
+			expectedReason: UpgradePausedReason,
+		},
+		{
+			name:           "case 2: outside of maintenance window",
+			windows:        "0 22 * * * 4h",
+			expectedReason: WaitingForMaintenanceWindowReason,
+		},
+		{
+			name:    "case 3: inside of maintenance window",
+			windows: "0 10 * * 4 4h",
+		},
+		{
+			name:           "case 4: paused inside of maintenance window",
+			paused:         true,
+			windows:        "0 10 * * 4 4h",
+			expectedReason: UpgradePausedReason,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			windows, err := upgradepolicy.ParseWindows(tc.windows)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			policy := upgradepolicy.Policy{
+				Paused:  tc.paused,
+				Windows: windows,
+			}
+
+			reason, _ := upgradeBlocked(policy, now)
+			if reason != tc.expectedReason {
+				t.Fatalf("reason == %#q, want %#q", reason, tc.expectedReason)
+			}
+		})
+	}
+}
@@ -1,21 +1,51 @@
 package encrypter
 
 import (
+	"bytes"
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
 
 	"github.com/giantswarm/microerror"
 )
 
+const (
+	// envelopePrefix marks payloads encrypted with AES-GCM. The full header
+	// line has the format "gsenc:v2:<key id>:<hex nonce>\n" and is followed by
+	// the ciphertext and the authentication tag, which covers the header line
+	// as additional data. Payloads without a prefix are legacy AES-CFB
+	// payloads encrypted using the fixed initial vector of the cluster.
+	envelopePrefix = "gsenc:v2:"
+	// keyIDLength is the number of hex characters of the key fingerprint used
+	// as key ID.
+	keyIDLength = 16
+)
+
 type Config struct {
+	// Key is the key used for encryption.
 	Key []byte
-	IV  []byte
+	// IV is the fixed initial vector which is used to decrypt legacy AES-CFB
+	// payloads.
+	IV []byte
+	// PreviousKey is the key which was used before the last key rotation. It is
+	// only used for decryption and may be empty.
+	PreviousKey []byte
 }
 
 type Encrypter struct {
-	key []byte
-	iv  []byte
+	key           []byte
+	keyID         string
+	iv            []byte
+	previousKey   []byte
+	previousKeyID string
+
+	// keys maps key IDs to all keys which can be used for decryption.
+	keys map[string][]byte
 }
 
 func New(config Config) (*Encrypter, error) {
@@ -26,33 +56,127 @@ func New(config Config) (*Encrypter, error) {
 		return nil, microerror.Maskf(invalidConfigError, "%T.IV must not be empty", config)
 	}
 
+	keys := map[string][]byte{
+		KeyID(config.Key): config.Key,
+	}
+	if len(config.PreviousKey) > 0 {
+		keys[KeyID(config.PreviousKey)] = config.PreviousKey
+	}
+
+	var previousKeyID string
+	if len(config.PreviousKey) > 0 {
+		previousKeyID = KeyID(config.PreviousKey)
+	}
+
 	encrypter := &Encrypter{
-		key: config.Key,
-		iv:  config.IV,
+		key:           config.Key,
+		keyID:         KeyID(config.Key),
+		iv:            config.IV,
+		previousKey:   config.PreviousKey,
+		previousKeyID: previousKeyID,
+
+		keys: keys,
 	}
 
 	return encrypter, nil
 }
 
+// KeyID returns the ID of the given key, which is derived from the SHA-256
+// fingerprint of the key so that it does not have to be stored separately.
+func KeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:])[:keyIDLength]
+}
+
+// Encrypt encrypts data using AES-GCM with a random nonce and returns the
+// versioned envelope carrying the key ID and nonce. During a key rotation the
+// previous key is used, so that nodes which did not get the current key yet
+// can still decrypt their certificates when they reboot. Rolled nodes get both
+// keys.
 func (e *Encrypter) Encrypt(data []byte) ([]byte, error) {
-	block, err := aes.NewCipher(e.key)
+	key, keyID := e.key, e.keyID
+	if len(e.previousKey) > 0 {
+		key, keyID = e.previousKey, e.previousKeyID
+	}
+
+	gcm, err := newGCM(key)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
 
-	encryptedData := make([]byte, len(data))
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
 
-	cfb := cipher.NewCFBEncrypter(block, e.iv)
-	cfb.XORKeyStream(encryptedData, data)
+	header := []byte(fmt.Sprintf("%s%s:%s\n", envelopePrefix, keyID, hex.EncodeToString(nonce)))
 
-	return encryptedData, nil
+	return gcm.Seal(header, nonce, data, header), nil
 }
 
+// Decrypt decrypts versioned envelopes using the key referenced by their key
+// ID as well as legacy AES-CFB payloads.
 func (e *Encrypter) Decrypt(encrypted []byte) ([]byte, error) {
-	var block cipher.Block
-	var err error
+	if bytes.HasPrefix(encrypted, []byte(envelopePrefix)) {
+		return e.decryptGCM(encrypted)
+	}
+
+	return e.decryptCFB(encrypted)
+}
+
+func (e *Encrypter) decryptGCM(encrypted []byte) ([]byte, error) {
+	header, key, nonce, err := e.parseHeader(encrypted)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
 
-	if block, err = aes.NewCipher(e.key); err != nil {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return nil, microerror.Maskf(invalidEnvelopeError, "nonce must be %d bytes long", gcm.NonceSize())
+	}
+
+	decrypted, err := gcm.Open(nil, nonce, encrypted[len(header):], header)
+	if err != nil {
+		return nil, microerror.Maskf(invalidEnvelopeError, err.Error())
+	}
+
+	return decrypted, nil
+}
+
+// parseHeader returns the header line of the given envelope, the key
+// referenced by its key ID and its decoded nonce.
+func (e *Encrypter) parseHeader(encrypted []byte) ([]byte, []byte, []byte, error) {
+	i := bytes.IndexByte(encrypted, '\n')
+	if i < 0 {
+		return nil, nil, nil, microerror.Maskf(invalidEnvelopeError, "header line is not terminated")
+	}
+	header := encrypted[:i+1]
+
+	parts := strings.Split(strings.TrimPrefix(strings.TrimSuffix(string(header), "\n"), envelopePrefix), ":")
+	if len(parts) != 2 {
+		return nil, nil, nil, microerror.Maskf(invalidEnvelopeError, "header line must contain key ID and nonce")
+	}
+
+	key, ok := e.keys[parts[0]]
+	if !ok {
+		return nil, nil, nil, microerror.Maskf(keyNotFoundError, "key with ID %#q is not known", parts[0])
+	}
+
+	nonce, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return nil, nil, nil, microerror.Maskf(invalidEnvelopeError, "nonce must be hex encoded")
+	}
+
+	return header, key, nonce, nil
+}
+
+func (e *Encrypter) decryptCFB(encrypted []byte) ([]byte, error) {
+	block, err := aes.NewCipher(e.key)
+	if err != nil {
 		return nil, microerror.Mask(err)
 	}
 
@@ -69,7 +193,38 @@ func (e *Encrypter) GetEncryptionKey() string {
 	return hex.EncodeToString(e.key)
 }
 
-// GetInitialVector returns hex of the initial vector, which is used in certificate encryption.
+// GetEncryptionKeyID returns the ID of the key, which is used for certificates encryption.
+func (e *Encrypter) GetEncryptionKeyID() string {
+	return e.keyID
+}
+
+// GetPreviousEncryptionKey returns hex of the key which was used before the
+// last key rotation or an empty string once it is retired.
+func (e *Encrypter) GetPreviousEncryptionKey() string {
+	return hex.EncodeToString(e.previousKey)
+}
+
+// GetPreviousEncryptionKeyID returns the ID of the key which was used before
+// the last key rotation or an empty string once it is retired.
+func (e *Encrypter) GetPreviousEncryptionKeyID() string {
+	return e.previousKeyID
+}
+
+// GetInitialVector returns hex of the initial vector, which is used in legacy certificate encryption.
 func (e *Encrypter) GetInitialVector() string {
 	return hex.EncodeToString(e.iv)
 }
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return gcm, nil
+}
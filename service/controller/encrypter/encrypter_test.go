@@ -1,10 +1,22 @@
 package encrypter
 
 import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
 	"testing"
 )
 
-func Test_EncryptCFB(t *testing.T) {
+var (
+	testKey         = []byte("12345678901234567890123456789012")
+	testPreviousKey = []byte("abcdefghijklmnopqrstuvwxyz123456")
+	testIV          = []byte("1234567891234567")
+)
+
+func Test_Encrypt(t *testing.T) {
 	testCases := []struct {
 		Name  string
 		Input []byte
@@ -13,19 +25,13 @@ func Test_EncryptCFB(t *testing.T) {
 			Name:  "plain text encryption",
 			Input: []byte("testtext"),
 		},
+		{
+			Name:  "multi line encryption",
+			Input: []byte("-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"),
+		},
 	}
 
-	testKey := []byte("12345678901234567890123456789012")
-	testIV := []byte("1234567891234567")
-	c := Config{
-		Key: testKey,
-		IV:  testIV,
-	}
-
-	encrypter, err := New(c)
-	if err != nil {
-		t.Errorf("failed to create encrypter, %v", err)
-	}
+	encrypter := mustNew(t, Config{Key: testKey, IV: testIV})
 
 	for i, tc := range testCases {
 		encrypted, err := encrypter.Encrypt(tc.Input)
@@ -33,6 +39,10 @@ func Test_EncryptCFB(t *testing.T) {
 			t.Errorf("case %d: %s: expected err = nil, got %v", i, tc.Name, err)
 		}
 
+		if !strings.HasPrefix(string(encrypted), envelopePrefix+KeyID(testKey)+":") {
+			t.Errorf("case %d: %s: expected envelope to carry key ID %s", i, tc.Name, KeyID(testKey))
+		}
+
 		decrypted, err := encrypter.Decrypt(encrypted)
 		if err != nil {
 			t.Errorf("case %d: %s: expected err = nil, got %v", i, tc.Name, err)
@@ -41,5 +51,220 @@ func Test_EncryptCFB(t *testing.T) {
 		if string(tc.Input) != string(decrypted) {
 			t.Errorf("case %d: %s: expected %s, got %s", i, tc.Name, tc.Input, decrypted)
 		}
+
+		again, err := encrypter.Encrypt(tc.Input)
+		if err != nil {
+			t.Errorf("case %d: %s: expected err = nil, got %v", i, tc.Name, err)
+		}
+		if bytes.Equal(encrypted, again) {
+			t.Errorf("case %d: %s: expected different ciphertexts for random nonces", i, tc.Name)
+		}
+	}
+}
+
+func Test_DecryptTampered(t *testing.T) {
+	encrypter := mustNew(t, Config{Key: testKey, IV: testIV})
+
+	encrypted, err := encrypter.Encrypt([]byte("testtext"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encrypted[len(encrypted)-1] ^= 0x01
+
+	_, err = encrypter.Decrypt(encrypted)
+	if !IsInvalidEnvelope(err) {
+		t.Fatalf("expected invalid envelope error, got %v", err)
+	}
+}
+
+func Test_DecryptRotated(t *testing.T) {
+	previous := mustNew(t, Config{Key: testPreviousKey, IV: testIV})
+	encrypted, err := previous.Encrypt([]byte("testtext"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// After the rotation payloads encrypted with the previous key can still be
+	// decrypted.
+	rotated := mustNew(t, Config{Key: testKey, IV: testIV, PreviousKey: testPreviousKey})
+	decrypted, err := rotated.Decrypt(encrypted)
+	if err != nil {
+		t.Fatalf("expected err = nil, got %v", err)
+	}
+	if string(decrypted) != "testtext" {
+		t.Fatalf("expected %s, got %s", "testtext", decrypted)
 	}
+
+	// Once the previous key is retired, they cannot be decrypted anymore.
+	retired := mustNew(t, Config{Key: testKey, IV: testIV})
+	_, err = retired.Decrypt(encrypted)
+	if !IsKeyNotFound(err) {
+		t.Fatalf("expected key not found error, got %v", err)
+	}
+}
+
+func Test_DecryptLegacyCFB(t *testing.T) {
+	block, err := aes.NewCipher(testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := []byte("testtext")
+	encrypted := make([]byte, len(input))
+	cipher.NewCFBEncrypter(block, testIV).XORKeyStream(encrypted, input)
+
+	encrypter := mustNew(t, Config{Key: testKey, IV: testIV})
+	decrypted, err := encrypter.Decrypt(encrypted)
+	if err != nil {
+		t.Fatalf("expected err = nil, got %v", err)
+	}
+	if string(decrypted) != string(input) {
+		t.Fatalf("expected %s, got %s", input, decrypted)
+	}
+}
+
+func Test_EncryptDuringRotation(t *testing.T) {
+	rotated := mustNew(t, Config{Key: testKey, IV: testIV, PreviousKey: testPreviousKey})
+
+	encrypted, err := rotated.Encrypt([]byte("testtext"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Nodes which only got the previous key must be able to decrypt payloads
+	// encrypted during the rotation.
+	if !strings.HasPrefix(string(encrypted), envelopePrefix+KeyID(testPreviousKey)+":") {
+		t.Fatalf("expected envelope to carry key ID %s", KeyID(testPreviousKey))
+	}
+
+	previous := mustNew(t, Config{Key: testPreviousKey, IV: testIV})
+	decrypted, err := previous.Decrypt(encrypted)
+	if err != nil {
+		t.Fatalf("expected err = nil, got %v", err)
+	}
+	if string(decrypted) != "testtext" {
+		t.Fatalf("expected %s, got %s", "testtext", decrypted)
+	}
+}
+
+// Test_DecryptLikeNodes ensures envelopes can be verified and decrypted the way
+// the certificate decrypter on the nodes does it using the openssl CLI, which
+// is computing the GCM authentication tag using GHASH one bit at a time and
+// then decrypting the ciphertext with AES-CTR starting with the counter block
+// nonce||00000002.
+func Test_DecryptLikeNodes(t *testing.T) {
+	encrypter := mustNew(t, Config{Key: testKey, IV: testIV})
+
+	input := []byte("-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n")
+	encrypted, err := encrypter.Encrypt(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block, err := aes.NewCipher(testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nodeDecrypt := func(encrypted []byte) ([]byte, bool) {
+		i := bytes.IndexByte(encrypted, '\n')
+		header := encrypted[:i+1]
+		ciphertext := encrypted[i+1 : len(encrypted)-16]
+		tag := encrypted[len(encrypted)-16:]
+
+		parts := strings.Split(string(encrypted[:i]), ":")
+		nonce, err := hex.DecodeString(parts[len(parts)-1])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		h := make([]byte, 16)
+		block.Encrypt(h, h)
+		j := append(append([]byte{}, nonce...), 0, 0, 0, 1)
+		block.Encrypt(j, j)
+
+		s := nodeGHASH(h, header, ciphertext)
+		for k := range s {
+			s[k] ^= j[k]
+		}
+		if !bytes.Equal(s, tag) {
+			return nil, false
+		}
+
+		decrypted := make([]byte, len(ciphertext))
+		cipher.NewCTR(block, append(append([]byte{}, nonce...), 0, 0, 0, 2)).XORKeyStream(decrypted, ciphertext)
+
+		return decrypted, true
+	}
+
+	decrypted, ok := nodeDecrypt(encrypted)
+	if !ok {
+		t.Fatalf("expected authentication to pass")
+	}
+	if string(decrypted) != string(input) {
+		t.Fatalf("expected %s, got %s", input, decrypted)
+	}
+
+	// Tampering with the ciphertext or truncating it must be detected.
+	tampered := append([]byte{}, encrypted...)
+	tampered[len(tampered)-17] ^= 0x01
+	if _, ok := nodeDecrypt(tampered); ok {
+		t.Fatalf("expected authentication of tampered ciphertext to fail")
+	}
+	truncated := append(append([]byte{}, encrypted[:len(encrypted)-17]...), encrypted[len(encrypted)-16:]...)
+	if _, ok := nodeDecrypt(truncated); ok {
+		t.Fatalf("expected authentication of truncated ciphertext to fail")
+	}
+}
+
+// nodeGHASH computes GHASH the way the certificate decrypter does it, handling
+// blocks as two 64 bit halves and multiplying them bit by bit.
+func nodeGHASH(h []byte, aad []byte, ciphertext []byte) []byte {
+	pad := func(b []byte) []byte {
+		return append(append([]byte{}, b...), make([]byte, (16-len(b)%16)%16)...)
+	}
+	lengths := make([]byte, 16)
+	binary.BigEndian.PutUint64(lengths[:8], uint64(len(aad))*8)
+	binary.BigEndian.PutUint64(lengths[8:], uint64(len(ciphertext))*8)
+	blocks := append(append(pad(aad), pad(ciphertext)...), lengths...)
+
+	hh, hl := binary.BigEndian.Uint64(h[:8]), binary.BigEndian.Uint64(h[8:])
+	var yh, yl uint64
+	for j := 0; j < len(blocks); j += 16 {
+		xh, xl := yh^binary.BigEndian.Uint64(blocks[j:j+8]), yl^binary.BigEndian.Uint64(blocks[j+8:j+16])
+		var zh, zl uint64
+		vh, vl := hh, hl
+		for i := 0; i < 128; i++ {
+			var b uint64
+			if i < 64 {
+				b = (xh >> (63 - i)) & 1
+			} else {
+				b = (xl >> (127 - i)) & 1
+			}
+			if b == 1 {
+				zh, zl = zh^vh, zl^vl
+			}
+			lsb := vl & 1
+			vl = vl>>1 | vh<<63
+			vh = vh >> 1
+			if lsb == 1 {
+				vh ^= 0xe100000000000000
+			}
+		}
+		yh, yl = zh, zl
+	}
+
+	s := make([]byte, 16)
+	binary.BigEndian.PutUint64(s[:8], yh)
+	binary.BigEndian.PutUint64(s[8:], yl)
+	return s
+}
+
+func mustNew(t *testing.T, c Config) *Encrypter {
+	encrypter, err := New(c)
+	if err != nil {
+		t.Fatalf("failed to create encrypter, %v", err)
+	}
+
+	return encrypter
 }
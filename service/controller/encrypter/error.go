@@ -12,3 +12,21 @@ var invalidConfigError = &microerror.Error{
 func IsInvalidConfig(err error) bool {
 	return microerror.Cause(err) == invalidConfigError
 }
+
+var invalidEnvelopeError = &microerror.Error{
+	Kind: "invalidEnvelopeError",
+}
+
+// IsInvalidEnvelope asserts invalidEnvelopeError.
+func IsInvalidEnvelope(err error) bool {
+	return microerror.Cause(err) == invalidEnvelopeError
+}
+
+var keyNotFoundError = &microerror.Error{
+	Kind: "keyNotFoundError",
+}
+
+// IsKeyNotFound asserts keyNotFoundError.
+func IsKeyNotFound(err error) bool {
+	return microerror.Cause(err) == keyNotFoundError
+}
@@ -4,5 +4,8 @@ type Interface interface {
 	Encrypt([]byte) ([]byte, error)
 	Decrypt([]byte) ([]byte, error)
 	GetEncryptionKey() string
+	GetEncryptionKeyID() string
+	GetPreviousEncryptionKey() string
+	GetPreviousEncryptionKeyID() string
 	GetInitialVector() string
 }
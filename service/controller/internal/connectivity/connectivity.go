@@ -0,0 +1,41 @@
+package connectivity
+
+import (
+	"context"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
+)
+
+// ForAzureConfig returns how the control plane connects to the tenant cluster
+// of the given AzureConfig CR, either setting.ConnectivityVPN or
+// setting.ConnectivityVNetPeering. The connectivity of the installation is
+// overridden by the annotation of the AzureCluster CR of the cluster, which
+// does not exist for clusters not migrated yet.
+func ForAzureConfig(ctx context.Context, ctrlClient ctrlclient.Client, azure setting.Azure, cr providerv1alpha1.AzureConfig) (string, error) {
+	var annotations map[string]string
+	{
+		azureCluster := &capzv1alpha3.AzureCluster{}
+		err := ctrlClient.Get(ctx, ctrlclient.ObjectKey{Namespace: key.OrganizationNamespace(&cr), Name: cr.Name}, azureCluster)
+		if apierrors.IsNotFound(err) {
+			// The connectivity of the installation is used.
+		} else if err != nil {
+			return "", microerror.Mask(err)
+		} else {
+			annotations = azureCluster.Annotations
+		}
+	}
+
+	connectivity, err := azure.ConnectivityForCluster(annotations)
+	if err != nil {
+		return "", microerror.Maskf(invalidConfigError, "AzureCluster %#q: %s", cr.Name, err)
+	}
+
+	return connectivity, nil
+}
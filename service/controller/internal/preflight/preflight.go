@@ -0,0 +1,209 @@
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+
+	"github.com/giantswarm/azure-operator/v5/service/controller/internal/vmsku"
+)
+
+const (
+	QuotaExceededReason             = "QuotaExceeded"
+	VMSizeNotAvailableInZonesReason = "VMSizeNotAvailableInZones"
+	VMSizeNotFoundReason            = "VMSizeNotFound"
+	VMSizeRestrictedReason          = "VMSizeRestricted"
+
+	// regionalVCPUsQuota is the name of the quota of all regular vCPUs of a
+	// subscription in a location, the VM families have quotas of their own.
+	regionalVCPUsQuota = "cores"
+	// spotVCPUsQuota is the name of the quota of all spot vCPUs of a
+	// subscription in a location.
+	spotVCPUsQuota = "lowPriorityCores"
+)
+
+type Config struct {
+	Logger micrologger.Logger
+	VMSKU  *vmsku.VMSKUs
+}
+
+// Validator checks whether Azure can provide the VMs of a node pool before
+// its deployment is submitted, so that the node pool is blocked with a
+// meaningful reason instead of a failed deployment.
+type Validator struct {
+	logger micrologger.Logger
+	vmsku  *vmsku.VMSKUs
+}
+
+// Spec describes the VMs a node pool deployment asks for.
+type Spec struct {
+	VMSize string
+	Zones  []string
+	Spot   bool
+	// AdditionalInstances is the number of instances the deployment creates
+	// in addition to the running ones.
+	AdditionalInstances int64
+}
+
+// Problem is a reason why the deployment of a node pool would fail.
+type Problem struct {
+	Reason  string
+	Message string
+}
+
+func New(config Config) (*Validator, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.VMSKU == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.VMSKU must not be empty", config)
+	}
+
+	v := &Validator{
+		logger: config.Logger,
+		vmsku:  config.VMSKU,
+	}
+
+	return v, nil
+}
+
+// Validate checks that the VM size of the given spec is offered in all of its
+// zones, that it is not restricted for the subscription of the given clients
+// and that the vCPU quotas of the subscription fit the additional instances.
+// No problems are returned if the deployment can be submitted.
+func (v *Validator) Validate(ctx context.Context, skusClient *compute.ResourceSkusClient, usageClient *compute.UsageClient, spec Spec) ([]Problem, error) {
+	location := v.vmsku.Location()
+
+	sku, err := v.vmsku.Get(ctx, skusClient, spec.VMSize)
+	if vmsku.IsSkuNotFoundError(err) {
+		problem := Problem{
+			Reason:  VMSizeNotFoundReason,
+			Message: fmt.Sprintf("VM size %s is not offered in location %s", spec.VMSize, location),
+		}
+		return []Problem{problem}, nil
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	if restricted, reasonCode := vmsku.IsRestricted(sku, location); restricted {
+		problem := Problem{
+			Reason:  VMSizeRestrictedReason,
+			Message: fmt.Sprintf("VM size %s is restricted for the subscription in location %s, reason is %s", spec.VMSize, location, reasonCode),
+		}
+		return []Problem{problem}, nil
+	}
+
+	var problems []Problem
+
+	unavailableZones := vmsku.UnavailableZones(sku, location, spec.Zones)
+	if len(unavailableZones) > 0 {
+		problems = append(problems, Problem{
+			Reason:  VMSizeNotAvailableInZonesReason,
+			Message: fmt.Sprintf("VM size %s is not available in zones %s of location %s", spec.VMSize, strings.Join(unavailableZones, ", "), location),
+		})
+	}
+
+	if spec.AdditionalInstances > 0 {
+		var usages []compute.Usage
+		{
+			iterator, err := usageClient.ListComplete(ctx, location)
+			if err != nil {
+				return nil, microerror.Mask(err)
+			}
+
+			for iterator.NotDone() {
+				usages = append(usages, iterator.Value())
+
+				err = iterator.NextWithContext(ctx)
+				if err != nil {
+					return nil, microerror.Mask(err)
+				}
+			}
+		}
+
+		quotaProblems, err := validateQuotas(sku, usages, spec)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		problems = append(problems, quotaProblems...)
+	}
+
+	return problems, nil
+}
+
+// Reason returns the reason of the first of the given problems.
+func Reason(problems []Problem) string {
+	if len(problems) == 0 {
+		return ""
+	}
+
+	return problems[0].Reason
+}
+
+// Message joins the messages of the given problems.
+func Message(problems []Problem) string {
+	var messages []string
+	for _, p := range problems {
+		messages = append(messages, p.Message)
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// validateQuotas checks that the additional instances of the given spec fit
+// into the vCPU quotas of the given usages. Spot instances only count against
+// the spot quota, regular ones against the quota of their family and the
+// regional one.
+func validateQuotas(sku *compute.ResourceSku, usages []compute.Usage, spec Spec) ([]Problem, error) {
+	vCPUs, err := vmsku.VCPUs(sku)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	required := spec.AdditionalInstances * vCPUs
+
+	var quotas []string
+	if spec.Spot {
+		quotas = []string{spotVCPUsQuota}
+	} else {
+		if sku.Family != nil {
+			quotas = append(quotas, *sku.Family)
+		}
+		quotas = append(quotas, regionalVCPUsQuota)
+	}
+
+	var problems []Problem
+	for _, quota := range quotas {
+		usage, found := findUsage(usages, quota)
+		if !found || usage.Limit == nil || usage.CurrentValue == nil {
+			// Quotas which are not reported are not enforced either.
+			continue
+		}
+
+		available := *usage.Limit - int64(*usage.CurrentValue)
+		if required > available {
+			problems = append(problems, Problem{
+				Reason: QuotaExceededReason,
+				Message: fmt.Sprintf("%d additional instances of VM size %s need %d vCPUs of quota %s, only %d of %d are available",
+					spec.AdditionalInstances, spec.VMSize, required, quota, available, *usage.Limit),
+			})
+		}
+	}
+
+	return problems, nil
+}
+
+func findUsage(usages []compute.Usage, name string) (compute.Usage, bool) {
+	for _, u := range usages {
+		if u.Name != nil && u.Name.Value != nil && strings.EqualFold(*u.Name.Value, name) {
+			return u, true
+		}
+	}
+
+	return compute.Usage{}, false
+}
@@ -0,0 +1,108 @@
+package preflight
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func Test_validateQuotas(t *testing.T) {
+	sku := &compute.ResourceSku{
+		Name:   to.StringPtr("Standard_D4s_v3"),
+		Family: to.StringPtr("standardDSv3Family"),
+		Capabilities: &[]compute.ResourceSkuCapabilities{
+			{Name: to.StringPtr("vCPUs"), Value: to.StringPtr("4")},
+		},
+	}
+
+	usages := []compute.Usage{
+		newTestUsage("cores", 60, 100),
+		newTestUsage("standardDSv3Family", 20, 40),
+		newTestUsage("lowPriorityCores", 0, 8),
+	}
+
+	testCases := []struct {
+		name             string
+		spec             Spec
+		expectedProblems []Problem
+	}{
+		{
+			name: "case 0: instances fit into quotas",
+			spec: Spec{
+				VMSize:              "Standard_D4s_v3",
+				AdditionalInstances: 5,
+			},
+			expectedProblems: nil,
+		},
+		{
+			name: "case 1: family quota exceeded",
+			spec: Spec{
+				VMSize:              "Standard_D4s_v3",
+				AdditionalInstances: 6,
+			},
+			expectedProblems: []Problem{
+				{
+					Reason:  QuotaExceededReason,
+					Message: "6 additional instances of VM size Standard_D4s_v3 need 24 vCPUs of quota standardDSv3Family, only 20 of 40 are available",
+				},
+			},
+		},
+		{
+			name: "case 2: family and regional quota exceeded",
+			spec: Spec{
+				VMSize:              "Standard_D4s_v3",
+				AdditionalInstances: 11,
+			},
+			expectedProblems: []Problem{
+				{
+					Reason:  QuotaExceededReason,
+					Message: "11 additional instances of VM size Standard_D4s_v3 need 44 vCPUs of quota standardDSv3Family, only 20 of 40 are available",
+				},
+				{
+					Reason:  QuotaExceededReason,
+					Message: "11 additional instances of VM size Standard_D4s_v3 need 44 vCPUs of quota cores, only 40 of 100 are available",
+				},
+			},
+		},
+		{
+			name: "case 3: spot quota exceeded",
+			spec: Spec{
+				VMSize:              "Standard_D4s_v3",
+				Spot:                true,
+				AdditionalInstances: 3,
+			},
+			expectedProblems: []Problem{
+				{
+					Reason:  QuotaExceededReason,
+					Message: "3 additional instances of VM size Standard_D4s_v3 need 12 vCPUs of quota lowPriorityCores, only 8 of 8 are available",
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			problems, err := validateQuotas(sku, usages, tc.spec)
+			if err != nil {
+				t.Fatalf("unexpected error %#v", err)
+			}
+
+			if !reflect.DeepEqual(problems, tc.expectedProblems) {
+				t.Fatalf("expected %#v, got %#v", tc.expectedProblems, problems)
+			}
+		})
+	}
+}
+
+func newTestUsage(name string, current int32, limit int64) compute.Usage {
+	return compute.Usage{
+		Name:         &compute.UsageName{Value: to.StringPtr(name)},
+		CurrentValue: to.Int32Ptr(current),
+		Limit:        to.Int64Ptr(limit),
+	}
+}
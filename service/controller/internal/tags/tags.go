@@ -0,0 +1,149 @@
+package tags
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	securityv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/security/v1alpha1"
+	"github.com/giantswarm/microerror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+const (
+	// maxTags bounds the number of user-defined tags. Azure allows 50 tags
+	// per resource and the operator puts up to 10 on its own.
+	maxTags = 40
+
+	maxNameLength  = 512
+	maxValueLength = 256
+
+	// invalidNameCharacters can't be used in tag names.
+	invalidNameCharacters = `<>%&\?/`
+)
+
+// object is a CR which may hold the annotation.Tags annotation.
+type object interface {
+	metav1.Object
+	runtime.Object
+}
+
+// ForCluster returns the user-defined tags of the cluster the given CR
+// belongs to, taken from the annotation.Tags annotation of its Organization,
+// Cluster and AzureCluster CRs. Tags of the Cluster CR override the ones of
+// the Organization CR and tags of the AzureCluster CR override both. CRs which
+// don't exist are skipped.
+func ForCluster(ctx context.Context, ctrlClient ctrlclient.Client, getter key.LabelsGetter) (map[string]string, error) {
+	tags := map[string]string{}
+
+	objects := []object{
+		&securityv1alpha1.Organization{},
+		&capiv1alpha3.Cluster{},
+		&capzv1alpha3.AzureCluster{},
+	}
+	objectKeys := []ctrlclient.ObjectKey{
+		{Name: key.OrganizationID(getter)},
+		{Namespace: key.OrganizationNamespace(getter), Name: key.ClusterID(getter)},
+		{Namespace: key.OrganizationNamespace(getter), Name: key.ClusterID(getter)},
+	}
+
+	for i, obj := range objects {
+		if objectKeys[i].Name == "" {
+			continue
+		}
+
+		err := ctrlClient.Get(ctx, objectKeys[i], obj)
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			continue
+		} else if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		parsed, err := Parse(obj.GetAnnotations()[annotation.Tags])
+		if err != nil {
+			return nil, microerror.Maskf(invalidConfigError, "%T %#q: %s", obj, obj.GetName(), err)
+		}
+
+		for name, value := range parsed {
+			tags[name] = value
+		}
+	}
+
+	if len(tags) > maxTags {
+		return nil, microerror.Maskf(invalidConfigError, "annotation %#q: cluster %#q has %d tags, at most %d are allowed", annotation.Tags, key.ClusterID(getter), len(tags), maxTags)
+	}
+
+	return tags, nil
+}
+
+// Parse returns the tags given as "<name>=<value>" pairs separated by commas,
+// e.g. "cost-centre=1234,environment=production".
+func Parse(s string) (map[string]string, error) {
+	tags := map[string]string{}
+
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return tags, nil
+	}
+
+	for _, pair := range strings.Split(s, ",") {
+		parts := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("annotation %#q: tag %#q must be given as <name>=<value>", annotation.Tags, pair)
+		}
+
+		name, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+
+		switch {
+		case name == "":
+			return nil, fmt.Errorf("annotation %#q: tag %#q must have a name", annotation.Tags, pair)
+		case len(name) > maxNameLength:
+			return nil, fmt.Errorf("annotation %#q: tag name %#q must not be longer than %d characters", annotation.Tags, name, maxNameLength)
+		case strings.ContainsAny(name, invalidNameCharacters):
+			return nil, fmt.Errorf("annotation %#q: tag name %#q must not contain any of %#q", annotation.Tags, name, invalidNameCharacters)
+		case key.IsOperatorTagName(name):
+			return nil, fmt.Errorf("annotation %#q: tag name %#q is reserved for the operator", annotation.Tags, name)
+		case len(value) > maxValueLength:
+			return nil, fmt.Errorf("annotation %#q: value of tag %#q must not be longer than %d characters", annotation.Tags, name, maxValueLength)
+		}
+
+		tags[name] = value
+	}
+
+	return tags, nil
+}
+
+// Merge returns the given user-defined tags together with the given tags of
+// the operator, which take precedence.
+func Merge(custom map[string]string, operator map[string]*string) map[string]*string {
+	merged := map[string]*string{}
+	for name, value := range custom {
+		v := value
+		merged[name] = &v
+	}
+	for name, value := range operator {
+		merged[name] = value
+	}
+
+	return merged
+}
+
+// TemplateParameter returns the given user-defined tags as the customTags
+// parameter of ARM templates, typed the way the ARM API returns it.
+func TemplateParameter(custom map[string]string) map[string]interface{} {
+	param := map[string]interface{}{}
+	for name, value := range custom {
+		param[name] = value
+	}
+
+	return param
+}
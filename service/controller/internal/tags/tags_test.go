@@ -0,0 +1,121 @@
+package tags
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func Test_Parse(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedTags  map[string]string
+		errorExpected bool
+	}{
+		{
+			name:         "case 0: no tags",
+			input:        "",
+			expectedTags: map[string]string{},
+		},
+		{
+			name:         "case 1: single tag",
+			input:        "cost-centre=1234",
+			expectedTags: map[string]string{"cost-centre": "1234"},
+		},
+		{
+			name:         "case 2: several tags with whitespace",
+			input:        " cost-centre = 1234 , environment=production",
+			expectedTags: map[string]string{"cost-centre": "1234", "environment": "production"},
+		},
+		{
+			name:         "case 3: empty value",
+			input:        "team=",
+			expectedTags: map[string]string{"team": ""},
+		},
+		{
+			name:         "case 4: value containing equal sign",
+			input:        "query=a=b",
+			expectedTags: map[string]string{"query": "a=b"},
+		},
+		{
+			name:          "case 5: missing value",
+			input:         "cost-centre",
+			errorExpected: true,
+		},
+		{
+			name:          "case 6: missing name",
+			input:         "=1234",
+			errorExpected: true,
+		},
+		{
+			name:          "case 7: invalid character in name",
+			input:         "cost/centre=1234",
+			errorExpected: true,
+		},
+		{
+			name:          "case 8: name of the operator",
+			input:         "GiantSwarmCluster=abc12",
+			errorExpected: true,
+		},
+		{
+			name:          "case 9: reserved prefix",
+			input:         "gs-owner=me",
+			errorExpected: true,
+		},
+		{
+			name:          "case 10: value too long",
+			input:         "description=" + strings.Repeat("a", maxValueLength+1),
+			errorExpected: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			tags, err := Parse(tc.input)
+			if tc.errorExpected {
+				if err == nil {
+					t.Fatalf("expected error, got tags %v", tags)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(tags, tc.expectedTags) {
+				t.Fatalf("expected %v, got %v", tc.expectedTags, tags)
+			}
+		})
+	}
+}
+
+func Test_Merge(t *testing.T) {
+	custom := map[string]string{
+		"cost-centre": "1234",
+		"provider":    "custom",
+	}
+	operator := map[string]*string{
+		"provider": to.StringPtr("operator"),
+	}
+
+	merged := Merge(custom, operator)
+
+	expected := map[string]string{
+		"cost-centre": "1234",
+		"provider":    "operator",
+	}
+	if len(merged) != len(expected) {
+		t.Fatalf("expected %d tags, got %d", len(expected), len(merged))
+	}
+	for name, value := range expected {
+		if to.String(merged[name]) != value {
+			t.Fatalf("expected tag %#q to be %#q, got %#q", name, value, to.String(merged[name]))
+		}
+	}
+}
@@ -19,3 +19,12 @@ var skuNotFoundError = &microerror.Error{
 func IsSkuNotFoundError(err error) bool {
 	return microerror.Cause(err) == skuNotFoundError
 }
+
+var invalidCapabilityError = &microerror.Error{
+	Kind: "invalidCapabilityError",
+}
+
+// IsInvalidCapability asserts invalidCapabilityError.
+func IsInvalidCapability(err error) bool {
+	return microerror.Cause(err) == invalidCapabilityError
+}
@@ -3,8 +3,10 @@ package vmsku
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
 	"github.com/giantswarm/microerror"
@@ -18,20 +20,37 @@ const (
 	CapabilitySupported = "True"
 
 	CapabilityAcceleratedNetworking = "AcceleratedNetworkingEnabled"
+	CapabilityVCPUs                 = "vCPUs"
+
+	// DefaultCacheTTL is the time after which the SKUs of a subscription are
+	// fetched again.
+	DefaultCacheTTL = 1 * time.Hour
 )
 
 type Config struct {
 	AzureClientSet *client.AzureClientSet
 	Location       string
 	Logger         micrologger.Logger
+
+	// CacheTTL defaults to DefaultCacheTTL.
+	CacheTTL time.Duration
 }
 
 type VMSKUs struct {
 	azureClientSet *client.AzureClientSet
+	cacheTTL       time.Duration
 	location       string
-	skus           map[string]*compute.ResourceSku
 	logger         micrologger.Logger
-	initMutex      sync.Mutex
+
+	// caches holds the SKUs by subscription ID, because restrictions differ
+	// between subscriptions.
+	caches    map[string]*cache
+	initMutex sync.Mutex
+}
+
+type cache struct {
+	expires time.Time
+	skus    map[string]*compute.ResourceSku
 }
 
 func New(config Config) (*VMSKUs, error) {
@@ -44,56 +63,164 @@ func New(config Config) (*VMSKUs, error) {
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
+	if config.CacheTTL == 0 {
+		config.CacheTTL = DefaultCacheTTL
+	}
+
 	return &VMSKUs{
 		azureClientSet: config.AzureClientSet,
+		cacheTTL:       config.CacheTTL,
 		location:       config.Location,
 		logger:         config.Logger,
+
+		caches: map[string]*cache{},
 	}, nil
 }
 
+// Location returns the location the SKUs are listed for.
+func (v *VMSKUs) Location() string {
+	return v.location
+}
+
 func (v *VMSKUs) HasCapability(ctx context.Context, vmType string, name string) (bool, error) {
-	err := v.ensureInitialized(ctx)
+	vmsku, err := v.Get(ctx, v.azureClientSet.ResourceSkusClient, vmType)
 	if err != nil {
 		return false, microerror.Mask(err)
 	}
-	vmsku, found := v.skus[vmType]
+
+	value := capability(vmsku, name)
+
+	return strings.EqualFold(value, CapabilitySupported), nil
+}
+
+// Get returns the SKU of the given VM type as seen by the subscription of the
+// given client.
+func (v *VMSKUs) Get(ctx context.Context, skusClient *compute.ResourceSkusClient, vmType string) (*compute.ResourceSku, error) {
+	skus, err := v.ensureInitialized(ctx, skusClient)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	vmsku, found := skus[vmType]
 	if !found {
-		return false, microerror.Maskf(skuNotFoundError, vmType)
-	}
-	if vmsku.Capabilities != nil {
-		for _, capability := range *vmsku.Capabilities {
-			if capability.Name != nil && *capability.Name == name {
-				if capability.Value != nil && strings.EqualFold(*capability.Value, string(CapabilitySupported)) {
-					return true, nil
-				}
+		return nil, microerror.Maskf(skuNotFoundError, vmType)
+	}
+
+	return vmsku, nil
+}
+
+// VCPUs returns the number of vCPUs of the given SKU.
+func VCPUs(sku *compute.ResourceSku) (int64, error) {
+	value := capability(sku, CapabilityVCPUs)
+
+	vCPUs, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, microerror.Maskf(invalidCapabilityError, "%s of %s is %#q", CapabilityVCPUs, *sku.Name, value)
+	}
+
+	return vCPUs, nil
+}
+
+// IsRestricted tells whether the given SKU cannot be used in the given
+// location at all, e.g. because the subscription is not allowed to.
+func IsRestricted(sku *compute.ResourceSku, location string) (bool, compute.ResourceSkuRestrictionsReasonCode) {
+	if sku.Restrictions == nil {
+		return false, ""
+	}
+
+	for _, r := range *sku.Restrictions {
+		if r.Type != compute.Location {
+			continue
+		}
+
+		var locations []string
+		if r.RestrictionInfo != nil && r.RestrictionInfo.Locations != nil {
+			locations = *r.RestrictionInfo.Locations
+		} else if r.Values != nil {
+			locations = *r.Values
+		}
+
+		if containsFold(locations, location) {
+			return true, r.ReasonCode
+		}
+	}
+
+	return false, ""
+}
+
+// UnavailableZones returns the given zones of the given location in which the
+// given SKU is either not offered or restricted.
+func UnavailableZones(sku *compute.ResourceSku, location string, zones []string) []string {
+	var offered []string
+	if sku.LocationInfo != nil {
+		for _, info := range *sku.LocationInfo {
+			if info.Location != nil && strings.EqualFold(*info.Location, location) && info.Zones != nil {
+				offered = append(offered, *info.Zones...)
 			}
 		}
 	}
-	return false, nil
+
+	var restricted []string
+	if sku.Restrictions != nil {
+		for _, r := range *sku.Restrictions {
+			if r.Type != compute.Zone || r.RestrictionInfo == nil || r.RestrictionInfo.Zones == nil {
+				continue
+			}
+			if r.RestrictionInfo.Locations != nil && !containsFold(*r.RestrictionInfo.Locations, location) {
+				continue
+			}
+
+			restricted = append(restricted, *r.RestrictionInfo.Zones...)
+		}
+	}
+
+	var unavailable []string
+	for _, zone := range zones {
+		if !containsFold(offered, zone) || containsFold(restricted, zone) {
+			unavailable = append(unavailable, zone)
+		}
+	}
+
+	return unavailable
 }
 
-func (v *VMSKUs) ensureInitialized(ctx context.Context) error {
+func (v *VMSKUs) ensureInitialized(ctx context.Context, skusClient *compute.ResourceSkusClient) (map[string]*compute.ResourceSku, error) {
 	v.initMutex.Lock()
 	defer v.initMutex.Unlock()
-	if len(v.skus) == 0 {
-		err := v.initCache(ctx)
-		if err != nil {
-			return microerror.Mask(err)
-		}
+
+	subscriptionID := skusClient.SubscriptionID
+
+	c, ok := v.caches[subscriptionID]
+	if ok && time.Now().Before(c.expires) {
+		return c.skus, nil
 	}
 
-	return nil
+	skus, err := v.listSKUs(ctx, skusClient)
+	if ok && err != nil {
+		// The outdated SKUs are better than none, they are fetched again on
+		// the next call.
+		v.logger.Debugf(ctx, "failed to refresh cache for VMSKU, using outdated cache: %s", err)
+		return c.skus, nil
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	v.caches[subscriptionID] = &cache{
+		expires: time.Now().Add(v.cacheTTL),
+		skus:    skus,
+	}
+
+	return skus, nil
 }
 
-func (v *VMSKUs) initCache(ctx context.Context) error {
+func (v *VMSKUs) listSKUs(ctx context.Context, cl *compute.ResourceSkusClient) (map[string]*compute.ResourceSku, error) {
 	v.logger.Debugf(ctx, "Initializing cache for VMSKU")
-	cl := v.azureClientSet.ResourceSkusClient
 
 	filter := fmt.Sprintf("location eq '%s'", v.location)
 	v.logger.Debugf(ctx, "Filter is: '%s'", filter)
 	iterator, err := cl.ListComplete(ctx, filter)
 	if err != nil {
-		return microerror.Mask(err)
+		return nil, microerror.Mask(err)
 	}
 
 	skus := map[string]*compute.ResourceSku{}
@@ -101,17 +228,41 @@ func (v *VMSKUs) initCache(ctx context.Context) error {
 	for iterator.NotDone() {
 		sku := iterator.Value()
 
-		skus[*sku.Name] = &sku
+		// Disks and other resources have SKUs as well, some of them with
+		// the same names.
+		if sku.ResourceType == nil || *sku.ResourceType == "virtualMachines" {
+			skus[*sku.Name] = &sku
+		}
 
 		err := iterator.NextWithContext(ctx)
 		if err != nil {
-			return microerror.Mask(err)
+			return nil, microerror.Mask(err)
 		}
 	}
 
-	v.skus = skus
-
 	v.logger.Debugf(ctx, "Number of SKUs in cache: '%d'", len(skus))
 
-	return nil
+	return skus, nil
+}
+
+func capability(sku *compute.ResourceSku, name string) string {
+	if sku.Capabilities != nil {
+		for _, capability := range *sku.Capabilities {
+			if capability.Name != nil && *capability.Name == name && capability.Value != nil {
+				return *capability.Value
+			}
+		}
+	}
+
+	return ""
+}
+
+func containsFold(list []string, s string) bool {
+	for _, e := range list {
+		if strings.EqualFold(e, s) {
+			return true
+		}
+	}
+
+	return false
 }
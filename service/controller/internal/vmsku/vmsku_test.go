@@ -0,0 +1,161 @@
+package vmsku
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func Test_UnavailableZones(t *testing.T) {
+	testCases := []struct {
+		name                     string
+		sku                      *compute.ResourceSku
+		zones                    []string
+		expectedUnavailableZones []string
+	}{
+		{
+			name:                     "case 0: offered in all zones",
+			sku:                      newTestSKU([]string{"1", "2", "3"}, nil),
+			zones:                    []string{"1", "2", "3"},
+			expectedUnavailableZones: nil,
+		},
+		{
+			name:                     "case 1: not offered in one zone",
+			sku:                      newTestSKU([]string{"1", "2"}, nil),
+			zones:                    []string{"1", "2", "3"},
+			expectedUnavailableZones: []string{"3"},
+		},
+		{
+			name: "case 2: restricted in one zone",
+			sku: newTestSKU([]string{"1", "2", "3"}, []compute.ResourceSkuRestrictions{
+				{
+					Type:       compute.Zone,
+					ReasonCode: compute.NotAvailableForSubscription,
+					RestrictionInfo: &compute.ResourceSkuRestrictionInfo{
+						Locations: &[]string{"westeurope"},
+						Zones:     &[]string{"2"},
+					},
+				},
+			}),
+			zones:                    []string{"1", "2"},
+			expectedUnavailableZones: []string{"2"},
+		},
+		{
+			name: "case 3: zone restricted in another location",
+			sku: newTestSKU([]string{"1", "2", "3"}, []compute.ResourceSkuRestrictions{
+				{
+					Type:       compute.Zone,
+					ReasonCode: compute.NotAvailableForSubscription,
+					RestrictionInfo: &compute.ResourceSkuRestrictionInfo{
+						Locations: &[]string{"northeurope"},
+						Zones:     &[]string{"2"},
+					},
+				},
+			}),
+			zones:                    []string{"1", "2"},
+			expectedUnavailableZones: nil,
+		},
+		{
+			name:                     "case 4: no zones requested",
+			sku:                      newTestSKU(nil, nil),
+			zones:                    nil,
+			expectedUnavailableZones: nil,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			unavailableZones := UnavailableZones(tc.sku, "westeurope", tc.zones)
+			if !reflect.DeepEqual(unavailableZones, tc.expectedUnavailableZones) {
+				t.Fatalf("expected %v, got %v", tc.expectedUnavailableZones, unavailableZones)
+			}
+		})
+	}
+}
+
+func Test_IsRestricted(t *testing.T) {
+	testCases := []struct {
+		name               string
+		restrictions       []compute.ResourceSkuRestrictions
+		expectedRestricted bool
+	}{
+		{
+			name:               "case 0: no restrictions",
+			restrictions:       nil,
+			expectedRestricted: false,
+		},
+		{
+			name: "case 1: restricted in location",
+			restrictions: []compute.ResourceSkuRestrictions{
+				{
+					Type:       compute.Location,
+					ReasonCode: compute.NotAvailableForSubscription,
+					Values:     &[]string{"westeurope"},
+					RestrictionInfo: &compute.ResourceSkuRestrictionInfo{
+						Locations: &[]string{"westeurope"},
+					},
+				},
+			},
+			expectedRestricted: true,
+		},
+		{
+			name: "case 2: restricted in another location",
+			restrictions: []compute.ResourceSkuRestrictions{
+				{
+					Type:       compute.Location,
+					ReasonCode: compute.QuotaID,
+					Values:     &[]string{"northeurope"},
+				},
+			},
+			expectedRestricted: false,
+		},
+		{
+			name: "case 3: only restricted in a zone",
+			restrictions: []compute.ResourceSkuRestrictions{
+				{
+					Type: compute.Zone,
+					RestrictionInfo: &compute.ResourceSkuRestrictionInfo{
+						Locations: &[]string{"westeurope"},
+						Zones:     &[]string{"1"},
+					},
+				},
+			},
+			expectedRestricted: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			restricted, _ := IsRestricted(newTestSKU(nil, tc.restrictions), "westeurope")
+			if restricted != tc.expectedRestricted {
+				t.Fatalf("expected %t, got %t", tc.expectedRestricted, restricted)
+			}
+		})
+	}
+}
+
+func newTestSKU(zones []string, restrictions []compute.ResourceSkuRestrictions) *compute.ResourceSku {
+	sku := &compute.ResourceSku{
+		Name:         to.StringPtr("Standard_D4s_v3"),
+		ResourceType: to.StringPtr("virtualMachines"),
+		LocationInfo: &[]compute.ResourceSkuLocationInfo{
+			{
+				Location: to.StringPtr("westeurope"),
+				Zones:    &zones,
+			},
+		},
+	}
+
+	if restrictions != nil {
+		sku.Restrictions = &restrictions
+	}
+
+	return sku
+}
@@ -0,0 +1,14 @@
+package workerpool
+
+import (
+	"github.com/giantswarm/microerror"
+)
+
+var jobsFailedError = &microerror.Error{
+	Kind: "jobsFailedError",
+}
+
+// IsJobsFailed asserts jobsFailedError.
+func IsJobsFailed(err error) bool {
+	return microerror.Cause(err) == jobsFailedError
+}
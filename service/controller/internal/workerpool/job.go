@@ -0,0 +1,79 @@
+package workerpool
+
+import (
+	"context"
+	"sync"
+)
+
+// funcJob is a Job which runs a function once.
+type funcJob struct {
+	id       string
+	f        func() error
+	finished bool
+}
+
+// NewJob returns a Job which runs f once, successful or not.
+func NewJob(id string, f func() error) Job {
+	return &funcJob{
+		id: id,
+		f:  f,
+	}
+}
+
+func (j *funcJob) ID() string {
+	return j.id
+}
+
+func (j *funcJob) Finished() bool {
+	return j.finished
+}
+
+func (j *funcJob) Run() error {
+	j.finished = true
+	return j.f()
+}
+
+// trackedJob reports the outcome of a job enqueued by Pool.Run. The job is not
+// run anymore once the context of the run is done.
+type trackedJob struct {
+	Job
+	ctx  context.Context
+	done chan error
+
+	mutex    sync.Mutex
+	finished bool
+}
+
+func newTrackedJob(ctx context.Context, job Job) *trackedJob {
+	return &trackedJob{
+		Job:  job,
+		ctx:  ctx,
+		done: make(chan error, 1),
+	}
+}
+
+func (j *trackedJob) Finished() bool {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	return j.finished
+}
+
+func (j *trackedJob) Run() error {
+	err := j.ctx.Err()
+	if err == nil {
+		err = j.Job.Run()
+		if err == nil && !j.Job.Finished() {
+			// The job is enqueued again by the worker.
+			return nil
+		}
+	}
+
+	j.mutex.Lock()
+	j.finished = true
+	j.mutex.Unlock()
+
+	j.done <- err
+
+	return err
+}
@@ -1,7 +1,10 @@
 package workerpool
 
 import (
+	"context"
+	"fmt"
 	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/giantswarm/microerror"
@@ -32,6 +35,37 @@ func (p *Pool) EnqueueJob(job Job) {
 	}()
 }
 
+// Run enqueues the given jobs and waits until all of them are finished, or
+// until the context is done. Jobs which have not been started when the context
+// is done are not run anymore. The errors of all failed jobs are returned as
+// one jobsFailedError.
+func (p *Pool) Run(ctx context.Context, jobs ...Job) error {
+	var tracked []*trackedJob
+	for _, j := range jobs {
+		t := newTrackedJob(ctx, j)
+		tracked = append(tracked, t)
+		p.EnqueueJob(t)
+	}
+
+	var failed []string
+	for _, t := range tracked {
+		select {
+		case err := <-t.done:
+			if err != nil {
+				failed = append(failed, fmt.Sprintf("job %s: %s", t.ID(), err))
+			}
+		case <-ctx.Done():
+			return microerror.Mask(ctx.Err())
+		}
+	}
+
+	if len(failed) > 0 {
+		return microerror.Maskf(jobsFailedError, "%d of %d jobs failed: %s", len(failed), len(jobs), strings.Join(failed, "; "))
+	}
+
+	return nil
+}
+
 func (p *Pool) Stop() {
 	close(p.jobQueue)
 }
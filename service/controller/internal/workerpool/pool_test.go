@@ -1,10 +1,15 @@
 package workerpool
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/giantswarm/microerror"
 
 	"github.com/giantswarm/micrologger/microloggertest"
 )
@@ -94,3 +99,101 @@ func Test_Basic_Smoke_of_WorkerPool(t *testing.T) {
 		})
 	}
 }
+
+func Test_Pool_Run(t *testing.T) {
+	testCases := []struct {
+		name         string
+		numJobs      int
+		numWorkers   int
+		failingJobs  int
+		cancel       bool
+		errorMatcher func(error) bool
+	}{
+		{
+			name:       "case 0: nine jobs, three workers",
+			numJobs:    9,
+			numWorkers: 3,
+		},
+		{
+			name:         "case 1: two of five jobs fail",
+			numJobs:      5,
+			numWorkers:   2,
+			failingJobs:  2,
+			errorMatcher: IsJobsFailed,
+		},
+		{
+			name:         "case 2: canceled context",
+			numJobs:      3,
+			numWorkers:   1,
+			cancel:       true,
+			errorMatcher: func(err error) bool { return microerror.Cause(err) == context.Canceled },
+		},
+		{
+			name:       "case 3: no jobs",
+			numWorkers: 1,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			if tc.cancel {
+				cancel()
+			}
+
+			workerPool := New(tc.numWorkers, microloggertest.New())
+			defer workerPool.Stop()
+
+			var running, maxRunning, runs int32
+			var jobs []Job
+			for j := 0; j < tc.numJobs; j++ {
+				fail := j < tc.failingJobs
+				jobs = append(jobs, NewJob(strconv.Itoa(j), func() error {
+					n := atomic.AddInt32(&running, 1)
+					defer atomic.AddInt32(&running, -1)
+					for {
+						m := atomic.LoadInt32(&maxRunning)
+						if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+							break
+						}
+					}
+					atomic.AddInt32(&runs, 1)
+
+					time.Sleep(5 * time.Millisecond)
+
+					if fail {
+						return microerror.Maskf(jobsFailedError, "test")
+					}
+					return nil
+				}))
+			}
+
+			err := workerPool.Run(ctx, jobs...)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if int(maxRunning) > tc.numWorkers {
+				t.Fatalf("expected at most %d jobs running at once, got %d", tc.numWorkers, maxRunning)
+			}
+			if !tc.cancel && int(runs) != tc.numJobs {
+				t.Fatalf("expected %d jobs to run, got %d", tc.numJobs, runs)
+			}
+			if tc.cancel && runs != 0 {
+				t.Fatalf("expected no job to run after cancellation, got %d", runs)
+			}
+		})
+	}
+}
@@ -19,9 +19,9 @@ import (
 	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	expcapiv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
 
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
 	"github.com/giantswarm/azure-operator/v5/pkg/employees"
 	"github.com/giantswarm/azure-operator/v5/pkg/label"
-	"github.com/giantswarm/azure-operator/v5/service/controller/templates/ignition"
 )
 
 const (
@@ -40,10 +40,12 @@ const (
 	// cloudConfigVersion is used in blob object ignition name
 	cloudConfigVersion        = "v7.0.1"
 	storageAccountSuffix      = "gssa"
+	keyVaultPrefix            = "gskv"
 	routeTableSuffix          = "RouteTable"
 	masterSecurityGroupSuffix = "MasterSecurityGroup"
 	workerSecurityGroupSuffix = "WorkerSecurityGroup"
 	masterSubnetSuffix        = "MasterSubnet"
+	etcdPeerPort              = 2380
 	workerSubnetSuffix        = "WorkerSubnet"
 	masterNatGatewayName      = "masters-nat-gw"
 	prefixMaster              = "master"
@@ -67,9 +69,24 @@ const (
 
 	LegacyLabelCluster = "cluster"
 
-	CertificateEncryptionNamespace = "default"
-	CertificateEncryptionKeyName   = "encryptionkey"
-	CertificateEncryptionIVName    = "encryptioniv"
+	CertificateEncryptionNamespace       = "default"
+	CertificateEncryptionKeyName         = "encryptionkey"
+	CertificateEncryptionIVName          = "encryptioniv"
+	CertificateEncryptionPreviousKeyName = "previousencryptionkey"
+
+	// EncryptionKeyIDTag is the tag put on VMSSes to keep track of the
+	// certificate encryption key used in their model.
+	EncryptionKeyIDTag = "gs-encryption-key-id"
+
+	// ProviderTagName and ProviderTagValue make up the tag put on every
+	// resource created by the operator. It tells them apart from foreign
+	// resources in resource groups created beforehand.
+	ProviderTagName  = "provider"
+	ProviderTagValue = "F80D01C0-7AAC-4440-98F6-5061511962AD"
+
+	// DeletionProtectionLockName is the name of the management lock put on
+	// the resource group of clusters protected against deletion.
+	DeletionProtectionLockName = "giantswarm-deletion-protection"
 
 	ContainerLinuxComponentName = "containerlinux"
 
@@ -101,8 +118,14 @@ func AzureConfigNetworkCIDR(customObject providerv1alpha1.AzureConfig) string {
 }
 
 func AzureMachineName(getter LabelsGetter) string {
+	return MasterAzureMachineName(getter, 0)
+}
+
+// MasterAzureMachineName returns the name of the AzureMachine representing the
+// master with the given index.
+func MasterAzureMachineName(getter LabelsGetter, index int) string {
 	clusterID := ClusterID(getter)
-	return fmt.Sprintf("%s-master-0", clusterID)
+	return fmt.Sprintf("%s-master-%d", clusterID, index)
 }
 
 func ToCluster(v interface{}) (capiv1alpha3.Cluster, error) {
@@ -185,12 +208,6 @@ func CertificateEncryptionSecretName(customObject LabelsGetter) string {
 	return fmt.Sprintf("%s-certificate-encryption", ClusterID(customObject))
 }
 
-func CloudConfigSmallTemplates() []string {
-	return []string{
-		ignition.Small,
-	}
-}
-
 func ClusterAPIEndpoint(customObject providerv1alpha1.AzureConfig) string {
 	return customObject.Spec.Cluster.Kubernetes.API.Domain
 }
@@ -249,6 +266,55 @@ func ClusterTags(customObject providerv1alpha1.AzureConfig, installationName str
 	return tags
 }
 
+// ClusterIDFromTags returns the ID of the cluster a resource with the given
+// tags belongs to. It returns false when the resource is not tagged as
+// belonging to a cluster of the given installation.
+func ClusterIDFromTags(tags map[string]*string, installationName string) (string, bool) {
+	if installationName == "" || to.String(tags[installationTagName]) != installationName {
+		return "", false
+	}
+
+	clusterID := to.String(tags[clusterTagName])
+	if clusterID == "" {
+		return "", false
+	}
+
+	return clusterID, true
+}
+
+// IsClusterResource returns true when the given tags mark a resource the
+// operator manages for the given cluster. Resources the operator does not
+// manage, e.g. in bring-your-own resource groups, don't carry the tag.
+func IsClusterResource(tags map[string]*string, clusterID string) bool {
+	return clusterID != "" && to.String(tags[clusterTagName]) == clusterID
+}
+
+// IsOperatorTagName returns true when the given tag name is reserved for the
+// tags the operator and the cluster autoscaler put on Azure resources, which
+// user-defined tags must not override. Azure compares tag names ignoring case.
+func IsOperatorTagName(name string) bool {
+	name = strings.ToLower(name)
+
+	for _, n := range []string{clusterTagName, installationTagName, organizationTagName, ProviderTagName} {
+		if name == strings.ToLower(n) {
+			return true
+		}
+	}
+
+	return strings.HasPrefix(name, "gs-") || strings.HasPrefix(name, "cluster-autoscaler-")
+}
+
+// TemplateTags returns the GiantSwarmTags parameter of ARM templates. Next to
+// the provider tag it holds the cluster and installation tags, which tell the
+// resources of clusters apart from the ones of other installations.
+func TemplateTags(customObject providerv1alpha1.AzureConfig, installationName string) map[string]interface{} {
+	return map[string]interface{}{
+		ProviderTagName:     ProviderTagValue,
+		clusterTagName:      ClusterID(&customObject),
+		installationTagName: installationName,
+	}
+}
+
 // CredentialName returns name of the credential secret.
 func CredentialName(customObject providerv1alpha1.AzureConfig) string {
 	name := customObject.Spec.Azure.CredentialSecret.Name
@@ -379,6 +445,20 @@ func IsSucceededProvisioningState(s string) bool {
 	return s == "Succeeded"
 }
 
+// KeyVaultName returns the name of the Key Vault holding the certificate
+// encryption keys of the cluster. Key Vault names are globally unique, start
+// with a letter and have at most 24 characters.
+func KeyVaultName(getter LabelsGetter) string {
+	keyVaultName := fmt.Sprintf("%s%s", keyVaultPrefix, ClusterID(getter))
+	return strings.Replace(keyVaultName, "-", "", -1)
+}
+
+// KeyVaultSecretName returns the name of the Key Vault secret holding the
+// certificate encryption key with the given ID.
+func KeyVaultSecretName(keyID string) string {
+	return fmt.Sprintf("certificate-encryption-key-%s", keyID)
+}
+
 // These are the same labels that kubernetesd adds when creating/updating an AzureConfig.
 func KubeletLabelsNodePool(getter LabelsGetter) (string, error) {
 	var labels string
@@ -449,6 +529,40 @@ func MasterNICName(customObject providerv1alpha1.AzureConfig) string {
 	return fmt.Sprintf("%s-master-%s-nic", ClusterID(&customObject), ClusterID(&customObject))
 }
 
+// MasterCount returns the number of masters of the cluster.
+func MasterCount(customObject providerv1alpha1.AzureConfig) int {
+	return len(customObject.Spec.Azure.Masters)
+}
+
+// MasterFailureDomain returns the availability zone of the master with the
+// given index. Masters are spread over the availability zones of the cluster
+// round robin.
+func MasterFailureDomain(customObject providerv1alpha1.AzureConfig, location string, index int) (int, bool) {
+	zones := AvailabilityZones(customObject, location)
+	if len(zones) == 0 {
+		return 0, false
+	}
+
+	return zones[index%len(zones)], true
+}
+
+// EtcdMemberName returns the name of the etcd member running on the master
+// with the given index, counting from 1. The single master of a cluster keeps
+// the name without suffix for backwards compatibility.
+func EtcdMemberName(index int, masterCount int) string {
+	if masterCount == 1 {
+		return "etcd"
+	}
+
+	return fmt.Sprintf("etcd%d", index)
+}
+
+// EtcdPeerURL returns the URL etcd members use to reach the member with the
+// given name.
+func EtcdPeerURL(customObject providerv1alpha1.AzureConfig, memberName string) string {
+	return fmt.Sprintf("https://%s.%s:%d", memberName, ClusterBaseDomain(customObject), etcdPeerPort)
+}
+
 func MasterVMSSName(customObject providerv1alpha1.AzureConfig) string {
 	return fmt.Sprintf("%s-master-%s", ClusterID(&customObject), ClusterID(&customObject))
 }
@@ -467,9 +581,66 @@ func PrefixWorker() string {
 
 // ResourceGroupName returns name of the resource group for this cluster.
 func ResourceGroupName(customObject providerv1alpha1.AzureConfig) string {
+	if name, ok := ExistingResourceGroup(customObject); ok {
+		return name
+	}
+
 	return ClusterID(&customObject)
 }
 
+// ResourceGroupNameFromAzureCluster returns the name of the resource group of
+// the given AzureCluster.
+func ResourceGroupNameFromAzureCluster(azureCluster capzv1alpha3.AzureCluster) string {
+	if azureCluster.Spec.ResourceGroup != "" {
+		return azureCluster.Spec.ResourceGroup
+	}
+
+	return ClusterName(&azureCluster)
+}
+
+// ExistingResourceGroup returns the name of the resource group created
+// beforehand the cluster is placed into, which the operator must not create
+// or delete. It returns false when the cluster has a resource group of its
+// own.
+func ExistingResourceGroup(customObject providerv1alpha1.AzureConfig) (string, bool) {
+	return ParseExistingResourceGroup(customObject.Annotations[annotation.ExistingResourceGroup])
+}
+
+// ParseExistingResourceGroup parses the value of the existing resource group
+// annotation.
+func ParseExistingResourceGroup(value string) (string, bool) {
+	name := strings.TrimSpace(value)
+	if name == "" || strings.Contains(name, "/") {
+		return "", false
+	}
+
+	return name, true
+}
+
+// ExistingVirtualNetwork returns the resource group and name of the existing
+// virtual network the cluster is placed into. It returns false when the
+// cluster has a virtual network of its own.
+func ExistingVirtualNetwork(customObject providerv1alpha1.AzureConfig) (string, string, bool) {
+	return ParseExistingVirtualNetwork(customObject.Annotations[annotation.ExistingVirtualNetwork])
+}
+
+// ParseExistingVirtualNetwork parses the "<resource group>/<name>" value of
+// the existing virtual network annotation.
+func ParseExistingVirtualNetwork(value string) (string, string, bool) {
+	parts := strings.Split(strings.TrimSpace(value), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+
+	return parts[0], parts[1], true
+}
+
+// DeletionProtection returns true when the cluster is protected against
+// deletion by the annotation on its AzureCluster CR.
+func DeletionProtection(azureCluster capzv1alpha3.AzureCluster) bool {
+	return azureCluster.Annotations[annotation.DeletionProtection] == "true"
+}
+
 // RouteTableName returns name of the route table for this cluster.
 func RouteTableName(customObject providerv1alpha1.AzureConfig) string {
 	return fmt.Sprintf("%s-%s", ClusterID(&customObject), routeTableSuffix)
@@ -641,9 +812,39 @@ func ToString(v interface{}) (string, error) {
 
 // VnetName returns name of the virtual network.
 func VnetName(customObject providerv1alpha1.AzureConfig) string {
+	if _, name, ok := ExistingVirtualNetwork(customObject); ok {
+		return name
+	}
+
 	return fmt.Sprintf("%s-%s", ClusterID(&customObject), virtualNetworkSuffix)
 }
 
+// VnetResourceGroupName returns the name of the resource group the virtual
+// network of the cluster is in.
+func VnetResourceGroupName(customObject providerv1alpha1.AzureConfig) string {
+	if resourceGroup, _, ok := ExistingVirtualNetwork(customObject); ok {
+		return resourceGroup
+	}
+
+	return ResourceGroupName(customObject)
+}
+
+// VnetResourceGroupNameFromAzureCluster returns the name of the resource
+// group the virtual network of the given AzureCluster is in.
+func VnetResourceGroupNameFromAzureCluster(azureCluster capzv1alpha3.AzureCluster) string {
+	if azureCluster.Spec.NetworkSpec.Vnet.ResourceGroup != "" {
+		return azureCluster.Spec.NetworkSpec.Vnet.ResourceGroup
+	}
+
+	return ResourceGroupNameFromAzureCluster(azureCluster)
+}
+
+// VNetPeeringName returns the name of the peering of tenant cluster virtual
+// networks to the host cluster virtual network with the given name.
+func VNetPeeringName(hostVirtualNetwork string) string {
+	return hostVirtualNetwork
+}
+
 func VnetCIDR(customObject providerv1alpha1.AzureConfig) string {
 	return customObject.Spec.Azure.VirtualNetwork.CIDR
 }
@@ -658,6 +859,11 @@ func VPNGatewayName(customObject providerv1alpha1.AzureConfig) string {
 	return fmt.Sprintf("%s-%s", ClusterID(&customObject), vpnGatewaySuffix)
 }
 
+// VPNGatewayPublicIPName returns name of the public IP of the vpn gateway.
+func VPNGatewayPublicIPName(customObject providerv1alpha1.AzureConfig) string {
+	return fmt.Sprintf("%s-PublicIP", VPNGatewayName(customObject))
+}
+
 func WorkerInstanceName(clusterID, instanceID string) string {
 	idB36, err := vmssInstanceIDBase36(instanceID)
 	if err != nil {
@@ -675,6 +881,12 @@ func SubnetDeploymentName(subnetName string) string {
 	return fmt.Sprintf("%s-%s", subnetDeploymentPrefix, subnetName)
 }
 
+// IsNodePoolDeploymentName returns true when the given name is the name of the
+// deployment of a node pool or of its subnet.
+func IsNodePoolDeploymentName(name string) bool {
+	return strings.HasPrefix(name, "nodepool-") || strings.HasPrefix(name, subnetDeploymentPrefix+"-")
+}
+
 func MachinePoolID(getter LabelsGetter) (string, error) {
 	machinePoolID, exists := getter.GetLabels()[apiextensionslabels.MachinePool]
 	if !exists {
@@ -727,6 +939,18 @@ func WorkersSubnetCIDR(customObject providerv1alpha1.AzureConfig) string {
 	return customObject.Spec.Azure.VirtualNetwork.WorkerSubnetCIDR
 }
 
+// NodePoolRollbackBlobName returns the name of the blob holding the copy of
+// the cloud config of the last good node pool deployment.
+func NodePoolRollbackBlobName(azureMachinePool *expcapzv1alpha3.AzureMachinePool) string {
+	return fmt.Sprintf("%s-%s-rollback", ClusterID(azureMachinePool), azureMachinePool.Name)
+}
+
+// NodePoolRollbackSecretName returns the name of the secret holding the
+// parameters of the last good node pool deployment.
+func NodePoolRollbackSecretName(azureMachinePool *expcapzv1alpha3.AzureMachinePool) string {
+	return fmt.Sprintf("%s-rollback", NodePoolVMSSName(azureMachinePool))
+}
+
 func NodePoolSpotInstancesMaxPrice(azureMachinePool *expcapzv1alpha3.AzureMachinePool) string {
 	if azureMachinePool.Spec.Template.SpotVMOptions == nil || azureMachinePool.Spec.Template.SpotVMOptions.MaxPrice == nil {
 		return ""
@@ -3,6 +3,7 @@ package key
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"testing"
 
 	"github.com/Azure/go-autorest/autorest/to"
@@ -10,6 +11,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
 	"github.com/giantswarm/azure-operator/v5/pkg/label"
 )
 
@@ -380,3 +382,129 @@ func Test_InstanceIDFromNode(t *testing.T) {
 		}
 	}
 }
+
+func Test_ExistingVirtualNetwork(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		annotation            string
+		expectedVnetName      string
+		expectedResourceGroup string
+	}{
+		{
+			name:                  "case 0: cluster virtual network",
+			expectedVnetName:      "eggs2-VirtualNetwork",
+			expectedResourceGroup: "eggs2",
+		},
+		{
+			name:                  "case 1: existing virtual network",
+			annotation:            "network-rg/spoke-vnet",
+			expectedVnetName:      "spoke-vnet",
+			expectedResourceGroup: "network-rg",
+		},
+		{
+			name:                  "case 2: existing virtual network without resource group",
+			annotation:            "spoke-vnet",
+			expectedVnetName:      "eggs2-VirtualNetwork",
+			expectedResourceGroup: "eggs2",
+		},
+		{
+			name:                  "case 3: existing virtual network with empty name",
+			annotation:            "network-rg/",
+			expectedVnetName:      "eggs2-VirtualNetwork",
+			expectedResourceGroup: "eggs2",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			customObject := providerv1alpha1.AzureConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{},
+					Labels: map[string]string{
+						label.Cluster: "eggs2",
+					},
+				},
+				Spec: providerv1alpha1.AzureConfigSpec{
+					Cluster: providerv1alpha1.Cluster{
+						ID: "eggs2",
+					},
+				},
+			}
+			if tc.annotation != "" {
+				customObject.Annotations[annotation.ExistingVirtualNetwork] = tc.annotation
+			}
+
+			if VnetName(customObject) != tc.expectedVnetName {
+				t.Fatalf("expected virtual network %#q, got %#q", tc.expectedVnetName, VnetName(customObject))
+			}
+			if VnetResourceGroupName(customObject) != tc.expectedResourceGroup {
+				t.Fatalf("expected resource group %#q, got %#q", tc.expectedResourceGroup, VnetResourceGroupName(customObject))
+			}
+		})
+	}
+}
+
+func Test_ExistingResourceGroup(t *testing.T) {
+	testCases := []struct {
+		name                      string
+		annotation                string
+		expectedResourceGroup     string
+		expectedVnetResourceGroup string
+	}{
+		{
+			name:                      "case 0: cluster resource group",
+			expectedResourceGroup:     "eggs2",
+			expectedVnetResourceGroup: "eggs2",
+		},
+		{
+			name:                      "case 1: existing resource group",
+			annotation:                "customer-rg",
+			expectedResourceGroup:     "customer-rg",
+			expectedVnetResourceGroup: "customer-rg",
+		},
+		{
+			name:                      "case 2: existing resource group with surrounding spaces",
+			annotation:                " customer-rg ",
+			expectedResourceGroup:     "customer-rg",
+			expectedVnetResourceGroup: "customer-rg",
+		},
+		{
+			name:                      "case 3: existing resource group given as path",
+			annotation:                "customer-rg/nested",
+			expectedResourceGroup:     "eggs2",
+			expectedVnetResourceGroup: "eggs2",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			customObject := providerv1alpha1.AzureConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{},
+					Labels: map[string]string{
+						label.Cluster: "eggs2",
+					},
+				},
+				Spec: providerv1alpha1.AzureConfigSpec{
+					Cluster: providerv1alpha1.Cluster{
+						ID: "eggs2",
+					},
+				},
+			}
+			if tc.annotation != "" {
+				customObject.Annotations[annotation.ExistingResourceGroup] = tc.annotation
+			}
+
+			if ResourceGroupName(customObject) != tc.expectedResourceGroup {
+				t.Fatalf("expected resource group %#q, got %#q", tc.expectedResourceGroup, ResourceGroupName(customObject))
+			}
+			if VnetResourceGroupName(customObject) != tc.expectedVnetResourceGroup {
+				t.Fatalf("expected virtual network resource group %#q, got %#q", tc.expectedVnetResourceGroup, VnetResourceGroupName(customObject))
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package setting
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+)
+
+const (
+	LoadBalancerSkuBasic    = "basic"
+	LoadBalancerSkuStandard = "standard"
+)
+
+// CloudProvider holds the rate limits and backoff of the Azure cloud provider
+// of tenant clusters and the SKU of the load balancers it creates.
+type CloudProvider struct {
+	BackoffDuration      int
+	BackoffExponent      float64
+	BackoffJitter        float64
+	BackoffRetries       int
+	LoadBalancerSku      string
+	RateLimitBucket      int
+	RateLimitBucketWrite int
+	RateLimitQPS         float64
+	RateLimitQPSWrite    float64
+}
+
+func (c CloudProvider) Validate() error {
+	if c.BackoffDuration <= 0 {
+		return fmt.Errorf("BackoffDuration must be greater than 0")
+	}
+	if c.BackoffExponent < 1 {
+		return fmt.Errorf("BackoffExponent must be at least 1")
+	}
+	if c.BackoffJitter < 0 {
+		return fmt.Errorf("BackoffJitter must not be negative")
+	}
+	if c.BackoffRetries < 0 {
+		return fmt.Errorf("BackoffRetries must not be negative")
+	}
+	switch c.LoadBalancerSku {
+	case LoadBalancerSkuBasic, LoadBalancerSkuStandard:
+	default:
+		return fmt.Errorf("LoadBalancerSku must be %#q or %#q", LoadBalancerSkuBasic, LoadBalancerSkuStandard)
+	}
+	if c.RateLimitBucket <= 0 {
+		return fmt.Errorf("RateLimitBucket must be greater than 0")
+	}
+	if c.RateLimitBucketWrite <= 0 {
+		return fmt.Errorf("RateLimitBucketWrite must be greater than 0")
+	}
+	if c.RateLimitQPS <= 0 {
+		return fmt.Errorf("RateLimitQPS must be greater than 0")
+	}
+	if c.RateLimitQPSWrite <= 0 {
+		return fmt.Errorf("RateLimitQPSWrite must be greater than 0")
+	}
+
+	return nil
+}
+
+// ForCluster returns the settings with the overrides of the
+// azure-operator.giantswarm.io/cloud-provider-config annotation in the given
+// annotations of an AzureCluster CR applied.
+func (c CloudProvider) ForCluster(annotations map[string]string) (CloudProvider, error) {
+	overrides := strings.TrimSpace(annotations[annotation.CloudProviderConfig])
+	if overrides == "" {
+		return c, nil
+	}
+
+	c, err := c.override(overrides)
+	if err != nil {
+		return CloudProvider{}, fmt.Errorf("annotation %#q: %s", annotation.CloudProviderConfig, err)
+	}
+
+	return c, nil
+}
+
+func (c CloudProvider) override(overrides string) (CloudProvider, error) {
+	for _, pair := range strings.Split(overrides, ",") {
+		parts := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(parts) != 2 {
+			return CloudProvider{}, fmt.Errorf("override %#q must be given as <name>=<value>", pair)
+		}
+
+		name, value := parts[0], strings.TrimSpace(parts[1])
+
+		var err error
+		switch name {
+		case "backoffDuration":
+			c.BackoffDuration, err = strconv.Atoi(value)
+		case "backoffExponent":
+			c.BackoffExponent, err = strconv.ParseFloat(value, 64)
+		case "backoffJitter":
+			c.BackoffJitter, err = strconv.ParseFloat(value, 64)
+		case "backoffRetries":
+			c.BackoffRetries, err = strconv.Atoi(value)
+		case "loadBalancerSku":
+			c.LoadBalancerSku = value
+		case "rateLimitBucket":
+			c.RateLimitBucket, err = strconv.Atoi(value)
+		case "rateLimitBucketWrite":
+			c.RateLimitBucketWrite, err = strconv.Atoi(value)
+		case "rateLimitQPS":
+			c.RateLimitQPS, err = strconv.ParseFloat(value, 64)
+		case "rateLimitQPSWrite":
+			c.RateLimitQPSWrite, err = strconv.ParseFloat(value, 64)
+		default:
+			return CloudProvider{}, fmt.Errorf("unknown override %#q", name)
+		}
+		if err != nil {
+			return CloudProvider{}, fmt.Errorf("override %#q: %s", name, err)
+		}
+	}
+
+	err := c.Validate()
+	if err != nil {
+		return CloudProvider{}, err
+	}
+
+	return c, nil
+}
+
+// String returns the settings as sorted list of "<name>=<value>" pairs, in
+// the format of the azure-operator.giantswarm.io/cloud-provider-config
+// annotation.
+func (c CloudProvider) String() string {
+	pairs := []string{
+		fmt.Sprintf("backoffDuration=%d", c.BackoffDuration),
+		fmt.Sprintf("backoffExponent=%s", formatFloat(c.BackoffExponent)),
+		fmt.Sprintf("backoffJitter=%s", formatFloat(c.BackoffJitter)),
+		fmt.Sprintf("backoffRetries=%d", c.BackoffRetries),
+		fmt.Sprintf("loadBalancerSku=%s", c.LoadBalancerSku),
+		fmt.Sprintf("rateLimitBucket=%d", c.RateLimitBucket),
+		fmt.Sprintf("rateLimitBucketWrite=%d", c.RateLimitBucketWrite),
+		fmt.Sprintf("rateLimitQPS=%s", formatFloat(c.RateLimitQPS)),
+		fmt.Sprintf("rateLimitQPSWrite=%s", formatFloat(c.RateLimitQPSWrite)),
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,101 @@
+package setting
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+)
+
+func Test_CloudProvider_ForCluster(t *testing.T) {
+	defaults := CloudProvider{
+		BackoffDuration:      6,
+		BackoffExponent:      1.5,
+		BackoffJitter:        1,
+		BackoffRetries:       6,
+		LoadBalancerSku:      LoadBalancerSkuStandard,
+		RateLimitBucket:      10,
+		RateLimitBucketWrite: 10,
+		RateLimitQPS:         3,
+		RateLimitQPSWrite:    3,
+	}
+
+	testCases := []struct {
+		name                  string
+		annotations           map[string]string
+		expectedCloudProvider CloudProvider
+		expectedString        string
+		expectedErr           bool
+	}{
+		{
+			name:                  "case 0: defaults without annotation",
+			annotations:           nil,
+			expectedCloudProvider: defaults,
+			expectedString:        "backoffDuration=6,backoffExponent=1.5,backoffJitter=1,backoffRetries=6,loadBalancerSku=standard,rateLimitBucket=10,rateLimitBucketWrite=10,rateLimitQPS=3,rateLimitQPSWrite=3",
+		},
+		{
+			name: "case 1: rate limits raised",
+			annotations: map[string]string{
+				annotation.CloudProviderConfig: "rateLimitQPS=10, rateLimitBucket=100,rateLimitQPSWrite=2.5",
+			},
+			expectedCloudProvider: CloudProvider{
+				BackoffDuration:      6,
+				BackoffExponent:      1.5,
+				BackoffJitter:        1,
+				BackoffRetries:       6,
+				LoadBalancerSku:      LoadBalancerSkuStandard,
+				RateLimitBucket:      100,
+				RateLimitBucketWrite: 10,
+				RateLimitQPS:         10,
+				RateLimitQPSWrite:    2.5,
+			},
+			expectedString: "backoffDuration=6,backoffExponent=1.5,backoffJitter=1,backoffRetries=6,loadBalancerSku=standard,rateLimitBucket=100,rateLimitBucketWrite=10,rateLimitQPS=10,rateLimitQPSWrite=2.5",
+		},
+		{
+			name: "case 2: unknown name",
+			annotations: map[string]string{
+				annotation.CloudProviderConfig: "qps=10",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "case 3: invalid number",
+			annotations: map[string]string{
+				annotation.CloudProviderConfig: "rateLimitBucket=many",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "case 4: invalid value",
+			annotations: map[string]string{
+				annotation.CloudProviderConfig: "loadBalancerSku=premium",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			cloudProvider, err := defaults.ForCluster(tc.annotations)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatalf("error == nil, want non-nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			if !reflect.DeepEqual(cloudProvider, tc.expectedCloudProvider) {
+				t.Fatalf("expected %#v, got %#v", tc.expectedCloudProvider, cloudProvider)
+			}
+			if cloudProvider.String() != tc.expectedString {
+				t.Fatalf("expected %#q, got %#q", tc.expectedString, cloudProvider.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package setting
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+)
+
+const (
+	// minEtcdSnapshotInterval bounds how often the etcd data disks are
+	// snapshotted, so that snapshots don't pile up faster than they are
+	// taken.
+	minEtcdSnapshotInterval = 15 * time.Minute
+)
+
+// EtcdSnapshots holds how often the etcd data disks of the masters of tenant
+// clusters are snapshotted and how many snapshots are kept. An Interval of 0
+// disables the snapshots.
+type EtcdSnapshots struct {
+	Interval  time.Duration
+	Retention int
+}
+
+func (e EtcdSnapshots) Enabled() bool {
+	return e.Interval > 0
+}
+
+func (e EtcdSnapshots) Validate() error {
+	if e.Interval < 0 {
+		return fmt.Errorf("Interval must not be negative")
+	}
+	if e.Enabled() && e.Interval < minEtcdSnapshotInterval {
+		return fmt.Errorf("Interval must be at least %s", minEtcdSnapshotInterval)
+	}
+	if e.Retention < 1 {
+		return fmt.Errorf("Retention must be at least 1")
+	}
+
+	return nil
+}
+
+// ForCluster returns the settings with the overrides of the
+// azure-operator.giantswarm.io/etcd-snapshots annotation in the given
+// annotations of an AzureCluster CR applied.
+func (e EtcdSnapshots) ForCluster(annotations map[string]string) (EtcdSnapshots, error) {
+	overrides := strings.TrimSpace(annotations[annotation.EtcdSnapshots])
+	if overrides == "" {
+		return e, nil
+	}
+
+	for _, pair := range strings.Split(overrides, ",") {
+		parts := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(parts) != 2 {
+			return EtcdSnapshots{}, fmt.Errorf("annotation %#q: override %#q must be given as <name>=<value>", annotation.EtcdSnapshots, pair)
+		}
+
+		name, value := parts[0], strings.TrimSpace(parts[1])
+
+		var err error
+		switch name {
+		case "interval":
+			e.Interval, err = time.ParseDuration(value)
+		case "retention":
+			e.Retention, err = strconv.Atoi(value)
+		default:
+			return EtcdSnapshots{}, fmt.Errorf("annotation %#q: unknown override %#q", annotation.EtcdSnapshots, name)
+		}
+		if err != nil {
+			return EtcdSnapshots{}, fmt.Errorf("annotation %#q: override %#q: %s", annotation.EtcdSnapshots, name, err)
+		}
+	}
+
+	err := e.Validate()
+	if err != nil {
+		return EtcdSnapshots{}, fmt.Errorf("annotation %#q: %s", annotation.EtcdSnapshots, err)
+	}
+
+	return e, nil
+}
@@ -0,0 +1,83 @@
+package setting
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+)
+
+func Test_EtcdSnapshots_ForCluster(t *testing.T) {
+	defaults := EtcdSnapshots{
+		Interval:  24 * time.Hour,
+		Retention: 7,
+	}
+
+	testCases := []struct {
+		name                  string
+		annotations           map[string]string
+		expectedEtcdSnapshots EtcdSnapshots
+		expectedErr           bool
+	}{
+		{
+			name:                  "case 0: defaults without annotation",
+			expectedEtcdSnapshots: defaults,
+		},
+		{
+			name: "case 1: interval and retention overridden",
+			annotations: map[string]string{
+				annotation.EtcdSnapshots: "interval=6h, retention=28",
+			},
+			expectedEtcdSnapshots: EtcdSnapshots{
+				Interval:  6 * time.Hour,
+				Retention: 28,
+			},
+		},
+		{
+			name: "case 2: snapshots disabled",
+			annotations: map[string]string{
+				annotation.EtcdSnapshots: "interval=0",
+			},
+			expectedEtcdSnapshots: EtcdSnapshots{
+				Retention: 7,
+			},
+		},
+		{
+			name: "case 3: interval too short",
+			annotations: map[string]string{
+				annotation.EtcdSnapshots: "interval=1m",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "case 4: unknown name",
+			annotations: map[string]string{
+				annotation.EtcdSnapshots: "schedule=daily",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			etcdSnapshots, err := defaults.ForCluster(tc.annotations)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatalf("error == nil, want non-nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			if !reflect.DeepEqual(etcdSnapshots, tc.expectedEtcdSnapshots) {
+				t.Fatalf("expected %#v, got %#v", tc.expectedEtcdSnapshots, etcdSnapshots)
+			}
+		})
+	}
+}
@@ -1,12 +1,38 @@
 package setting
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+)
+
+const (
+	// EncryptionKeyDeliveryKeyVault stores the certificate encryption key in a
+	// Key Vault of the cluster, from where nodes fetch it at boot using the
+	// managed identity of their VMSS.
+	EncryptionKeyDeliveryKeyVault = "keyvault"
+	// EncryptionKeyDeliveryCustomData writes the certificate encryption key in
+	// plain text into the custom data of the VMSS.
+	EncryptionKeyDeliveryCustomData = "customdata"
+
+	// ConnectivityVPN connects the control plane and tenant clusters with a
+	// VPN gateway in each tenant cluster connected to the host cluster VPN
+	// gateway.
+	ConnectivityVPN = "vpn"
+	// ConnectivityVNetPeering connects the control plane and tenant clusters
+	// with peerings between the host cluster and tenant cluster virtual
+	// networks.
+	ConnectivityVNetPeering = "vnetpeering"
+)
 
 type Azure struct {
-	EnvironmentName string
-	HostCluster     AzureHostCluster
-	MSI             AzureMSI
-	Location        string
+	Connectivity          string
+	EncryptionKeyDelivery string
+	EnvironmentName       string
+	HostCluster           AzureHostCluster
+	MSI                   AzureMSI
+	Location              string
 }
 
 type Debug struct {
@@ -23,10 +49,47 @@ func (a Azure) Validate() error {
 	if a.Location == "" {
 		return fmt.Errorf("Location must not be empty")
 	}
+	switch a.EncryptionKeyDelivery {
+	case "", EncryptionKeyDeliveryKeyVault, EncryptionKeyDeliveryCustomData:
+	default:
+		return fmt.Errorf("EncryptionKeyDelivery must be %#q or %#q", EncryptionKeyDeliveryKeyVault, EncryptionKeyDeliveryCustomData)
+	}
+	switch a.Connectivity {
+	case "", ConnectivityVPN, ConnectivityVNetPeering:
+	default:
+		return fmt.Errorf("Connectivity must be %#q or %#q", ConnectivityVPN, ConnectivityVNetPeering)
+	}
 
 	return nil
 }
 
+// ConnectivityForCluster returns how the control plane connects to the tenant
+// cluster with the given annotations of its AzureCluster CR. The
+// azure-operator.giantswarm.io/connectivity annotation overrides the
+// connectivity of the installation, which defaults to VPN.
+func (a Azure) ConnectivityForCluster(annotations map[string]string) (string, error) {
+	connectivity := strings.TrimSpace(annotations[annotation.Connectivity])
+	if connectivity == "" {
+		connectivity = a.Connectivity
+	}
+
+	switch connectivity {
+	case "":
+		return ConnectivityVPN, nil
+	case ConnectivityVPN, ConnectivityVNetPeering:
+		return connectivity, nil
+	default:
+		return "", fmt.Errorf("annotation %#q must be %#q or %#q, got %#q", annotation.Connectivity, ConnectivityVPN, ConnectivityVNetPeering, connectivity)
+	}
+}
+
+// KeyVaultEnabled returns true when the certificate encryption key is delivered
+// to nodes through Key Vault. This requires the VMSS managed identity, without
+// it the key falls back to be delivered in the custom data.
+func (a Azure) KeyVaultEnabled() bool {
+	return a.EncryptionKeyDelivery == EncryptionKeyDeliveryKeyVault && a.MSI.Enabled
+}
+
 type AzureHostCluster struct {
 	CIDR                  string
 	ResourceGroup         string
@@ -56,10 +119,11 @@ type AzureMSI struct {
 }
 
 type Ignition struct {
-	Path       string
-	Debug      bool
-	LogsPrefix string
-	LogsToken  string
+	Path        string
+	Debug       bool
+	LogsPrefix  string
+	LogsToken   string
+	SpecVersion string
 }
 
 type OIDC struct {
@@ -0,0 +1,66 @@
+package setting
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+)
+
+func Test_Azure_ConnectivityForCluster(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		connectivity         string
+		annotations          map[string]string
+		expectedConnectivity string
+		expectedErr          bool
+	}{
+		{
+			name:                 "case 0: vpn by default",
+			expectedConnectivity: ConnectivityVPN,
+		},
+		{
+			name:                 "case 1: connectivity of the installation",
+			connectivity:         ConnectivityVNetPeering,
+			expectedConnectivity: ConnectivityVNetPeering,
+		},
+		{
+			name:         "case 2: annotation overrides the installation",
+			connectivity: ConnectivityVNetPeering,
+			annotations: map[string]string{
+				annotation.Connectivity: "vpn",
+			},
+			expectedConnectivity: ConnectivityVPN,
+		},
+		{
+			name: "case 3: invalid annotation",
+			annotations: map[string]string{
+				annotation.Connectivity: "expressroute",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			azure := Azure{Connectivity: tc.connectivity}
+
+			connectivity, err := azure.ConnectivityForCluster(tc.annotations)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if connectivity != tc.expectedConnectivity {
+				t.Fatalf("expected %#q, got %#q", tc.expectedConnectivity, connectivity)
+			}
+		})
+	}
+}
@@ -7,13 +7,109 @@ After=k8s-setup-network-env.service
 Before=k8s-kubelet.service etcd3.service
 [Service]
 Type=oneshot
+{{ if .KeyVault -}}
+ExecStartPre=/opt/bin/encryption-key-fetcher
+EnvironmentFile=-/etc/.enc/key
+EnvironmentFile=-/etc/.enc/iv
+{{ else -}}
 EnvironmentFile=/etc/.enc/key
 EnvironmentFile=/etc/.enc/iv
+{{ end -}}
 ExecStart=/bin/sh -c "\
 {{ range $index, $file := .CertsPaths -}}
-openssl enc -aes-256-cfb -d -K ${ENCRYPTION_KEY} -iv ${INITIAL_VECTOR} -in {{ $file }}.enc -out {{ $file }} ; \
+/opt/bin/certificate-decrypter {{ $file }}.enc {{ $file }} ; \
 {{ end -}}
 "
  [Install]
 WantedBy=multi-user.target
 `
+
+// CertificateDecrypter decrypts a single certificate file. Files starting with
+// a "gsenc:v2:<key id>:<nonce>" header line are AES-256-GCM encrypted with the
+// header line as additional data. The openssl CLI does not support AEAD
+// ciphers, so the authentication tag is computed using GHASH and compared
+// before anything is decrypted. The GCM keystream equals AES-256-CTR starting
+// with the counter block nonce||00000002, which openssl decrypts. The key is
+// selected by its ID out of the current and the previous key, which nodes get
+// during a key rotation. Files without header are legacy AES-256-CFB encrypted
+// using the fixed initial vector.
+const CertificateDecrypter = `#!/bin/bash
+set -eu -o pipefail
+
+in="$1"
+out="$2"
+
+# aes_block prints the hex encoded AES-256 encryption of the counter block $2
+# using the hex key $1.
+aes_block() {
+  head -c 16 /dev/zero | openssl enc -aes-256-ctr -K "$1" -iv "$2" | od -An -v -tx1 | tr -d ' \n'
+}
+
+# pad prints the hex string $1 padded with zeros to a multiple of 16 bytes.
+pad() {
+  local s="$1"
+  while (( ${#s} % 32 )); do
+    s="${s}0"
+  done
+  printf '%s' "${s}"
+}
+
+# ghash prints the GHASH of the hex encoded additional data $2 and ciphertext
+# $3 using the hex encoded hash key $1 as defined by GCM. Blocks are handled
+# as two 64 bit halves.
+ghash() {
+  local blocks hh hl yh=0 yl=0 xh xl zh zl vh vl b i j
+  blocks="$(pad "$2")$(pad "$3")$(printf '%016x%016x' $(( ${#2} * 4 )) $(( ${#3} * 4 )))"
+  hh=$(( 16#${1:0:16} ))
+  hl=$(( 16#${1:16:16} ))
+  for (( j = 0; j < ${#blocks}; j += 32 )); do
+    : $(( xh = yh ^ 16#${blocks:j:16}, xl = yl ^ 16#${blocks:j+16:16}, zh = 0, zl = 0, vh = hh, vl = hl ))
+    for (( i = 0; i < 128; i++ )); do
+      : $(( b = i < 64 ? (xh >> (63 - i)) & 1 : (xl >> (127 - i)) & 1,
+            zh ^= b ? vh : 0, zl ^= b ? vl : 0,
+            b = vl & 1,
+            vl = ((vl >> 1) & 0x7fffffffffffffff) | ((vh & 1) << 63),
+            vh = ((vh >> 1) & 0x7fffffffffffffff) ^ (b ? 0xe100000000000000 : 0) ))
+    done
+    : $(( yh = zh, yl = zl ))
+  done
+  printf '%016x%016x' "${yh}" "${yl}"
+}
+
+header="$(head -n 1 "${in}" | tr -d '\0')"
+if [[ "${header}" == gsenc:v2:* ]]; then
+  IFS=: read -r _ _ key_id nonce <<< "${header}"
+  if [ -n "${key_id}" ] && [ "${key_id}" == "${ENCRYPTION_KEY_ID}" ]; then
+    key="${ENCRYPTION_KEY}"
+  elif [ -n "${key_id}" ] && [ "${key_id}" == "${PREVIOUS_ENCRYPTION_KEY_ID:-}" ]; then
+    key="${PREVIOUS_ENCRYPTION_KEY}"
+  else
+    echo "${in} is encrypted with key ${key_id} which is not configured" >&2
+    exit 1
+  fi
+  if [ "${#nonce}" -ne 24 ]; then
+    echo "${in} has an invalid nonce" >&2
+    exit 1
+  fi
+  data="$(od -An -v -tx1 "${in}" | tr -d ' \n')"
+  aad_length=$(( (${#header} + 1) * 2 ))
+  ciphertext_length=$(( ${#data} - aad_length - 32 ))
+  if [ "${ciphertext_length}" -lt 0 ]; then
+    echo "${in} is truncated" >&2
+    exit 1
+  fi
+  s="$(ghash "$(aes_block "${key}" 00000000000000000000000000000000)" "${data:0:aad_length}" "${data:aad_length:ciphertext_length}")"
+  j="$(aes_block "${key}" "${nonce}00000001")"
+  tag="$(printf '%016x%016x' $(( 16#${s:0:16} ^ 16#${j:0:16} )) $(( 16#${s:16:16} ^ 16#${j:16:16} )))"
+  if [ "${tag}" != "${data:aad_length+ciphertext_length}" ]; then
+    echo "${in} failed authentication" >&2
+    exit 1
+  fi
+  tail -c +$(( ${#header} + 2 )) "${in}" | head -c -16 | openssl enc -aes-256-ctr -d -K "${key}" -iv "${nonce}00000002" -out "${out}"
+elif [[ "${header}" == gsenc:* ]]; then
+  echo "${in} is encrypted in an unsupported format" >&2
+  exit 1
+else
+  openssl enc -aes-256-cfb -d -K "${ENCRYPTION_KEY}" -iv "${INITIAL_VECTOR}" -in "${in}" -out "${out}"
+fi
+`
@@ -10,21 +10,24 @@ aadClientId: {{ .AADClientID }}
 aadClientSecret: {{ .AADClientSecret }}
 {{- end}}
 cloudProviderBackoff: true
-cloudProviderBackoffRetries: 6
-cloudProviderBackoffJitter: 1
-cloudProviderBackoffDuration: 6
-cloudProviderBackoffExponent: 1.5
+cloudProviderBackoffRetries: {{ .CloudProvider.BackoffRetries }}
+cloudProviderBackoffJitter: {{ .CloudProvider.BackoffJitter }}
+cloudProviderBackoffDuration: {{ .CloudProvider.BackoffDuration }}
+cloudProviderBackoffExponent: {{ .CloudProvider.BackoffExponent }}
 cloudProviderRateLimit: true
-cloudProviderRateLimitQPS: 3
-cloudProviderRateLimitBucket: 10
-cloudProviderRateLimitQPSWrite: 3
-cloudProviderRateLimitBucketWrite: 10
+cloudProviderRateLimitQPS: {{ .CloudProvider.RateLimitQPS }}
+cloudProviderRateLimitBucket: {{ .CloudProvider.RateLimitBucket }}
+cloudProviderRateLimitQPSWrite: {{ .CloudProvider.RateLimitQPSWrite }}
+cloudProviderRateLimitBucketWrite: {{ .CloudProvider.RateLimitBucketWrite }}
 primaryScaleSetName: {{ .PrimaryScaleSetName }}
 subnetName: {{ .SubnetName }}
 securityGroupName: {{ .SecurityGroupName }}
 vnetName: {{ .VnetName }}
+{{- if .VnetResourceGroup }}
+vnetResourceGroup: {{ .VnetResourceGroup }}
+{{- end }}
 vmType: vmss
 routeTableName: {{ .RouteTableName }}
 useManagedIdentityExtension: {{ .UseManagedIdentityExtension }}
-loadBalancerSku: standard
+loadBalancerSku: {{ .CloudProvider.LoadBalancerSku }}
 `
@@ -0,0 +1,16 @@
+package ignition
+
+const DataDiskMountUnit = `[Unit]
+Description=Mounts disk to {{ .MountPoint }}
+{{- if .Before }}
+Before={{ .Before }}
+{{- end }}
+
+[Mount]
+What=/dev/disk/by-label/{{ .Name }}
+Where={{ .MountPoint }}
+Type={{ .Format }}
+
+[Install]
+WantedBy=multi-user.target
+`
@@ -0,0 +1,29 @@
+package ignition
+
+// EncryptionKeyFetcher fetches the certificate encryption key from the Key
+// Vault of the cluster using the managed identity of the VMSS and writes it to
+// the environment file read by the certificate decrypter. It keeps trying until
+// the identity got read access to the Key Vault.
+const EncryptionKeyFetcher = `#!/bin/bash
+set -u -o pipefail
+
+imds_url="http://169.254.169.254/metadata/identity/oauth2/token?api-version=2018-02-01&resource={{ .KeyVaultResource }}"
+secret_url="{{ .KeyVaultSecretURL }}?api-version=7.0"
+
+while true; do
+  token="$(curl -sf -H Metadata:true "${imds_url}" | sed -n 's/.*"access_token":"\([^"]*\)".*/\1/p')"
+  if [ -n "${token}" ]; then
+    value="$(curl -sf -H "Authorization: Bearer ${token}" "${secret_url}" | sed -n 's/.*"value":"\([^"]*\)".*/\1/p')"
+    if [ -n "${value}" ]; then
+      break
+    fi
+  fi
+  echo "waiting for encryption key in key vault" >&2
+  sleep 10
+done
+
+umask 077
+mkdir -p /etc/.enc
+printf '%b' "${value}" > /etc/.enc/key
+printf '%b' "${value}" | grep '^INITIAL_VECTOR=' > /etc/.enc/iv
+`
@@ -0,0 +1,74 @@
+package ignition
+
+// EtcdMemberInit prepares the start of the etcd member of a master in a
+// cluster with multiple masters. All masters share the same cloud config, so
+// each of them finds its member name by looking for the DNS record pointing
+// to its IP. A master without etcd data joins the cluster as a new member when
+// the cluster is already serving, e.g. when the cluster grows or the data disk
+// of the master got replaced.
+const EtcdMemberInit = `#!/bin/bash
+set -o errexit -o nounset -o pipefail
+
+env_file=/etc/etcd-member.env
+data_dir=/var/lib/etcd/member
+base_domain="{{ .BaseDomain }}"
+
+name=""
+for i in $(seq 1 {{ .MasterCount }}); do
+  if getent hosts "etcd${i}.${base_domain}" | grep -qw "${DEFAULT_IPV4}"; then
+    name="etcd${i}"
+  fi
+done
+if [ -z "${name}" ]; then
+  echo "waiting for an etcd member DNS record pointing to ${DEFAULT_IPV4}" >&2
+  exit 1
+fi
+peer_url="https://${name}.${base_domain}:2380"
+
+initial_cluster="{{ .InitialCluster }}"
+state=new
+
+etcdctl() {
+  docker run --rm --net=host -v /etc/kubernetes/ssl/etcd/:/etc/etcd \
+    -e ETCDCTL_API=3 "${IMAGE}" etcdctl \
+    --endpoints="{{ .EtcdEndpoint }}" \
+    --cacert=/etc/etcd/server-ca.pem \
+    --cert=/etc/etcd/server-crt.pem \
+    --key=/etc/etcd/server-key.pem \
+    --command-timeout=10s "$@"
+}
+
+if [ ! -d "${data_dir}" ] && members="$(etcdctl member list)"; then
+  # The single master of a cluster which grows advertises the etcd load
+  # balancer as its peer URL, which does not reach it. Point it to its own
+  # record before any member joins.
+  legacy_id="$(echo "${members}" | awk -F', ' '$4 == "https://etcd.'"${base_domain}"':2380" { print $1 }')"
+  if [ -n "${legacy_id}" ]; then
+    etcdctl member update "${legacy_id}" --peer-urls="https://etcd1.${base_domain}:2380"
+  fi
+
+  if ! echo "${members}" | grep -q "${peer_url}"; then
+    etcdctl member add "${name}" --peer-urls="${peer_url}"
+  fi
+
+  state=existing
+  initial_cluster="$(etcdctl member list | awk -F', ' -v name="${name}" -v url="${peer_url}" '
+    $4 == url { print name "=" url; next }
+    { print $3 "=" $4 }
+  ' | paste -sd, -)"
+fi
+
+cat > "${env_file}" <<EOF
+ETCD_NAME=${name}
+ETCD_INITIAL_CLUSTER=${initial_cluster}
+ETCD_INITIAL_CLUSTER_STATE=${state}
+EOF
+`
+
+// EtcdMemberDropin makes etcd use the member name and initial cluster prepared
+// by EtcdMemberInit. Variables of environment files override the ones set in
+// the unit.
+const EtcdMemberDropin = `[Service]
+ExecStartPre=/opt/bin/etcd-member-init
+EnvironmentFile=-/etc/etcd-member.env
+`
@@ -15,12 +15,15 @@ import (
 	"github.com/giantswarm/tenantcluster/v3/pkg/tenantcluster"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/cluster-api/api/v1alpha3"
 
 	"github.com/giantswarm/azure-operator/v5/client"
 	"github.com/giantswarm/azure-operator/v5/pkg/credential"
 	"github.com/giantswarm/azure-operator/v5/pkg/label"
 	"github.com/giantswarm/azure-operator/v5/pkg/project"
+	"github.com/giantswarm/azure-operator/v5/pkg/ratelimit"
+	"github.com/giantswarm/azure-operator/v5/pkg/recorder"
 	"github.com/giantswarm/azure-operator/v5/service/collector"
 	"github.com/giantswarm/azure-operator/v5/service/controller/unhealthynode/handler/terminateunhealthynode"
 )
@@ -30,6 +33,7 @@ type ControllerConfig struct {
 	Logger    micrologger.Logger
 
 	AzureMetricsCollector collector.AzureAPIMetrics
+	AzureRateLimiter      *ratelimit.Limiter
 	CredentialProvider    credential.Provider
 	SentryDSN             string
 }
@@ -114,6 +118,7 @@ func newTerminateUnhealthyNodeResources(config ControllerConfig, certsSearcher *
 	{
 		c := client.FactoryConfig{
 			AzureAPIMetrics:    config.AzureMetricsCollector,
+			RateLimiter:        config.AzureRateLimiter,
 			CacheDuration:      30 * time.Minute,
 			CredentialProvider: config.CredentialProvider,
 			Logger:             config.Logger,
@@ -149,11 +154,26 @@ func newTerminateUnhealthyNodeResources(config ControllerConfig, certsSearcher *
 		}
 	}
 
+	var eventRecorder record.EventRecorder
+	{
+		c := recorder.Config{
+			K8sClient: config.K8sClient,
+
+			Component: project.Name(),
+		}
+
+		eventRecorder, err = recorder.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var terminateUnhealthyNodeResource resource.Interface
 	{
 		c := terminateunhealthynode.Config{
 			AzureClientsFactory:      &organizationClientFactory,
 			CtrlClient:               config.K8sClient.CtrlClient(),
+			EventRecorder:            eventRecorder,
 			Logger:                   config.Logger,
 			TenantRestConfigProvider: tenantRestConfigProvider,
 		}
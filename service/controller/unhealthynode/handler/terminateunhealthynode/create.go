@@ -2,26 +2,36 @@ package terminateunhealthynode
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
 	"github.com/giantswarm/apiextensions/v3/pkg/annotation"
-	"github.com/giantswarm/apiextensions/v3/pkg/label"
 	"github.com/giantswarm/badnodedetector/pkg/detector"
 	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
 	"github.com/giantswarm/microerror"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/rest"
-	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
 	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	azureannotation "github.com/giantswarm/azure-operator/v5/pkg/annotation"
 	"github.com/giantswarm/azure-operator/v5/service/controller/key"
 )
 
 const (
 	nodeTerminationTickThreshold = 6
+
+	NodeRemediationReason           = "NodeRemediation"
+	RemediationExhaustedReason      = "RemediationExhausted"
+	RemediationShortCircuitedReason = "RemediationShortCircuited"
 )
 
+// EnsureCreated remediates at most one unhealthy node of the cluster per
+// reconciliation, modelled on the MachineHealthCheck of Cluster API. Workers
+// are rebooted first, reimaged when they are still unhealthy afterwards and
+// finally drained and replaced. The master is reimaged once etcd is healthy,
+// at most maxMasterReimages times until it becomes ready again.
+// Nothing is remediated while more nodes are unhealthy than the cluster allows.
 func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 	var err error
 	cr, err := key.ToCluster(obj)
@@ -35,7 +45,7 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		return nil
 	}
 
-	var tenantClusterK8sClient client.Client
+	var tenantClusterK8sClient k8sclient.Interface
 	{
 		tenantClusterK8sClient, err = r.getTenantClusterClient(ctx, &cr)
 		if err != nil {
@@ -43,10 +53,59 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		}
 	}
 
+	var nodes []corev1.Node
+	{
+		nodeList := &corev1.NodeList{}
+		err = tenantClusterK8sClient.CtrlClient().List(ctx, nodeList)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		nodes = nodeList.Items
+	}
+
+	// Nodes which became ready again were remediated successfully, the next
+	// remediation starts over with the first step.
+	for _, n := range nodes {
+		if isReady(n) && !isBeingReplaced(n) && n.Annotations[azureannotation.NodeRemediationStep] != "" {
+			err = r.setRemediationStep(ctx, tenantClusterK8sClient.CtrlClient(), n, "")
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	}
+
+	{
+		exceeded, unhealthy, limit, err := maxUnhealthyExceeded(nodes, cr.Annotations[azureannotation.MaxUnhealthy])
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		if exceeded {
+			message := fmt.Sprintf("Remediation of unhealthy nodes is short-circuited, %d of %d nodes are not ready but at most %d may be", unhealthy, len(nodes), limit)
+			r.logger.Debugf(ctx, "%s", message)
+			r.eventRecorder.Event(&cr, corev1.EventTypeWarning, RemediationShortCircuitedReason, message)
+			r.logger.Debugf(ctx, "cancelling resource")
+			return nil
+		}
+	}
+
+	replacing, err := r.ensureReplacements(ctx, cr, tenantClusterK8sClient.CtrlClient(), nodes)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if replacing {
+		// Only one node is remediated at a time.
+		r.logger.Debugf(ctx, "node replacement is in progress")
+		r.logger.Debugf(ctx, "cancelling resource")
+		return nil
+	}
+
 	var detectorService *detector.Detector
 	{
 		detectorConfig := detector.Config{
-			K8sClient: tenantClusterK8sClient,
+			K8sClient: tenantClusterK8sClient.CtrlClient(),
 			Logger:    r.logger,
 
 			NotReadyTickThreshold: nodeTerminationTickThreshold,
@@ -58,31 +117,51 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		}
 	}
 
-	nodesToTerminate, err := detectorService.DetectBadNodes(ctx)
+	badNodes, err := detectorService.DetectBadNodes(ctx)
 	if err != nil {
 		return microerror.Mask(err)
 	}
 
-	if len(nodesToTerminate) > 0 {
-		for _, n := range nodesToTerminate {
-			err := r.terminateNode(ctx, n, cr)
-			if err != nil {
-				return microerror.Mask(err)
+	for _, n := range badNodes {
+		if masterReimagesExhausted(n) {
+			message := fmt.Sprintf("Unhealthy master node %s is not remediated anymore, it is still not ready after %d reimages. Remove annotation %s from the node to reimage it again.", n.Name, masterReimages(n), azureannotation.NodeRemediationAttempts)
+			r.logger.LogCtx(ctx, "level", "warning", "message", message)
+			r.eventRecorder.Event(&cr, corev1.EventTypeWarning, RemediationExhaustedReason, message)
+			continue
+		}
+
+		step := nextRemediationStep(n)
+		if step == "" {
+			continue
+		}
+
+		if isMaster(n) {
+			if !r.isMasterRemediationSafe(ctx, tenantClusterK8sClient.RESTClient(), nodes) {
+				r.logger.Debugf(ctx, "not remediating master node %#q, etcd health checks did not pass", n.Name)
+				continue
 			}
 		}
 
-		// reset tick counters on all nodes in cluster to have a graceful period after terminating nodes
-		err := detectorService.ResetTickCounters(ctx)
+		err = r.remediateNode(ctx, cr, tenantClusterK8sClient.CtrlClient(), n, step)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		// reset tick counters on all nodes in cluster to have a graceful period after remediating nodes
+		err = detectorService.ResetTickCounters(ctx)
 		if err != nil {
 			return microerror.Mask(err)
 		}
 		r.logger.Debugf(ctx, "resetting tick node counters on all nodes in tenant cluster")
+
+		// Only one node is remediated per reconciliation.
+		break
 	}
 
 	return nil
 }
 
-func (r *Resource) getTenantClusterClient(ctx context.Context, cluster *capiv1alpha3.Cluster) (client.Client, error) {
+func (r *Resource) getTenantClusterClient(ctx context.Context, cluster *capiv1alpha3.Cluster) (k8sclient.Interface, error) {
 	var k8sClient k8sclient.Interface
 	{
 		restConfig, err := r.tenantRestConfigProvider.NewRestConfig(ctx, key.ClusterID(cluster), cluster.Spec.ControlPlaneEndpoint.Host)
@@ -99,78 +178,63 @@ func (r *Resource) getTenantClusterClient(ctx context.Context, cluster *capiv1al
 		}
 	}
 
-	return k8sClient.CtrlClient(), nil
+	return k8sClient, nil
 }
 
-func (r *Resource) terminateNode(ctx context.Context, node corev1.Node, cluster capiv1alpha3.Cluster) error {
-	if node.Labels["role"] != "worker" {
-		// We can only terminate workers in azure.
-		r.logger.Debugf(ctx, "Termination of master nodes is not supported on Azure")
-		return nil
+// remediateNode takes the given remediation step for the given node and
+// records it on the node, so that the next remediation of the node escalates.
+func (r *Resource) remediateNode(ctx context.Context, cluster capiv1alpha3.Cluster, tenantClusterK8sClient client.Client, node corev1.Node, step string) error {
+	r.logger.Debugf(ctx, "remediating unhealthy node %#q with step %#q", node.Name, step)
+
+	var err error
+	switch step {
+	case RebootStep:
+		err = r.rebootInstance(ctx, cluster, node)
+	case ReimageStep:
+		err = r.reimageInstance(ctx, cluster, node)
+	case ReplaceStep:
+		err = r.drainNode(ctx, cluster, node)
+	}
+	if err != nil {
+		return microerror.Mask(err)
 	}
 
-	vmssClient, err := r.azureClientsFactory.GetVirtualMachineScaleSetsClient(ctx, cluster.ObjectMeta)
+	err = r.setRemediationStep(ctx, tenantClusterK8sClient, node, step)
 	if err != nil {
 		return microerror.Mask(err)
 	}
 
-	// Scale VMSS up by one.
-	var vmssName string
-	{
-		r.logger.Debugf(ctx, "Retrieving AzureMachinePool CR")
-		amp := capzexpv1alpha3.AzureMachinePool{}
-		err := r.ctrlClient.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: node.Labels[label.MachinePool]}, &amp)
-		if err != nil {
-			return microerror.Mask(err)
-		}
-		r.logger.Debugf(ctx, "Retrieved AzureMachinePool CR")
+	r.eventRecorder.Eventf(&cluster, corev1.EventTypeNormal, NodeRemediationReason, "Unhealthy node %s is remediated with step %s", node.Name, step)
+	reportNodeRemediation(key.ClusterID(&cluster), node.Name, step)
 
-		r.logger.Debugf(ctx, "Retrieving VMSS")
-		vmssName = key.NodePoolVMSSName(&amp)
-		vmss, err := vmssClient.Get(ctx, cluster.Name, vmssName)
-		if err != nil {
-			return microerror.Mask(err)
-		}
-		r.logger.Debugf(ctx, "Retrieved VMSS")
+	r.logger.Debugf(ctx, "remediated unhealthy node %#q with step %#q", node.Name, step)
 
-		newCapacity := *vmss.Sku.Capacity + 1
-		r.logger.Debugf(ctx, "Scaling up VMSS %q to %d replicas", vmssName, newCapacity)
+	return nil
+}
 
-		update := compute.VirtualMachineScaleSetUpdate{
-			Sku: &compute.Sku{
-				Capacity: &newCapacity,
-			},
+// setRemediationStep records the given remediation step on the given node and
+// counts the reimages of masters. An empty step removes the records.
+func (r *Resource) setRemediationStep(ctx context.Context, tenantClusterK8sClient client.Client, node corev1.Node, step string) error {
+	patch := client.MergeFrom(node.DeepCopy())
+
+	if step == "" {
+		delete(node.Annotations, azureannotation.NodeRemediationStep)
+		delete(node.Annotations, azureannotation.NodeRemediationAttempts)
+	} else {
+		if node.Annotations == nil {
+			node.Annotations = map[string]string{}
 		}
+		node.Annotations[azureannotation.NodeRemediationStep] = step
 
-		_, err = vmssClient.Update(ctx, cluster.Name, vmssName, update)
-		if err != nil {
-			return microerror.Mask(err)
+		if isMaster(node) && step == ReimageStep {
+			node.Annotations[azureannotation.NodeRemediationAttempts] = strconv.Itoa(masterReimages(node) + 1)
 		}
-
-		r.logger.Debugf(ctx, "Scaled up VMSS %q to %d replicas", vmssName, newCapacity)
 	}
 
-	// Terminate faulty node.
-	var instanceID string
-	{
-		instanceID, err := key.InstanceIDFromNode(node)
-		if err != nil {
-			return microerror.Mask(err)
-		}
-
-		r.logger.Debugf(ctx, "Deleting instance with ID %q from vmss %q", instanceID, vmssName)
-		res, err := vmssClient.DeleteInstances(ctx, cluster.Name, vmssName, compute.VirtualMachineScaleSetVMInstanceRequiredIDs{InstanceIds: &[]string{instanceID}})
-		if err != nil {
-			return microerror.Mask(err)
-		}
-		_, err = vmssClient.DeleteInstancesResponder(res.Response())
-		if err != nil {
-			return microerror.Mask(err)
-		}
-		r.logger.Debugf(ctx, "Deleted instance with ID %q from vmss %q", instanceID, vmssName)
+	err := tenantClusterK8sClient.Patch(ctx, &node, patch)
+	if err != nil {
+		return microerror.Mask(err)
 	}
 
-	// expose metric about node termination
-	reportNodeTermination(cluster.Name, node.Name, instanceID)
 	return nil
 }
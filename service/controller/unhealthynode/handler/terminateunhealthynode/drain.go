@@ -0,0 +1,86 @@
+package terminateunhealthynode
+
+import (
+	"context"
+
+	corev1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/core/v1alpha1"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+	"github.com/giantswarm/azure-operator/v5/pkg/handler/nodes"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+// drainNode asks node-operator to drain the given node before its instance is
+// replaced.
+func (r *Resource) drainNode(ctx context.Context, cluster capiv1alpha3.Cluster, node corev1.Node) error {
+	err := nodes.CreateDrainerConfig(ctx, r.ctrlClient, r.logger, key.ClusterID(&cluster), cluster.Spec.ControlPlaneEndpoint.String(), node.Name)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// ensureReplacements replaces the instances of the nodes which are being
+// drained as soon as their DrainerConfig is drained or timed out. It returns
+// true as long as any node is still being drained.
+func (r *Resource) ensureReplacements(ctx context.Context, cluster capiv1alpha3.Cluster, tenantClusterK8sClient client.Client, tenantNodes []corev1.Node) (bool, error) {
+	var draining bool
+	for _, n := range tenantNodes {
+		if n.Annotations[annotation.NodeRemediationStep] != ReplaceStep {
+			continue
+		}
+
+		dc := &corev1alpha1.DrainerConfig{}
+		err := r.ctrlClient.Get(ctx, client.ObjectKey{Namespace: key.ClusterID(&cluster), Name: n.Name}, dc)
+		if errors.IsNotFound(err) {
+			// The DrainerConfig got lost, e.g. it was deleted manually.
+			err = r.drainNode(ctx, cluster, n)
+			if err != nil {
+				return false, microerror.Mask(err)
+			}
+
+			draining = true
+			continue
+		} else if err != nil {
+			return false, microerror.Mask(err)
+		}
+
+		if !dc.Status.HasDrainedCondition() && !dc.Status.HasTimeoutCondition() {
+			r.logger.Debugf(ctx, "node %#q is being drained", n.Name)
+			draining = true
+			continue
+		}
+
+		if dc.Status.HasTimeoutCondition() {
+			r.logger.Debugf(ctx, "draining node %#q timed out, replacing it anyway", n.Name)
+		}
+
+		instanceID, err := r.replaceInstance(ctx, cluster, n)
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+
+		err = r.ctrlClient.Delete(ctx, dc)
+		if err != nil && !errors.IsNotFound(err) {
+			return false, microerror.Mask(err)
+		}
+
+		err = r.setRemediationStep(ctx, tenantClusterK8sClient, n, ReplacedStep)
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+
+		r.eventRecorder.Eventf(&cluster, corev1.EventTypeNormal, NodeRemediationReason, "Unhealthy node %s is replaced", n.Name)
+
+		// expose metric about node termination
+		reportNodeTermination(key.ClusterID(&cluster), n.Name, instanceID)
+	}
+
+	return draining, nil
+}
@@ -0,0 +1,36 @@
+package terminateunhealthynode
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	etcdHealthPath = "/healthz/etcd"
+)
+
+// isMasterRemediationSafe returns true when a master can be reimaged without
+// putting the etcd cluster at risk. The API server of the tenant cluster must
+// report etcd to be healthy and etcd must keep its quorum while the master is
+// down.
+func (r *Resource) isMasterRemediationSafe(ctx context.Context, restClient rest.Interface, tenantNodes []corev1.Node) bool {
+	body, err := restClient.Get().AbsPath(etcdHealthPath).DoRaw(ctx)
+	if err != nil {
+		r.logger.Debugf(ctx, "etcd health check failed: %s", err)
+		return false
+	}
+
+	if string(body) != "ok" {
+		r.logger.Debugf(ctx, "etcd health check failed: %s", string(body))
+		return false
+	}
+
+	if !mastersKeepQuorum(tenantNodes) {
+		r.logger.Debugf(ctx, "etcd would lose its quorum")
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,199 @@
+package terminateunhealthynode
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/giantswarm/apiextensions/v3/pkg/label"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capiexpv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/helpers"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+func (r *Resource) rebootInstance(ctx context.Context, cluster capiv1alpha3.Cluster, node corev1.Node) error {
+	vmssClient, err := r.azureClientsFactory.GetVirtualMachineScaleSetsClient(ctx, cluster.ObjectMeta)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	vmssName, err := r.getVMSSName(ctx, cluster, node)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	resourceGroupName, err := r.getResourceGroupName(ctx, cluster)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	instanceID, err := key.InstanceIDFromNode(node)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "Restarting instance with ID %q of vmss %q", instanceID, vmssName)
+	res, err := vmssClient.Restart(ctx, resourceGroupName, vmssName, &compute.VirtualMachineScaleSetVMInstanceIDs{InstanceIds: to.StringSlicePtr([]string{instanceID})})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	_, err = vmssClient.RestartResponder(res.Response())
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	r.logger.Debugf(ctx, "Restarted instance with ID %q of vmss %q", instanceID, vmssName)
+
+	return nil
+}
+
+func (r *Resource) reimageInstance(ctx context.Context, cluster capiv1alpha3.Cluster, node corev1.Node) error {
+	vmssClient, err := r.azureClientsFactory.GetVirtualMachineScaleSetsClient(ctx, cluster.ObjectMeta)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	vmssName, err := r.getVMSSName(ctx, cluster, node)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	resourceGroupName, err := r.getResourceGroupName(ctx, cluster)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	instanceID, err := key.InstanceIDFromNode(node)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "Reimaging instance with ID %q of vmss %q", instanceID, vmssName)
+	res, err := vmssClient.Reimage(ctx, resourceGroupName, vmssName, &compute.VirtualMachineScaleSetReimageParameters{InstanceIds: to.StringSlicePtr([]string{instanceID})})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	_, err = vmssClient.ReimageResponder(res.Response())
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	r.logger.Debugf(ctx, "Reimaged instance with ID %q of vmss %q", instanceID, vmssName)
+
+	return nil
+}
+
+// replaceInstance scales the VMSS of the given worker node up by one before
+// its instance is deleted, so that the node pool keeps its capacity. The VMSS
+// is scaled to one more than the replicas of the node pool instead of one more
+// than its current capacity, so that retrying after a failed deletion does not
+// scale it up again.
+func (r *Resource) replaceInstance(ctx context.Context, cluster capiv1alpha3.Cluster, node corev1.Node) (string, error) {
+	vmssClient, err := r.azureClientsFactory.GetVirtualMachineScaleSetsClient(ctx, cluster.ObjectMeta)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	vmssName, err := r.getVMSSName(ctx, cluster, node)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	resourceGroupName, err := r.getResourceGroupName(ctx, cluster)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	// Scale VMSS up by one.
+	{
+		r.logger.Debugf(ctx, "Retrieving MachinePool CR")
+		machinePool := capiexpv1alpha3.MachinePool{}
+		err = r.ctrlClient.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: node.Labels[label.MachinePool]}, &machinePool)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+		r.logger.Debugf(ctx, "Retrieved MachinePool CR")
+
+		r.logger.Debugf(ctx, "Retrieving VMSS")
+		vmss, err := vmssClient.Get(ctx, resourceGroupName, vmssName)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+		r.logger.Debugf(ctx, "Retrieved VMSS")
+
+		newCapacity := int64(to.Int32(machinePool.Spec.Replicas)) + 1
+		if *vmss.Sku.Capacity >= newCapacity {
+			r.logger.Debugf(ctx, "VMSS %q scaled up to %d replicas already", vmssName, *vmss.Sku.Capacity)
+		} else {
+			r.logger.Debugf(ctx, "Scaling up VMSS %q to %d replicas", vmssName, newCapacity)
+
+			update := compute.VirtualMachineScaleSetUpdate{
+				Sku: &compute.Sku{
+					Capacity: &newCapacity,
+				},
+			}
+
+			res, err := vmssClient.Update(ctx, resourceGroupName, vmssName, update)
+			if err != nil {
+				return "", microerror.Mask(err)
+			}
+			_, err = vmssClient.UpdateResponder(res.Response())
+			if err != nil {
+				return "", microerror.Mask(err)
+			}
+
+			r.logger.Debugf(ctx, "Scaled up VMSS %q to %d replicas", vmssName, newCapacity)
+		}
+	}
+
+	// Terminate faulty node.
+	instanceID, err := key.InstanceIDFromNode(node)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "Deleting instance with ID %q from vmss %q", instanceID, vmssName)
+	res, err := vmssClient.DeleteInstances(ctx, resourceGroupName, vmssName, compute.VirtualMachineScaleSetVMInstanceRequiredIDs{InstanceIds: &[]string{instanceID}})
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+	_, err = vmssClient.DeleteInstancesResponder(res.Response())
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+	r.logger.Debugf(ctx, "Deleted instance with ID %q from vmss %q", instanceID, vmssName)
+
+	return instanceID, nil
+}
+
+// getResourceGroupName returns the name of the resource group of the given
+// cluster.
+func (r *Resource) getResourceGroupName(ctx context.Context, cluster capiv1alpha3.Cluster) (string, error) {
+	azureCluster, err := helpers.GetAzureClusterByName(ctx, r.ctrlClient, cluster.Namespace, cluster.Name)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	return key.ResourceGroupNameFromAzureCluster(*azureCluster), nil
+}
+
+// getVMSSName returns the name of the VMSS the given node is an instance of.
+func (r *Resource) getVMSSName(ctx context.Context, cluster capiv1alpha3.Cluster, node corev1.Node) (string, error) {
+	if isMaster(node) {
+		return key.MasterVMSSNameFromClusterAPIObject(&cluster), nil
+	}
+
+	r.logger.Debugf(ctx, "Retrieving AzureMachinePool CR")
+	amp := capzexpv1alpha3.AzureMachinePool{}
+	err := r.ctrlClient.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: node.Labels[label.MachinePool]}, &amp)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+	r.logger.Debugf(ctx, "Retrieved AzureMachinePool CR")
+
+	return key.NodePoolVMSSName(&amp), nil
+}
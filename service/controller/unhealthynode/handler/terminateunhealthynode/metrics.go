@@ -16,11 +16,18 @@ var (
 		},
 		[]string{"cluster_id", "terminated_node", "terminated_instance_id"},
 	)
+	nodeAutoRepairRemediation = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "azure_operator_unhealthy_node_remediation_total",
+			Help: "Number of remediation steps taken for unhealthy nodes by the node auto repair feature.",
+		},
+		[]string{"cluster_id", "node", "step"},
+	)
 )
 
 func init() {
 	prometheus.MustRegister(nodeAutoRepairTermination)
-
+	prometheus.MustRegister(nodeAutoRepairRemediation)
 }
 
 // reportNodeTermination is a utility function for updating metrics related to
@@ -30,3 +37,11 @@ func reportNodeTermination(clusterID string, nodeName string, instanceID string)
 		clusterID, nodeName, instanceID,
 	).Set(gaugeValue)
 }
+
+// reportNodeRemediation is a utility function for updating metrics related to
+// node auto repair remediation steps.
+func reportNodeRemediation(clusterID string, nodeName string, step string) {
+	nodeAutoRepairRemediation.WithLabelValues(
+		clusterID, nodeName, step,
+	).Inc()
+}
@@ -0,0 +1,146 @@
+package terminateunhealthynode
+
+import (
+	"strconv"
+
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+)
+
+const (
+	// RebootStep restarts the VMSS instance of the node.
+	RebootStep = "Reboot"
+	// ReimageStep reinstalls the OS disk of the VMSS instance of the node.
+	ReimageStep = "Reimage"
+	// ReplaceStep drains the node before its VMSS instance is replaced.
+	ReplaceStep = "Replace"
+	// ReplacedStep marks nodes whose VMSS instance was deleted and which are
+	// about to disappear from the tenant cluster.
+	ReplacedStep = "Replaced"
+
+	defaultMaxUnhealthy = "40%"
+
+	// maxMasterReimages is the number of times an unhealthy master is
+	// reimaged before its remediation is given up.
+	maxMasterReimages = 3
+
+	labelNodeRole       = "role"
+	labelNodeRoleMaster = "master"
+)
+
+// nextRemediationStep returns the step to take for the given unhealthy node,
+// based on the last step taken for it. Workers escalate from a reboot over a
+// reimage to a replacement, the single master instance of a cluster can only
+// be reimaged, at most maxMasterReimages times. An empty step means the node
+// is already being replaced or its remediation was given up.
+func nextRemediationStep(node corev1.Node) string {
+	lastStep := node.Annotations[annotation.NodeRemediationStep]
+
+	if isMaster(node) {
+		if masterReimagesExhausted(node) {
+			return ""
+		}
+		return ReimageStep
+	}
+
+	switch lastStep {
+	case "":
+		return RebootStep
+	case RebootStep:
+		return ReimageStep
+	case ReimageStep:
+		return ReplaceStep
+	default:
+		return ""
+	}
+}
+
+// masterReimages returns the number of reimages recorded for the given node.
+// Invalid values count as no reimage.
+func masterReimages(node corev1.Node) int {
+	attempts, err := strconv.Atoi(node.Annotations[annotation.NodeRemediationAttempts])
+	if err != nil {
+		return 0
+	}
+
+	return attempts
+}
+
+// masterReimagesExhausted returns true when the given master node was
+// reimaged maxMasterReimages times without becoming ready again.
+func masterReimagesExhausted(node corev1.Node) bool {
+	return isMaster(node) && masterReimages(node) >= maxMasterReimages
+}
+
+// isBeingReplaced returns true when the given node is drained or deleted
+// already, so no other remediation step must be taken for it.
+func isBeingReplaced(node corev1.Node) bool {
+	step := node.Annotations[annotation.NodeRemediationStep]
+	return step == ReplaceStep || step == ReplacedStep
+}
+
+// maxUnhealthyExceeded returns true when more of the given nodes are not ready
+// than the given number or percentage allows. Remediating nodes during such
+// an outage would only make it worse.
+func maxUnhealthyExceeded(nodes []corev1.Node, maxUnhealthy string) (bool, int, int, error) {
+	if maxUnhealthy == "" {
+		maxUnhealthy = defaultMaxUnhealthy
+	}
+
+	v := intstr.Parse(maxUnhealthy)
+	limit, err := intstr.GetValueFromIntOrPercent(&v, len(nodes), false)
+	if err != nil {
+		return false, 0, 0, microerror.Maskf(invalidConfigError, "max unhealthy %#q: %s", maxUnhealthy, err)
+	}
+
+	var unhealthy int
+	for _, n := range nodes {
+		if !isReady(n) {
+			unhealthy++
+		}
+	}
+
+	return unhealthy > limit, unhealthy, limit, nil
+}
+
+// mastersKeepQuorum returns true when etcd keeps its quorum while one more
+// master is reimaged, given the masters of the cluster which are not ready.
+func mastersKeepQuorum(nodes []corev1.Node) bool {
+	var masters, notReady int
+	for _, n := range nodes {
+		if !isMaster(n) {
+			continue
+		}
+
+		masters++
+		if !isReady(n) {
+			notReady++
+		}
+	}
+
+	maxUnavailable := (masters - 1) / 2
+	if maxUnavailable < 1 {
+		// The single master of a cluster can always be reimaged, etcd data is
+		// kept on its data disk.
+		maxUnavailable = 1
+	}
+
+	return notReady <= maxUnavailable
+}
+
+func isMaster(n corev1.Node) bool {
+	return n.Labels[labelNodeRole] == labelNodeRoleMaster
+}
+
+func isReady(n corev1.Node) bool {
+	for _, c := range n.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
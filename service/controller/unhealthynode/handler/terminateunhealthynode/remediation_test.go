@@ -0,0 +1,250 @@
+package terminateunhealthynode
+
+import (
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/azure-operator/v5/pkg/annotation"
+)
+
+func Test_nextRemediationStep(t *testing.T) {
+	testCases := []struct {
+		name         string
+		role         string
+		lastStep     string
+		reimages     string
+		expectedStep string
+	}{
+		{
+			name:         "case 0: worker is rebooted first",
+			role:         "worker",
+			lastStep:     "",
+			expectedStep: RebootStep,
+		},
+		{
+			name:         "case 1: worker is reimaged after a reboot",
+			role:         "worker",
+			lastStep:     RebootStep,
+			expectedStep: ReimageStep,
+		},
+		{
+			name:         "case 2: worker is replaced after a reimage",
+			role:         "worker",
+			lastStep:     ReimageStep,
+			expectedStep: ReplaceStep,
+		},
+		{
+			name:         "case 3: worker being replaced is left alone",
+			role:         "worker",
+			lastStep:     ReplaceStep,
+			expectedStep: "",
+		},
+		{
+			name:         "case 4: master is reimaged first",
+			role:         "master",
+			lastStep:     "",
+			expectedStep: ReimageStep,
+		},
+		{
+			name:         "case 5: master is never replaced",
+			role:         "master",
+			lastStep:     ReimageStep,
+			reimages:     "1",
+			expectedStep: ReimageStep,
+		},
+		{
+			name:         "case 6: master is reimaged up to the limit",
+			role:         "master",
+			lastStep:     ReimageStep,
+			reimages:     "2",
+			expectedStep: ReimageStep,
+		},
+		{
+			name:         "case 7: master is not reimaged anymore after the limit",
+			role:         "master",
+			lastStep:     ReimageStep,
+			reimages:     "3",
+			expectedStep: "",
+		},
+		{
+			name:         "case 8: worker does not count reimages",
+			role:         "worker",
+			lastStep:     ReimageStep,
+			reimages:     "3",
+			expectedStep: ReplaceStep,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			node := newTestNode(tc.role, true)
+			if tc.lastStep != "" {
+				node.Annotations[annotation.NodeRemediationStep] = tc.lastStep
+			}
+			if tc.reimages != "" {
+				node.Annotations[annotation.NodeRemediationAttempts] = tc.reimages
+			}
+
+			step := nextRemediationStep(node)
+			if step != tc.expectedStep {
+				t.Fatalf("expected %#q, got %#q", tc.expectedStep, step)
+			}
+		})
+	}
+}
+
+func Test_maxUnhealthyExceeded(t *testing.T) {
+	testCases := []struct {
+		name             string
+		ready            int
+		notReady         int
+		maxUnhealthy     string
+		expectedExceeded bool
+		errorMatcher     func(error) bool
+	}{
+		{
+			name:             "case 0: default percentage not exceeded",
+			ready:            6,
+			notReady:         4,
+			maxUnhealthy:     "",
+			expectedExceeded: false,
+		},
+		{
+			name:             "case 1: default percentage exceeded",
+			ready:            5,
+			notReady:         5,
+			maxUnhealthy:     "",
+			expectedExceeded: true,
+		},
+		{
+			name:             "case 2: absolute number exceeded",
+			ready:            8,
+			notReady:         3,
+			maxUnhealthy:     "2",
+			expectedExceeded: true,
+		},
+		{
+			name:             "case 3: percentage not exceeded",
+			ready:            1,
+			notReady:         3,
+			maxUnhealthy:     "100%",
+			expectedExceeded: false,
+		},
+		{
+			name:         "case 4: invalid value",
+			ready:        1,
+			notReady:     1,
+			maxUnhealthy: "many%",
+			errorMatcher: IsInvalidConfig,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			var nodes []corev1.Node
+			for j := 0; j < tc.ready; j++ {
+				nodes = append(nodes, newTestNode("worker", true))
+			}
+			for j := 0; j < tc.notReady; j++ {
+				nodes = append(nodes, newTestNode("worker", false))
+			}
+
+			exceeded, _, _, err := maxUnhealthyExceeded(nodes, tc.maxUnhealthy)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if exceeded != tc.expectedExceeded {
+				t.Fatalf("expected %t, got %t", tc.expectedExceeded, exceeded)
+			}
+		})
+	}
+}
+
+func Test_mastersKeepQuorum(t *testing.T) {
+	testCases := []struct {
+		name                string
+		readyMasters        int
+		notReadyMasters     int
+		expectedKeepsQuorum bool
+	}{
+		{
+			name:                "case 0: single master",
+			readyMasters:        0,
+			notReadyMasters:     1,
+			expectedKeepsQuorum: true,
+		},
+		{
+			name:                "case 1: one of three masters not ready",
+			readyMasters:        2,
+			notReadyMasters:     1,
+			expectedKeepsQuorum: true,
+		},
+		{
+			name:                "case 2: two of three masters not ready",
+			readyMasters:        1,
+			notReadyMasters:     2,
+			expectedKeepsQuorum: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			nodes := []corev1.Node{
+				newTestNode("worker", false),
+			}
+			for j := 0; j < tc.readyMasters; j++ {
+				nodes = append(nodes, newTestNode("master", true))
+			}
+			for j := 0; j < tc.notReadyMasters; j++ {
+				nodes = append(nodes, newTestNode("master", false))
+			}
+
+			keepsQuorum := mastersKeepQuorum(nodes)
+			if keepsQuorum != tc.expectedKeepsQuorum {
+				t.Fatalf("expected %t, got %t", tc.expectedKeepsQuorum, keepsQuorum)
+			}
+		})
+	}
+}
+
+func newTestNode(role string, ready bool) corev1.Node {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{},
+			Labels: map[string]string{
+				"role": role,
+			},
+		},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{
+				{
+					Type:   corev1.NodeReady,
+					Status: status,
+				},
+			},
+		},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	"github.com/giantswarm/tenantcluster/v3/pkg/tenantcluster"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	azureclient "github.com/giantswarm/azure-operator/v5/client"
@@ -16,6 +17,7 @@ const (
 type Config struct {
 	AzureClientsFactory      *azureclient.OrganizationFactory
 	CtrlClient               client.Client
+	EventRecorder            record.EventRecorder
 	Logger                   micrologger.Logger
 	TenantRestConfigProvider *tenantcluster.TenantCluster
 }
@@ -23,6 +25,7 @@ type Config struct {
 type Resource struct {
 	azureClientsFactory      *azureclient.OrganizationFactory
 	ctrlClient               client.Client
+	eventRecorder            record.EventRecorder
 	logger                   micrologger.Logger
 	tenantRestConfigProvider *tenantcluster.TenantCluster
 }
@@ -34,6 +37,9 @@ func New(config Config) (*Resource, error) {
 	if config.CtrlClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.CtrlClient must not be empty", config)
 	}
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
@@ -44,6 +50,7 @@ func New(config Config) (*Resource, error) {
 	r := &Resource{
 		azureClientsFactory:      config.AzureClientsFactory,
 		ctrlClient:               config.CtrlClient,
+		eventRecorder:            config.EventRecorder,
 		logger:                   config.Logger,
 		tenantRestConfigProvider: config.TenantRestConfigProvider,
 	}
@@ -10,6 +10,7 @@ import (
 	corev1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/core/v1alpha1"
 	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
 	releasev1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/release/v1alpha1"
+	securityv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/security/v1alpha1"
 	exporterkitcollector "github.com/giantswarm/exporterkit/collector"
 	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
 	"github.com/giantswarm/k8sclient/v5/pkg/k8srestconfig"
@@ -31,6 +32,7 @@ import (
 	"github.com/giantswarm/azure-operator/v5/pkg/employees"
 	"github.com/giantswarm/azure-operator/v5/pkg/locker"
 	"github.com/giantswarm/azure-operator/v5/pkg/project"
+	"github.com/giantswarm/azure-operator/v5/pkg/ratelimit"
 	"github.com/giantswarm/azure-operator/v5/service/collector"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azurecluster"
 	"github.com/giantswarm/azure-operator/v5/service/controller/azureconfig"
@@ -40,6 +42,7 @@ import (
 	"github.com/giantswarm/azure-operator/v5/service/controller/machinepool"
 	"github.com/giantswarm/azure-operator/v5/service/controller/setting"
 	"github.com/giantswarm/azure-operator/v5/service/controller/unhealthynode"
+	"github.com/giantswarm/azure-operator/v5/service/sweeper"
 )
 
 // Config represents the configuration used to create a new service.
@@ -63,6 +66,7 @@ type Service struct {
 	bootOnce          sync.Once
 	operatorCollector *exporterkitcollector.Set
 	controllers       []*operatorkitcontroller.Controller
+	sweeper           *sweeper.Sweeper
 }
 
 // New creates a new configured service object.
@@ -107,7 +111,9 @@ func New(config Config) (*Service, error) {
 	}
 
 	azure := setting.Azure{
-		EnvironmentName: config.Viper.GetString(config.Flag.Service.Azure.EnvironmentName),
+		Connectivity:          config.Viper.GetString(config.Flag.Service.Azure.Connectivity),
+		EncryptionKeyDelivery: config.Viper.GetString(config.Flag.Service.Azure.EncryptionKeyDelivery),
+		EnvironmentName:       config.Viper.GetString(config.Flag.Service.Azure.EnvironmentName),
 		HostCluster: setting.AzureHostCluster{
 			CIDR:                  config.Viper.GetString(config.Flag.Service.Azure.HostCluster.CIDR),
 			ResourceGroup:         resourceGroup,
@@ -120,11 +126,29 @@ func New(config Config) (*Service, error) {
 		Location: config.Viper.GetString(config.Flag.Service.Azure.Location),
 	}
 
+	cloudProvider := setting.CloudProvider{
+		BackoffDuration:      config.Viper.GetInt(config.Flag.Service.Cluster.CloudProvider.BackoffDuration),
+		BackoffExponent:      config.Viper.GetFloat64(config.Flag.Service.Cluster.CloudProvider.BackoffExponent),
+		BackoffJitter:        config.Viper.GetFloat64(config.Flag.Service.Cluster.CloudProvider.BackoffJitter),
+		BackoffRetries:       config.Viper.GetInt(config.Flag.Service.Cluster.CloudProvider.BackoffRetries),
+		LoadBalancerSku:      config.Viper.GetString(config.Flag.Service.Cluster.CloudProvider.LoadBalancerSku),
+		RateLimitBucket:      config.Viper.GetInt(config.Flag.Service.Cluster.CloudProvider.RateLimitBucket),
+		RateLimitBucketWrite: config.Viper.GetInt(config.Flag.Service.Cluster.CloudProvider.RateLimitBucketWrite),
+		RateLimitQPS:         config.Viper.GetFloat64(config.Flag.Service.Cluster.CloudProvider.RateLimitQPS),
+		RateLimitQPSWrite:    config.Viper.GetFloat64(config.Flag.Service.Cluster.CloudProvider.RateLimitQPSWrite),
+	}
+
+	etcdSnapshots := setting.EtcdSnapshots{
+		Interval:  config.Viper.GetDuration(config.Flag.Service.Cluster.Etcd.Snapshots.Interval),
+		Retention: config.Viper.GetInt(config.Flag.Service.Cluster.Etcd.Snapshots.Retention),
+	}
+
 	Ignition := setting.Ignition{
-		Path:       config.Viper.GetString(config.Flag.Service.Tenant.Ignition.Path),
-		Debug:      config.Viper.GetBool(config.Flag.Service.Tenant.Ignition.Debug.Enabled),
-		LogsPrefix: config.Viper.GetString(config.Flag.Service.Tenant.Ignition.Debug.LogsPrefix),
-		LogsToken:  config.Viper.GetString(config.Flag.Service.Tenant.Ignition.Debug.LogsToken),
+		Path:        config.Viper.GetString(config.Flag.Service.Tenant.Ignition.Path),
+		Debug:       config.Viper.GetBool(config.Flag.Service.Tenant.Ignition.Debug.Enabled),
+		LogsPrefix:  config.Viper.GetString(config.Flag.Service.Tenant.Ignition.Debug.LogsPrefix),
+		LogsToken:   config.Viper.GetString(config.Flag.Service.Tenant.Ignition.Debug.LogsToken),
+		SpecVersion: config.Viper.GetString(config.Flag.Service.Tenant.Ignition.SpecVersion),
 	}
 
 	OIDC := setting.OIDC{
@@ -197,6 +221,7 @@ func New(config Config) (*Service, error) {
 				corev1alpha1.AddToScheme,
 				providerv1alpha1.AddToScheme,
 				releasev1alpha1.AddToScheme,
+				securityv1alpha1.AddToScheme,
 				capiv1alpha3.AddToScheme,
 				capzv1alpha3.AddToScheme,
 				expcapiv1alpha3.AddToScheme,
@@ -220,14 +245,14 @@ func New(config Config) (*Service, error) {
 		}
 	}
 
-	var kubeLockLocker locker.Interface
+	var leaseLocker locker.Interface
 	{
-		c := locker.KubeLockLockerConfig{
-			Logger:     config.Logger,
-			RestConfig: restConfig,
+		c := locker.LeaseLockerConfig{
+			K8sClient: k8sClient.K8sClient(),
+			Logger:    config.Logger,
 		}
 
-		kubeLockLocker, err = locker.NewKubeLockLocker(c)
+		leaseLocker, err = locker.NewLeaseLocker(c)
 		if err != nil {
 			return nil, microerror.Mask(err)
 		}
@@ -286,6 +311,22 @@ func New(config Config) (*Service, error) {
 		}
 	}
 
+	// The rate limiter is shared by all Azure clients so that the ARM quota of
+	// a subscription is tracked across all clusters using it.
+	var azureRateLimiter *ratelimit.Limiter
+	{
+		c := ratelimit.Config{
+			MaxDelay:   ratelimit.DefaultMaxDelay,
+			Thresholds: ratelimit.DefaultThresholds,
+			TTL:        ratelimit.DefaultTTL,
+		}
+
+		azureRateLimiter, err = ratelimit.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var controllers []*operatorkitcontroller.Controller
 
 	var azureClusterController *operatorkitcontroller.Controller
@@ -300,6 +341,7 @@ func New(config Config) (*Service, error) {
 
 			Azure:                 azure,
 			AzureMetricsCollector: azureCollector,
+			AzureRateLimiter:      azureRateLimiter,
 			Ignition:              Ignition,
 			OIDC:                  OIDC,
 			InstallationName:      config.Viper.GetString(config.Flag.Service.Installation.Name),
@@ -318,11 +360,48 @@ func New(config Config) (*Service, error) {
 		controllers = append(controllers, azureClusterController)
 	}
 
-	cpAzureClientSet, err := NewCPAzureClientSet(config, gsClientCredentialsConfig, azureCollector)
+	cpAzureClientSet, err := NewCPAzureClientSet(config, gsClientCredentialsConfig, azureCollector, azureRateLimiter)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
 
+	var sweeperLocker locker.Interface
+	{
+		c := locker.LeaseLockerConfig{
+			K8sClient: k8sClient.K8sClient(),
+			Logger:    config.Logger,
+
+			Name: "sweeper",
+		}
+
+		sweeperLocker, err = locker.NewLeaseLocker(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var orphanSweeper *sweeper.Sweeper
+	{
+		c := sweeper.Config{
+			AzureMetricsCollector: azureCollector,
+			AzureRateLimiter:      azureRateLimiter,
+			CPAzureClientSet:      cpAzureClientSet,
+			CredentialProvider:    credentialProvider,
+			CtrlClient:            k8sClient.CtrlClient(),
+			Locker:                sweeperLocker,
+			Logger:                config.Logger,
+
+			Delete:           config.Viper.GetBool(config.Flag.Service.Installation.Sweeper.Delete),
+			InstallationName: config.Viper.GetString(config.Flag.Service.Installation.Name),
+			Interval:         config.Viper.GetDuration(config.Flag.Service.Installation.Sweeper.Interval),
+		}
+
+		orphanSweeper, err = sweeper.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var sshUserList employees.SSHUserList
 	{
 		str := config.Viper.GetString(config.Flag.Service.Cluster.Kubernetes.SSH.UserList)
@@ -338,16 +417,20 @@ func New(config Config) (*Service, error) {
 		c := azureconfig.ControllerConfig{
 			Azure:                 azure,
 			AzureMetricsCollector: azureCollector,
+			AzureRateLimiter:      azureRateLimiter,
 			ClusterVNetMaskBits:   config.Viper.GetInt(config.Flag.Service.Installation.Guest.IPAM.Network.SubnetMaskBits),
+			InstanceConcurrency:   config.Viper.GetInt(config.Flag.Service.Azure.InstanceConcurrency),
 			CredentialProvider:    credentialProvider,
 			CPAzureClientSet:      cpAzureClientSet,
+			CloudProvider:         cloudProvider,
 			DockerhubToken:        config.Viper.GetString(config.Flag.Service.Registry.DockerhubToken),
+			EtcdSnapshots:         etcdSnapshots,
 			Ignition:              Ignition,
 			InstallationName:      config.Viper.GetString(config.Flag.Service.Installation.Name),
 			IPAMNetworkRange:      ipamNetworkRange,
 			IPAMReservedCIDRs:     reservedCIDRs,
 			K8sClient:             k8sClient,
-			Locker:                kubeLockLocker,
+			Locker:                leaseLocker,
 			Logger:                config.Logger,
 			OIDC:                  OIDC,
 			ProjectName:           config.ProjectName,
@@ -373,18 +456,21 @@ func New(config Config) (*Service, error) {
 			APIServerSecurePort:   config.Viper.GetInt(config.Flag.Service.Cluster.Kubernetes.API.SecurePort),
 			Azure:                 azure,
 			AzureMetricsCollector: azureCollector,
+			AzureRateLimiter:      azureRateLimiter,
 			CalicoCIDRSize:        config.Viper.GetInt(config.Flag.Service.Cluster.Calico.CIDR),
 			CalicoMTU:             config.Viper.GetInt(config.Flag.Service.Cluster.Calico.MTU),
 			CalicoSubnet:          config.Viper.GetString(config.Flag.Service.Cluster.Calico.Subnet),
 			ClusterIPRange:        config.Viper.GetString(config.Flag.Service.Cluster.Kubernetes.API.ClusterIPRange),
+			CloudProvider:         cloudProvider,
 			CredentialProvider:    credentialProvider,
 			CPAzureClientSet:      cpAzureClientSet,
 			DockerhubToken:        config.Viper.GetString(config.Flag.Service.Registry.DockerhubToken),
 			EtcdPrefix:            config.Viper.GetString(config.Flag.Service.Cluster.Etcd.Prefix),
 			Ignition:              Ignition,
 			InstallationName:      config.Viper.GetString(config.Flag.Service.Installation.Name),
+			InstanceConcurrency:   config.Viper.GetInt(config.Flag.Service.Azure.InstanceConcurrency),
 			K8sClient:             k8sClient,
-			Locker:                kubeLockLocker,
+			Locker:                leaseLocker,
 			Logger:                config.Logger,
 			OIDC:                  OIDC,
 			RegistryDomain:        config.Viper.GetString(config.Flag.Service.Registry.Domain),
@@ -406,6 +492,7 @@ func New(config Config) (*Service, error) {
 	{
 		c := azuremachine.ControllerConfig{
 			AzureMetricsCollector: azureCollector,
+			AzureRateLimiter:      azureRateLimiter,
 			CredentialProvider:    credentialProvider,
 			K8sClient:             k8sClient,
 			Logger:                config.Logger,
@@ -456,6 +543,7 @@ func New(config Config) (*Service, error) {
 	{
 		c := unhealthynode.ControllerConfig{
 			AzureMetricsCollector: azureCollector,
+			AzureRateLimiter:      azureRateLimiter,
 			CredentialProvider:    credentialProvider,
 			K8sClient:             k8sClient,
 			Logger:                config.Logger,
@@ -491,6 +579,7 @@ func New(config Config) (*Service, error) {
 		bootOnce:          sync.Once{},
 		controllers:       controllers,
 		operatorCollector: collectorSet,
+		sweeper:           orphanSweeper,
 		Version:           versionService,
 	}
 
@@ -505,6 +594,8 @@ func (s *Service) Boot(ctx context.Context) {
 		}
 
 		go s.operatorCollector.Boot(context.Background())
+
+		go s.sweeper.Boot(ctx)
 	})
 }
 
@@ -531,7 +622,7 @@ func buildK8sRestConfig(config Config) (*rest.Config, error) {
 }
 
 // NewCPAzureClientSet return an Azure client set configured for the Control Plane cluster.
-func NewCPAzureClientSet(config Config, gsClientCredentialsConfig auth.ClientCredentialsConfig, metricsCollector collector.AzureAPIMetrics) (*client.AzureClientSet, error) {
+func NewCPAzureClientSet(config Config, gsClientCredentialsConfig auth.ClientCredentialsConfig, metricsCollector collector.AzureAPIMetrics, rateLimiter *ratelimit.Limiter) (*client.AzureClientSet, error) {
 	cpTenantID := config.Viper.GetString(config.Flag.Service.Azure.HostCluster.Tenant.TenantID)
 	if cpTenantID != "" {
 		// We want the code to work both when using Single Tenant Service Principal and Multi Tenant Service Principal.
@@ -549,5 +640,5 @@ func NewCPAzureClientSet(config Config, gsClientCredentialsConfig auth.ClientCre
 		cpPartnerID = config.Viper.GetString(config.Flag.Service.Azure.PartnerID)
 	}
 
-	return client.NewAzureClientSet(gsClientCredentialsConfig, metricsCollector, cpSubscriptionID, cpPartnerID)
+	return client.NewAzureClientSet(gsClientCredentialsConfig, metricsCollector, rateLimiter, cpSubscriptionID, cpPartnerID)
 }
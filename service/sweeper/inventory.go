@@ -0,0 +1,163 @@
+package sweeper
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v5/client"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+// inventory holds the resources of a subscription which may belong to guest
+// clusters of the installation.
+type inventory struct {
+	subscriptionID string
+
+	groups      []resources.Group
+	deployments []deployment
+	publicIPs   []network.PublicIPAddress
+	recordSets  []recordSet
+}
+
+// deployment is a deployment of a node pool in the resource group of a guest
+// cluster.
+type deployment struct {
+	clusterID     string
+	resourceGroup string
+	name          string
+}
+
+// recordSet is a DNS record set in a zone of the control plane, e.g. the NS
+// records delegating to the DNS zones of guest clusters.
+type recordSet struct {
+	resourceGroup string
+	zone          string
+	recordSet     dns.RecordSet
+}
+
+// listInventory lists the resources of the subscription of the given client
+// set. The DNS record sets are only listed for the control plane, which
+// holds the DNS zones the ones of the guest clusters are delegated from.
+func (s *Sweeper) listInventory(ctx context.Context, azureClientSet *client.AzureClientSet, controlPlane bool) (inventory, error) {
+	inv := inventory{
+		subscriptionID: azureClientSet.SubscriptionID,
+	}
+
+	{
+		iterator, err := azureClientSet.GroupsClient.ListComplete(ctx, "", nil)
+		if err != nil {
+			return inventory{}, microerror.Mask(err)
+		}
+
+		for iterator.NotDone() {
+			group := iterator.Value()
+			if _, ok := key.ClusterIDFromTags(group.Tags, s.installationName); ok {
+				inv.groups = append(inv.groups, group)
+			}
+
+			err = iterator.NextWithContext(ctx)
+			if err != nil {
+				return inventory{}, microerror.Mask(err)
+			}
+		}
+	}
+
+	for _, group := range inv.groups {
+		clusterID, _ := key.ClusterIDFromTags(group.Tags, s.installationName)
+
+		iterator, err := azureClientSet.DeploymentsClient.ListByResourceGroupComplete(ctx, to.String(group.Name), "", nil)
+		if IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return inventory{}, microerror.Mask(err)
+		}
+
+		for iterator.NotDone() {
+			d := iterator.Value()
+			if key.IsNodePoolDeploymentName(to.String(d.Name)) {
+				inv.deployments = append(inv.deployments, deployment{clusterID: clusterID, resourceGroup: to.String(group.Name), name: to.String(d.Name)})
+			}
+
+			err = iterator.NextWithContext(ctx)
+			if err != nil {
+				return inventory{}, microerror.Mask(err)
+			}
+		}
+	}
+
+	{
+		iterator, err := azureClientSet.PublicIpAddressesClient.ListAllComplete(ctx)
+		if err != nil {
+			return inventory{}, microerror.Mask(err)
+		}
+
+		for iterator.NotDone() {
+			publicIP := iterator.Value()
+			if _, ok := key.ClusterIDFromTags(publicIP.Tags, s.installationName); ok {
+				inv.publicIPs = append(inv.publicIPs, publicIP)
+			}
+
+			err = iterator.NextWithContext(ctx)
+			if err != nil {
+				return inventory{}, microerror.Mask(err)
+			}
+		}
+	}
+
+	if !controlPlane {
+		return inv, nil
+	}
+
+	var zones []dns.Zone
+	{
+		iterator, err := azureClientSet.DNSZonesClient.ListComplete(ctx, nil)
+		if err != nil {
+			return inventory{}, microerror.Mask(err)
+		}
+
+		for iterator.NotDone() {
+			zones = append(zones, iterator.Value())
+
+			err = iterator.NextWithContext(ctx)
+			if err != nil {
+				return inventory{}, microerror.Mask(err)
+			}
+		}
+	}
+
+	for _, zone := range zones {
+		id, err := azure.ParseResourceID(to.String(zone.ID))
+		if err != nil {
+			return inventory{}, microerror.Mask(err)
+		}
+
+		iterator, err := azureClientSet.DNSRecordSetsClient.ListAllByDNSZoneComplete(ctx, id.ResourceGroup, to.String(zone.Name), nil, "")
+		if IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return inventory{}, microerror.Mask(err)
+		}
+
+		for iterator.NotDone() {
+			rs := iterator.Value()
+			if rs.RecordSetProperties != nil {
+				if _, ok := key.ClusterIDFromTags(rs.Metadata, s.installationName); ok {
+					inv.recordSets = append(inv.recordSets, recordSet{resourceGroup: id.ResourceGroup, zone: to.String(zone.Name), recordSet: rs})
+				}
+			}
+
+			err = iterator.NextWithContext(ctx)
+			if err != nil {
+				return inventory{}, microerror.Mask(err)
+			}
+		}
+	}
+
+	return inv, nil
+}
@@ -0,0 +1,29 @@
+package sweeper
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	orphanedResources = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "azure_operator_orphaned_resources",
+			Help: "Azure resources of guest clusters found by the last sweep whose cluster or node pool no longer exists.",
+		},
+		[]string{"subscription_id", "kind"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(orphanedResources)
+}
+
+// reportOrphans is a utility function for updating metrics related to the
+// orphaned resources found by a sweep.
+func reportOrphans(orphans []orphan) {
+	orphanedResources.Reset()
+
+	for _, o := range orphans {
+		orphanedResources.WithLabelValues(o.subscriptionID, o.kind).Inc()
+	}
+}
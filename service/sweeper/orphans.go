@@ -0,0 +1,301 @@
+package sweeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v5/client"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+const (
+	kindDeployment    = "deployment"
+	kindDNSRecordSet  = "dns_record_set"
+	kindPublicIP      = "public_ip"
+	kindResourceGroup = "resource_group"
+)
+
+const (
+	// provisioningStateDeleting is the provisioning state of resource groups
+	// being deleted already.
+	provisioningStateDeleting = "Deleting"
+
+	// nodePoolClusterTagName is the tag holding the cluster ID on the VMSS of
+	// node pools.
+	nodePoolClusterTagName = "cluster-autoscaler-name"
+)
+
+// orphan is a resource of a guest cluster whose cluster or node pool no
+// longer exists.
+type orphan struct {
+	kind           string
+	subscriptionID string
+	clusterID      string
+	resourceGroup  string
+	name           string
+
+	// zone and recordType identify DNS record sets in addition.
+	zone       string
+	recordType dns.RecordType
+}
+
+// liveClusters holds the IDs of the clusters which have CRs, each with the
+// names of the deployments of its node pools.
+type liveClusters map[string]map[string]bool
+
+func (c liveClusters) add(clusterID string) {
+	if clusterID == "" {
+		return
+	}
+	if _, ok := c[clusterID]; !ok {
+		c[clusterID] = map[string]bool{}
+	}
+}
+
+func (c liveClusters) addDeployment(clusterID, name string) {
+	c.add(clusterID)
+	if deployments, ok := c[clusterID]; ok {
+		deployments[name] = true
+	}
+}
+
+// findOrphans returns the resources of the given inventory whose cluster has
+// no CRs anymore, and the node pool deployments of live clusters whose node
+// pool has no CR anymore. The deployments of clusters without CRs are not
+// returned, since they go with the resource group of their cluster.
+func findOrphans(inv inventory, clusters liveClusters, installationName string) []orphan {
+	var orphans []orphan
+
+	for _, group := range inv.groups {
+		clusterID, ok := key.ClusterIDFromTags(group.Tags, installationName)
+		if !ok {
+			continue
+		}
+		if _, ok := clusters[clusterID]; ok {
+			continue
+		}
+		if group.Properties != nil && to.String(group.Properties.ProvisioningState) == provisioningStateDeleting {
+			continue
+		}
+
+		orphans = append(orphans, orphan{
+			kind:           kindResourceGroup,
+			subscriptionID: inv.subscriptionID,
+			clusterID:      clusterID,
+			resourceGroup:  to.String(group.Name),
+			name:           to.String(group.Name),
+		})
+	}
+
+	for _, d := range inv.deployments {
+		deployments, ok := clusters[d.clusterID]
+		if !ok || deployments[d.name] {
+			continue
+		}
+
+		orphans = append(orphans, orphan{
+			kind:           kindDeployment,
+			subscriptionID: inv.subscriptionID,
+			clusterID:      d.clusterID,
+			resourceGroup:  d.resourceGroup,
+			name:           d.name,
+		})
+	}
+
+	for _, publicIP := range inv.publicIPs {
+		clusterID, ok := key.ClusterIDFromTags(publicIP.Tags, installationName)
+		if !ok {
+			continue
+		}
+		if _, ok := clusters[clusterID]; ok {
+			continue
+		}
+		if publicIP.PublicIPAddressPropertiesFormat != nil && publicIP.ProvisioningState == network.Deleting {
+			continue
+		}
+
+		id, err := azure.ParseResourceID(to.String(publicIP.ID))
+		if err != nil {
+			continue
+		}
+
+		orphans = append(orphans, orphan{
+			kind:           kindPublicIP,
+			subscriptionID: inv.subscriptionID,
+			clusterID:      clusterID,
+			resourceGroup:  id.ResourceGroup,
+			name:           to.String(publicIP.Name),
+		})
+	}
+
+	for _, rs := range inv.recordSets {
+		if rs.recordSet.RecordSetProperties == nil {
+			continue
+		}
+		clusterID, ok := key.ClusterIDFromTags(rs.recordSet.Metadata, installationName)
+		if !ok {
+			continue
+		}
+		if _, ok := clusters[clusterID]; ok {
+			continue
+		}
+
+		orphans = append(orphans, orphan{
+			kind:           kindDNSRecordSet,
+			subscriptionID: inv.subscriptionID,
+			clusterID:      clusterID,
+			resourceGroup:  rs.resourceGroup,
+			name:           to.String(rs.recordSet.Name),
+			zone:           rs.zone,
+			recordType:     recordType(to.String(rs.recordSet.Type)),
+		})
+	}
+
+	return orphans
+}
+
+// recordType returns the type of a record set from its resource type, e.g.
+// "NS" from "Microsoft.Network/dnszones/NS".
+func recordType(resourceType string) dns.RecordType {
+	return dns.RecordType(resourceType[strings.LastIndex(resourceType, "/")+1:])
+}
+
+// deleteOrphan deletes the given orphaned resource. Resource groups protected
+// against deletion by a management lock are kept. The VMSS of orphaned node
+// pool deployments is deleted along with them, which deletes the network
+// interfaces of its instances.
+func (s *Sweeper) deleteOrphan(ctx context.Context, azureClientSet *client.AzureClientSet, o orphan) error {
+	s.logger.Debugf(ctx, "deleting orphaned %s %#q in resource group %#q", o.kind, o.name, o.resourceGroup)
+
+	switch o.kind {
+	case kindResourceGroup:
+		locked, err := isDeletionProtected(ctx, azureClientSet, o.resourceGroup)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		if locked {
+			s.logger.Debugf(ctx, "not deleting orphaned %s %#q protected against deletion", o.kind, o.name)
+			return nil
+		}
+
+		res, err := azureClientSet.GroupsClient.Delete(ctx, o.resourceGroup)
+		if IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+		_, err = azureClientSet.GroupsClient.DeleteResponder(res.Response())
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+	case kindDeployment:
+		err := s.deleteNodePoolVMSS(ctx, azureClientSet, o)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		res, err := azureClientSet.DeploymentsClient.Delete(ctx, o.resourceGroup, o.name)
+		if IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+		_, err = azureClientSet.DeploymentsClient.DeleteResponder(res.Response())
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+	case kindPublicIP:
+		res, err := azureClientSet.PublicIpAddressesClient.Delete(ctx, o.resourceGroup, o.name)
+		if IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+		_, err = azureClientSet.PublicIpAddressesClient.DeleteResponder(res.Response())
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+	case kindDNSRecordSet:
+		_, err := azureClientSet.DNSRecordSetsClient.Delete(ctx, o.resourceGroup, o.zone, o.name, o.recordType, "")
+		if IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	s.logger.Debugf(ctx, "deleted orphaned %s %#q in resource group %#q", o.kind, o.name, o.resourceGroup)
+
+	return nil
+}
+
+// deleteNodePoolVMSS deletes the VMSS of the given orphaned node pool
+// deployment, which is named like the deployment. Only a VMSS tagged with the
+// cluster of the deployment is deleted.
+func (s *Sweeper) deleteNodePoolVMSS(ctx context.Context, azureClientSet *client.AzureClientSet, o orphan) error {
+	if !strings.HasPrefix(o.name, "nodepool-") {
+		return nil
+	}
+
+	vmss, err := azureClientSet.VirtualMachineScaleSetsClient.Get(ctx, o.resourceGroup, o.name)
+	if IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if to.String(vmss.Tags[nodePoolClusterTagName]) != o.clusterID {
+		s.logger.Debugf(ctx, "not deleting VMSS %#q of orphaned %s %#q not tagged with cluster %#q", o.name, o.kind, o.name, o.clusterID)
+		return nil
+	}
+
+	s.logger.Debugf(ctx, "deleting VMSS %#q of orphaned %s %#q", o.name, o.kind, o.name)
+
+	res, err := azureClientSet.VirtualMachineScaleSetsClient.Delete(ctx, o.resourceGroup, o.name)
+	if IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+	_, err = azureClientSet.VirtualMachineScaleSetsClient.DeleteResponder(res.Response())
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	s.logger.Debugf(ctx, "deleted VMSS %#q of orphaned %s %#q", o.name, o.kind, o.name)
+
+	return nil
+}
+
+// isDeletionProtected returns true when any resource of the given resource
+// group has the management lock of clusters protected against deletion.
+func isDeletionProtected(ctx context.Context, azureClientSet *client.AzureClientSet, resourceGroup string) (bool, error) {
+	iterator, err := azureClientSet.ManagementLocksClient.ListAtResourceGroupLevelComplete(ctx, resourceGroup, "")
+	if IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	for iterator.NotDone() {
+		if to.String(iterator.Value().Name) == key.DeletionProtectionLockName {
+			return true, nil
+		}
+
+		err = iterator.NextWithContext(ctx)
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,136 @@
+package sweeper
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func Test_findOrphans(t *testing.T) {
+	testCases := []struct {
+		name            string
+		inv             inventory
+		clusters        liveClusters
+		expectedOrphans []orphan
+	}{
+		{
+			name: "case 0: resources of live cluster",
+			inv: inventory{
+				subscriptionID: "sub",
+				groups:         []resources.Group{newGroup("abc12", "ghost", "")},
+				deployments:    []deployment{{clusterID: "abc12", resourceGroup: "abc12", name: "nodepool-np001"}},
+				publicIPs:      []network.PublicIPAddress{newPublicIP("abc12", "ghost", "abc12", "abc12-API-PublicLoadBalancer-PublicIP")},
+			},
+			clusters: liveClusters{"abc12": {"nodepool-np001": true}},
+		},
+		{
+			name: "case 1: resource group of deleted cluster",
+			inv: inventory{
+				subscriptionID: "sub",
+				groups:         []resources.Group{newGroup("abc12", "ghost", "Succeeded")},
+				deployments:    []deployment{{clusterID: "abc12", resourceGroup: "abc12", name: "nodepool-np001"}},
+			},
+			clusters: liveClusters{},
+			expectedOrphans: []orphan{
+				{kind: kindResourceGroup, subscriptionID: "sub", clusterID: "abc12", resourceGroup: "abc12", name: "abc12"},
+			},
+		},
+		{
+			name: "case 2: resource group of deleted cluster being deleted",
+			inv: inventory{
+				subscriptionID: "sub",
+				groups:         []resources.Group{newGroup("abc12", "ghost", provisioningStateDeleting)},
+			},
+			clusters: liveClusters{},
+		},
+		{
+			name: "case 3: resource group of other installation",
+			inv: inventory{
+				subscriptionID: "sub",
+				groups:         []resources.Group{newGroup("abc12", "other", "Succeeded")},
+			},
+			clusters: liveClusters{},
+		},
+		{
+			name: "case 4: deployment of deleted node pool",
+			inv: inventory{
+				subscriptionID: "sub",
+				groups:         []resources.Group{newGroup("abc12", "ghost", "Succeeded")},
+				deployments: []deployment{
+					{clusterID: "abc12", resourceGroup: "abc12", name: "nodepool-np001"},
+					{clusterID: "abc12", resourceGroup: "abc12", name: "nodepool-np002"},
+				},
+			},
+			clusters: liveClusters{"abc12": {"nodepool-np001": true}},
+			expectedOrphans: []orphan{
+				{kind: kindDeployment, subscriptionID: "sub", clusterID: "abc12", resourceGroup: "abc12", name: "nodepool-np002"},
+			},
+		},
+		{
+			name: "case 5: public IP and DNS record set of deleted cluster",
+			inv: inventory{
+				subscriptionID: "sub",
+				publicIPs:      []network.PublicIPAddress{newPublicIP("abc12", "ghost", "abc12", "abc12-API-PublicLoadBalancer-PublicIP")},
+				recordSets: []recordSet{
+					{
+						resourceGroup: "root_dns_zone_rg",
+						zone:          "ghost.example.com",
+						recordSet: dns.RecordSet{
+							Name: to.StringPtr("abc12"),
+							Type: to.StringPtr("Microsoft.Network/dnszones/NS"),
+							RecordSetProperties: &dns.RecordSetProperties{
+								Metadata: newTags("abc12", "ghost"),
+							},
+						},
+					},
+				},
+			},
+			clusters: liveClusters{"def34": {}},
+			expectedOrphans: []orphan{
+				{kind: kindPublicIP, subscriptionID: "sub", clusterID: "abc12", resourceGroup: "abc12", name: "abc12-API-PublicLoadBalancer-PublicIP"},
+				{kind: kindDNSRecordSet, subscriptionID: "sub", clusterID: "abc12", resourceGroup: "root_dns_zone_rg", name: "abc12", zone: "ghost.example.com", recordType: dns.NS},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			orphans := findOrphans(tc.inv, tc.clusters, "ghost")
+			if !reflect.DeepEqual(orphans, tc.expectedOrphans) {
+				t.Fatalf("expected %#v, got %#v", tc.expectedOrphans, orphans)
+			}
+		})
+	}
+}
+
+func newTags(clusterID, installationName string) map[string]*string {
+	return map[string]*string{
+		"GiantSwarmCluster":      to.StringPtr(clusterID),
+		"GiantSwarmInstallation": to.StringPtr(installationName),
+	}
+}
+
+func newGroup(clusterID, installationName, provisioningState string) resources.Group {
+	return resources.Group{
+		Name: to.StringPtr(clusterID),
+		Tags: newTags(clusterID, installationName),
+		Properties: &resources.GroupProperties{
+			ProvisioningState: to.StringPtr(provisioningState),
+		},
+	}
+}
+
+func newPublicIP(clusterID, installationName, resourceGroup, name string) network.PublicIPAddress {
+	return network.PublicIPAddress{
+		ID:   to.StringPtr("/subscriptions/sub/resourceGroups/" + resourceGroup + "/providers/Microsoft.Network/publicIPAddresses/" + name),
+		Name: to.StringPtr(name),
+		Tags: newTags(clusterID, installationName),
+	}
+}
@@ -0,0 +1,314 @@
+package sweeper
+
+import (
+	"context"
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+	expcapzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/azure-operator/v5/client"
+	"github.com/giantswarm/azure-operator/v5/pkg/credential"
+	"github.com/giantswarm/azure-operator/v5/pkg/locker"
+	"github.com/giantswarm/azure-operator/v5/pkg/ratelimit"
+	"github.com/giantswarm/azure-operator/v5/service/collector"
+	"github.com/giantswarm/azure-operator/v5/service/controller/key"
+)
+
+type Config struct {
+	AzureMetricsCollector collector.AzureAPIMetrics
+	AzureRateLimiter      *ratelimit.Limiter
+	CPAzureClientSet      *client.AzureClientSet
+	CredentialProvider    credential.Provider
+	CtrlClient            ctrlclient.Client
+	// Locker elects the replica sweeping for orphaned resources, so that
+	// replicas do not delete the same resources concurrently.
+	Locker locker.Interface
+	Logger micrologger.Logger
+
+	// Delete enables deleting the orphaned resources found, which are only
+	// reported otherwise.
+	Delete           bool
+	InstallationName string
+	// Interval is the time between two sweeps, 0 disables sweeping.
+	Interval time.Duration
+}
+
+// Sweeper periodically looks for Azure resources of guest clusters of the
+// installation whose cluster or node pool no longer exists, e.g. because
+// their deletion was interrupted. The resources are found by the tags the
+// operator puts on them, in the subscription of the control plane and the
+// subscriptions of all organizations.
+type Sweeper struct {
+	azureMetricsCollector collector.AzureAPIMetrics
+	azureRateLimiter      *ratelimit.Limiter
+	cpAzureClientSet      *client.AzureClientSet
+	credentialProvider    credential.Provider
+	ctrlClient            ctrlclient.Client
+	locker                locker.Interface
+	logger                micrologger.Logger
+
+	delete           bool
+	installationName string
+	interval         time.Duration
+}
+
+func New(config Config) (*Sweeper, error) {
+	if config.AzureMetricsCollector == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.AzureMetricsCollector must not be empty", config)
+	}
+	if config.AzureRateLimiter == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.AzureRateLimiter must not be empty", config)
+	}
+	if config.CPAzureClientSet == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CPAzureClientSet must not be empty", config)
+	}
+	if config.CredentialProvider == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CredentialProvider must not be empty", config)
+	}
+	if config.CtrlClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CtrlClient must not be empty", config)
+	}
+	if config.Locker == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Locker must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	if config.InstallationName == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.InstallationName must not be empty", config)
+	}
+	if config.Interval < 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Interval must not be negative", config)
+	}
+
+	s := &Sweeper{
+		azureMetricsCollector: config.AzureMetricsCollector,
+		azureRateLimiter:      config.AzureRateLimiter,
+		cpAzureClientSet:      config.CPAzureClientSet,
+		credentialProvider:    config.CredentialProvider,
+		ctrlClient:            config.CtrlClient,
+		locker:                config.Locker,
+		logger:                config.Logger,
+
+		delete:           config.Delete,
+		installationName: config.InstallationName,
+		interval:         config.Interval,
+	}
+
+	return s, nil
+}
+
+// Boot sweeps in the configured interval until the given context is done.
+// Only the replica holding the lease of the configured locker sweeps. The
+// other replicas try to take over the lease in every interval.
+func (s *Sweeper) Boot(ctx context.Context) {
+	if s.interval == 0 {
+		s.logger.Debugf(ctx, "sweeping for orphaned resources is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		lease, err := s.locker.Lock(ctx)
+		if locker.IsAlreadyExists(err) {
+			s.logger.Debugf(ctx, "not sweeping for orphaned resources, another replica is the leader")
+			continue
+		} else if err != nil {
+			s.logger.Errorf(ctx, err, "failed to acquire the sweeper lease")
+			continue
+		}
+
+		s.lead(ctx, lease, ticker)
+	}
+}
+
+// lead sweeps in the interval of the given ticker for as long as the given
+// lease is held. The lease is released when the given context is done.
+func (s *Sweeper) lead(ctx context.Context, lease locker.Lease, ticker *time.Ticker) {
+	s.logger.Debugf(ctx, "became the leader for sweeping for orphaned resources")
+
+	leaderCtx, cancel := locker.KeepAlive(ctx, lease)
+	defer cancel()
+
+	for {
+		err := s.Sweep(leaderCtx)
+		if err != nil {
+			s.logger.Errorf(ctx, err, "failed to sweep for orphaned resources")
+		}
+
+		select {
+		case <-ctx.Done():
+			// The context of the operator is done, so the lease is released
+			// with a fresh context to let another replica take over right
+			// away.
+			err = lease.Release(context.Background())
+			if err != nil && !locker.IsNotFound(err) {
+				s.logger.Errorf(ctx, err, "failed to release the sweeper lease")
+			}
+			return
+		case <-leaderCtx.Done():
+			s.logger.Debugf(ctx, "lost the lease for sweeping for orphaned resources")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep looks for orphaned resources once, reports them in the
+// azure_operator_orphaned_resources metric and deletes them when enabled.
+func (s *Sweeper) Sweep(ctx context.Context) error {
+	s.logger.Debugf(ctx, "sweeping for orphaned resources")
+
+	azureClientSets, err := s.getAzureClientSets(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	// The resources are listed before the CRs, so that the resources of
+	// clusters created in the meantime are not taken for orphans.
+	var inventories []inventory
+	for subscriptionID, azureClientSet := range azureClientSets {
+		inv, err := s.listInventory(ctx, azureClientSet, subscriptionID == s.cpAzureClientSet.SubscriptionID)
+		if err != nil {
+			s.logger.Errorf(ctx, err, "failed to list resources of subscription %#q", subscriptionID)
+			continue
+		}
+
+		inventories = append(inventories, inv)
+	}
+
+	clusters, err := s.listClusters(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	var orphans []orphan
+	for _, inv := range inventories {
+		orphans = append(orphans, findOrphans(inv, clusters, s.installationName)...)
+	}
+
+	reportOrphans(orphans)
+
+	for _, o := range orphans {
+		s.logger.LogCtx(ctx, "level", "warning", "message", "found orphaned resource", "kind", o.kind, "subscription", o.subscriptionID, "cluster", o.clusterID, "resourceGroup", o.resourceGroup, "name", o.name)
+
+		if !s.delete {
+			continue
+		}
+
+		err = s.deleteOrphan(ctx, azureClientSets[o.subscriptionID], o)
+		if err != nil {
+			s.logger.Errorf(ctx, err, "failed to delete orphaned %s %#q in resource group %#q", o.kind, o.name, o.resourceGroup)
+			continue
+		}
+	}
+
+	s.logger.Debugf(ctx, "swept for orphaned resources, found %d", len(orphans))
+
+	return nil
+}
+
+// getAzureClientSets returns the client sets of the subscription of the
+// control plane and the subscriptions of all organizations by subscription ID.
+func (s *Sweeper) getAzureClientSets(ctx context.Context) (map[string]*client.AzureClientSet, error) {
+	azureClientSets := map[string]*client.AzureClientSet{
+		s.cpAzureClientSet.SubscriptionID: s.cpAzureClientSet,
+	}
+
+	selector, err := labels.Parse(key.OrganizationSecretsLabelSelector)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var secrets corev1.SecretList
+	err = s.ctrlClient.List(ctx, &secrets, ctrlclient.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	for _, secret := range secrets.Items {
+		credentials, subscriptionID, partnerID, err := s.credentialProvider.GetOrganizationAzureCredentials(ctx, secret.Namespace, secret.Name)
+		if err != nil {
+			s.logger.Errorf(ctx, err, "failed to get credentials from secret %#q in namespace %#q", secret.Name, secret.Namespace)
+			continue
+		}
+		if _, ok := azureClientSets[subscriptionID]; ok {
+			continue
+		}
+
+		azureClientSet, err := client.NewAzureClientSet(credentials, s.azureMetricsCollector, s.azureRateLimiter, subscriptionID, partnerID)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		azureClientSets[subscriptionID] = azureClientSet
+	}
+
+	return azureClientSets, nil
+}
+
+// listClusters returns the IDs of the clusters which have CRs together with
+// the names of the deployments of their node pools.
+func (s *Sweeper) listClusters(ctx context.Context) (liveClusters, error) {
+	clusters := liveClusters{}
+
+	{
+		var azureConfigs providerv1alpha1.AzureConfigList
+		err := s.ctrlClient.List(ctx, &azureConfigs)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		for i := range azureConfigs.Items {
+			clusters.add(azureConfigs.Items[i].Name)
+			clusters.add(key.ClusterID(&azureConfigs.Items[i]))
+		}
+	}
+
+	{
+		var azureClusters capzv1alpha3.AzureClusterList
+		err := s.ctrlClient.List(ctx, &azureClusters)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		for i := range azureClusters.Items {
+			clusters.add(azureClusters.Items[i].Name)
+			clusters.add(key.ClusterID(&azureClusters.Items[i]))
+		}
+	}
+
+	{
+		var azureMachinePools expcapzv1alpha3.AzureMachinePoolList
+		err := s.ctrlClient.List(ctx, &azureMachinePools)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		for i := range azureMachinePools.Items {
+			amp := &azureMachinePools.Items[i]
+			clusterID := key.ClusterID(amp)
+			clusters.add(clusterID)
+			clusters.addDeployment(clusterID, key.NodePoolDeploymentName(amp))
+			clusters.addDeployment(clusterID, key.SubnetDeploymentName(amp.Name))
+		}
+	}
+
+	return clusters, nil
+}